@@ -21,6 +21,8 @@ var DefaultRoles = map[string]string{
 	atc.CreateBuild:                   MemberRole,
 	atc.ListBuilds:                    ViewerRole,
 	atc.BuildEvents:                   ViewerRole,
+	atc.GetBuildStepLog:               ViewerRole,
+	atc.DownloadBuildLog:              ViewerRole,
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
@@ -39,6 +41,7 @@ var DefaultRoles = map[string]string{
 	atc.JobBadge:                      ViewerRole,
 	atc.MainJobBadge:                  ViewerRole,
 	atc.ClearTaskCache:                OperatorRole,
+	atc.ClearTaskResultCache:          OperatorRole,
 	atc.ListAllResources:              ViewerRole,
 	atc.ListResources:                 ViewerRole,
 	atc.ListResourceTypes:             ViewerRole,
@@ -59,6 +62,8 @@ var DefaultRoles = map[string]string{
 	atc.ListAllPipelines:              ViewerRole,
 	atc.ListPipelines:                 ViewerRole,
 	atc.GetPipeline:                   ViewerRole,
+	atc.GetPipelineGraph:              ViewerRole,
+	atc.GetPipelineStats:              ViewerRole,
 	atc.DeletePipeline:                MemberRole,
 	atc.OrderPipelines:                MemberRole,
 	atc.PausePipeline:                 OperatorRole,
@@ -87,12 +92,17 @@ var DefaultRoles = map[string]string{
 	atc.HijackContainer:               MemberRole,
 	atc.ListDestroyingContainers:      ViewerRole,
 	atc.ReportWorkerContainers:        MemberRole,
+	atc.ListHijackSessions:            OwnerRole,
+	atc.GetHijackSessionRecording:     OwnerRole,
+	atc.ListLockPools:                 ViewerRole,
+	atc.ReleaseLockPool:               OperatorRole,
 	atc.ListVolumes:                   ViewerRole,
 	atc.ListDestroyingVolumes:         ViewerRole,
 	atc.ReportWorkerVolumes:           MemberRole,
 	atc.ListTeams:                     ViewerRole,
 	atc.GetTeam:                       ViewerRole,
 	atc.SetTeam:                       OwnerRole,
+	atc.ApplyTeam:                     OwnerRole,
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
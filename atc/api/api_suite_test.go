@@ -12,10 +12,12 @@ import (
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/api/componentserver/componentserverfakes"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
@@ -57,6 +59,13 @@ var (
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbMaintenance           *dbfakes.FakeMaintenance
+	dbLockPoolFactory       *dbfakes.FakeLockPoolFactory
+	dbSystemLockFactory     *dbfakes.FakeSystemLockFactory
+	dbHijackSessionFactory  *dbfakes.FakeHijackSessionFactory
+	dbComponentFactory      *dbfakes.FakeComponentFactory
+	fakeComponentNotifier   *componentserverfakes.FakeNotifier
+	lintRules               atc.LintRules
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -104,6 +113,13 @@ var _ = BeforeEach(func() {
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbMaintenance = new(dbfakes.FakeMaintenance)
+	dbLockPoolFactory = new(dbfakes.FakeLockPoolFactory)
+	dbSystemLockFactory = new(dbfakes.FakeSystemLockFactory)
+	dbHijackSessionFactory = new(dbfakes.FakeHijackSessionFactory)
+	dbComponentFactory = new(dbfakes.FakeComponentFactory)
+	fakeComponentNotifier = new(componentserverfakes.FakeNotifier)
+	lintRules = atc.LintRules{}
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -191,6 +207,11 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbLockPoolFactory,
+		dbSystemLockFactory,
+		dbHijackSessionFactory,
+		dbComponentFactory,
+		fakeComponentNotifier,
 
 		constructedEventHandler.Construct,
 
@@ -208,10 +229,13 @@ var _ = BeforeEach(func() {
 		credsManagers,
 		interceptTimeoutFactory,
 		time.Second,
+		true, /* recordHijackSessions */
 		dbWall,
+		dbMaintenance,
 		fakeClock,
 
 		true, /* enableArchivePipeline */
+		lintRules,
 	)
 
 	Expect(err).NotTo(HaveOccurred())
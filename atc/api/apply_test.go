@@ -0,0 +1,425 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply API", func() {
+	Describe("PUT /api/v1/teams/:team_name/apply", func() {
+		var (
+			fakeTeam *dbfakes.FakeTeam
+
+			existingPipeline  *dbfakes.FakePipeline
+			unmanagedPipeline *dbfakes.FakePipeline
+
+			teamAuth    atc.TeamAuth
+			manifest    atc.TeamManifest
+			queryParams string
+
+			response *http.Response
+			plan     atc.TeamApplyPlan
+		)
+
+		validConfig := func(jobName string) atc.Config {
+			return atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: jobName,
+						PlanSequence: []atc.Step{
+							{
+								Config: &atc.TaskStep{
+									Name:       "some-task",
+									ConfigPath: "some/config/path.yml",
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			fakeTeam = new(dbfakes.FakeTeam)
+			fakeTeam.NameReturns("some-team")
+
+			teamAuth = atc.TeamAuth{
+				"owner": map[string][]string{
+					"groups": {}, "users": {"local:username"},
+				},
+			}
+			fakeTeam.AuthReturns(teamAuth)
+
+			existingPipeline = new(dbfakes.FakePipeline)
+			existingPipeline.NameReturns("existing")
+			existingPipeline.ConfigReturns(validConfig("some-job"), nil)
+			existingPipeline.ConfigVersionReturns(db.ConfigVersion(42))
+
+			unmanagedPipeline = new(dbfakes.FakePipeline)
+			unmanagedPipeline.NameReturns("unmanaged")
+
+			fakeTeam.PipelinesReturns([]db.Pipeline{existingPipeline, unmanagedPipeline}, nil)
+
+			manifest = atc.TeamManifest{
+				Pipelines: []atc.PipelineManifest{
+					{Name: "existing", Config: validConfig("some-job")},
+				},
+			}
+			queryParams = ""
+
+			plan = atc.TeamApplyPlan{}
+		})
+
+		JustBeforeEach(func() {
+			path := fmt.Sprintf("%s/api/v1/teams/some-team/apply%s", server.URL, queryParams)
+
+			request, err := http.NewRequest("PUT", path, jsonEncode(manifest))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			if response.StatusCode == http.StatusOK {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				Expect(json.NewDecoder(response.Body).Decode(&plan)).To(Succeed())
+			}
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized for the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			Context("when nothing has changed", func() {
+				It("returns a plan without changes", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(plan).To(Equal(atc.TeamApplyPlan{
+						Team: "some-team",
+						Pipelines: []atc.PipelineApplyPlan{
+							{Name: "existing", Action: atc.ApplyActionNone, ConfigVersion: 42},
+						},
+					}))
+				})
+
+				It("does not save anything", func() {
+					Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+					Expect(fakeTeam.OrderPipelinesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when pipelines are added, changed, paused and exposed", func() {
+				BeforeEach(func() {
+					manifest.Pipelines = []atc.PipelineManifest{
+						{Name: "new", Config: validConfig("new-job"), Exposed: true},
+						{Name: "existing", Config: validConfig("other-job"), Paused: true},
+					}
+
+					fakeTeam.SavePipelineReturns(new(dbfakes.FakePipeline), true, nil)
+				})
+
+				It("returns the applied plan", func() {
+					paused := true
+					exposed := true
+
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(plan.Team).To(Equal("some-team"))
+					Expect(plan.Reorder).To(BeTrue())
+					Expect(plan.Applied).To(BeTrue())
+					Expect(plan.Pipelines).To(HaveLen(2))
+
+					Expect(plan.Pipelines[0].Name).To(Equal("new"))
+					Expect(plan.Pipelines[0].Action).To(Equal(atc.ApplyActionCreate))
+					Expect(plan.Pipelines[0].Expose).To(Equal(&exposed))
+					Expect(plan.Pipelines[0].Diff).To(ContainSubstring("job new-job has been added"))
+
+					Expect(plan.Pipelines[0].Applied).To(BeTrue())
+
+					Expect(plan.Pipelines[1].Name).To(Equal("existing"))
+					Expect(plan.Pipelines[1].Action).To(Equal(atc.ApplyActionUpdate))
+					Expect(plan.Pipelines[1].ConfigVersion).To(Equal(42))
+					Expect(plan.Pipelines[1].Applied).To(BeTrue())
+					Expect(plan.Pipelines[1].Pause).To(Equal(&paused))
+					Expect(plan.Pipelines[1].Diff).To(ContainSubstring("job some-job has been removed"))
+					Expect(plan.Pipelines[1].Diff).ToNot(ContainSubstring("\x1b["))
+				})
+
+				It("saves the pipelines", func() {
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(2))
+
+					name, config, from, paused := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal("new"))
+					Expect(config).To(Equal(validConfig("new-job")))
+					Expect(from).To(Equal(db.ConfigVersion(0)))
+					Expect(paused).To(BeFalse())
+
+					name, config, from, paused = fakeTeam.SavePipelineArgsForCall(1)
+					Expect(name).To(Equal("existing"))
+					Expect(config).To(Equal(validConfig("other-job")))
+					Expect(from).To(Equal(db.ConfigVersion(42)))
+					Expect(paused).To(BeTrue())
+				})
+
+				It("pauses the existing pipeline", func() {
+					Expect(existingPipeline.PauseCallCount()).To(Equal(1))
+				})
+
+				It("orders the pipelines", func() {
+					Expect(fakeTeam.OrderPipelinesCallCount()).To(Equal(1))
+					Expect(fakeTeam.OrderPipelinesArgsForCall(0)).To(Equal([]string{"new", "existing"}))
+				})
+
+				It("leaves unmanaged pipelines alone", func() {
+					Expect(unmanagedPipeline.DestroyCallCount()).To(BeZero())
+				})
+
+				It("notifies the resource scanner", func() {
+					Expect(dbTeamFactory.NotifyResourceScannerCallCount()).To(Equal(1))
+				})
+
+				Context("when it is a dry run", func() {
+					BeforeEach(func() {
+						queryParams = "?dry_run=true"
+					})
+
+					It("returns the plan without applying it", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(plan.Applied).To(BeFalse())
+						Expect(plan.Pipelines).To(HaveLen(2))
+
+						Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+						Expect(existingPipeline.PauseCallCount()).To(BeZero())
+						Expect(fakeTeam.OrderPipelinesCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the confirmed plan's config versions are sent", func() {
+					BeforeEach(func() {
+						manifest.ConfigVersions = map[string]int{"new": 0, "existing": 42}
+					})
+
+					It("applies the plan", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(plan.Applied).To(BeTrue())
+						Expect(fakeTeam.SavePipelineCallCount()).To(Equal(2))
+					})
+
+					Context("when a pipeline has been saved since", func() {
+						BeforeEach(func() {
+							manifest.ConfigVersions["existing"] = 41
+						})
+
+						It("returns 409 Conflict naming the pipeline", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("pipelines changed since the plan was made: existing"))
+						})
+
+						It("does not apply anything", func() {
+							Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+							Expect(existingPipeline.PauseCallCount()).To(BeZero())
+							Expect(fakeTeam.OrderPipelinesCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when a pipeline to be created has been created since", func() {
+						BeforeEach(func() {
+							newPipeline := new(dbfakes.FakePipeline)
+							newPipeline.NameReturns("new")
+							newPipeline.ConfigReturns(validConfig("new-job"), nil)
+							newPipeline.ConfigVersionReturns(db.ConfigVersion(43))
+
+							fakeTeam.PipelinesReturns([]db.Pipeline{existingPipeline, unmanagedPipeline, newPipeline}, nil)
+						})
+
+						It("returns 409 Conflict", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when saving a pipeline fails", func() {
+					BeforeEach(func() {
+						fakeTeam.SavePipelineReturnsOnCall(1, nil, false, errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server Error with the partially applied plan", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+						Expect(json.NewDecoder(response.Body).Decode(&plan)).To(Succeed())
+
+						Expect(plan.Applied).To(BeFalse())
+						Expect(plan.Error).To(Equal("nope"))
+						Expect(plan.Pipelines).To(HaveLen(2))
+						Expect(plan.Pipelines[0].Applied).To(BeTrue())
+						Expect(plan.Pipelines[1].Applied).To(BeFalse())
+					})
+
+					It("does not continue applying", func() {
+						Expect(existingPipeline.PauseCallCount()).To(BeZero())
+						Expect(fakeTeam.OrderPipelinesCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when a pipeline was saved while applying", func() {
+					BeforeEach(func() {
+						fakeTeam.SavePipelineReturnsOnCall(1, nil, false, db.ErrConfigComparisonFailed)
+					})
+
+					It("returns 409 Conflict with the partially applied plan", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(json.NewDecoder(response.Body).Decode(&plan)).To(Succeed())
+						Expect(plan.Error).To(Equal(db.ErrConfigComparisonFailed.Error()))
+						Expect(plan.Pipelines[0].Applied).To(BeTrue())
+						Expect(plan.Pipelines[1].Applied).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when pruning", func() {
+				BeforeEach(func() {
+					queryParams = "?prune=true"
+				})
+
+				It("destroys unmanaged pipelines", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(plan.Pipelines).To(ContainElement(atc.PipelineApplyPlan{
+						Name:    "unmanaged",
+						Action:  atc.ApplyActionDestroy,
+						Applied: true,
+					}))
+
+					Expect(unmanagedPipeline.DestroyCallCount()).To(Equal(1))
+					Expect(existingPipeline.DestroyCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the auth changes", func() {
+				BeforeEach(func() {
+					manifest.Auth = atc.TeamAuth{
+						"owner": map[string][]string{
+							"groups": {}, "users": {"local:someone-else"},
+						},
+					}
+				})
+
+				It("updates the team's auth", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(plan.UpdateAuth).To(BeTrue())
+
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateProviderAuthArgsForCall(0)).To(Equal(manifest.Auth))
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a pipeline config is invalid", func() {
+				BeforeEach(func() {
+					manifest.Pipelines = append(manifest.Pipelines, atc.PipelineManifest{
+						Name: "broken",
+						Config: atc.Config{
+							Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "some-job"}},
+						},
+					})
+				})
+
+				It("returns 400 with the errors of each pipeline", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("pipeline 'broken': "))
+
+					Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a pipeline is declared twice", func() {
+				BeforeEach(func() {
+					manifest.Pipelines = append(manifest.Pipelines, manifest.Pipelines[0])
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{"errors":["pipeline 'existing' is declared more than once"]}`))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbTeamFactory.CreateTeamCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when authorized as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+					dbTeamFactory.CreateTeamReturns(fakeTeam, nil)
+					fakeTeam.SavePipelineReturns(new(dbfakes.FakePipeline), true, nil)
+
+					manifest.Auth = teamAuth
+				})
+
+				It("creates the team and its pipelines", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(plan.CreateTeam).To(BeTrue())
+					Expect(plan.Applied).To(BeTrue())
+
+					Expect(dbTeamFactory.CreateTeamCallCount()).To(Equal(1))
+					Expect(dbTeamFactory.CreateTeamArgsForCall(0)).To(Equal(atc.Team{
+						Name: "some-team",
+						Auth: teamAuth,
+					}))
+
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+				})
+
+				Context("when the manifest has no auth", func() {
+					BeforeEach(func() {
+						manifest.Auth = nil
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbTeamFactory.CreateTeamCallCount()).To(BeZero())
+					})
+				})
+			})
+		})
+	})
+})
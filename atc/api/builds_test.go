@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -82,6 +84,38 @@ var _ = Describe("Builds API", func() {
 					fakeAccess.IsAuthorizedReturns(true)
 				})
 
+				Context("when the cluster is under maintenance", func() {
+					BeforeEach(func() {
+						dbMaintenance.StatusReturns(atc.Maintenance{
+							Enabled: true,
+							Message: "upgrading postgres",
+						}, nil)
+					})
+
+					It("returns 503 with the maintenance message", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("cluster is under maintenance: upgrading postgres"))
+					})
+
+					It("does not create a build", func() {
+						Expect(dbTeam.CreateStartedBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when getting the maintenance status fails", func() {
+					BeforeEach(func() {
+						dbMaintenance.StatusReturns(atc.Maintenance{}, errors.New("oh no!"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						Expect(dbTeam.CreateStartedBuildCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when creating a started build fails", func() {
 					BeforeEach(func() {
 						dbTeam.CreateStartedBuildReturns(nil, errors.New("oh no!"))
@@ -597,6 +631,51 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						It("does not estimate when a finished build will finish", func() {
+							Expect(build.DurationEstimateCallCount()).To(BeZero())
+						})
+
+						Context("when the build is running", func() {
+							BeforeEach(func() {
+								build.StatusReturns(db.BuildStatusStarted)
+								build.EndTimeReturns(time.Time{})
+							})
+
+							Context("when its duration can be estimated", func() {
+								BeforeEach(func() {
+									build.DurationEstimateReturns(db.DurationEstimate{Build: 5 * time.Minute}, true, nil)
+								})
+
+								It("returns the estimated finish", func() {
+									var presented atc.Build
+									Expect(json.NewDecoder(response.Body).Decode(&presented)).To(Succeed())
+									Expect(presented.EstimatedFinish).To(Equal(int64(301)))
+								})
+							})
+
+							Context("when its duration cannot be estimated", func() {
+								BeforeEach(func() {
+									build.DurationEstimateReturns(db.DurationEstimate{}, false, nil)
+								})
+
+								It("leaves out the estimated finish", func() {
+									body, err := ioutil.ReadAll(response.Body)
+									Expect(err).NotTo(HaveOccurred())
+									Expect(string(body)).ToNot(ContainSubstring("estimated_finish"))
+								})
+							})
+
+							Context("when estimating its duration fails", func() {
+								BeforeEach(func() {
+									build.DurationEstimateReturns(db.DurationEstimate{}, false, errors.New("nope"))
+								})
+
+								It("still returns the build", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+								})
+							})
+						})
 					})
 				})
 			})
@@ -976,6 +1055,130 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("build logs", func() {
+		var fakeEventSource *dbfakes.FakeEventSource
+
+		logEvent := func(origin string, payload string) event.Envelope {
+			data, err := json.Marshal(event.Log{
+				Origin:  event.Origin{ID: event.OriginID(origin)},
+				Payload: payload,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			msg := json.RawMessage(data)
+			return event.Envelope{
+				Data:    &msg,
+				Event:   event.EventTypeLog,
+				Version: "5.1",
+			}
+		}
+
+		BeforeEach(func() {
+			fakeEventSource = new(dbfakes.FakeEventSource)
+			fakeEventSource.NextReturnsOnCall(0, logEvent("some-origin", "hello "), nil)
+			fakeEventSource.NextReturnsOnCall(1, logEvent("other-origin", "elsewhere "), nil)
+			fakeEventSource.NextReturnsOnCall(2, logEvent("some-origin", "world"), nil)
+			fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+			build.EventsReturns(fakeEventSource, nil)
+			build.FilteredEventsReturns(fakeEventSource, nil)
+
+			build.IDReturns(128)
+			build.JobNameReturns("some-job")
+			build.TeamNameReturns("some-team")
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		Describe("GET /api/v1/builds/:build_id/steps/:origin/log", func() {
+			var response *http.Response
+
+			JustBeforeEach(func() {
+				var err error
+
+				response, err = client.Get(server.URL + "/api/v1/builds/128/steps/some-origin/log")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the logs of the step as plain text", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal("hello world"))
+			})
+
+			It("filters the events by the origin", func() {
+				Expect(build.FilteredEventsCallCount()).To(Equal(1))
+				from, filter := build.FilteredEventsArgsForCall(0)
+				Expect(from).To(BeZero())
+				Expect(filter).To(Equal(db.EventsFilter{Origins: []string{"some-origin"}}))
+			})
+
+			It("closes the event source", func() {
+				Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					build.FilteredEventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Describe("GET /api/v1/builds/:build_id/log", func() {
+			var response *http.Response
+
+			JustBeforeEach(func() {
+				var err error
+
+				response, err = client.Get(server.URL + "/api/v1/builds/128/log")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the logs of every step gzipped", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/gzip"))
+				Expect(response.Header.Get("Content-Disposition")).To(Equal("attachment; filename=build-128.log.gz"))
+
+				reader, err := gzip.NewReader(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				body, err := ioutil.ReadAll(reader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal("hello elsewhere world"))
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					build.EventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/builds/:build_id/abort", func() {
 		var (
 			response *http.Response
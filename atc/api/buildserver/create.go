@@ -2,6 +2,7 @@ package buildserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -22,6 +23,22 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 			return
 		}
 
+		// one-off builds start straight away, so they must not be created
+		// while maintenance has paused all other work
+		maintenance, err := s.maintenance.Status()
+		if err != nil {
+			hLog.Error("failed-to-get-maintenance-status", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if maintenance.Enabled {
+			hLog.Info("rejected-during-maintenance")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, "cluster is under maintenance: %s", maintenance.Message)
+			return
+		}
+
 		build, err := team.CreateStartedBuild(plan)
 		if err != nil {
 			hLog.Error("failed-to-create-one-off-build", err)
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/vito/go-sse/sse"
 )
 
@@ -29,6 +33,13 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 			eventID++
 		}
 
+		filter, err := parseEventsFilter(r.URL.Query())
+		if err != nil {
+			logger.Info("invalid-events-filter", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
 		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 		w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 		w.Header().Add("X-Accel-Buffering", "no")
@@ -39,7 +50,12 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 			responseFlusher: w.(http.Flusher),
 		}
 
-		events, err := build.Events(eventID)
+		var events db.EventSource
+		if filter.IsZero() {
+			events, err = build.Events(eventID)
+		} else {
+			events, err = build.FilteredEvents(eventID, filter)
+		}
 		if err != nil {
 			logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID(), "start": eventID})
 			w.WriteHeader(http.StatusInternalServerError)
@@ -48,10 +64,52 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 
 		defer db.Close(events)
 
+		// progress events are opt-in, as older clients do not know them
+		var progress *progressTracker
+		if r.URL.Query().Get("progress") == "true" {
+			progress, err = newProgressTracker(build)
+			if err != nil {
+				logger.Error("failed-to-track-build-progress", err, lager.Data{"build-id": build.ID()})
+			}
+		}
+
+		var ticks <-chan time.Time
+		if progress != nil {
+			ticker := time.NewTicker(ProgressInterval)
+			defer ticker.Stop()
+
+			ticks = ticker.C
+		}
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		nextEvents := streamEvents(events, stop)
+
 		for {
 			logger = logger.WithData(lager.Data{"id": eventID})
 
-			ev, err := events.Next()
+			var next nextEvent
+			select {
+			case <-ticks:
+				// progress events repeat the id of the last event, so that
+				// resuming the stream after one starts from the next event
+				if eventID == 0 {
+					continue
+				}
+
+				err := writer.WriteEvent(eventID-1, event.Message{Event: progress.Progress(time.Now())})
+				if err != nil {
+					logger.Info("failed-to-write-progress", lager.Data{"error": err.Error()})
+					return
+				}
+
+				continue
+
+			case next = <-nextEvents:
+			}
+
+			ev, err := next.envelope, next.err
 			if err != nil {
 				if err == db.ErrEndOfBuildEventStream {
 					err := writer.WriteEnd(eventID)
@@ -69,6 +127,10 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 				return
 			}
 
+			if progress != nil {
+				progress.Observe(ev)
+			}
+
 			err = writer.WriteEvent(eventID, ev)
 			if err != nil {
 				logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
@@ -80,6 +142,72 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 	})
 }
 
+// parseEventsFilter reads the filter from the query parameters: any number
+// of 'origin' ids, a 'since' and 'until' time in unix seconds, and 'tail',
+// the number of most recent events to start from.
+func parseEventsFilter(query url.Values) (db.EventsFilter, error) {
+	filter := db.EventsFilter{
+		Origins: query["origin"],
+	}
+
+	for param, t := range map[string]*time.Time{
+		"since": &filter.Since,
+		"until": &filter.Until,
+	} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+
+		seconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return db.EventsFilter{}, fmt.Errorf("invalid '%s': %s", param, raw)
+		}
+
+		*t = time.Unix(seconds, 0)
+	}
+
+	if raw := query.Get("tail"); raw != "" {
+		tail, err := strconv.Atoi(raw)
+		if err != nil || tail < 0 {
+			return db.EventsFilter{}, fmt.Errorf("invalid 'tail': %s", raw)
+		}
+
+		filter.Tail = tail
+	}
+
+	return filter, nil
+}
+
+type nextEvent struct {
+	envelope event.Envelope
+	err      error
+}
+
+// streamEvents reads events from the source in the background, so that
+// progress may be sent while waiting for the next event.
+func streamEvents(events db.EventSource, stop <-chan struct{}) <-chan nextEvent {
+	nextEvents := make(chan nextEvent)
+
+	go func() {
+		for {
+			ev, err := events.Next()
+
+			select {
+			case nextEvents <- nextEvent{envelope: ev, err: err}:
+			case <-stop:
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return nextEvents
+}
+
 type eventWriter struct {
 	responseWriter  io.Writer
 	responseFlusher http.Flusher
@@ -3,6 +3,7 @@ package buildserver_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	"io"
 	"net/http"
@@ -180,6 +181,113 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the build is running and its duration can be estimated", func() {
+			var (
+				fakeEventSource  *dbfakes.FakeEventSource
+				unblock          chan struct{}
+				startTime        time.Time
+				originalInterval time.Duration
+			)
+
+			BeforeEach(func() {
+				request.URL.RawQuery = "progress=true"
+
+				originalInterval = ProgressInterval
+				ProgressInterval = 10 * time.Millisecond
+
+				startTime = time.Now().Add(-time.Minute).Truncate(time.Second)
+
+				publicPlan := json.RawMessage(`{"id":"1","do":[{"id":"2","task":{"name":"unit"}}]}`)
+
+				build.StatusReturns(db.BuildStatusStarted)
+				build.StartTimeReturns(startTime)
+				build.PublicPlanReturns(&publicPlan)
+				build.DurationEstimateReturns(db.DurationEstimate{
+					Build: 5 * time.Minute,
+					Steps: map[string]time.Duration{"task:unit": 2 * time.Minute},
+				}, true, nil)
+
+				initialize := json.RawMessage(fmt.Sprintf(`{"origin":{"id":"2"},"time":%d}`, time.Now().Add(-10*time.Minute).Unix()))
+
+				unblock = make(chan struct{})
+
+				fakeEventSource = new(dbfakes.FakeEventSource)
+				fakeEventSource.NextStub = func() (event.Envelope, error) {
+					if fakeEventSource.NextCallCount() == 1 {
+						return event.Envelope{
+							Data:    &initialize,
+							Event:   event.EventTypeInitializeTask,
+							Version: "1.0",
+						}, nil
+					}
+
+					<-unblock
+
+					return event.Envelope{}, db.ErrBuildEventStreamClosed
+				}
+
+				build.EventsReturns(fakeEventSource, nil)
+			})
+
+			AfterEach(func() {
+				close(unblock)
+				ProgressInterval = originalInterval
+			})
+
+			JustBeforeEach(func() {
+				var err error
+
+				client := &http.Client{
+					Transport: &http.Transport{},
+				}
+				response, err = client.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("interleaves progress events which repeat the id of the last event", func() {
+				defer db.Close(response.Body)
+				reader := sse.NewReadCloser(response.Body)
+
+				first, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(first.ID).To(Equal("0"))
+
+				progressEvent, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progressEvent.ID).To(Equal("0"))
+				Expect(progressEvent.Name).To(Equal("event"))
+
+				var message event.Message
+				Expect(json.Unmarshal(progressEvent.Data, &message)).To(Succeed())
+				Expect(message.Event).To(BeAssignableToTypeOf(event.Progress{}))
+
+				progress := message.Event.(event.Progress)
+				Expect(progress.EstimatedFinish).To(Equal(startTime.Add(5 * time.Minute).Unix()))
+				Expect(progress.Outliers).To(HaveLen(1))
+				Expect(progress.Outliers[0].Origin.ID).To(Equal(event.OriginID("2")))
+				Expect(progress.Outliers[0].Step).To(Equal("task:unit"))
+				Expect(progress.Outliers[0].Usual).To(Equal(int64(120)))
+				Expect(progress.Outliers[0].Elapsed).To(BeNumerically(">=", 600))
+			})
+
+			Context("when progress is not requested", func() {
+				BeforeEach(func() {
+					request.URL.RawQuery = ""
+				})
+
+				It("does not track the build's progress", func() {
+					defer db.Close(response.Body)
+					reader := sse.NewReadCloser(response.Body)
+
+					first, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(first.ID).To(Equal("0"))
+
+					Expect(build.DurationEstimateCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when the eventsource returns an error", func() {
 			var fakeEventSource *dbfakes.FakeEventSource
 			var disaster error
@@ -286,5 +394,65 @@ var _ = Describe("Handler", func() {
 				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 			})
 		})
+
+		Context("when the events are filtered", func() {
+			var fakeEventSource *dbfakes.FakeEventSource
+
+			BeforeEach(func() {
+				fakeEventSource = new(dbfakes.FakeEventSource)
+				fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+				build.FilteredEventsReturns(fakeEventSource, nil)
+
+				request.URL.RawQuery = "origin=some-origin&origin=other-origin&since=100&until=200&tail=10"
+				request.Header.Set("Last-Event-ID", "4")
+			})
+
+			JustBeforeEach(func() {
+				var err error
+
+				client := &http.Client{
+					Transport: &http.Transport{},
+				}
+				response, err = client.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("gets the filtered events from after the id", func() {
+				_ = response.Body.Close()
+				Eventually(build.FilteredEventsCallCount).Should(Equal(1))
+				Expect(build.EventsCallCount()).To(BeZero())
+
+				from, filter := build.FilteredEventsArgsForCall(0)
+				Expect(from).To(Equal(uint(5)))
+				Expect(filter).To(Equal(db.EventsFilter{
+					Origins: []string{"some-origin", "other-origin"},
+					Since:   time.Unix(100, 0),
+					Until:   time.Unix(200, 0),
+					Tail:    10,
+				}))
+			})
+
+			Context("when the tail is invalid", func() {
+				BeforeEach(func() {
+					request.URL.RawQuery = "tail=-1"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(build.FilteredEventsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the time range is invalid", func() {
+				BeforeEach(func() {
+					request.URL.RawQuery = "since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
 	})
 })
@@ -12,10 +12,21 @@ func (s *Server) GetBuild(build db.Build) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger := s.logger.Session("get-build")
 
+		presented := present.Build(build)
+
+		if build.Status() == db.BuildStatusStarted {
+			estimate, found, err := build.DurationEstimate()
+			if err != nil {
+				logger.Error("failed-to-estimate-build-duration", err)
+			} else if found {
+				presented.EstimatedFinish = build.StartTime().Add(estimate.Build).Unix()
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
-		err := json.NewEncoder(w).Encode(present.Build(build))
+		err := json.NewEncoder(w).Encode(presented)
 		if err != nil {
 			logger.Error("failed-to-encode-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,140 @@
+package buildserver
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/tedsuo/rata"
+)
+
+// BuildStepLog writes the logs of a single step as plain text, following
+// them until the build has finished.
+func (s *Server) BuildStepLog(build db.Build) http.Handler {
+	logger := s.logger.Session("build-step-log")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := rata.Param(r, "origin")
+
+		events, err := build.FilteredEvents(0, db.EventsFilter{
+			Origins: []string{origin},
+		})
+		if err != nil {
+			logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer db.Close(events)
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		flusher, _ := w.(http.Flusher)
+
+		err = writeLogs(r, events, w, func(log event.Log) bool {
+			return string(log.Origin.ID) == origin
+		}, flusher)
+		if err != nil {
+			logger.Info("failed-to-write-logs", lager.Data{"error": err.Error()})
+		}
+	})
+}
+
+// DownloadBuildLog writes the logs of every step of the build as gzipped
+// plain text. The download starts right away, and for a running build
+// follows its logs until the build finishes.
+func (s *Server) DownloadBuildLog(build db.Build) http.Handler {
+	logger := s.logger.Session("download-build-log")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		events, err := build.Events(0)
+		if err != nil {
+			logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer db.Close(events)
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=build-%d.log.gz", build.ID()))
+		w.WriteHeader(http.StatusOK)
+
+		gzipWriter := gzip.NewWriter(w)
+
+		err = writeLogs(r, events, gzipWriter, func(event.Log) bool {
+			return true
+		}, nil)
+		if err != nil {
+			logger.Info("failed-to-write-logs", lager.Data{"error": err.Error()})
+			return
+		}
+
+		err = gzipWriter.Close()
+		if err != nil {
+			logger.Info("failed-to-close-gzip-writer", lager.Data{"error": err.Error()})
+		}
+	})
+}
+
+// writeLogs writes the payload of each matching log event until the end of
+// the stream, or until the request is cancelled.
+func writeLogs(
+	r *http.Request,
+	events db.EventSource,
+	w io.Writer,
+	matches func(event.Log) bool,
+	flusher http.Flusher,
+) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	nextEvents := streamEvents(events, stop)
+
+	for {
+		var next nextEvent
+		select {
+		case <-r.Context().Done():
+			return nil
+		case next = <-nextEvents:
+		}
+
+		if next.err != nil {
+			if next.err == db.ErrEndOfBuildEventStream {
+				return nil
+			}
+
+			return next.err
+		}
+
+		if next.envelope.Event != event.EventTypeLog || next.envelope.Data == nil {
+			continue
+		}
+
+		var log event.Log
+		err := json.Unmarshal(*next.envelope.Data, &log)
+		if err != nil {
+			return err
+		}
+
+		if !matches(log) {
+			continue
+		}
+
+		_, err = io.WriteString(w, log.Payload)
+		if err != nil {
+			return err
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// ProgressInterval is how often progress events are sent while streaming the
+// events of a running build.
+var ProgressInterval = 30 * time.Second
+
+const (
+	// outlierRatio is how many times longer than usual a step must have been
+	// running for before it is reported as an outlier.
+	outlierRatio = 2
+
+	// outlierMinimumDelay keeps steps which usually take only a few seconds
+	// from being reported as soon as they are slightly slower.
+	outlierMinimumDelay = time.Minute
+)
+
+// progressTracker follows the events of a running build to estimate when it
+// will finish and which of its steps are running for longer than usual.
+type progressTracker struct {
+	startTime time.Time
+	estimate  db.DurationEstimate
+	steps     map[atc.PlanID]string
+
+	running map[atc.PlanID]int64
+}
+
+// newProgressTracker returns a tracker for the build, or nil if the build is
+// not running or there is nothing to estimate its progress from.
+func newProgressTracker(build db.Build) (*progressTracker, error) {
+	if build.Status() != db.BuildStatusStarted {
+		return nil, nil
+	}
+
+	estimate, found, err := build.DurationEstimate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	steps := map[atc.PlanID]string{}
+	if build.PublicPlan() != nil {
+		steps, err = atc.PublicPlanSteps(*build.PublicPlan())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &progressTracker{
+		startTime: build.StartTime(),
+		estimate:  estimate,
+		steps:     steps,
+		running:   map[atc.PlanID]int64{},
+	}, nil
+}
+
+// Observe records the beginning and end of steps.
+func (tracker *progressTracker) Observe(envelope event.Envelope) {
+	var finished bool
+	switch envelope.Event {
+	case event.EventTypeInitialize,
+		event.EventTypeInitializeGet,
+		event.EventTypeInitializePut,
+		event.EventTypeInitializeTask,
+		event.EventTypeStart,
+		event.EventTypeStartGet,
+		event.EventTypeStartPut,
+		event.EventTypeStartTask:
+	case event.EventTypeFinish,
+		event.EventTypeFinishGet,
+		event.EventTypeFinishPut,
+		event.EventTypeFinishTask,
+		event.EventTypeSkipped:
+		finished = true
+	default:
+		return
+	}
+
+	if envelope.Data == nil {
+		return
+	}
+
+	var ev struct {
+		Origin event.Origin `json:"origin"`
+		Time   int64        `json:"time"`
+	}
+
+	err := json.Unmarshal(*envelope.Data, &ev)
+	if err != nil || ev.Origin.ID == "" {
+		return
+	}
+
+	planID := atc.PlanID(ev.Origin.ID)
+
+	if finished {
+		delete(tracker.running, planID)
+		return
+	}
+
+	if _, found := tracker.running[planID]; !found {
+		tracker.running[planID] = ev.Time
+	}
+}
+
+// Progress estimates when the build will finish, and reports the steps which
+// have been running for longer than usual.
+func (tracker *progressTracker) Progress(now time.Time) event.Progress {
+	progress := event.Progress{
+		Time:            now.Unix(),
+		EstimatedFinish: tracker.startTime.Add(tracker.estimate.Build).Unix(),
+	}
+
+	for planID, started := range tracker.running {
+		key, found := tracker.steps[planID]
+		if !found {
+			continue
+		}
+
+		usual, found := tracker.estimate.Steps[key]
+		if !found {
+			continue
+		}
+
+		elapsed := now.Sub(time.Unix(started, 0))
+		if elapsed < outlierRatio*usual || elapsed-usual < outlierMinimumDelay {
+			continue
+		}
+
+		progress.Outliers = append(progress.Outliers, event.ProgressOutlier{
+			Origin:  event.Origin{ID: event.OriginID(planID)},
+			Step:    key,
+			Elapsed: int64(elapsed / time.Second),
+			Usual:   int64(usual / time.Second),
+		})
+	}
+
+	sort.Slice(progress.Outliers, func(i, j int) bool {
+		return progress.Outliers[i].Origin.ID < progress.Outliers[j].Origin.ID
+	})
+
+	return progress
+}
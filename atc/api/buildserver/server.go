@@ -18,6 +18,7 @@ type Server struct {
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
 	eventHandlerFactory EventHandlerFactory
+	maintenance         db.Maintenance
 	rejector            auth.Rejector
 }
 
@@ -27,6 +28,7 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
+	maintenance db.Maintenance,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -36,6 +38,7 @@ func NewServer(
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
 		eventHandlerFactory: eventHandlerFactory,
+		maintenance:         maintenance,
 
 		rejector: auth.UnauthorizedRejector{},
 	}
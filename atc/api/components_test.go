@@ -0,0 +1,294 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Components API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/components", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/components")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when listing the components succeeds", func() {
+				BeforeEach(func() {
+					scheduler := new(dbfakes.FakeComponent)
+					scheduler.NameReturns("scheduler")
+					scheduler.IntervalReturns(10 * time.Second)
+					scheduler.LastRanReturns(time.Unix(1598270400, 0))
+					scheduler.LastDurationReturns(1500 * time.Millisecond)
+					scheduler.LastRanByReturns("some-atc")
+
+					collector := new(dbfakes.FakeComponent)
+					collector.NameReturns("collector_containers")
+					collector.IntervalReturns(30 * time.Second)
+					collector.PausedReturns(true)
+					collector.LastErrorReturns("disaster")
+
+					dbComponentFactory.AllReturns([]db.Component{collector, scheduler}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the components", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"name": "collector_containers",
+							"interval": 30,
+							"paused": true,
+							"last_error": "disaster"
+						},
+						{
+							"name": "scheduler",
+							"interval": 10,
+							"paused": false,
+							"last_ran": 1598270400,
+							"last_duration": 1.5,
+							"last_ran_by": "some-atc"
+						}
+					]`))
+				})
+			})
+
+			Context("when listing the components fails", func() {
+				BeforeEach(func() {
+					dbComponentFactory.AllReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	for _, action := range []string{"pause", "unpause"} {
+		action := action
+
+		Describe("PUT /api/v1/components/:component_name/"+action, func() {
+			var fakeComponent *dbfakes.FakeComponent
+
+			BeforeEach(func() {
+				fakeComponent = new(dbfakes.FakeComponent)
+			})
+
+			JustBeforeEach(func() {
+				req, err := http.NewRequest("PUT", server.URL+"/api/v1/components/collector_containers/"+action, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = client.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when authenticated as an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAdminReturns(true)
+				})
+
+				Context("when the component exists", func() {
+					BeforeEach(func() {
+						dbComponentFactory.FindReturns(fakeComponent, true, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("finds the component by name", func() {
+						Expect(dbComponentFactory.FindArgsForCall(0)).To(Equal("collector_containers"))
+					})
+
+					It(action+"s the component", func() {
+						if action == "pause" {
+							Expect(fakeComponent.PauseCallCount()).To(Equal(1))
+							Expect(fakeComponent.UnpauseCallCount()).To(BeZero())
+						} else {
+							Expect(fakeComponent.UnpauseCallCount()).To(Equal(1))
+							Expect(fakeComponent.PauseCallCount()).To(BeZero())
+						}
+					})
+
+					Context("when updating the component fails", func() {
+						BeforeEach(func() {
+							fakeComponent.PauseReturns(errors.New("disaster"))
+							fakeComponent.UnpauseReturns(errors.New("disaster"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the component does not exist", func() {
+					BeforeEach(func() {
+						dbComponentFactory.FindReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when finding the component fails", func() {
+					BeforeEach(func() {
+						dbComponentFactory.FindReturns(nil, false, errors.New("disaster"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when authenticated but not an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+	}
+
+	Describe("PUT /api/v1/components/:component_name/run", func() {
+		var fakeComponent *dbfakes.FakeComponent
+
+		BeforeEach(func() {
+			fakeComponent = new(dbfakes.FakeComponent)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/components/collector_containers/run", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the component exists", func() {
+				BeforeEach(func() {
+					dbComponentFactory.FindReturns(fakeComponent, true, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("notifies the component's runners", func() {
+					Expect(fakeComponentNotifier.NotifyCallCount()).To(Equal(1))
+					Expect(fakeComponentNotifier.NotifyArgsForCall(0)).To(Equal("collector_containers"))
+				})
+
+				Context("when notifying fails", func() {
+					BeforeEach(func() {
+						fakeComponentNotifier.NotifyReturns(errors.New("disaster"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the component is paused", func() {
+					BeforeEach(func() {
+						fakeComponent.PausedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not notify the component's runners", func() {
+						Expect(fakeComponentNotifier.NotifyCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the component does not exist", func() {
+				BeforeEach(func() {
+					dbComponentFactory.FindReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not notify the component's runners", func() {
+				Expect(fakeComponentNotifier.NotifyCallCount()).To(BeZero())
+			})
+		})
+	})
+})
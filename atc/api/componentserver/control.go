@@ -0,0 +1,88 @@
+package componentserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+)
+
+func (s *Server) PauseComponent(w http.ResponseWriter, r *http.Request) {
+	s.setPaused(w, r, true)
+}
+
+func (s *Server) UnpauseComponent(w http.ResponseWriter, r *http.Request) {
+	s.setPaused(w, r, false)
+}
+
+func (s *Server) setPaused(w http.ResponseWriter, r *http.Request, paused bool) {
+	componentName := r.FormValue(":component_name")
+
+	logger := s.logger.Session("set-component-paused", lager.Data{
+		"component": componentName,
+		"paused":    paused,
+	})
+
+	component, found, err := s.componentFactory.Find(componentName)
+	if err != nil {
+		logger.Error("failed-to-find-component", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if paused {
+		err = component.Pause()
+	} else {
+		err = component.Unpause()
+	}
+	if err != nil {
+		logger.Error("failed-to-set-paused", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("updated")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// RunComponent asks the ATCs to run a component immediately, regardless of
+// its interval. Paused components are not run, so they are rejected with 409
+// Conflict.
+func (s *Server) RunComponent(w http.ResponseWriter, r *http.Request) {
+	componentName := r.FormValue(":component_name")
+
+	logger := s.logger.Session("run-component", lager.Data{"component": componentName})
+
+	component, found, err := s.componentFactory.Find(componentName)
+	if err != nil {
+		logger.Error("failed-to-find-component", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if component.Paused() {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	err = s.notifier.Notify(componentName)
+	if err != nil {
+		logger.Error("failed-to-notify-component", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("triggered")
+
+	w.WriteHeader(http.StatusOK)
+}
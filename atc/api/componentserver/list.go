@@ -0,0 +1,52 @@
+package componentserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListComponents(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("list-components")
+
+	components, err := s.componentFactory.All()
+	if err != nil {
+		hLog.Error("failed-to-list-components", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	hLog.Debug("listed", lager.Data{"component-count": len(components)})
+
+	statuses := []atc.ComponentStatus{}
+	for _, component := range components {
+		statuses = append(statuses, present(component))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(statuses)
+	if err != nil {
+		hLog.Error("failed-to-encode-components", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func present(component db.Component) atc.ComponentStatus {
+	status := atc.ComponentStatus{
+		Name:         component.Name(),
+		Interval:     component.Interval().Seconds(),
+		Paused:       component.Paused(),
+		LastDuration: component.LastDuration().Seconds(),
+		LastError:    component.LastError(),
+		LastRanBy:    component.LastRanBy(),
+	}
+
+	if !component.LastRan().IsZero() {
+		status.LastRan = component.LastRan().Unix()
+	}
+
+	return status
+}
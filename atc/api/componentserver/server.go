@@ -0,0 +1,32 @@
+package componentserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . Notifier
+
+// Notifier notifies the component runners listening on a channel named after
+// their component, which makes them run the component immediately.
+type Notifier interface {
+	Notify(channel string) error
+}
+
+type Server struct {
+	logger           lager.Logger
+	componentFactory db.ComponentFactory
+	notifier         Notifier
+}
+
+func NewServer(
+	logger lager.Logger,
+	componentFactory db.ComponentFactory,
+	notifier Notifier,
+) *Server {
+	return &Server{
+		logger:           logger,
+		componentFactory: componentFactory,
+		notifier:         notifier,
+	}
+}
@@ -412,6 +412,72 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when lint rules are configured for the cluster", func() {
+							BeforeEach(func() {
+								lintRules["resource-without-icon"] = atc.LintSeverityWarning
+							})
+
+							It("returns the findings as warnings", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+									"warnings": [
+										{
+											"type": "lint",
+											"message": "resources.some-resource: resource 'some-resource' has no icon",
+											"rule": "resource-without-icon",
+											"hint": "set 'icon' to the name of a Material Design icon"
+										},
+										{
+											"type": "lint",
+											"message": "jobs.some-job.plan.task(some-task): task 'some-task' runs privileged",
+											"rule": "privileged-task",
+											"hint": "remove 'privileged: true' unless the task really needs full capabilities on the worker"
+										}
+									]
+								}`))
+							})
+
+							Context("when the team raises the severity to error", func() {
+								BeforeEach(func() {
+									dbTeam.LintRulesReturns(atc.LintRules{"resource-without-icon": atc.LintSeverityError}, nil)
+								})
+
+								It("returns 400", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								})
+
+								It("returns the error in the response body", func() {
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+										"errors": [
+											"resources.some-resource: resource 'some-resource' has no icon (resource-without-icon)"
+										]
+									}`))
+								})
+
+								It("does not save anything", func() {
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
+						})
+
+						Context("and a passed job in another pipeline cannot be found", func() {
+							BeforeEach(func() {
+								dbTeam.SavePipelineReturns(nil, false, db.PassedJobNotFoundError{Job: "other-pipeline/some-job"})
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns the error in the response body", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+									"errors": [
+										"passed job 'other-pipeline/some-job' not found or not exposed"
+									]
+								}`))
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
@@ -96,8 +96,30 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	teamLintRules, err := team.LintRules()
+	if err != nil {
+		session.Error("failed-to-get-lint-rules", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	lintWarnings, lintErrors := configvalidate.LintWarnings(configvalidate.Lint(config, s.lintRules.Merge(teamLintRules)))
+	if len(lintErrors) > 0 {
+		session.Info("ignoring-config-failing-lint-rules", lager.Data{"errors": lintErrors})
+		s.handleBadRequest(w, lintErrors...)
+		return
+	}
+
+	warnings = append(warnings, lintWarnings...)
+
 	_, created, err := team.SavePipeline(pipelineName, config, version, true)
 	if err != nil {
+		if notFoundErr, ok := err.(db.PassedJobNotFoundError); ok {
+			session.Info("ignoring-invalid-config", lager.Data{"error": notFoundErr.Error()})
+			s.handleBadRequest(w, notFoundErr.Error())
+			return
+		}
+
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "failed to save config: %s", err)
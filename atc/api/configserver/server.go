@@ -2,6 +2,7 @@ package configserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -10,16 +11,19 @@ type Server struct {
 	logger        lager.Logger
 	teamFactory   db.TeamFactory
 	secretManager creds.Secrets
+	lintRules     atc.LintRules
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	secretManager creds.Secrets,
+	lintRules atc.LintRules,
 ) *Server {
 	return &Server{
 		logger:        logger,
 		teamFactory:   teamFactory,
 		secretManager: secretManager,
+		lintRules:     lintRules,
 	}
 }
@@ -10,11 +10,13 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
 	gfakes "code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -774,7 +776,8 @@ var _ = Describe("Containers API", func() {
 
 							Context("when the hijack timer elapses", func() {
 								JustBeforeEach(func() {
-									fakeClock.WaitForWatcherAndIncrement(time.Second)
+									// the hijack timer and the recording's flush ticker
+									fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
 								})
 
 								It("updates the last hijack value again", func() {
@@ -972,6 +975,127 @@ var _ = Describe("Containers API", func() {
 									Expect(hijackOutput.Error).To(Equal("too slow"))
 								})
 							})
+
+							Context("when the session is recorded", func() {
+								BeforeEach(func() {
+									fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
+									fakeContainer.HandleReturns("some-handle")
+									fakeContainer.WorkerNameReturns("some-worker")
+
+									fakeDBContainer.MetadataReturns(db.ContainerMetadata{
+										Type:         db.ContainerTypeTask,
+										PipelineName: "some-pipeline",
+										JobName:      "some-job",
+										BuildID:      42,
+										BuildName:    "7",
+										StepName:     "some-task",
+									})
+
+									dbHijackSessionFactory.StartReturns(99, nil)
+								})
+
+								JustBeforeEach(func() {
+									Eventually(fakeContainer.RunCallCount).Should(Equal(1))
+
+									_, _, io := fakeContainer.RunArgsForCall(0)
+
+									err := conn.WriteJSON(atc.HijackInput{
+										Stdin: []byte("some stdin\n"),
+									})
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(bufio.NewReader(io.Stdin).ReadBytes('\n')).To(Equal([]byte("some stdin\n")))
+
+									_, err = fmt.Fprintf(io.Stderr, "some stderr\n")
+									Expect(err).NotTo(HaveOccurred())
+
+									var hijackOutput atc.HijackOutput
+									err = conn.ReadJSON(&hijackOutput)
+									Expect(err).NotTo(HaveOccurred())
+								})
+
+								It("records who hijacked which container", func() {
+									Expect(dbHijackSessionFactory.StartCallCount()).To(Equal(1))
+
+									teamID, session := dbHijackSessionFactory.StartArgsForCall(0)
+									Expect(teamID).To(Equal(734))
+									Expect(session).To(Equal(atc.HijackSession{
+										User: "some-user",
+										Container: atc.HijackSessionContainer{
+											Handle:       "some-handle",
+											WorkerName:   "some-worker",
+											Type:         "task",
+											PipelineName: "some-pipeline",
+											JobName:      "some-job",
+											BuildID:      42,
+											BuildName:    "7",
+											StepName:     "some-task",
+										},
+										Path: "ls",
+									}))
+								})
+
+								It("saves the recording and exit status once the process exits", func() {
+									Eventually(processExit).Should(BeSent(123))
+
+									Eventually(dbHijackSessionFactory.FinishCallCount).Should(Equal(1))
+
+									id, exitStatus := dbHijackSessionFactory.FinishArgsForCall(0)
+									Expect(id).To(Equal(99))
+									Expect(exitStatus).ToNot(BeNil())
+									Expect(*exitStatus).To(Equal(123))
+
+									var recording []byte
+									for i := 0; i < dbHijackSessionFactory.AppendRecordingCallCount(); i++ {
+										id, chunk := dbHijackSessionFactory.AppendRecordingArgsForCall(i)
+										Expect(id).To(Equal(99))
+										recording = append(recording, chunk...)
+									}
+
+									lines := strings.Split(strings.TrimSpace(string(recording)), "\n")
+									Expect(lines).To(HaveLen(3))
+									Expect(lines[0]).To(MatchJSON(fmt.Sprintf(`{
+										"version": 2,
+										"width": 80,
+										"height": 24,
+										"timestamp": %d,
+										"command": "ls"
+									}`, fakeClock.Now().Unix())))
+									Expect(lines[1]).To(MatchJSON(`[0, "i", "some stdin\n"]`))
+									Expect(lines[2]).To(MatchJSON(`[0, "o", "some stderr\n"]`))
+								})
+
+								It("saves the recording periodically while the process runs", func() {
+									Eventually(func() int {
+										fakeClock.Increment(5 * time.Second)
+										return dbHijackSessionFactory.AppendRecordingCallCount()
+									}).Should(Equal(1))
+
+									Expect(dbHijackSessionFactory.FinishCallCount()).To(BeZero())
+
+									id, chunk := dbHijackSessionFactory.AppendRecordingArgsForCall(0)
+									Expect(id).To(Equal(99))
+
+									lines := strings.Split(strings.TrimSpace(string(chunk)), "\n")
+									Expect(lines).To(HaveLen(3))
+									Expect(lines[2]).To(MatchJSON(`[0, "o", "some stderr\n"]`))
+								})
+							})
+
+							Context("when the session cannot be recorded", func() {
+								BeforeEach(func() {
+									dbHijackSessionFactory.StartReturns(0, errors.New("disaster"))
+								})
+
+								It("closes the connection with an error without running the process", func() {
+									_, _, err := conn.ReadMessage()
+									Expect(websocket.IsCloseError(err, websocket.CloseInternalServerErr)).To(BeTrue())
+									Expect(err).To(MatchError(ContainSubstring("failed to record session")))
+
+									Expect(fakeContainer.RunCallCount()).To(Equal(0))
+								})
+							})
 						})
 					})
 				})
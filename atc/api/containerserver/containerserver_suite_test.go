@@ -0,0 +1,13 @@
+package containerserver
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestContainerServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Container Server Suite")
+}
@@ -154,13 +154,78 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 			Process:   processSpec,
 		}
 
-		s.hijack(hLog, conn, hijackRequest)
+		if !s.recordHijackSessions {
+			s.hijack(hLog, conn, hijackRequest)
+			return
+		}
+
+		sessionID, err := s.startHijackSession(team, container, processSpec, accessor.GetAccessor(r))
+		if err != nil {
+			hLog.Error("failed-to-start-hijack-session", err)
+			closeWithErr(hLog, conn, websocket.CloseInternalServerErr, "failed to record session")
+			return
+		}
+
+		hijackRequest.Recorder, err = newSessionRecorder(s.clock, processSpec, func(chunk []byte) error {
+			return s.hijackSessionFactory.AppendRecording(sessionID, chunk)
+		})
+		if err != nil {
+			hLog.Error("failed-to-start-recording", err)
+			closeWithErr(hLog, conn, websocket.CloseInternalServerErr, "failed to record session")
+			return
+		}
+
+		exitStatus := s.hijack(hLog, conn, hijackRequest)
+
+		err = hijackRequest.Recorder.Flush()
+		if err != nil {
+			hLog.Error("failed-to-save-recording", err)
+		}
+
+		err = s.hijackSessionFactory.Finish(sessionID, exitStatus)
+		if err != nil {
+			hLog.Error("failed-to-finish-hijack-session", err)
+		}
 	})
 }
 
 type hijackRequest struct {
 	Container worker.Container
 	Process   atc.HijackProcessSpec
+	Recorder  *sessionRecorder
+}
+
+// startHijackSession records who is hijacking which container, keeping a copy
+// of the container's metadata as the container will eventually be gone.
+func (s *Server) startHijackSession(team db.Team, container worker.Container, processSpec atc.HijackProcessSpec, acc accessor.Access) (int, error) {
+	session := atc.HijackSession{
+		User: acc.Claims().UserName,
+		Container: atc.HijackSessionContainer{
+			Handle:     container.Handle(),
+			WorkerName: container.WorkerName(),
+		},
+		Path: processSpec.Path,
+		Args: processSpec.Args,
+	}
+
+	dbContainer, found, err := team.FindContainerByHandle(container.Handle())
+	if err != nil {
+		return 0, err
+	}
+
+	if found {
+		meta := dbContainer.Metadata()
+
+		session.Container.Type = string(meta.Type)
+		session.Container.PipelineName = meta.PipelineName
+		session.Container.JobName = meta.JobName
+		session.Container.BuildID = meta.BuildID
+		session.Container.BuildName = meta.BuildName
+		session.Container.StepName = meta.StepName
+		session.Container.Attempt = meta.Attempt
+	}
+
+	return s.hijackSessionFactory.Start(team.ID(), session)
 }
 
 func closeWithErr(log lager.Logger, conn *websocket.Conn, code int, reason string) {
@@ -175,7 +240,9 @@ func closeWithErr(log lager.Logger, conn *websocket.Conn, code int, reason strin
 	}
 }
 
-func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackRequest) {
+// hijack runs the process in the container, returning its exit status if it
+// exited.
+func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackRequest) *int {
 	hLog = hLog.Session("hijack", lager.Data{
 		"handle":  request.Container.Handle(),
 		"process": request.Process,
@@ -233,13 +300,13 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 			Error: err.Error(),
 		})
 		hLog.Error("failed-to-hijack", err)
-		return
+		return nil
 	}
 
 	err = request.Container.UpdateLastHijack()
 	if err != nil {
 		hLog.Error("failed-to-update-container-hijack-time", err)
-		return
+		return nil
 	}
 
 	go func() {
@@ -288,6 +355,15 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 	idle = s.interceptTimeoutFactory.NewInterceptTimeout()
 	idleChan := idle.Channel()
 
+	// save the recording as the session runs rather than only once it ends
+	var flushChan <-chan time.Time
+	if request.Recorder != nil {
+		flushTicker := s.clock.NewTicker(recordingFlushInterval)
+		defer flushTicker.Stop()
+
+		flushChan = flushTicker.C()
+	}
+
 	for {
 		select {
 		case input := <-inputs:
@@ -295,6 +371,8 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 			if input.Closed {
 				_ = stdinW.Close()
 			} else if input.TTYSpec != nil {
+				request.Recorder.Resize(input.TTYSpec.WindowSize.Columns, input.TTYSpec.WindowSize.Rows)
+
 				err := process.SetTTY(garden.TTYSpec{
 					WindowSize: &garden.WindowSize{
 						Columns: input.TTYSpec.WindowSize.Columns,
@@ -307,16 +385,26 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 					})
 				}
 			} else {
+				request.Recorder.Input(input.Stdin)
 				_, _ = stdinW.Write(input.Stdin)
 			}
 
 		case <-idleChan:
 			errs <- idle.Error()
 
+		case <-flushChan:
+			err := request.Recorder.Flush()
+			if err != nil {
+				hLog.Error("failed-to-save-recording", err)
+			}
+
 		case output := <-outputs:
+			request.Recorder.Output(output.Stdout)
+			request.Recorder.Output(output.Stderr)
+
 			err := conn.WriteJSON(output)
 			if err != nil {
-				return
+				return nil
 			}
 
 		case status := <-exited:
@@ -324,14 +412,14 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 				ExitStatus: &status,
 			})
 
-			return
+			return &status
 
 		case err := <-errs:
 			_ = conn.WriteJSON(atc.HijackOutput{
 				Error: err.Error(),
 			})
 
-			return
+			return nil
 		}
 	}
 }
@@ -0,0 +1,69 @@
+package containerserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListHijackSessions(team db.Team) http.Handler {
+	hLog := s.logger.Session("list-hijack-sessions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessions, err := s.hijackSessionFactory.HijackSessions(team.ID())
+		if err != nil {
+			hLog.Error("failed-to-list-hijack-sessions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		hLog.Debug("listed", lager.Data{"hijack-session-count": len(sessions)})
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(sessions)
+		if err != nil {
+			hLog.Error("failed-to-encode-hijack-sessions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// GetHijackSessionRecording responds with the asciicast recorded for a
+// session once the session has ended.
+func (s *Server) GetHijackSessionRecording(team db.Team) http.Handler {
+	hLog := s.logger.Session("get-hijack-session-recording")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessionID, err := strconv.Atoi(r.FormValue(":hijack_session_id"))
+		if err != nil {
+			hLog.Info("malformed-hijack-session-id", lager.Data{"hijack-session-id": r.FormValue(":hijack_session_id")})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		logger := hLog.WithData(lager.Data{"hijack-session-id": sessionID})
+
+		recording, found, err := s.hijackSessionFactory.Recording(team.ID(), sessionID)
+		if err != nil {
+			logger.Error("failed-to-get-recording", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-asciicast")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = w.Write(recording)
+		if err != nil {
+			logger.Error("failed-to-write-recording", err)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package containerserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	defaultRecordingColumns = 80
+	defaultRecordingRows    = 24
+
+	// recordingFlushInterval is how often the recording is saved while the
+	// session runs, so that little is lost if the ATC goes away.
+	recordingFlushInterval = 5 * time.Second
+
+	// maxRecordingSize caps the size of a recording. Anything recorded past it
+	// is dropped.
+	maxRecordingSize = 10 * 1024 * 1024
+)
+
+// sessionRecorder records the input and output of a hijacked process in the
+// asciicast v2 format understood by asciinema and `fly replay-session`:
+//
+// https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md
+//
+// Stdout and stderr are both recorded as output, interleaved as they were
+// sent to the terminal. The recording is buffered until it is flushed to
+// save. A nil recorder records nothing.
+type sessionRecorder struct {
+	clock clock.Clock
+	start time.Time
+	save  func([]byte) error
+
+	buf       bytes.Buffer
+	size      int
+	truncated bool
+}
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Command   string            `json:"command,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+func newSessionRecorder(clock clock.Clock, spec atc.HijackProcessSpec, save func([]byte) error) (*sessionRecorder, error) {
+	recorder := &sessionRecorder{
+		clock: clock,
+		start: clock.Now(),
+		save:  save,
+	}
+
+	header := asciicastHeader{
+		Version:   2,
+		Width:     defaultRecordingColumns,
+		Height:    defaultRecordingRows,
+		Timestamp: recorder.start.Unix(),
+		Command:   strings.Join(append([]string{spec.Path}, spec.Args...), " "),
+	}
+
+	if spec.TTY != nil {
+		header.Width = spec.TTY.WindowSize.Columns
+		header.Height = spec.TTY.WindowSize.Rows
+	}
+
+	for _, env := range spec.Env {
+		if strings.HasPrefix(env, "TERM=") {
+			header.Env = map[string]string{"TERM": strings.TrimPrefix(env, "TERM=")}
+		}
+	}
+
+	err := json.NewEncoder(&recorder.buf).Encode(header)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.size = recorder.buf.Len()
+
+	return recorder, nil
+}
+
+func (recorder *sessionRecorder) Input(data []byte) {
+	recorder.record("i", string(data))
+}
+
+func (recorder *sessionRecorder) Output(data []byte) {
+	recorder.record("o", string(data))
+}
+
+func (recorder *sessionRecorder) Resize(columns int, rows int) {
+	recorder.record("r", fmt.Sprintf("%dx%d", columns, rows))
+}
+
+// Flush saves what has been recorded since the last flush.
+func (recorder *sessionRecorder) Flush() error {
+	if recorder == nil || recorder.buf.Len() == 0 {
+		return nil
+	}
+
+	err := recorder.save(recorder.buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	recorder.buf.Reset()
+
+	return nil
+}
+
+func (recorder *sessionRecorder) record(code string, data string) {
+	if recorder == nil || data == "" || recorder.truncated {
+		return
+	}
+
+	elapsed := recorder.clock.Since(recorder.start).Seconds()
+
+	var event bytes.Buffer
+
+	// encoding a []interface{} of these types cannot fail
+	_ = json.NewEncoder(&event).Encode([]interface{}{elapsed, code, data})
+
+	if recorder.size+event.Len() > maxRecordingSize {
+		recorder.truncated = true
+
+		event.Reset()
+		_ = json.NewEncoder(&event).Encode([]interface{}{
+			elapsed,
+			"o",
+			fmt.Sprintf("\r\n[recording truncated at %d bytes]\r\n", recorder.size),
+		})
+	}
+
+	recorder.size += event.Len()
+	recorder.buf.Write(event.Bytes())
+}
@@ -0,0 +1,86 @@
+package containerserver
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sessionRecorder", func() {
+	var (
+		fakeClock *fakeclock.FakeClock
+		saved     bytes.Buffer
+		saveErr   error
+		recorder  *sessionRecorder
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		saved.Reset()
+		saveErr = nil
+
+		var err error
+		recorder, err = newSessionRecorder(fakeClock, atc.HijackProcessSpec{Path: "bash"}, func(chunk []byte) error {
+			if saveErr != nil {
+				return saveErr
+			}
+
+			saved.Write(chunk)
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("saves what was recorded since the last flush", func() {
+		Expect(recorder.Flush()).To(Succeed())
+		Expect(saved.String()).To(MatchJSON(`{"version":2,"width":80,"height":24,"timestamp":123,"command":"bash"}`))
+
+		saved.Reset()
+
+		fakeClock.Increment(time.Second)
+		recorder.Output([]byte("hello"))
+
+		Expect(recorder.Flush()).To(Succeed())
+		Expect(saved.String()).To(MatchJSON(`[1, "o", "hello"]`))
+	})
+
+	Context("when saving fails", func() {
+		BeforeEach(func() {
+			saveErr = errors.New("disaster")
+		})
+
+		It("keeps the recording for the next flush", func() {
+			recorder.Output([]byte("hello"))
+			Expect(recorder.Flush()).To(MatchError("disaster"))
+
+			saveErr = nil
+			Expect(recorder.Flush()).To(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(saved.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[1]).To(MatchJSON(`[0, "o", "hello"]`))
+		})
+	})
+
+	Context("when the recording exceeds the maximum size", func() {
+		It("stops recording, noting that the recording was truncated", func() {
+			chunk := []byte(strings.Repeat("x", 1024*1024))
+			for i := 0; i < 20; i++ {
+				recorder.Output(chunk)
+				Expect(recorder.Flush()).To(Succeed())
+			}
+
+			Expect(saved.Len()).To(BeNumerically("<=", maxRecordingSize+100))
+
+			lines := strings.Split(strings.TrimSpace(saved.String()), "\n")
+			Expect(lines[len(lines)-1]).To(ContainSubstring("recording truncated"))
+		})
+	})
+})
@@ -19,6 +19,8 @@ type Server struct {
 	varSourcePool           creds.VarSourcePool
 	interceptTimeoutFactory InterceptTimeoutFactory
 	interceptUpdateInterval time.Duration
+	recordHijackSessions    bool
+	hijackSessionFactory    db.HijackSessionFactory
 	containerRepository     db.ContainerRepository
 	destroyer               gc.Destroyer
 	clock                   clock.Clock
@@ -31,6 +33,8 @@ func NewServer(
 	varSourcePool creds.VarSourcePool,
 	interceptTimeoutFactory InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
+	recordHijackSessions bool,
+	hijackSessionFactory db.HijackSessionFactory,
 	containerRepository db.ContainerRepository,
 	destroyer gc.Destroyer,
 	clock clock.Clock,
@@ -42,6 +46,8 @@ func NewServer(
 		varSourcePool:           varSourcePool,
 		interceptTimeoutFactory: interceptTimeoutFactory,
 		interceptUpdateInterval: interceptUpdateInterval,
+		recordHijackSessions:    recordHijackSessions,
+		hijackSessionFactory:    hijackSessionFactory,
 		containerRepository:     containerRepository,
 		destroyer:               destroyer,
 		clock:                   clock,
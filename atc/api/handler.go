@@ -13,11 +13,14 @@ import (
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/checkserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
+	"github.com/concourse/concourse/atc/api/componentserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
+	"github.com/concourse/concourse/atc/api/lockserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
+	"github.com/concourse/concourse/atc/api/maintenanceserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
@@ -55,6 +58,11 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbLockPoolFactory db.LockPoolFactory,
+	dbSystemLockFactory db.SystemLockFactory,
+	dbHijackSessionFactory db.HijackSessionFactory,
+	dbComponentFactory db.ComponentFactory,
+	componentNotifier componentserver.Notifier,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -72,10 +80,13 @@ func NewHandler(
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
+	recordHijackSessions bool,
 	dbWall db.Wall,
+	dbMaintenance db.Maintenance,
 	clock clock.Clock,
 
 	enableArchivePipeline bool,
+	lintRules atc.LintRules,
 ) (http.Handler, error) {
 
 	absCLIDownloadsDir, err := filepath.Abs(cliDownloadsDir)
@@ -87,25 +98,28 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, dbMaintenance)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
 	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, enableArchivePipeline)
-	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
+	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager, lintRules)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
 	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
-	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, interceptUpdateInterval, containerRepository, destroyer, clock)
+	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, interceptUpdateInterval, recordHijackSessions, dbHijackSessionFactory, containerRepository, destroyer, clock)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
-	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
+	lockServer := lockserver.NewServer(logger, dbLockPoolFactory, dbSystemLockFactory)
+	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL, lintRules)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	maintenanceServer := maintenanceserver.NewServer(dbMaintenance, dbWall, logger)
+	componentServer := componentserver.NewServer(logger, dbComponentFactory, componentNotifier)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -121,6 +135,8 @@ func NewHandler(
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
+		atc.GetBuildStepLog:     buildHandlerFactory.HandlerFor(buildServer.BuildStepLog),
+		atc.DownloadBuildLog:    buildHandlerFactory.HandlerFor(buildServer.DownloadBuildLog),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
@@ -142,7 +158,8 @@ func NewHandler(
 			Route:  atc.JobBadge,
 		},
 
-		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
+		atc.ClearTaskCache:       pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
+		atc.ClearTaskResultCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskResultCache),
 
 		atc.ListAllPipelines:    http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:       http.HandlerFunc(pipelineServer.ListPipelines),
@@ -159,6 +176,8 @@ func NewHandler(
 		atc.ListPipelineBuilds:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
+		atc.GetPipelineGraph:    pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipelineGraph),
+		atc.GetPipelineStats:    pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipelineStats),
 
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
@@ -203,6 +222,12 @@ func NewHandler(
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
+		atc.ListHijackSessions:        teamHandlerFactory.HandlerFor(containerServer.ListHijackSessions),
+		atc.GetHijackSessionRecording: teamHandlerFactory.HandlerFor(containerServer.GetHijackSessionRecording),
+
+		atc.ListLockPools:   teamHandlerFactory.HandlerFor(lockServer.ListLockPools),
+		atc.ReleaseLockPool: teamHandlerFactory.HandlerFor(lockServer.ReleaseLockPool),
+
 		atc.ListVolumes:           teamHandlerFactory.HandlerFor(volumesServer.ListVolumes),
 		atc.ListDestroyingVolumes: http.HandlerFunc(volumesServer.ListDestroyingVolumes),
 		atc.ReportWorkerVolumes:   http.HandlerFunc(volumesServer.ReportWorkerVolumes),
@@ -210,6 +235,7 @@ func NewHandler(
 		atc.ListTeams:      http.HandlerFunc(teamServer.ListTeams),
 		atc.GetTeam:        http.HandlerFunc(teamServer.GetTeam),
 		atc.SetTeam:        http.HandlerFunc(teamServer.SetTeam),
+		atc.ApplyTeam:      http.HandlerFunc(teamServer.ApplyTeam),
 		atc.RenameTeam:     http.HandlerFunc(teamServer.RenameTeam),
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
@@ -220,6 +246,18 @@ func NewHandler(
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.GetMaintenance:     http.HandlerFunc(maintenanceServer.GetMaintenance),
+		atc.EnableMaintenance:  http.HandlerFunc(maintenanceServer.EnableMaintenance),
+		atc.DisableMaintenance: http.HandlerFunc(maintenanceServer.DisableMaintenance),
+
+		atc.ListComponents:   http.HandlerFunc(componentServer.ListComponents),
+		atc.PauseComponent:   http.HandlerFunc(componentServer.PauseComponent),
+		atc.UnpauseComponent: http.HandlerFunc(componentServer.UnpauseComponent),
+		atc.RunComponent:     http.HandlerFunc(componentServer.RunComponent),
+
+		atc.ListSystemLocks:   http.HandlerFunc(lockServer.ListSystemLocks),
+		atc.ReleaseSystemLock: http.HandlerFunc(lockServer.ReleaseSystemLock),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
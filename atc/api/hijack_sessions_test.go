@@ -0,0 +1,250 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hijack Sessions API", func() {
+	Describe("GET /api/v1/teams/a-team/hijack-sessions", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/hijack-sessions")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeam.IDReturns(1)
+			})
+
+			Context("when listing the sessions succeeds", func() {
+				BeforeEach(func() {
+					exitStatus := 0
+
+					dbHijackSessionFactory.HijackSessionsReturns([]atc.HijackSession{
+						{
+							ID:       2,
+							TeamName: "a-team",
+							User:     "some-user",
+							Container: atc.HijackSessionContainer{
+								Handle:       "some-handle",
+								WorkerName:   "some-worker",
+								Type:         "task",
+								PipelineName: "some-pipeline",
+								JobName:      "some-job",
+								BuildID:      42,
+								BuildName:    "7",
+								StepName:     "some-task",
+							},
+							Path:       "bash",
+							Args:       []string{"-l"},
+							StartTime:  1000,
+							EndTime:    1060,
+							ExitStatus: &exitStatus,
+						},
+						{
+							ID:       1,
+							TeamName: "a-team",
+							User:     "other-user",
+							Container: atc.HijackSessionContainer{
+								Handle: "other-handle",
+							},
+							Path:      "sh",
+							StartTime: 900,
+						},
+					}, nil)
+				})
+
+				It("lists the team's sessions", func() {
+					Expect(dbHijackSessionFactory.HijackSessionsArgsForCall(0)).To(Equal(1))
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the sessions", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"team_name": "a-team",
+							"user": "some-user",
+							"container": {
+								"handle": "some-handle",
+								"worker_name": "some-worker",
+								"type": "task",
+								"pipeline_name": "some-pipeline",
+								"job_name": "some-job",
+								"build_id": 42,
+								"build_name": "7",
+								"step_name": "some-task"
+							},
+							"path": "bash",
+							"args": ["-l"],
+							"start_time": 1000,
+							"end_time": 1060,
+							"exit_status": 0
+						},
+						{
+							"id": 1,
+							"team_name": "a-team",
+							"user": "other-user",
+							"container": {
+								"handle": "other-handle"
+							},
+							"path": "sh",
+							"start_time": 900
+						}
+					]`))
+				})
+			})
+
+			Context("when listing the sessions fails", func() {
+				BeforeEach(func() {
+					dbHijackSessionFactory.HijackSessionsReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/a-team/hijack-sessions/:hijack_session_id/recording", func() {
+		var (
+			response  *http.Response
+			sessionID string
+		)
+
+		BeforeEach(func() {
+			sessionID = "2"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/hijack-sessions/" + sessionID + "/recording")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeam.IDReturns(1)
+			})
+
+			Context("when the recording exists", func() {
+				BeforeEach(func() {
+					dbHijackSessionFactory.RecordingReturns([]byte("some-recording\n"), true, nil)
+				})
+
+				It("looks up the team's session", func() {
+					teamID, id := dbHijackSessionFactory.RecordingArgsForCall(0)
+					Expect(teamID).To(Equal(1))
+					Expect(id).To(Equal(2))
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/x-asciicast'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/x-asciicast",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the recording", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("some-recording\n"))
+				})
+			})
+
+			Context("when the recording does not exist", func() {
+				BeforeEach(func() {
+					dbHijackSessionFactory.RecordingReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the session id is not a number", func() {
+				BeforeEach(func() {
+					sessionID = "nope"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when looking up the recording fails", func() {
+				BeforeEach(func() {
+					dbHijackSessionFactory.RecordingReturns(nil, false, errors.New("disaster"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
@@ -2156,6 +2156,60 @@ var _ = Describe("Jobs API", func() {
 						}`))
 							})
 						})
+
+						Context("when rerunning partially", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = "partial=true"
+
+								build := new(dbfakes.FakeBuild)
+								build.IDReturns(2)
+								build.NameReturns("1.1")
+								fakeJob.PartialRerunBuildReturns(build, nil)
+							})
+
+							It("creates a partial rerun from the first failed step", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+								Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+								Expect(fakeJob.PartialRerunBuildCallCount()).To(Equal(1))
+
+								buildToRerun, fromStep := fakeJob.PartialRerunBuildArgsForCall(0)
+								Expect(buildToRerun).To(Equal(fakeBuild))
+								Expect(fromStep).To(BeEmpty())
+							})
+
+							Context("from a step", func() {
+								BeforeEach(func() {
+									request.URL.RawQuery = "from_step=deploy"
+
+									fakeJob.ConfigReturns(atc.JobConfig{
+										Name: "some-job",
+										PlanSequence: []atc.Step{
+											{Config: &atc.GetStep{Name: "some-input"}},
+											{Config: &atc.TaskStep{Name: "deploy"}},
+										},
+									}, nil)
+								})
+
+								It("creates a partial rerun from the step", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+									_, fromStep := fakeJob.PartialRerunBuildArgsForCall(0)
+									Expect(fromStep).To(Equal("deploy"))
+								})
+
+								Context("when the job has no such step", func() {
+									BeforeEach(func() {
+										request.URL.RawQuery = "from_step=bogus"
+									})
+
+									It("returns a 400", func() {
+										Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+										Expect(fakeJob.PartialRerunBuildCallCount()).To(BeZero())
+									})
+								})
+							})
+						})
 					})
 				})
 			})
@@ -2489,6 +2543,75 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/result-cache", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/job-name/tasks/some-task/result-cache", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAuthenticatedReturns(true)
+
+				fakePipeline.JobReturns(fakeJob, true, nil)
+				fakeJob.ClearTaskResultCacheReturns(2, nil)
+			})
+
+			It("clears the step's cached results", func() {
+				jobName := fakePipeline.JobArgsForCall(0)
+				Expect(jobName).To(Equal("job-name"))
+
+				Expect(fakeJob.ClearTaskResultCacheCallCount()).To(Equal(1))
+				Expect(fakeJob.ClearTaskResultCacheArgsForCall(0)).To(Equal("some-task"))
+			})
+
+			It("returns the number of cached results removed", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{"caches_removed": 2}`))
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns a 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when clearing the cached results fails", func() {
+				BeforeEach(func() {
+					fakeJob.ClearTaskResultCacheReturns(0, errors.New("some-error"))
+				})
+
+				It("returns a 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Status Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/schedule", func() {
 		var response *http.Response
 
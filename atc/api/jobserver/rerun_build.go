@@ -3,8 +3,10 @@ package jobserver
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -47,7 +49,27 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.RerunBuild(buildToRerun)
+		var build db.Build
+		if fromStep := r.FormValue("from_step"); fromStep != "" || r.FormValue("partial") == "true" {
+			if fromStep != "" {
+				config, err := job.Config()
+				if err != nil {
+					logger.Error("failed-to-get-job-config", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				if !hasStep(config, fromStep) {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprintf(w, "unknown step '%s'", fromStep)
+					return
+				}
+			}
+
+			build, err = job.PartialRerunBuild(buildToRerun, fromStep)
+		} else {
+			build, err = job.RerunBuild(buildToRerun)
+		}
 		if err != nil {
 			logger.Error("failed-to-retrigger-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -61,3 +83,23 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+func hasStep(config atc.JobConfig, name string) bool {
+	found := false
+	_ = config.StepConfig().Visit(atc.StepRecursor{
+		OnGet: func(step *atc.GetStep) error {
+			found = found || step.Name == name
+			return nil
+		},
+		OnPut: func(step *atc.PutStep) error {
+			found = found || step.Name == name
+			return nil
+		},
+		OnTask: func(step *atc.TaskStep) error {
+			found = found || step.Name == name
+			return nil
+		},
+	})
+
+	return found
+}
@@ -0,0 +1,232 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Locks API", func() {
+	Describe("GET /api/v1/teams/a-team/locks", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/locks")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeam.IDReturns(1)
+			})
+
+			Context("when listing the lock pools succeeds", func() {
+				BeforeEach(func() {
+					dbLockPoolFactory.LockPoolsReturns([]atc.LockPool{
+						{
+							Name:     "some-lock",
+							TeamName: "a-team",
+							Size:     2,
+							Holders: []atc.LockPoolHolder{
+								{
+									BuildID:      42,
+									BuildName:    "7",
+									JobName:      "some-job",
+									PipelineName: "some-pipeline",
+									TeamName:     "a-team",
+									AcquiredAt:   1234,
+								},
+							},
+						},
+						{
+							Name:     "other-lock",
+							TeamName: "a-team",
+							Size:     1,
+							Holders:  []atc.LockPoolHolder{},
+						},
+					}, nil)
+				})
+
+				It("lists the team's lock pools", func() {
+					Expect(dbLockPoolFactory.LockPoolsArgsForCall(0)).To(Equal(1))
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the lock pools", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-lock",
+							"team_name": "a-team",
+							"size": 2,
+							"holders": [
+								{
+									"build_id": 42,
+									"build_name": "7",
+									"job_name": "some-job",
+									"pipeline_name": "some-pipeline",
+									"team_name": "a-team",
+									"acquired_at": 1234
+								}
+							]
+						},
+						{
+							"name": "other-lock",
+							"team_name": "a-team",
+							"size": 1,
+							"holders": []
+						}
+					]`))
+				})
+			})
+
+			Context("when listing the lock pools fails", func() {
+				BeforeEach(func() {
+					dbLockPoolFactory.LockPoolsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/a-team/locks/some-lock/release", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/locks/some-lock/release"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeam.IDReturns(1)
+			})
+
+			Context("when the lock exists", func() {
+				BeforeEach(func() {
+					dbLockPoolFactory.ForceReleaseReturns(true, nil)
+				})
+
+				It("releases every holder of the lock", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					teamID, name, buildID := dbLockPoolFactory.ForceReleaseArgsForCall(0)
+					Expect(teamID).To(Equal(1))
+					Expect(name).To(Equal("some-lock"))
+					Expect(buildID).To(BeZero())
+				})
+
+				Context("when a build is given", func() {
+					BeforeEach(func() {
+						query = "?build_id=42"
+					})
+
+					It("releases the build's lock", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						_, _, buildID := dbLockPoolFactory.ForceReleaseArgsForCall(0)
+						Expect(buildID).To(Equal(42))
+					})
+				})
+
+				Context("when the build is malformed", func() {
+					BeforeEach(func() {
+						query = "?build_id=nope"
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbLockPoolFactory.ForceReleaseCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the lock does not exist", func() {
+				BeforeEach(func() {
+					dbLockPoolFactory.ForceReleaseReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when releasing the lock fails", func() {
+				BeforeEach(func() {
+					dbLockPoolFactory.ForceReleaseReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
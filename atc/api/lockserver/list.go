@@ -0,0 +1,31 @@
+package lockserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListLockPools(team db.Team) http.Handler {
+	hLog := s.logger.Session("list-lock-pools")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pools, err := s.lockPoolFactory.LockPools(team.ID())
+		if err != nil {
+			hLog.Error("failed-to-list-lock-pools", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		hLog.Debug("listed", lager.Data{"lock-pool-count": len(pools)})
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(pools)
+		if err != nil {
+			hLog.Error("failed-to-encode-lock-pools", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package lockserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ReleaseLockPool forcibly releases a lock held by a build. If no build_id
+// query parameter is given, every holder of the lock is released.
+func (s *Server) ReleaseLockPool(team db.Team) http.Handler {
+	hLog := s.logger.Session("release-lock-pool")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lockName := r.FormValue(":lock_name")
+
+		var buildID int
+		if rawBuildID := r.FormValue("build_id"); rawBuildID != "" {
+			var err error
+			buildID, err = strconv.Atoi(rawBuildID)
+			if err != nil {
+				hLog.Info("malformed-build-id", lager.Data{"build-id": rawBuildID})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		logger := hLog.WithData(lager.Data{"lock": lockName, "build-id": buildID})
+
+		found, err := s.lockPoolFactory.ForceRelease(team.ID(), lockName, buildID)
+		if err != nil {
+			logger.Error("failed-to-release-lock", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		logger.Info("released")
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
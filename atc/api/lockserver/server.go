@@ -0,0 +1,24 @@
+package lockserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger            lager.Logger
+	lockPoolFactory   db.LockPoolFactory
+	systemLockFactory db.SystemLockFactory
+}
+
+func NewServer(
+	logger lager.Logger,
+	lockPoolFactory db.LockPoolFactory,
+	systemLockFactory db.SystemLockFactory,
+) *Server {
+	return &Server{
+		logger:            logger,
+		lockPoolFactory:   lockPoolFactory,
+		systemLockFactory: systemLockFactory,
+	}
+}
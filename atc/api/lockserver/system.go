@@ -0,0 +1,71 @@
+package lockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+func (s *Server) ListSystemLocks(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("list-system-locks")
+
+	locks, err := s.systemLockFactory.SystemLocks()
+	if err != nil {
+		hLog.Error("failed-to-list-system-locks", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	hLog.Debug("listed", lager.Data{"lock-count": len(locks)})
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(locks)
+	if err != nil {
+		hLog.Error("failed-to-encode-system-locks", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// ReleaseSystemLock requests the ATC holding a lock through the given database
+// session to release it. The lock is released asynchronously, once the ATC
+// has been notified.
+func (s *Server) ReleaseSystemLock(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("release-system-lock")
+
+	rawSessionID := r.FormValue(":session_id")
+	sessionID, err := strconv.Atoi(rawSessionID)
+	if err != nil {
+		hLog.Info("malformed-session-id", lager.Data{"session-id": rawSessionID})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var id lock.LockID
+	err = json.NewDecoder(r.Body).Decode(&id)
+	if err != nil || len(id) == 0 || len(id) > 2 {
+		hLog.Info("malformed-lock-id", lager.Data{"session-id": sessionID})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	logger := hLog.WithData(lager.Data{"session-id": sessionID, "id": id})
+
+	found, err := s.systemLockFactory.RequestRelease(sessionID, id)
+	if err != nil {
+		logger.Error("failed-to-request-release", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger.Info("requested-release")
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,246 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/maintenanceserver"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/maintenance", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/maintenance")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when getting the status succeeds", func() {
+				BeforeEach(func() {
+					dbMaintenance.StatusReturns(atc.Maintenance{
+						Enabled:       true,
+						Message:       "upgrading postgres",
+						StartTime:     1597665600,
+						RunningBuilds: 2,
+						PendingBuilds: 5,
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the status", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"enabled": true,
+						"message": "upgrading postgres",
+						"start_time": 1597665600,
+						"running_builds": 2,
+						"pending_builds": 5
+					}`))
+				})
+			})
+
+			Context("when getting the status fails", func() {
+				BeforeEach(func() {
+					dbMaintenance.StatusReturns(atc.Maintenance{}, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/maintenance", func() {
+		var payload string
+
+		BeforeEach(func() {
+			payload = `{"message":"upgrading postgres"}`
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/maintenance", bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("enables maintenance with the message", func() {
+				Expect(dbMaintenance.EnableCallCount()).To(Equal(1))
+				Expect(dbMaintenance.EnableArgsForCall(0)).To(Equal("upgrading postgres"))
+			})
+
+			It("puts the message up on the wall", func() {
+				Expect(dbWall.SetWallCallCount()).To(Equal(1))
+				Expect(dbWall.SetWallArgsForCall(0)).To(Equal(atc.Wall{Message: "upgrading postgres"}))
+			})
+
+			Context("when no message is given", func() {
+				BeforeEach(func() {
+					payload = `{}`
+				})
+
+				It("uses the default message", func() {
+					Expect(dbMaintenance.EnableArgsForCall(0)).To(Equal(maintenanceserver.DefaultMessage))
+					Expect(dbWall.SetWallArgsForCall(0)).To(Equal(atc.Wall{Message: maintenanceserver.DefaultMessage}))
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					payload = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not enable maintenance", func() {
+					Expect(dbMaintenance.EnableCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when enabling maintenance fails", func() {
+				BeforeEach(func() {
+					dbMaintenance.EnableReturns(errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+
+				It("does not set the wall", func() {
+					Expect(dbWall.SetWallCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not enable maintenance", func() {
+				Expect(dbMaintenance.EnableCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/maintenance", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/maintenance", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("disables maintenance and clears the wall", func() {
+				Expect(dbMaintenance.DisableCallCount()).To(Equal(1))
+				Expect(dbWall.ClearCallCount()).To(Equal(1))
+			})
+
+			Context("when disabling maintenance fails", func() {
+				BeforeEach(func() {
+					dbMaintenance.DisableReturns(errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package maintenanceserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// DefaultMessage is shown on the wall when maintenance is enabled without a
+// message.
+const DefaultMessage = "Concourse is undergoing maintenance. New builds will not be started until it is over."
+
+func (s *Server) GetMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("maintenance")
+
+	status, err := s.maintenance.Status()
+	if err != nil {
+		logger.Error("failed-to-get-maintenance-status", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(status)
+	if err != nil {
+		logger.Error("failed-to-encode-json", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+// EnableMaintenance enables maintenance mode and puts its message up on the
+// wall, replacing any message which was there before.
+func (s *Server) EnableMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("maintenance")
+
+	var maintenance atc.Maintenance
+	err := json.NewDecoder(r.Body).Decode(&maintenance)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	message := maintenance.Message
+	if message == "" {
+		message = DefaultMessage
+	}
+
+	err = s.maintenance.Enable(message)
+	if err != nil {
+		logger.Error("failed-to-enable-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	err = s.wall.SetWall(atc.Wall{Message: message})
+	if err != nil {
+		logger.Error("failed-to-set-wall-message", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("enabled", lager.Data{"message": message})
+}
+
+// DisableMaintenance disables maintenance mode and takes down the wall
+// message, after which pending builds are started as usual.
+func (s *Server) DisableMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("maintenance")
+
+	err := s.maintenance.Disable()
+	if err != nil {
+		logger.Error("failed-to-disable-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	err = s.wall.Clear()
+	if err != nil {
+		logger.Error("failed-to-clear-the-wall", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("disabled")
+}
@@ -0,0 +1,20 @@
+package maintenanceserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	maintenance db.Maintenance
+	wall        db.Wall
+	logger      lager.Logger
+}
+
+func NewServer(maintenance db.Maintenance, wall db.Wall, logger lager.Logger) *Server {
+	return &Server{
+		maintenance: maintenance,
+		wall:        wall,
+		logger:      logger,
+	}
+}
@@ -1544,6 +1544,277 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/graph", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/graph"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+
+				dbPipeline.ConfigReturns(atc.Config{
+					Groups: atc.GroupConfigs{
+						{Name: "some-group", Jobs: []string{"some-job"}},
+					},
+					Resources: atc.ResourceConfigs{
+						{Name: "some-resource", Type: "git"},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{Config: &atc.GetStep{Name: "some-resource", Trigger: true}},
+							},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns the graph as json", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"nodes": [
+						{"id": "resource:some-resource", "type": "resource", "name": "some-resource"},
+						{"id": "job:some-job", "type": "job", "name": "some-job", "groups": ["some-group"]}
+					],
+					"edges": [
+						{"from": "resource:some-resource", "to": "job:some-job", "type": "get", "trigger": true}
+					]
+				}`))
+			})
+
+			Context("when the dot format is requested", func() {
+				BeforeEach(func() {
+					query = "?format=dot"
+				})
+
+				It("returns the graph in dot", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("text/vnd.graphviz"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring(`"resource:some-resource" -> "job:some-job" [label="get"];`))
+				})
+			})
+
+			Context("when the mermaid format is requested", func() {
+				BeforeEach(func() {
+					query = "?format=mermaid"
+				})
+
+				It("returns the graph as a mermaid flowchart", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(HavePrefix("flowchart LR\n"))
+				})
+			})
+
+			Context("when an unknown format is requested", func() {
+				BeforeEach(func() {
+					query = "?format=png"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a group is requested", func() {
+				BeforeEach(func() {
+					query = "?group=some-group"
+				})
+
+				It("returns only the group's part of the graph", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"nodes": [
+							{"id": "job:some-job", "type": "job", "name": "some-job", "groups": ["some-group"]}
+						],
+						"edges": []
+					}`))
+				})
+			})
+
+			Context("when the group does not exist", func() {
+				BeforeEach(func() {
+					query = "?group=bogus"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the config fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigReturns(atc.Config{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/stats", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/stats"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+
+				dbPipeline.JobStatsReturns([]atc.JobStats{
+					{
+						JobName:            "some-job",
+						Builds:             4,
+						Succeeded:          3,
+						Failed:             1,
+						SuccessRate:        0.75,
+						DurationP50:        60,
+						DurationP95:        90,
+						MeanTimeToRecovery: 300,
+						Recoveries:         1,
+						Triggers:           atc.JobTriggerStats{Scheduled: 3, Manual: 1},
+					},
+				}, nil)
+			})
+
+			It("returns the stats of each job", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"job_name": "some-job",
+						"builds": 4,
+						"succeeded": 3,
+						"failed": 1,
+						"errored": 0,
+						"aborted": 0,
+						"success_rate": 0.75,
+						"duration_p50": 60,
+						"duration_p95": 90,
+						"mean_time_to_recovery": 300,
+						"recoveries": 1,
+						"triggers": {"scheduled": 3, "manual": 1, "rerun": 0}
+					}
+				]`))
+			})
+
+			It("summarizes the last 30 days by default", func() {
+				Expect(dbPipeline.JobStatsCallCount()).To(Equal(1))
+				Expect(dbPipeline.JobStatsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+			})
+
+			Context("when 'since' is given", func() {
+				BeforeEach(func() {
+					query = "?since=1600000000"
+				})
+
+				It("summarizes the builds since then", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbPipeline.JobStatsArgsForCall(0)).To(Equal(time.Unix(1600000000, 0)))
+				})
+			})
+
+			Context("when 'since' is not a timestamp", func() {
+				BeforeEach(func() {
+					query = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbPipeline.JobStatsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the stats fails", func() {
+				BeforeEach(func() {
+					dbPipeline.JobStatsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/rename", func() {
 		var response *http.Response
 
@@ -0,0 +1,62 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetPipelineGraph(pipelineDB db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-pipeline-graph")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format := r.FormValue("format")
+		if format == "" {
+			format = atc.PipelineGraphFormatJSON
+		}
+
+		config, err := pipelineDB.Config()
+		if err != nil {
+			logger.Error("failed-to-get-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		graph := atc.NewPipelineGraph(config)
+
+		if group := r.FormValue("group"); group != "" {
+			if _, _, found := config.Groups.Lookup(group); !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			graph = graph.Group(group)
+		}
+
+		switch format {
+		case atc.PipelineGraphFormatJSON:
+			w.Header().Set("Content-Type", "application/json")
+
+			err = json.NewEncoder(w).Encode(graph)
+			if err != nil {
+				logger.Error("failed-to-encode-graph", err)
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+
+		case atc.PipelineGraphFormatDOT:
+			w.Header().Set("Content-Type", "text/vnd.graphviz")
+			fmt.Fprint(w, graph.DOT())
+
+		case atc.PipelineGraphFormatMermaid:
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, graph.Mermaid())
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "unknown format '%s'", format)
+		}
+	})
+}
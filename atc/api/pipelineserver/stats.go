@@ -0,0 +1,49 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultStatsPeriod is the period summarized by GetPipelineStats when no
+// 'since' parameter is given.
+const defaultStatsPeriod = 30 * 24 * time.Hour
+
+func (s *Server) GetPipelineStats(pipelineDB db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-pipeline-stats")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since := time.Now().Add(-defaultStatsPeriod)
+
+		if param := r.FormValue("since"); param != "" {
+			unix, err := strconv.ParseInt(param, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "invalid 'since' timestamp '%s'", param)
+				return
+			}
+
+			since = time.Unix(unix, 0)
+		}
+
+		stats, err := pipelineDB.JobStats(since)
+		if err != nil {
+			logger.Error("failed-to-get-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(stats)
+		if err != nil {
+			logger.Error("failed-to-encode-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -26,6 +26,7 @@ func Worker(workerInfo db.Worker) atc.Worker {
 	atcWorker := atc.Worker{
 		GardenAddr:       gardenAddr,
 		BaggageclaimURL:  baggageclaimURL,
+		P2PURL:           workerInfo.P2PURL(),
 		HTTPProxyURL:     workerInfo.HTTPProxyURL(),
 		HTTPSProxyURL:    workerInfo.HTTPSProxyURL(),
 		NoProxy:          workerInfo.NoProxy(),
@@ -35,11 +36,24 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
+		Labels:           workerInfo.Labels(),
 		Name:             workerInfo.Name(),
 		Team:             workerInfo.TeamName(),
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+
+		VolumeStoreCapacity: workerInfo.VolumeStoreCapacity(),
+		VolumeStoreUsed:     workerInfo.VolumeStoreUsed(),
+	}
+
+	if allocatable := workerInfo.Allocatable(); !allocatable.IsZero() {
+		atcWorker.Allocatable = &allocatable
+	}
+
+	committed, err := workerInfo.CommittedRequests()
+	if err == nil && !committed.IsZero() {
+		atcWorker.Committed = &committed
 	}
 
 	if !workerInfo.StartTime().IsZero() {
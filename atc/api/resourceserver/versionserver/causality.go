@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -23,7 +24,13 @@ func (s *Server) GetCausality(pipeline db.Pipeline) http.Handler {
 			"version": versionID,
 		})
 
-		causality, err := pipeline.Causality(versionID)
+		var causality []db.Cause
+		acc := accessor.GetAccessor(r)
+		if acc.IsAdmin() {
+			causality, err = pipeline.Causality(versionID)
+		} else {
+			causality, err = pipeline.VisibleCausality(versionID, acc.TeamNames())
+		}
 		if err != nil {
 			hLog.Error("failed-to-fetch", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -42,6 +42,14 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			}
 		}
 
+		filter, err := parseVersionFilter(r)
+		if err != nil {
+			logger.Info("invalid-version-filter", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
 		resourceName := r.FormValue(":resource_name")
 		teamName := r.FormValue(":team_name")
 
@@ -83,7 +91,7 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			From:  from,
 			To:    to,
 			Limit: limit,
-		}, versionFilter)
+		}, versionFilter, filter)
 		if err != nil {
 			logger.Error("failed-to-get-resource-config-versions", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -121,6 +129,68 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 	})
 }
 
+// parseVersionFilter reads a filter from the metadata, version and semver
+// params, each of the form "NAME:VALUE". Metadata and version may be given
+// more than once.
+func parseVersionFilter(r *http.Request) (*atc.VersionFilter, error) {
+	var filter atc.VersionFilter
+
+	for _, param := range r.Form["metadata"] {
+		name, value, err := splitFilterParam("metadata", param)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Metadata == nil {
+			filter.Metadata = map[string]string{}
+		}
+
+		filter.Metadata[name] = value
+	}
+
+	for _, param := range r.Form["version"] {
+		name, value, err := splitFilterParam("version", param)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Version == nil {
+			filter.Version = map[string]string{}
+		}
+
+		filter.Version[name] = value
+	}
+
+	if param := r.FormValue("semver"); param != "" {
+		name, value, err := splitFilterParam("semver", param)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Semver = &atc.SemverFilter{Field: name, Range: value}
+	}
+
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	errs := filter.Validate()
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	return &filter, nil
+}
+
+func splitFilterParam(key string, param string) (string, string, error) {
+	vs := strings.SplitN(param, ":", 2)
+	if len(vs) != 2 || vs[0] == "" {
+		return "", "", fmt.Errorf("invalid '%s' param '%s': expected NAME:VALUE", key, param)
+	}
+
+	return vs[0], vs[1], nil
+}
+
 func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, resourceName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
 		`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d>; rel="%s"`,
@@ -0,0 +1,212 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("System Locks API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/locks", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/locks")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when listing the locks succeeds", func() {
+				BeforeEach(func() {
+					dbSystemLockFactory.SystemLocksReturns([]atc.SystemLock{
+						{
+							Type:         "JobScheduling",
+							ID:           []int{8, 42},
+							Object:       "job some-pipeline/some-job",
+							ATC:          "some-atc",
+							ClientAddr:   "10.0.0.1",
+							SessionID:    1234,
+							HoldDuration: 2.5,
+						},
+						{
+							Type:      "ResourceScanning",
+							ID:        []int{7},
+							ATC:       "other-atc",
+							SessionID: 5678,
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the locks", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"type": "JobScheduling",
+							"id": [8, 42],
+							"object": "job some-pipeline/some-job",
+							"atc": "some-atc",
+							"client_addr": "10.0.0.1",
+							"session_id": 1234,
+							"hold_duration": 2.5
+						},
+						{
+							"type": "ResourceScanning",
+							"id": [7],
+							"atc": "other-atc",
+							"session_id": 5678
+						}
+					]`))
+				})
+			})
+
+			Context("when listing the locks fails", func() {
+				BeforeEach(func() {
+					dbSystemLockFactory.SystemLocksReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/locks/sessions/:session_id/release", func() {
+		var sessionID string
+		var body string
+
+		BeforeEach(func() {
+			sessionID = "1234"
+			body = "[8,42]"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/locks/sessions/"+sessionID+"/release", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the session holds the lock", func() {
+				BeforeEach(func() {
+					dbSystemLockFactory.RequestReleaseReturns(true, nil)
+				})
+
+				It("requests the release of only that lock", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+
+					Expect(dbSystemLockFactory.RequestReleaseCallCount()).To(Equal(1))
+					requestedSessionID, requestedID := dbSystemLockFactory.RequestReleaseArgsForCall(0)
+					Expect(requestedSessionID).To(Equal(1234))
+					Expect(requestedID).To(Equal(lock.LockID{8, 42}))
+				})
+			})
+
+			Context("when the session does not hold the lock", func() {
+				BeforeEach(func() {
+					dbSystemLockFactory.RequestReleaseReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when requesting the release fails", func() {
+				BeforeEach(func() {
+					dbSystemLockFactory.RequestReleaseReturns(false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the session ID is malformed", func() {
+				BeforeEach(func() {
+					sessionID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbSystemLockFactory.RequestReleaseCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the lock ID is malformed", func() {
+				BeforeEach(func() {
+					body = "[]"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbSystemLockFactory.RequestReleaseCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when authenticated but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbSystemLockFactory.RequestReleaseCallCount()).To(BeZero())
+			})
+		})
+	})
+})
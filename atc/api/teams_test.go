@@ -330,15 +330,67 @@ var _ = Describe("Teams API", func() {
 
 					It("updates provider auth", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusOK))
-						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(1))
 
-						updatedProviderAuth := fakeTeam.UpdateProviderAuthArgsForCall(0)
+						updatedProviderAuth, _, updatedQuotas := fakeTeam.UpdateSettingsArgsForCall(0)
 						Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
+						Expect(updatedQuotas).To(BeNil())
 					})
 
-					Context("when updating provider auth fails", func() {
+					Context("when lint rules are given", func() {
 						BeforeEach(func() {
-							fakeTeam.UpdateProviderAuthReturns(errors.New("stop trying to make fetch happen"))
+							atcTeam.LintRules = atc.LintRules{"privileged-task": atc.LintSeverityError}
+						})
+
+						It("updates the lint rules", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(1))
+
+							_, updatedLintRules, _ := fakeTeam.UpdateSettingsArgsForCall(0)
+							Expect(updatedLintRules).To(Equal(atc.LintRules{"privileged-task": atc.LintSeverityError}))
+						})
+					})
+
+					Context("when lint rules are invalid", func() {
+						BeforeEach(func() {
+							atcTeam.LintRules = atc.LintRules{"bogus-rule": atc.LintSeverityError}
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when quotas are negative", func() {
+						BeforeEach(func() {
+							atcTeam.Quotas = &atc.TeamQuotas{MaxContainers: -1}
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the quotas are unchanged", func() {
+						BeforeEach(func() {
+							atcTeam.Quotas = &atc.TeamQuotas{MaxContainers: 10}
+							fakeTeam.QuotasReturns(atc.TeamQuotas{MaxContainers: 10}, nil)
+						})
+
+						It("does not update the quotas", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(1))
+
+							_, _, updatedQuotas := fakeTeam.UpdateSettingsArgsForCall(0)
+							Expect(updatedQuotas).To(BeNil())
+						})
+					})
+
+					Context("when updating the team fails", func() {
+						BeforeEach(func() {
+							fakeTeam.UpdateSettingsReturns(errors.New("stop trying to make fetch happen"))
 						})
 
 						It("returns 500 Internal Server error", func() {
@@ -353,7 +405,7 @@ var _ = Describe("Teams API", func() {
 
 						It("does not update provider auth", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
-							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(0))
 						})
 					})
 
@@ -372,7 +424,7 @@ var _ = Describe("Teams API", func() {
 
 						It("does not update provider auth", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
-							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -387,12 +439,44 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists and quotas are given", func() {
+					BeforeEach(func() {
+						atcTeam.Quotas = &atc.TeamQuotas{MaxConcurrentBuilds: 5}
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("updates the quotas", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(1))
+
+						_, _, updatedQuotas := fakeTeam.UpdateSettingsArgsForCall(0)
+						Expect(updatedQuotas).To(Equal(&atc.TeamQuotas{MaxConcurrentBuilds: 5}))
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
 						dbTeamFactory.CreateTeamReturns(fakeTeam, nil)
 					})
 
+					It("does not save quotas on the new team", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+						Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+					})
+
+					Context("when quotas are given", func() {
+						BeforeEach(func() {
+							atcTeam.Quotas = &atc.TeamQuotas{MaxChecksPerMinute: 60}
+						})
+
+						It("saves the quotas on the new team", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusCreated))
+							Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateQuotasArgsForCall(0)).To(Equal(atc.TeamQuotas{MaxChecksPerMinute: 60}))
+						})
+					})
+
 					It("creates the team", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusCreated))
 						Expect(dbTeamFactory.CreateTeamCallCount()).To(Equal(1))
@@ -432,6 +516,18 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists and the quotas are changed", func() {
+					BeforeEach(func() {
+						atcTeam.Quotas = &atc.TeamQuotas{MaxConcurrentBuilds: 5}
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("returns 403 Forbidden without updating the team", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(fakeTeam.UpdateSettingsCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
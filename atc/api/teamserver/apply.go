@@ -0,0 +1,427 @@
+package teamserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/db"
+)
+
+var ansiEscapeRegex = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// ApplyTeam brings a team and its pipelines in line with a manifest. With
+// dry_run set, the plan is only computed and returned.
+func (s *Server) ApplyTeam(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("apply-team")
+
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+	dryRun := r.FormValue(atc.ApplyDryRun) == "true"
+	prune := r.FormValue(atc.ApplyPrune) == "true"
+
+	var manifest atc.TeamManifest
+	err := json.NewDecoder(r.Body).Decode(&manifest)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		s.handleBadRequest(w, fmt.Sprintf("malformed manifest: %s", err))
+		return
+	}
+
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		hLog.Debug("not-allowed")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found && !acc.IsAdmin() {
+		hLog.Debug("not-allowed-to-create-team")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	lintRules := s.lintRules
+	if found {
+		teamLintRules, err := team.LintRules()
+		if err != nil {
+			hLog.Error("failed-to-get-lint-rules", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		lintRules = lintRules.Merge(teamLintRules)
+	}
+
+	errorMessages := validateManifest(manifest, !found, lintRules)
+	if len(errorMessages) > 0 {
+		hLog.Info("ignoring-invalid-manifest", lager.Data{"errors": errorMessages})
+		s.handleBadRequest(w, errorMessages...)
+		return
+	}
+
+	var existing []db.Pipeline
+	if found {
+		existing, err = team.Pipelines()
+		if err != nil {
+			hLog.Error("failed-to-get-pipelines", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	plan, err := planApply(teamName, team, existing, manifest, prune)
+	if err != nil {
+		hLog.Error("failed-to-plan", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !dryRun && manifest.ConfigVersions != nil {
+		changed := changedSincePlan(plan, manifest.ConfigVersions)
+		if len(changed) > 0 {
+			hLog.Info("pipelines-changed-since-plan", lager.Data{"pipelines": changed})
+			http.Error(w, fmt.Sprintf("pipelines changed since the plan was made: %s", strings.Join(changed, ", ")), http.StatusConflict)
+			return
+		}
+	}
+
+	status := http.StatusOK
+	if !dryRun && plan.HasChanges() {
+		err = s.apply(hLog, team, existing, manifest, &plan)
+		if err != nil {
+			hLog.Error("failed-to-apply", err)
+
+			// the pipelines applied so far are not rolled back, so the
+			// plan is returned marking them
+			plan.Error = err.Error()
+
+			switch {
+			case err == db.ErrConfigComparisonFailed:
+				status = http.StatusConflict
+			case isPassedJobNotFound(err):
+				status = http.StatusBadRequest
+			default:
+				status = http.StatusInternalServerError
+			}
+		} else {
+			plan.Applied = true
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err = json.NewEncoder(w).Encode(plan)
+	if err != nil {
+		hLog.Error("failed-to-encode-plan", err)
+	}
+}
+
+// changedSincePlan returns the pipelines in the plan whose config version
+// differs from the one the confirmed plan was made against.
+func changedSincePlan(plan atc.TeamApplyPlan, confirmed map[string]int) []string {
+	var changed []string
+	for _, pipelinePlan := range plan.Pipelines {
+		if pipelinePlan.ConfigVersion != confirmed[pipelinePlan.Name] {
+			changed = append(changed, pipelinePlan.Name)
+		}
+	}
+
+	return changed
+}
+
+func isPassedJobNotFound(err error) bool {
+	_, ok := err.(db.PassedJobNotFoundError)
+	return ok
+}
+
+func validateManifest(manifest atc.TeamManifest, creatingTeam bool, lintRules atc.LintRules) []string {
+	var errorMessages []string
+
+	if manifest.Auth != nil {
+		if err := manifest.Auth.Validate(); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("invalid auth: %s", err))
+		}
+	} else if creatingTeam {
+		errorMessages = append(errorMessages, "auth must be configured to create the team")
+	}
+
+	names := map[string]bool{}
+	for i, pipeline := range manifest.Pipelines {
+		if pipeline.Name == "" {
+			errorMessages = append(errorMessages, fmt.Sprintf("pipelines[%d] has no name", i))
+			continue
+		}
+
+		if names[pipeline.Name] {
+			errorMessages = append(errorMessages, fmt.Sprintf("pipeline '%s' is declared more than once", pipeline.Name))
+			continue
+		}
+
+		names[pipeline.Name] = true
+
+		_, configErrors := configvalidate.Validate(pipeline.Config)
+		_, lintErrors := configvalidate.LintWarnings(configvalidate.Lint(pipeline.Config, lintRules))
+
+		for _, message := range append(configErrors, lintErrors...) {
+			errorMessages = append(errorMessages, fmt.Sprintf("pipeline '%s': %s", pipeline.Name, message))
+		}
+	}
+
+	return errorMessages
+}
+
+func planApply(teamName string, team db.Team, existing []db.Pipeline, manifest atc.TeamManifest, prune bool) (atc.TeamApplyPlan, error) {
+	plan := atc.TeamApplyPlan{
+		Team:       teamName,
+		CreateTeam: team == nil,
+		Pipelines:  []atc.PipelineApplyPlan{},
+	}
+
+	if team != nil && manifest.Auth != nil && !reflect.DeepEqual(team.Auth(), manifest.Auth) {
+		plan.UpdateAuth = true
+	}
+
+	existingByName := map[string]db.Pipeline{}
+	for _, pipeline := range existing {
+		existingByName[pipeline.Name()] = pipeline
+	}
+
+	managed := map[string]bool{}
+	var created []string
+
+	for _, desired := range manifest.Pipelines {
+		managed[desired.Name] = true
+
+		paused := desired.Paused
+		exposed := desired.Exposed
+
+		pipelinePlan := atc.PipelineApplyPlan{
+			Name:   desired.Name,
+			Action: atc.ApplyActionNone,
+		}
+
+		pipeline, found := existingByName[desired.Name]
+		if !found {
+			pipelinePlan.Action = atc.ApplyActionCreate
+			pipelinePlan.Diff = configDiff(atc.Config{}, desired.Config)
+
+			if exposed {
+				pipelinePlan.Expose = &exposed
+			}
+
+			created = append(created, desired.Name)
+		} else {
+			pipelinePlan.ConfigVersion = int(pipeline.ConfigVersion())
+
+			config, err := pipeline.Config()
+			if err != nil {
+				return atc.TeamApplyPlan{}, err
+			}
+
+			if diff := configDiff(config, desired.Config); diff != "" {
+				pipelinePlan.Action = atc.ApplyActionUpdate
+				pipelinePlan.Diff = diff
+			}
+
+			if pipeline.Paused() != desired.Paused {
+				pipelinePlan.Pause = &paused
+			}
+
+			if pipeline.Public() != desired.Exposed {
+				pipelinePlan.Expose = &exposed
+			}
+		}
+
+		plan.Pipelines = append(plan.Pipelines, pipelinePlan)
+	}
+
+	// pipelines that already exist keep their order and created pipelines are
+	// added to the end, unless they're reordered
+	var order []string
+	for _, pipeline := range existing {
+		if managed[pipeline.Name()] {
+			order = append(order, pipeline.Name())
+		}
+	}
+
+	order = append(order, created...)
+
+	for i, desired := range manifest.Pipelines {
+		if order[i] != desired.Name {
+			plan.Reorder = true
+			break
+		}
+	}
+
+	if prune {
+		for _, pipeline := range existing {
+			if !managed[pipeline.Name()] {
+				plan.Pipelines = append(plan.Pipelines, atc.PipelineApplyPlan{
+					Name:          pipeline.Name(),
+					Action:        atc.ApplyActionDestroy,
+					ConfigVersion: int(pipeline.ConfigVersion()),
+				})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func configDiff(before atc.Config, after atc.Config) string {
+	buf := new(bytes.Buffer)
+	if !before.Diff(buf, after) {
+		return ""
+	}
+
+	return ansiEscapeRegex.ReplaceAllString(buf.String(), "")
+}
+
+// apply makes the changes in the plan, marking each pipeline once it has been
+// applied.
+func (s *Server) apply(logger lager.Logger, team db.Team, existing []db.Pipeline, manifest atc.TeamManifest, plan *atc.TeamApplyPlan) error {
+	var err error
+	if plan.CreateTeam {
+		team, err = s.teamFactory.CreateTeam(atc.Team{
+			Name: plan.Team,
+			Auth: manifest.Auth,
+		})
+		if err != nil {
+			return err
+		}
+	} else if plan.UpdateAuth {
+		err = team.UpdateProviderAuth(manifest.Auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.CreateTeam || plan.UpdateAuth {
+		err = s.teamFactory.NotifyCacher()
+		if err != nil {
+			return err
+		}
+	}
+
+	existingByName := map[string]db.Pipeline{}
+	for _, pipeline := range existing {
+		existingByName[pipeline.Name()] = pipeline
+	}
+
+	desiredByName := map[string]atc.PipelineManifest{}
+	for _, desired := range manifest.Pipelines {
+		desiredByName[desired.Name] = desired
+	}
+
+	updated := false
+	for i := range plan.Pipelines {
+		pipelinePlan := &plan.Pipelines[i]
+
+		logger.Debug("applying-pipeline", lager.Data{"pipeline": pipelinePlan.Name, "action": pipelinePlan.Action})
+
+		pipeline := existingByName[pipelinePlan.Name]
+		desired := desiredByName[pipelinePlan.Name]
+
+		switch pipelinePlan.Action {
+		case atc.ApplyActionCreate:
+			pipeline, _, err = team.SavePipeline(desired.Name, desired.Config, db.ConfigVersion(0), desired.Paused)
+			if err != nil {
+				return err
+			}
+
+		case atc.ApplyActionUpdate:
+			_, _, err = team.SavePipeline(desired.Name, desired.Config, pipeline.ConfigVersion(), desired.Paused)
+			if err != nil {
+				return err
+			}
+
+			updated = true
+
+		case atc.ApplyActionDestroy:
+			err = pipeline.Destroy()
+			if err != nil {
+				return err
+			}
+
+			pipelinePlan.Applied = true
+			continue
+		}
+
+		if pipelinePlan.Pause != nil && pipelinePlan.Action != atc.ApplyActionCreate {
+			if *pipelinePlan.Pause {
+				err = pipeline.Pause()
+			} else {
+				err = pipeline.Unpause()
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if pipelinePlan.Expose != nil {
+			if *pipelinePlan.Expose {
+				err = pipeline.Expose()
+			} else {
+				err = pipeline.Hide()
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		pipelinePlan.Applied = true
+	}
+
+	if plan.Reorder {
+		var names []string
+		for _, desired := range manifest.Pipelines {
+			names = append(names, desired.Name)
+		}
+
+		err = team.OrderPipelines(names)
+		if err != nil {
+			return err
+		}
+	}
+
+	if updated {
+		err = s.teamFactory.NotifyResourceScanner()
+		if err != nil {
+			logger.Error("failed-to-notify-resource-scanner", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) handleBadRequest(w http.ResponseWriter, errorMessages ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+
+	err := json.NewEncoder(w).Encode(atc.SaveConfigResponse{
+		Errors: errorMessages,
+	})
+	if err != nil {
+		s.logger.Error("failed-to-encode-errors", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/configvalidate"
 )
 
 func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
@@ -34,6 +35,12 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := configvalidate.ValidateLintRules(atcTeam.LintRules); err != nil {
+		hLog.Error("malformed-lint-rules", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	atcTeam.Name = teamName
 	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
 		hLog.Debug("not-allowed")
@@ -49,8 +56,28 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if found {
+		var quotas *atc.TeamQuotas
+		if atcTeam.Quotas != nil {
+			current, err := team.Quotas()
+			if err != nil {
+				hLog.Error("failed-to-get-quotas", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if current != *atcTeam.Quotas {
+				if !acc.IsAdmin() {
+					hLog.Debug("not-allowed-to-change-quotas")
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+
+				quotas = atcTeam.Quotas
+			}
+		}
+
 		hLog.Debug("updating-credentials")
-		err = team.UpdateProviderAuth(atcTeam.Auth)
+		err = team.UpdateSettings(atcTeam.Auth, atcTeam.LintRules, quotas)
 		if err != nil {
 			hLog.Error("failed-to-update-team", err, lager.Data{"teamName": teamName})
 			w.WriteHeader(http.StatusInternalServerError)
@@ -68,6 +95,22 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+
+		err = team.UpdateLintRules(atcTeam.LintRules)
+		if err != nil {
+			hLog.Error("failed-to-update-lint-rules", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if atcTeam.Quotas != nil {
+			err = team.UpdateQuotas(*atcTeam.Quotas)
+			if err != nil {
+				hLog.Error("failed-to-update-quotas", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
 	} else {
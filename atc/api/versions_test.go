@@ -227,7 +227,7 @@ var _ = Describe("Versions API", func() {
 					It("does not set defaults for since and until", func() {
 						Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-						page, versionFilter := fakeResource.VersionsArgsForCall(0)
+						page, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 						Expect(page).To(Equal(db.Page{
 							Since: 0,
 							Until: 0,
@@ -247,7 +247,7 @@ var _ = Describe("Versions API", func() {
 					It("passes them through", func() {
 						Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-						page, versionFilter := fakeResource.VersionsArgsForCall(0)
+						page, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 						Expect(page).To(Equal(db.Page{
 							Since: 2,
 							Until: 3,
@@ -271,7 +271,7 @@ var _ = Describe("Versions API", func() {
 						It("passes them through", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-							_, versionFilter := fakeResource.VersionsArgsForCall(0)
+							_, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 							Expect(versionFilter).To(Equal(atc.Version{
 								"some ref": "some value",
 							}))
@@ -286,7 +286,7 @@ var _ = Describe("Versions API", func() {
 						It("passes them through", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-							_, versionFilter := fakeResource.VersionsArgsForCall(0)
+							_, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 							Expect(versionFilter).To(Equal(atc.Version{
 								"ref": "some%value",
 							}))
@@ -301,7 +301,7 @@ var _ = Describe("Versions API", func() {
 						It("passes them through by splitting on first colon", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-							_, versionFilter := fakeResource.VersionsArgsForCall(0)
+							_, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 							Expect(versionFilter).To(Equal(atc.Version{
 								"key": "with:colon:abcdef",
 							}))
@@ -316,12 +316,68 @@ var _ = Describe("Versions API", func() {
 						It("set no filter when fetching versions", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
-							_, versionFilter := fakeResource.VersionsArgsForCall(0)
+							_, versionFilter, _ := fakeResource.VersionsArgsForCall(0)
 							Expect(versionFilter).To(BeEmpty())
 						})
 					})
 				})
 
+				Context("when metadata, version and semver filters are passed", func() {
+					BeforeEach(func() {
+						queryParams = "?metadata=branch:master&metadata=author:bob&version=ref:%5E%5B0-9a-f%5D%2B%24&semver=tag:%3E%3D1.2%20%3C2"
+					})
+
+					It("passes them through as a filter", func() {
+						Expect(fakeResource.VersionsCallCount()).To(Equal(1))
+
+						_, _, filter := fakeResource.VersionsArgsForCall(0)
+						Expect(filter).To(Equal(&atc.VersionFilter{
+							Metadata: map[string]string{
+								"branch": "master",
+								"author": "bob",
+							},
+							Version: map[string]string{
+								"ref": "^[0-9a-f]+$",
+							},
+							Semver: &atc.SemverFilter{
+								Field: "tag",
+								Range: ">=1.2 <2",
+							},
+						}))
+					})
+				})
+
+				Context("when no filters are passed", func() {
+					It("passes no filter", func() {
+						_, _, filter := fakeResource.VersionsArgsForCall(0)
+						Expect(filter).To(BeNil())
+					})
+				})
+
+				Context("when the semver range is invalid", func() {
+					BeforeEach(func() {
+						queryParams = "?semver=tag:%3E%3Dlatest"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("does not look up versions", func() {
+						Expect(fakeResource.VersionsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when a filter is missing its field", func() {
+					BeforeEach(func() {
+						queryParams = "?metadata=master"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
 				Context("when getting the versions succeeds", func() {
 					var returnedVersions []atc.ResourceVersion
 
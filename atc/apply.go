@@ -0,0 +1,98 @@
+package atc
+
+const (
+	ApplyDryRun = "dry_run"
+	ApplyPrune  = "prune"
+)
+
+// TeamManifest declares the desired state of a team and its pipelines. The
+// order of the pipelines is the order they are shown in.
+type TeamManifest struct {
+	// Auth is left as-is if not set.
+	Auth TeamAuth `json:"auth,omitempty"`
+
+	Pipelines []PipelineManifest `json:"pipelines"`
+
+	// ConfigVersions are the config versions of the pipelines in a confirmed
+	// plan, by name. If set, the manifest is only applied if no pipeline it
+	// changes has been saved since; a pipeline which did not exist is
+	// expected to still not exist.
+	ConfigVersions map[string]int `json:"config_versions,omitempty"`
+}
+
+type PipelineManifest struct {
+	Name    string `json:"name"`
+	Config  Config `json:"config"`
+	Paused  bool   `json:"paused"`
+	Exposed bool   `json:"exposed"`
+}
+
+type ApplyAction string
+
+const (
+	ApplyActionNone    ApplyAction = "none"
+	ApplyActionCreate  ApplyAction = "create"
+	ApplyActionUpdate  ApplyAction = "update"
+	ApplyActionDestroy ApplyAction = "destroy"
+)
+
+// TeamApplyPlan is the set of changes needed to bring a team in line with its
+// manifest.
+type TeamApplyPlan struct {
+	Team string `json:"team"`
+
+	CreateTeam bool `json:"create_team,omitempty"`
+	UpdateAuth bool `json:"update_auth,omitempty"`
+
+	Pipelines []PipelineApplyPlan `json:"pipelines"`
+	Reorder   bool                `json:"reorder,omitempty"`
+
+	Applied bool `json:"applied"`
+
+	// Error is set if applying the plan failed part of the way through. The
+	// pipelines which were applied before the failure are marked as such.
+	Error string `json:"error,omitempty"`
+}
+
+// PipelineApplyPlan is the change to a single pipeline. Pause and Expose are
+// only set if the pipeline's paused or exposed state changes. ConfigVersion
+// is the version of the pipeline's config the plan was made against, or 0 if
+// the pipeline does not exist yet.
+type PipelineApplyPlan struct {
+	Name          string      `json:"name"`
+	Action        ApplyAction `json:"action"`
+	ConfigVersion int         `json:"config_version,omitempty"`
+	Diff          string      `json:"diff,omitempty"`
+	Pause         *bool       `json:"pause,omitempty"`
+	Expose        *bool       `json:"expose,omitempty"`
+	Applied       bool        `json:"applied,omitempty"`
+}
+
+func (plan TeamApplyPlan) HasChanges() bool {
+	if plan.CreateTeam || plan.UpdateAuth || plan.Reorder {
+		return true
+	}
+
+	for _, pipeline := range plan.Pipelines {
+		if pipeline.HasChanges() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (plan PipelineApplyPlan) HasChanges() bool {
+	return plan.Action != ApplyActionNone || plan.Pause != nil || plan.Expose != nil
+}
+
+// ConfigVersions returns the config version each pipeline in the plan was
+// made against, to be sent with the manifest when applying the plan.
+func (plan TeamApplyPlan) ConfigVersions() map[string]int {
+	versions := map[string]int{}
+	for _, pipeline := range plan.Pipelines {
+		versions[pipeline.Name] = pipeline.ConfigVersion
+	}
+
+	return versions
+}
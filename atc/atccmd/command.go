@@ -32,6 +32,7 @@ import (
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
@@ -50,6 +51,7 @@ import (
 	"github.com/concourse/concourse/atc/syslog"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/atc/worker/p2p"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/concourse/concourse/skymarshal/dexserver"
 	"github.com/concourse/concourse/skymarshal/legacyserver"
@@ -146,6 +148,8 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	EnableHijackSessionRecording bool `long:"enable-hijack-session-recording" description:"Record the input and output of hijacked and intercepted sessions, to be listed with fly hijack-sessions and replayed with fly replay-session."`
+
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
 	ComponentRunnerInterval time.Duration `long:"component-runner-interval" default:"10s" description:"Interval on which runners are kicked off for builds, locks, scans, and checks"`
@@ -158,10 +162,13 @@ type RunCommand struct {
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
+	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"bin-pack-requests" choice:"spread-requests" description:"Method by which a worker is selected during container placement. The bin-pack-requests and spread-requests strategies place tasks by their resource requests, without overcommitting workers."`
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
+	WorkerDiskHighWaterMark           int           `long:"worker-disk-high-water-mark" default:"90" description:"Percentage of a worker's volume store in use at or above which no containers are placed on it and its least recently used caches are evicted. 0 means no limit."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 	StreamingArtifactsCompression     string        `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for internal streaming."`
+	EnableP2PVolumeStreaming          bool          `long:"enable-p2p-volume-streaming" description:"Stream volumes directly between workers that advertise a P2P URL, instead of through the web node. Falls back to streaming through the web node if the workers cannot reach each other. Requires --p2p-volume-streaming-secret."`
+	P2PVolumeStreamingSecret          string        `long:"p2p-volume-streaming-secret" description:"Secret shared with the workers' --p2p-secret, used to sign P2P volume streaming requests."`
 
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
@@ -198,10 +205,23 @@ type RunCommand struct {
 		HijackGracePeriod      time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+
+		TaskResultCacheRetention time.Duration `long:"task-result-cache-retention" default:"168h" description:"Period after which cached task results that have not been reused will be garbage collected."`
+		HijackSessionRetention   time.Duration `long:"hijack-session-retention" default:"720h" description:"Period after which recorded hijack sessions will be garbage collected."`
+
+		CacheEvictionBatchSize int `long:"cache-eviction-batch-size" default:"10" description:"Maximum number of caches to evict from each worker above the disk high-water mark per garbage collection interval."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	JobStatsInterval time.Duration `long:"job-stats-interval" default:"0" description:"Interval on which to emit the success rate, duration and recovery time of every job as metrics. 0 means disabled."`
+	JobStatsPeriod   time.Duration `long:"job-stats-period" default:"720h" description:"Period of finished builds summarized by the job stats metrics."`
+
+	TeamUsageInterval time.Duration `long:"team-usage-interval" default:"1m" description:"Interval on which to emit every team's running builds, containers, volumes and quotas as metrics. 0 means disabled."`
+
+	MaxBuildDuration        time.Duration `long:"max-build-duration" default:"0" description:"Maximum duration of any build, after which it is aborted. Jobs may configure a shorter build_timeout. 0 means no maximum."`
+	BuildTimeoutGracePeriod time.Duration `long:"build-timeout-grace-period" default:"5m" description:"Period for which on_abort and ensure hooks may run once a build has timed out."`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -248,6 +268,8 @@ type RunCommand struct {
 	SystemClaimValues     []string `long:"system-claim-value" default:"concourse-worker" description:"Configure which token requests should be considered 'system' requests."`
 	EnableArchivePipeline bool     `long:"enable-archive-pipeline" description:"Enable /api/v1/teams/{team}/pipelines/{pipeline}/archive endpoint."`
 
+	LintRules atc.LintRules `long:"lint-rule" value-name:"RULE=SEVERITY" description:"Override the severity of a pipeline lint rule for all teams. Severity is one of error, warning, info or off. Can be specified multiple times."`
+
 	EnableBuildRerunWhenWorkerDisappears bool `long:"enable-rerun-when-worker-disappears" description:"Enable automatically build rerun when worker disappears"`
 }
 
@@ -528,6 +550,15 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		),
 	})
 
+	members = append(members, grouper.Member{
+		Name: "system-lock-releaser",
+		Runner: systemLockReleaseRunner{
+			logger:            logger.Session("system-lock-releaser"),
+			bus:               backendConn.Bus(),
+			systemLockFactory: db.NewSystemLockFactory(backendConn, lockFactory, cmd.atcName()),
+		},
+	})
+
 	onReady := func() {
 		logData := lager.Data{
 			"http":  cmd.nonTLSBindAddr(),
@@ -594,7 +625,8 @@ func (cmd *RunCommand) constructMembers(
 
 	// use backendConn so that the Component objects created by the factory uses
 	// the backend connection pool when reloading.
-	componentFactory := db.NewComponentFactory(backendConn)
+	componentFactory := db.NewComponentFactory(backendConn, cmd.atcName())
+	dbMaintenance := db.NewMaintenance(backendConn)
 	bus := backendConn.Bus()
 
 	members := apiMembers
@@ -607,18 +639,24 @@ func (cmd *RunCommand) constructMembers(
 
 		componentLogger := logger.Session(c.Component.Name)
 
+		coordinator := &component.Coordinator{
+			Locker:    lockFactory,
+			Component: dbComponent,
+			Runnable:  c.Runnable,
+		}
+
+		if c.PausedDuringMaintenance {
+			coordinator.Maintenance = dbMaintenance
+		}
+
 		members = append(members, grouper.Member{
 			Name: c.Component.Name,
 			Runner: &component.Runner{
-				Logger:    componentLogger,
-				Interval:  cmd.ComponentRunnerInterval,
-				Component: dbComponent,
-				Bus:       bus,
-				Schedulable: &component.Coordinator{
-					Locker:    lockFactory,
-					Component: dbComponent,
-					Runnable:  c.Runnable,
-				},
+				Logger:      componentLogger,
+				Interval:    cmd.ComponentRunnerInterval,
+				Component:   dbComponent,
+				Bus:         bus,
+				Schedulable: coordinator,
 			},
 		})
 
@@ -707,8 +745,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		policyChecker,
 	)
 
-	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval)
+	dbTeamQuotaFactory := db.NewTeamQuotaFactory(dbConn)
+	pool := worker.NewPool(workerProvider, cmd.workerDiskHighWaterMark(), dbTeamQuotaFactory)
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, cmd.p2pStreamer(), workerAvailabilityPollingInterval, workerStatusPublishInterval)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -720,6 +759,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbMaintenance := db.NewMaintenance(dbConn)
 
 	tokenVerifier := cmd.constructTokenVerifier(httpClient)
 
@@ -745,11 +785,16 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		db.NewLockPoolFactory(dbConn),
+		db.NewSystemLockFactory(dbConn, lockFactory, cmd.atcName()),
+		db.NewHijackSessionFactory(dbConn),
+		db.NewComponentFactory(dbConn, cmd.atcName()),
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbMaintenance,
 		tokenVerifier,
 		dbConn.Bus(),
 		policyChecker,
@@ -938,10 +983,12 @@ func (cmd *RunCommand) backendComponents(
 		policyChecker,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	dbTeamQuotaFactory := db.NewTeamQuotaFactory(dbConn)
+	pool := worker.NewPool(workerProvider, cmd.workerDiskHighWaterMark(), dbTeamQuotaFactory)
 	workerClient := worker.NewClient(pool,
 		workerProvider,
 		compressionLib,
+		cmd.p2pStreamer(),
 		workerAvailabilityPollingInterval,
 		workerStatusPublishInterval)
 
@@ -962,6 +1009,8 @@ func (cmd *RunCommand) backendComponents(
 		teamFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		db.NewLockPoolFactory(dbConn),
+		db.NewTaskResultCacheFactory(dbConn),
 		secretManager,
 		defaultLimits,
 		buildContainerStrategy,
@@ -1004,6 +1053,7 @@ func (cmd *RunCommand) backendComponents(
 				cmd.ResourceCheckingInterval,
 				cmd.ResourceWithWebhookCheckingInterval,
 			),
+			PausedDuringMaintenance: true,
 		},
 		{
 			Component: atc.Component{
@@ -1019,7 +1069,9 @@ func (cmd *RunCommand) backendComponents(
 					ResourceCheckingInterval: cmd.ResourceCheckingInterval,
 					CheckableCounter:         dbCheckableCounter,
 				},
+				dbTeamQuotaFactory,
 			),
+			PausedDuringMaintenance: true,
 		},
 		{
 			Component: atc.Component{
@@ -1035,10 +1087,12 @@ func (cmd *RunCommand) backendComponents(
 						builds.NewPlanner(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						dbTeamQuotaFactory),
 				},
 				cmd.JobSchedulingMaxInFlight,
 			),
+			PausedDuringMaintenance: true,
 		},
 		{
 			Component: atc.Component{
@@ -1082,6 +1136,26 @@ func (cmd *RunCommand) backendComponents(
 		})
 	}
 
+	if cmd.JobStatsInterval > 0 {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentJobStats,
+				Interval: cmd.JobStatsInterval,
+			},
+			Runnable: metric.NewJobStatsCollector(dbPipelineFactory, cmd.JobStatsPeriod),
+		})
+	}
+
+	if cmd.TeamUsageInterval > 0 {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentTeamUsage,
+				Interval: cmd.TeamUsageInterval,
+			},
+			Runnable: metric.NewTeamUsageCollector(dbTeamQuotaFactory),
+		})
+	}
+
 	return components, err
 }
 
@@ -1098,6 +1172,9 @@ func (cmd *RunCommand) gcComponents(
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
+	dbTaskResultCacheFactory := db.NewTaskResultCacheFactory(gcConn)
+	dbHijackSessionFactory := db.NewHijackSessionFactory(gcConn)
+	dbWorkerCacheLifecycle := db.NewWorkerCacheLifecycle(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1112,6 +1189,9 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
+		atc.ComponentCollectorTaskResultCaches:  gc.NewTaskResultCacheCollector(dbTaskResultCacheFactory, cmd.GC.TaskResultCacheRetention),
+		atc.ComponentCollectorCacheEvictions:    gc.NewCacheEvictionCollector(dbWorkerCacheLifecycle, cmd.workerDiskHighWaterMark(), cmd.GC.CacheEvictionBatchSize),
+		atc.ComponentCollectorHijackSessions:    gc.NewHijackSessionCollector(dbHijackSessionFactory, cmd.GC.HijackSessionRetention),
 	}
 
 	var components []RunnableComponent
@@ -1349,6 +1429,22 @@ func (cmd *RunCommand) tlsConfig(logger lager.Logger, dbConn db.Conn) (*tls.Conf
 	return tlsConfig, nil
 }
 
+func (cmd *RunCommand) p2pStreamer() p2p.Streamer {
+	if !cmd.EnableP2PVolumeStreaming {
+		return nil
+	}
+
+	return p2p.NewStreamer(&http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				// workers that cannot reach each other should fail fast so that
+				// we can fall back to streaming through the web node
+				Timeout: 10 * time.Second,
+			}).DialContext,
+		},
+	}, []byte(cmd.P2PVolumeStreamingSecret))
+}
+
 func (cmd *RunCommand) parseDefaultLimits() (atc.ContainerLimits, error) {
 	return atc.ParseContainerLimits(map[string]interface{}{
 		"cpu":    cmd.DefaultCpuLimit,
@@ -1433,6 +1529,24 @@ func (cmd *RunCommand) validate() error {
 		errs = multierror.Append(errs, err)
 	}
 
+	if err := configvalidate.ValidateLintRules(cmd.LintRules); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("invalid --lint-rule: %w", err))
+	}
+
+	if cmd.EnableP2PVolumeStreaming && cmd.P2PVolumeStreamingSecret == "" {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify --p2p-volume-streaming-secret to enable P2P volume streaming"),
+		)
+	}
+
+	if cmd.WorkerDiskHighWaterMark < 0 || cmd.WorkerDiskHighWaterMark > 100 {
+		errs = multierror.Append(
+			errs,
+			errors.New("worker-disk-high-water-mark must be between 0 and 100"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1448,6 +1562,13 @@ func (cmd *RunCommand) debugBindAddr() string {
 	return fmt.Sprintf("%s:%d", cmd.DebugBindIP, cmd.DebugBindPort)
 }
 
+// atcName identifies this ATC to operators, e.g. as the one which last ran a
+// component.
+func (cmd *RunCommand) atcName() string {
+	hostname, _ := os.Hostname()
+	return hostname
+}
+
 func (cmd *RunCommand) configureMetrics(logger lager.Logger) error {
 	host := cmd.Metrics.HostName
 	if host == "" {
@@ -1489,8 +1610,12 @@ type Closer interface {
 	Close() error
 }
 
+// constructLockConn opens the connection through which locks are acquired. It
+// is named after the ATC so that the locks it holds can be attributed to it.
 func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
-	dbConn, err := sql.Open(driverName, cmd.Postgres.ConnectionString())
+	connectionString := fmt.Sprintf("%s application_name='%s'", cmd.Postgres.ConnectionString(), escapeConnectionStringValue(cmd.atcName()))
+
+	dbConn, err := sql.Open(driverName, connectionString)
 	if err != nil {
 		return nil, err
 	}
@@ -1502,6 +1627,16 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
+func escapeConnectionStringValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
+// workerDiskHighWaterMark returns the configured high-water mark as a fraction
+// of a worker's volume store capacity, or 0 if there is none.
+func (cmd *RunCommand) workerDiskHighWaterMark() float64 {
+	return float64(cmd.WorkerDiskHighWaterMark) / 100
+}
+
 func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
 	var strategy worker.ContainerPlacementStrategy
 	if cmd.ContainerPlacementStrategy != "limit-active-tasks" && cmd.MaxActiveTasksPerWorker != 0 {
@@ -1517,6 +1652,10 @@ func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacement
 		strategy = worker.NewFewestBuildContainersPlacementStrategy()
 	case "limit-active-tasks":
 		strategy = worker.NewLimitActiveTasksPlacementStrategy(cmd.MaxActiveTasksPerWorker)
+	case "bin-pack-requests":
+		strategy = worker.NewResourceRequestsPlacementStrategy(false)
+	case "spread-requests":
+		strategy = worker.NewResourceRequestsPlacementStrategy(true)
 	default:
 		strategy = worker.NewVolumeLocalityPlacementStrategy()
 	}
@@ -1554,6 +1693,8 @@ func (cmd *RunCommand) constructEngine(
 	teamFactory db.TeamFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	lockPoolFactory db.LockPoolFactory,
+	taskResultCacheFactory db.TaskResultCacheFactory,
 	secretManager creds.Secrets,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
@@ -1567,9 +1708,12 @@ func (cmd *RunCommand) constructEngine(
 		teamFactory,
 		resourceCacheFactory,
 		resourceConfigFactory,
+		lockPoolFactory,
+		taskResultCacheFactory,
 		defaultLimits,
 		strategy,
 		lockFactory,
+		cmd.LintRules,
 		cmd.EnableBuildRerunWhenWorkerDisappears,
 	)
 
@@ -1582,7 +1726,10 @@ func (cmd *RunCommand) constructEngine(
 		cmd.EnableRedactSecrets,
 	)
 
-	return engine.NewEngine(stepBuilder)
+	return engine.NewEngine(stepBuilder, engine.BuildTimeout{
+		Max:         cmd.MaxBuildDuration,
+		GracePeriod: cmd.BuildTimeoutGracePeriod,
+	})
 }
 
 func (cmd *RunCommand) constructHTTPHandler(
@@ -1736,11 +1883,16 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbLockPoolFactory db.LockPoolFactory,
+	dbSystemLockFactory db.SystemLockFactory,
+	dbHijackSessionFactory db.HijackSessionFactory,
+	dbComponentFactory db.ComponentFactory,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbMaintenance db.Maintenance,
 	tokenVerifier accessor.TokenVerifier,
 	notifications db.NotificationsBus,
 	policyChecker *policy.Checker,
@@ -1831,6 +1983,11 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbLockPoolFactory,
+		dbSystemLockFactory,
+		dbHijackSessionFactory,
+		dbComponentFactory,
+		notifications,
 
 		buildserver.NewEventHandler,
 
@@ -1848,10 +2005,13 @@ func (cmd *RunCommand) constructAPIHandler(
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
+		cmd.EnableHijackSessionRecording,
 		dbWall,
+		dbMaintenance,
 		clock.NewClock(),
 
 		cmd.EnableArchivePipeline,
+		cmd.LintRules,
 	)
 }
 
@@ -1892,7 +2052,44 @@ func (runner drainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 	return nil
 }
 
+// systemLockReleaseRunner releases the locks this ATC is requested to, e.g.
+// through 'fly release-system-lock'.
+type systemLockReleaseRunner struct {
+	logger            lager.Logger
+	bus               db.NotificationsBus
+	systemLockFactory db.SystemLockFactory
+}
+
+func (runner systemLockReleaseRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	notifier, err := runner.bus.Listen(atc.SystemLockReleaseChannel)
+	if err != nil {
+		return err
+	}
+
+	defer runner.bus.Unlisten(atc.SystemLockReleaseChannel, notifier)
+
+	close(ready)
+
+	for {
+		select {
+		case <-notifier:
+			// also check after reconnecting, in case a request was missed
+			err := runner.systemLockFactory.ReleaseRequested(runner.logger)
+			if err != nil {
+				runner.logger.Error("failed-to-release-requested-locks", err)
+			}
+
+		case <-signals:
+			return nil
+		}
+	}
+}
+
 type RunnableComponent struct {
 	atc.Component
 	component.Runnable
+
+	// PausedDuringMaintenance is set for components which start new work, so
+	// that none is started while the cluster is in maintenance mode.
+	PausedDuringMaintenance bool
 }
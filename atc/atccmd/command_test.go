@@ -45,6 +45,29 @@ func (s *CommandSuite) TestInvalidConcurrentRequestLimitAction() {
 	)
 }
 
+func (s *CommandSuite) TestLintRules() {
+	cmd := &atccmd.RunCommand{}
+	parser := flags.NewParser(cmd, flags.None)
+	_, err := parser.ParseArgs([]string{
+		"--client-secret",
+		"client-secret",
+		"--lint-rule",
+		"missing-team-in-set-pipeline=error",
+		"--lint-rule",
+		"unused-resource=off",
+	})
+
+	// other required flags are missing, but the parsed values are still set
+	flagErr, ok := err.(*flags.Error)
+	s.True(ok)
+	s.Equal(flags.ErrRequired, flagErr.Type)
+
+	s.Equal(atc.LintRules{
+		"missing-team-in-set-pipeline": atc.LintSeverityError,
+		"unused-resource":              atc.LintSeverityOff,
+	}, cmd.LintRules)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, &CommandSuite{
 		Assertions: require.New(t),
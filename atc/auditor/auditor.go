@@ -61,6 +61,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RerunJobBuild,
 		atc.ListBuilds,
 		atc.BuildEvents,
+		atc.GetBuildStepLog,
+		atc.DownloadBuildLog,
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
@@ -68,13 +70,17 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
-		atc.ListBuildArtifacts:
+		atc.ListBuildArtifacts,
+		atc.ListLockPools,
+		atc.ReleaseLockPool:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
 		atc.ListDestroyingContainers,
-		atc.ReportWorkerContainers:
+		atc.ReportWorkerContainers,
+		atc.ListHijackSessions,
+		atc.GetHijackSessionRecording:
 		return a.EnableContainerAuditLog
 	case atc.GetJob,
 		atc.CreateJobBuild,
@@ -92,6 +98,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.ListAllPipelines,
 		atc.ListPipelines,
 		atc.GetPipeline,
+		atc.GetPipelineGraph,
+		atc.GetPipelineStats,
 		atc.DeletePipeline,
 		atc.OrderPipelines,
 		atc.PausePipeline,
@@ -127,6 +135,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
+		atc.ClearTaskResultCache,
 		atc.SetLogLevel,
 		atc.GetLogLevel,
 		atc.DownloadCLI,
@@ -136,10 +145,20 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.GetMaintenance,
+		atc.EnableMaintenance,
+		atc.DisableMaintenance,
+		atc.ListComponents,
+		atc.PauseComponent,
+		atc.UnpauseComponent,
+		atc.RunComponent,
+		atc.ListSystemLocks,
+		atc.ReleaseSystemLock:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
+		atc.ApplyTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
@@ -24,6 +24,10 @@ type Build struct {
 	ReapTime     int64         `json:"reap_time,omitempty"`
 	RerunNumber  int           `json:"rerun_number,omitempty"`
 	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+
+	// EstimatedFinish is only set when getting a single running build of a
+	// job which has succeeded before.
+	EstimatedFinish int64 `json:"estimated_finish,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -55,10 +55,12 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		ConfigPath:        step.ConfigPath,
 		Vars:              step.Vars,
 		Tags:              step.Tags,
+		WorkerSelector:    step.WorkerSelector,
 		Params:            step.Params,
 		InputMapping:      step.InputMapping,
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
+		CacheResult:       step.CacheResult,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -92,12 +94,13 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 	visitor.plan = visitor.planFactory.NewPlan(atc.GetPlan{
 		Name: step.Name,
 
-		Type:     resource.Type,
-		Resource: resourceName,
-		Source:   resource.Source,
-		Params:   step.Params,
-		Version:  &version,
-		Tags:     step.Tags,
+		Type:           resource.Type,
+		Resource:       resourceName,
+		Source:         resource.Source,
+		Params:         step.Params,
+		Version:        &version,
+		Tags:           step.Tags,
+		WorkerSelector: step.WorkerSelector,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -119,13 +122,14 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 	}
 
 	atcPutPlan := atc.PutPlan{
-		Type:     resource.Type,
-		Name:     logicalName,
-		Resource: resourceName,
-		Source:   resource.Source,
-		Params:   step.Params,
-		Tags:     step.Tags,
-		Inputs:   step.Inputs,
+		Type:           resource.Type,
+		Name:           logicalName,
+		Resource:       resourceName,
+		Source:         resource.Source,
+		Params:         step.Params,
+		Tags:           step.Tags,
+		WorkerSelector: step.WorkerSelector,
+		Inputs:         step.Inputs,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	}
@@ -138,9 +142,10 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Resource:    resourceName,
 		VersionFrom: &putPlan.ID,
 
-		Params: step.GetParams,
-		Tags:   step.Tags,
-		Source: resource.Source,
+		Params:         step.GetParams,
+		Tags:           step.Tags,
+		WorkerSelector: step.WorkerSelector,
+		Source:         resource.Source,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -383,3 +388,45 @@ func (visitor *planVisitor) VisitEnsure(step *atc.EnsureStep) error {
 
 	return nil
 }
+
+func (visitor *planVisitor) VisitAcquire(step *atc.AcquireStep) error {
+	err := step.Step.Visit(visitor)
+	if err != nil {
+		return err
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.AcquirePlan{
+		Locks: step.Locks,
+		Step:  visitor.plan,
+	})
+
+	return nil
+}
+
+func (visitor *planVisitor) VisitRelease(step *atc.ReleaseStep) error {
+	err := step.Step.Visit(visitor)
+	if err != nil {
+		return err
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.ReleasePlan{
+		Locks: step.Locks,
+		Step:  visitor.plan,
+	})
+
+	return nil
+}
+
+func (visitor *planVisitor) VisitIf(step *atc.IfStep) error {
+	err := step.Step.Visit(visitor)
+	if err != nil {
+		return err
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.IfPlan{
+		Condition: step.Condition,
+		Step:      visitor.plan,
+	})
+
+	return nil
+}
@@ -61,6 +61,11 @@ var factoryTests = []PlannerTest{
 			Params:   atc.Params{"some": "params"},
 			Version:  &atc.VersionConfig{Pinned: atc.Version{"doesnt": "matter"}},
 			Tags:     atc.Tags{"tag-1", "tag-2"},
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+			},
 		},
 		Inputs: []db.BuildInput{
 			{
@@ -78,6 +83,7 @@ var factoryTests = []PlannerTest{
 				"params": {"some":"params"},
 				"version": {"some":"version"},
 				"tags": ["tag-1", "tag-2"],
+				"worker_selector": {"required": ["zone=us-east"]},
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -108,10 +114,15 @@ var factoryTests = []PlannerTest{
 	{
 		Title: "put step",
 		Config: &atc.PutStep{
-			Name:      "some-name",
-			Resource:  "some-resource",
-			Params:    atc.Params{"some": "params"},
-			Tags:      atc.Tags{"tag-1", "tag-2"},
+			Name:     "some-name",
+			Resource: "some-resource",
+			Params:   atc.Params{"some": "params"},
+			Tags:     atc.Tags{"tag-1", "tag-2"},
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+			},
 			Inputs:    &atc.InputsConfig{All: true},
 			GetParams: atc.Params{"some": "get-params"},
 		},
@@ -137,6 +148,8 @@ var factoryTests = []PlannerTest{
 						"source": {"some":"source"},
 						"params": {"some":"params"},
 						"tags": ["tag-1", "tag-2"],
+						"worker_selector": {"required": ["zone=us-east"]},
+				"worker_selector": {"required": ["zone=us-east"]},
 						"resource_types": [
 							{
 								"name": "some-resource-type",
@@ -156,6 +169,8 @@ var factoryTests = []PlannerTest{
 						"source": {"some":"source"},
 						"params": {"some":"get-params"},
 						"tags": ["tag-1", "tag-2"],
+						"worker_selector": {"required": ["zone=us-east"]},
+				"worker_selector": {"required": ["zone=us-east"]},
 						"version_from": "1",
 						"resource_types": [
 							{
@@ -180,13 +195,19 @@ var factoryTests = []PlannerTest{
 				Platform: "linux",
 				Run:      atc.TaskRunConfig{Path: "hello"},
 			},
-			ConfigPath:        "some-task-file",
-			Vars:              atc.Params{"some": "vars"},
-			Params:            atc.Params{"SOME": "PARAMS"},
-			Tags:              atc.Tags{"tag-1", "tag-2"},
+			ConfigPath: "some-task-file",
+			Vars:       atc.Params{"some": "vars"},
+			Params:     atc.Params{"SOME": "PARAMS"},
+			Tags:       atc.Tags{"tag-1", "tag-2"},
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+			},
 			InputMapping:      map[string]string{"generic": "specific"},
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
+			CacheResult:       true,
 		},
 
 		PlanJSON: `{
@@ -202,9 +223,11 @@ var factoryTests = []PlannerTest{
 				"vars": {"some": "vars"},
 				"params": {"SOME": "PARAMS"},
 				"tags": ["tag-1", "tag-2"],
+				"worker_selector": {"required": ["zone=us-east"]},
 				"input_mapping": {"generic": "specific"},
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
+				"cache_result": true,
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -434,6 +457,81 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "acquire modifier",
+
+		Config: &atc.AcquireStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Locks: atc.LockConfigs{{Name: "some-lock"}, {Name: "some-pool", Size: 3}},
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"acquire": {
+				"step": {
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				"locks": ["some-lock", {"name": "some-pool", "size": 3}]
+			}
+		}`,
+	},
+	{
+		Title: "if modifier",
+
+		Config: &atc.IfStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Condition: "steps.some-task == 'failed'",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"if": {
+				"step": {
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				"condition": "steps.some-task == 'failed'"
+			}
+		}`,
+	},
+	{
+		Title: "release modifier",
+
+		Config: &atc.ReleaseStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Locks: atc.LockConfigs{{Name: "some-lock"}},
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"release": {
+				"step": {
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				"locks": ["some-lock"]
+			}
+		}`,
+	},
 	{
 		Title: "attempts modifier",
 
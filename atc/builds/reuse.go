@@ -0,0 +1,42 @@
+package builds
+
+import "github.com/concourse/concourse/atc"
+
+// ReuseStepResults marks the get, put and task steps of a partial rerun's
+// plan which can reuse the result of the build being rerun.
+//
+// Steps are reused in the order they appear in the plan, up until the step
+// named fromStep. If fromStep is empty, they are reused up until the first
+// step which has no result, i.e. the first step which did not succeed.
+func ReuseStepResults(plan *atc.Plan, buildID int, buildName string, results map[string]atc.StepResult, fromStep string) {
+	reusing := true
+
+	reuse := func(name string, key string) *atc.ReusedStep {
+		if !reusing {
+			return nil
+		}
+
+		result, found := results[key]
+		if name == fromStep || !found {
+			reusing = false
+			return nil
+		}
+
+		return &atc.ReusedStep{
+			BuildID:   buildID,
+			BuildName: buildName,
+			Result:    result,
+		}
+	}
+
+	plan.Each(func(p *atc.Plan) {
+		switch {
+		case p.Get != nil:
+			p.Get.Reuse = reuse(p.Get.Name, p.Get.ResultKey())
+		case p.Put != nil:
+			p.Put.Reuse = reuse(p.Put.Name, p.Put.ResultKey())
+		case p.Task != nil:
+			p.Task.Reuse = reuse(p.Task.Name, p.Task.ResultKey())
+		}
+	})
+}
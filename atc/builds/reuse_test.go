@@ -0,0 +1,86 @@
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/stretchr/testify/require"
+)
+
+type ReuseTest struct {
+	Title string
+
+	FromStep string
+	Results  map[string]atc.StepResult
+
+	Reused []string
+}
+
+var reuseTests = []ReuseTest{
+	{
+		Title: "reuses steps up until the first step without a result",
+		Results: map[string]atc.StepResult{
+			"get:some-input":    {Version: atc.Version{"some": "version"}},
+			"task:build":        {Outputs: map[string]string{"binary": "some-handle"}},
+			"put:some-output":   {Version: atc.Version{"some": "put-version"}},
+			"task:some-cleanup": {},
+		},
+		Reused: []string{"get:some-input", "task:build", "put:some-output"},
+	},
+	{
+		Title:    "reuses steps up until the given step",
+		FromStep: "build",
+		Results: map[string]atc.StepResult{
+			"get:some-input":  {Version: atc.Version{"some": "version"}},
+			"task:build":      {Outputs: map[string]string{"binary": "some-handle"}},
+			"put:some-output": {Version: atc.Version{"some": "put-version"}},
+		},
+		Reused: []string{"get:some-input"},
+	},
+	{
+		Title:  "reuses nothing without results",
+		Reused: []string{},
+	},
+}
+
+func TestReuseStepResults(t *testing.T) {
+	for _, test := range reuseTests {
+		t.Run(test.Title, func(t *testing.T) {
+			factory := atc.NewPlanFactory(0)
+
+			put := factory.NewPlan(atc.PutPlan{Name: "some-output"})
+			plan := factory.NewPlan(atc.EnsurePlan{
+				Step: factory.NewPlan(atc.DoPlan{
+					factory.NewPlan(atc.GetPlan{Name: "some-input"}),
+					factory.NewPlan(atc.TaskPlan{Name: "build"}),
+					factory.NewPlan(atc.OnSuccessPlan{
+						Step: put,
+						Next: factory.NewPlan(atc.GetPlan{Name: "some-output", VersionFrom: &put.ID}),
+					}),
+					factory.NewPlan(atc.TaskPlan{Name: "deploy"}),
+				}),
+				Next: factory.NewPlan(atc.TaskPlan{Name: "some-cleanup"}),
+			})
+
+			builds.ReuseStepResults(&plan, 42, "7", test.Results, test.FromStep)
+
+			reused := []string{}
+			plan.Each(func(p *atc.Plan) {
+				switch {
+				case p.Get != nil && p.Get.Reuse != nil:
+					reused = append(reused, p.Get.ResultKey())
+					require.Equal(t, test.Results[p.Get.ResultKey()], p.Get.Reuse.Result)
+				case p.Put != nil && p.Put.Reuse != nil:
+					reused = append(reused, p.Put.ResultKey())
+					require.Equal(t, 42, p.Put.Reuse.BuildID)
+					require.Equal(t, "7", p.Put.Reuse.BuildName)
+				case p.Task != nil && p.Task.Reuse != nil:
+					reused = append(reused, p.Task.ResultKey())
+				}
+			})
+
+			require.Equal(t, test.Reused, reused)
+		})
+	}
+}
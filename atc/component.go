@@ -9,14 +9,19 @@ const (
 	ComponentLidarChecker               = "checker"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentJobStats                   = "job_stats"
+	ComponentTeamUsage                  = "team_usage"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
+	ComponentCollectorCacheEvictions    = "collector_cache_evictions"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
 	ComponentCollectorContainers        = "collector_containers"
+	ComponentCollectorHijackSessions    = "collector_hijack_sessions"
 	ComponentCollectorResourceCacheUses = "collector_resource_cache_uses"
 	ComponentCollectorResourceCaches    = "collector_resource_caches"
 	ComponentCollectorResourceConfigs   = "collector_resource_configs"
+	ComponentCollectorTaskResultCaches  = "collector_task_result_caches"
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 )
@@ -25,3 +30,17 @@ type Component struct {
 	Name     string
 	Interval time.Duration
 }
+
+// ComponentStatus describes a background component along with how its most
+// recent run went. Durations are in seconds.
+type ComponentStatus struct {
+	Name     string  `json:"name"`
+	Interval float64 `json:"interval"`
+	Paused   bool    `json:"paused"`
+
+	// LastRan is when the component last ran successfully.
+	LastRan      int64   `json:"last_ran,omitempty"`
+	LastDuration float64 `json:"last_duration,omitempty"`
+	LastError    string  `json:"last_error,omitempty"`
+	LastRanBy    string  `json:"last_ran_by,omitempty"`
+}
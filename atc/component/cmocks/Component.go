@@ -2,7 +2,11 @@
 
 package cmocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // Component is an autogenerated mock type for the Component type
 type Component struct {
@@ -51,6 +55,20 @@ func (_m *Component) Paused() bool {
 	return r0
 }
 
+// RecordRun provides a mock function with given fields: duration, runErr
+func (_m *Component) RecordRun(duration time.Duration, runErr error) error {
+	ret := _m.Called(duration, runErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration, error) error); ok {
+		r0 = rf(duration, runErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Reload provides a mock function with given fields:
 func (_m *Component) Reload() (bool, error) {
 	ret := _m.Called()
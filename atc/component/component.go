@@ -1,5 +1,7 @@
 package component
 
+import "time"
+
 type Component interface {
 	Name() string
 	Paused() bool
@@ -8,5 +10,6 @@ type Component interface {
 
 	Reload() (bool, error)
 	UpdateLastRan() error
+	RecordRun(duration time.Duration, runErr error) error
 }
 
@@ -12,6 +12,10 @@ type Coordinator struct {
 	Locker    lock.LockFactory
 	Component Component
 	Runnable  Runnable
+
+	// Maintenance, if set, prevents the component from running while the
+	// cluster is in maintenance mode.
+	Maintenance Maintenance
 }
 
 func (coordinator *Coordinator) RunPeriodically(ctx context.Context) {
@@ -56,13 +60,33 @@ func (coordinator *Coordinator) run(ctx context.Context, immediate bool) {
 		return
 	}
 
+	if coordinator.Maintenance != nil {
+		inMaintenance, err := coordinator.Maintenance.Enabled()
+		if err != nil {
+			logger.Error("failed-to-check-maintenance", err)
+			return
+		}
+
+		if inMaintenance {
+			logger.Debug("cluster-in-maintenance")
+			return
+		}
+	}
+
 	if !immediate && !coordinator.Component.IntervalElapsed() {
 		logger.Debug("interval-not-elapsed")
 		return
 	}
 
-	if err := coordinator.Runnable.Run(ctx); err != nil {
-		logger.Error("component-failed", err)
+	start := Clock.Now()
+	runErr := coordinator.Runnable.Run(ctx)
+
+	if err := coordinator.Component.RecordRun(Clock.Since(start), runErr); err != nil {
+		logger.Error("failed-to-record-run", err)
+	}
+
+	if runErr != nil {
+		logger.Error("component-failed", runErr)
 		return
 	}
 
@@ -37,17 +37,24 @@ type CoordinatorTest struct {
 	Paused          bool
 	IntervalElapsed bool
 
+	PausedDuringMaintenance bool
+	InMaintenance           bool
+	MaintenanceErr          error
+
 	Runs   bool
 	RunErr error
 
 	UpdatesLastRan   bool
 	UpdateLastRanErr error
+
+	RecordRunErr error
 }
 
 func (test CoordinatorTest) Run(s *CoordinatorSuite, action func(*component.Coordinator, context.Context)) {
 	fakeLocker := new(lockfakes.FakeLockFactory)
 	fakeComponent := new(cmocks.Component)
 	fakeRunnable := new(cmocks.Runnable)
+	fakeMaintenance := new(cmocks.Maintenance)
 
 	var fakeLock *lockfakes.FakeLock
 	if test.LockAvailable {
@@ -63,6 +70,9 @@ func (test CoordinatorTest) Run(s *CoordinatorSuite, action func(*component.Coor
 	fakeComponent.On("Paused").Return(test.Paused)
 	fakeComponent.On("IntervalElapsed").Return(test.IntervalElapsed)
 	fakeComponent.On("UpdateLastRan").Return(test.UpdateLastRanErr)
+	fakeComponent.On("RecordRun", mock.Anything, test.RunErr).Return(test.RecordRunErr)
+
+	fakeMaintenance.On("Enabled").Return(test.InMaintenance, test.MaintenanceErr)
 
 	fakeComponent.On("Reload").Return(!test.Disappeared, test.ReloadErr).Run(func(mock.Arguments) {
 		// make sure we haven't asked for anything prior to reloading
@@ -88,12 +98,18 @@ func (test CoordinatorTest) Run(s *CoordinatorSuite, action func(*component.Coor
 		Runnable:  fakeRunnable,
 	}
 
+	if test.PausedDuringMaintenance {
+		coordinator.Maintenance = fakeMaintenance
+	}
+
 	action(coordinator, ctx)
 
 	if test.Runs {
 		fakeRunnable.AssertCalled(s.T(), "Run", ctx)
+		fakeComponent.AssertCalled(s.T(), "RecordRun", mock.Anything, test.RunErr)
 	} else {
 		fakeRunnable.AssertNotCalled(s.T(), "Run")
+		fakeComponent.AssertNotCalled(s.T(), "RecordRun", mock.Anything, mock.Anything)
 	}
 
 	if test.UpdatesLastRan {
@@ -175,6 +191,46 @@ func (s *CoordinatorSuite) TestRunPeriodically() {
 
 			Runs: false,
 		},
+		{
+			It: "does not run if the cluster is in maintenance",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+			InMaintenance:           true,
+
+			Runs: false,
+		},
+		{
+			It: "does not run if checking for maintenance errors",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+			MaintenanceErr:          someErr,
+
+			Runs: false,
+		},
+		{
+			It: "runs during maintenance if the component is not paused for it",
+
+			LockAvailable:   true,
+			IntervalElapsed: true,
+			InMaintenance:   true,
+
+			Runs:           true,
+			UpdatesLastRan: true,
+		},
+		{
+			It: "runs if the cluster is not in maintenance",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+
+			Runs:           true,
+			UpdatesLastRan: true,
+		},
 		{
 			It: "does not update last ran if running failed",
 
@@ -185,6 +241,16 @@ func (s *CoordinatorSuite) TestRunPeriodically() {
 			RunErr:         someErr,
 			UpdatesLastRan: false,
 		},
+		{
+			It: "updates last ran even if recording the run failed",
+
+			LockAvailable:   true,
+			IntervalElapsed: true,
+
+			Runs:           true,
+			RecordRunErr:   someErr,
+			UpdatesLastRan: true,
+		},
 	} {
 		s.Run(t.It, func() {
 			t.Run(s, (*component.Coordinator).RunPeriodically)
@@ -255,6 +321,46 @@ func (s *CoordinatorSuite) TestRunImmediately() {
 
 			Runs: false,
 		},
+		{
+			It: "does not run if the cluster is in maintenance",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+			InMaintenance:           true,
+
+			Runs: false,
+		},
+		{
+			It: "does not run if checking for maintenance errors",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+			MaintenanceErr:          someErr,
+
+			Runs: false,
+		},
+		{
+			It: "runs during maintenance if the component is not paused for it",
+
+			LockAvailable:   true,
+			IntervalElapsed: true,
+			InMaintenance:   true,
+
+			Runs:           true,
+			UpdatesLastRan: true,
+		},
+		{
+			It: "runs if the cluster is not in maintenance",
+
+			LockAvailable:           true,
+			IntervalElapsed:         true,
+			PausedDuringMaintenance: true,
+
+			Runs:           true,
+			UpdatesLastRan: true,
+		},
 	} {
 		s.Run(t.It, func() {
 			t.Run(s, (*component.Coordinator).RunImmediately)
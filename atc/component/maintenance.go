@@ -0,0 +1,7 @@
+package component
+
+// Maintenance reports whether the cluster is in maintenance mode, during which
+// components that start new work are not run.
+type Maintenance interface {
+	Enabled() (bool, error)
+}
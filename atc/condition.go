@@ -0,0 +1,755 @@
+package atc
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Condition is a parsed `if:` expression, deciding whether a step runs.
+//
+// Conditions compare and combine values with ==, !=, <, <=, >, >=, &&, || and
+// !, and may call the functions contains, starts_with, ends_with and matches.
+// Values are quoted strings, numbers, true, false, null, and references:
+//
+//	vars.NAME[.FIELD...]  a local var set by a load_var step
+//	build.FIELD           one of team, pipeline, job, name or trigger
+//	steps.NAME            the status of an earlier step in the build
+//
+// For example:
+//
+//	build.trigger == "manual" || starts_with(vars.branch, "release/")
+type Condition struct {
+	Raw string
+
+	root conditionNode
+}
+
+// ConditionBuild is the build metadata available to conditions.
+type ConditionBuild struct {
+	Team     string
+	Pipeline string
+	Job      string
+	Name     string
+
+	// Trigger is one of "manual", "rerun" or "scheduled".
+	Trigger string
+}
+
+// ConditionContext provides the values referenced by a condition when it is
+// evaluated.
+type ConditionContext struct {
+	Build ConditionBuild
+
+	// LocalVar returns the value of a local var.
+	LocalVar func(name string) (interface{}, bool)
+
+	// StepStatus returns the status of the step with the given name, if it
+	// has run.
+	StepStatus func(name string) (string, bool)
+}
+
+// StepStatusPending is the status of a referenced step which has not run.
+const StepStatusPending = "pending"
+
+// ConditionSyntaxError is returned when a condition cannot be parsed.
+type ConditionSyntaxError struct {
+	Condition string
+	Position  int
+	Message   string
+}
+
+func (err ConditionSyntaxError) Error() string {
+	return fmt.Sprintf("invalid condition '%s' at position %d: %s", err.Condition, err.Position, err.Message)
+}
+
+// ConditionEvaluationError is returned when a condition cannot be evaluated
+// with the values it references, e.g. when comparing a string to a number.
+type ConditionEvaluationError struct {
+	Condition string
+	Message   string
+}
+
+func (err ConditionEvaluationError) Error() string {
+	return fmt.Sprintf("failed to evaluate condition '%s': %s", err.Condition, err.Message)
+}
+
+// ParseCondition parses a condition, validating its syntax, references and
+// function calls.
+func ParseCondition(condition string) (Condition, error) {
+	p := &conditionParser{str: condition}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return Condition{}, err
+	}
+
+	p.skipSpace()
+
+	if p.pos < len(p.str) {
+		r, _ := utf8.DecodeRuneInString(p.str[p.pos:])
+		return Condition{}, p.errorf("unexpected '%c'", r)
+	}
+
+	return Condition{Raw: condition, root: root}, nil
+}
+
+// Evaluate returns whether the condition holds. The result of the expression
+// is converted to a boolean: null, false, 0 and "" are false, as are empty
+// lists and maps.
+func (condition Condition) Evaluate(ctx ConditionContext) (bool, error) {
+	value, err := condition.root.eval(ctx)
+	if err != nil {
+		return false, ConditionEvaluationError{
+			Condition: condition.Raw,
+			Message:   err.Error(),
+		}
+	}
+
+	return conditionTruthy(value), nil
+}
+
+type conditionNode interface {
+	eval(ConditionContext) (interface{}, error)
+}
+
+type conditionLiteral struct {
+	value interface{}
+}
+
+func (node conditionLiteral) eval(ConditionContext) (interface{}, error) {
+	return node.value, nil
+}
+
+type conditionReference struct {
+	root string
+	path []string
+}
+
+func (node conditionReference) eval(ctx ConditionContext) (interface{}, error) {
+	switch node.root {
+	case "build":
+		switch node.path[0] {
+		case "team":
+			return ctx.Build.Team, nil
+		case "pipeline":
+			return ctx.Build.Pipeline, nil
+		case "job":
+			return ctx.Build.Job, nil
+		case "name":
+			return ctx.Build.Name, nil
+		default:
+			return ctx.Build.Trigger, nil
+		}
+
+	case "steps":
+		if ctx.StepStatus != nil {
+			status, found := ctx.StepStatus(node.path[0])
+			if found {
+				return status, nil
+			}
+		}
+
+		return StepStatusPending, nil
+
+	default:
+		if ctx.LocalVar == nil {
+			return nil, nil
+		}
+
+		value, found := ctx.LocalVar(node.path[0])
+		if !found {
+			return nil, nil
+		}
+
+		for _, field := range node.path[1:] {
+			value = conditionField(value, field)
+		}
+
+		return value, nil
+	}
+}
+
+// conditionField looks up a field of a map or an index of a list, returning
+// nil if there is no such field.
+func conditionField(value interface{}, field string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v[field]
+
+	case map[interface{}]interface{}:
+		return v[field]
+
+	case []interface{}:
+		index, err := strconv.Atoi(field)
+		if err != nil || index < 0 || index >= len(v) {
+			return nil
+		}
+
+		return v[index]
+
+	default:
+		return nil
+	}
+}
+
+type conditionNot struct {
+	operand conditionNode
+}
+
+func (node conditionNot) eval(ctx ConditionContext) (interface{}, error) {
+	value, err := node.operand.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return !conditionTruthy(value), nil
+}
+
+type conditionBinary struct {
+	op          string
+	left, right conditionNode
+}
+
+func (node conditionBinary) eval(ctx ConditionContext) (interface{}, error) {
+	left, err := node.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.op {
+	case "&&":
+		if !conditionTruthy(left) {
+			return false, nil
+		}
+
+	case "||":
+		if conditionTruthy(left) {
+			return true, nil
+		}
+	}
+
+	right, err := node.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.op {
+	case "&&", "||":
+		return conditionTruthy(right), nil
+	case "==":
+		return conditionEqual(left, right), nil
+	case "!=":
+		return !conditionEqual(left, right), nil
+	}
+
+	if l, ok := conditionNumber(left); ok {
+		if r, ok := conditionNumber(right); ok {
+			return compareOrdered(node.op, l < r, l == r), nil
+		}
+	}
+
+	if l, ok := left.(string); ok {
+		if r, ok := right.(string); ok {
+			return compareOrdered(node.op, l < r, l == r), nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot compare %s and %s with '%s'", conditionTypeName(left), conditionTypeName(right), node.op)
+}
+
+func compareOrdered(op string, less bool, equal bool) bool {
+	switch op {
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	case ">":
+		return !less && !equal
+	default:
+		return !less
+	}
+}
+
+type conditionCall struct {
+	fn   string
+	args []conditionNode
+
+	// regexp is compiled when parsing if the pattern passed to matches is a
+	// literal
+	regexp *regexp.Regexp
+}
+
+func (node conditionCall) eval(ctx ConditionContext) (interface{}, error) {
+	args := make([]interface{}, len(node.args))
+	for i, arg := range node.args {
+		value, err := arg.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		args[i] = value
+	}
+
+	if node.fn == "contains" {
+		switch haystack := args[0].(type) {
+		case []interface{}:
+			for _, elem := range haystack {
+				if conditionEqual(elem, args[1]) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+
+		case map[string]interface{}:
+			key, ok := args[1].(string)
+			if !ok {
+				return false, nil
+			}
+
+			_, found := haystack[key]
+			return found, nil
+		}
+	}
+
+	// a missing var is treated like an empty string so that e.g.
+	// starts_with(vars.branch, "release/") is simply false without it
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			continue
+		}
+
+		str, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s expects strings, got %s", node.fn, conditionTypeName(arg))
+		}
+
+		strs[i] = str
+	}
+
+	switch node.fn {
+	case "contains":
+		return strings.Contains(strs[0], strs[1]), nil
+	case "starts_with":
+		return strings.HasPrefix(strs[0], strs[1]), nil
+	case "ends_with":
+		return strings.HasSuffix(strs[0], strs[1]), nil
+	default:
+		re := node.regexp
+		if re == nil {
+			var err error
+			re, err = regexp.Compile(strs[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern: %s", err)
+			}
+		}
+
+		return re.MatchString(strs[0]), nil
+	}
+}
+
+// conditionFunctions maps the functions available to conditions to their
+// number of arguments.
+var conditionFunctions = map[string]int{
+	"contains":    2,
+	"starts_with": 2,
+	"ends_with":   2,
+	"matches":     2,
+}
+
+var conditionBuildFields = map[string]bool{
+	"team":     true,
+	"pipeline": true,
+	"job":      true,
+	"name":     true,
+	"trigger":  true,
+}
+
+var (
+	conditionIdentRegex   = regexp.MustCompile(`\A[a-zA-Z_][-\w]*`)
+	conditionSegmentRegex = regexp.MustCompile(`\A[-\w]+`)
+	conditionNumberRegex  = regexp.MustCompile(`\A-?[0-9]+(\.[0-9]+)?`)
+)
+
+type conditionParser struct {
+	str string
+	pos int
+}
+
+func (p *conditionParser) parseOr() (conditionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+
+		if !p.consume("||") {
+			return left, nil
+		}
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = conditionBinary{op: "||", left: left, right: right}
+	}
+}
+
+func (p *conditionParser) parseAnd() (conditionNode, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+
+		if !p.consume("&&") {
+			return left, nil
+		}
+
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+
+		left = conditionBinary{op: "&&", left: left, right: right}
+	}
+}
+
+func (p *conditionParser) parseComparison() (conditionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+
+	// longer operators first, so that '<=' isn't parsed as '<'
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.consume(op) {
+			continue
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return conditionBinary{op: op, left: left, right: right}, nil
+	}
+
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionNode, error) {
+	p.skipSpace()
+
+	if strings.HasPrefix(p.str[p.pos:], "!") && !strings.HasPrefix(p.str[p.pos:], "!=") {
+		p.pos++
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return conditionNot{operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *conditionParser) parsePrimary() (conditionNode, error) {
+	p.skipSpace()
+
+	rest := p.str[p.pos:]
+
+	switch {
+	case rest == "":
+		return nil, p.errorf("unexpected end of condition")
+
+	case p.consume("("):
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+
+		if !p.consume(")") {
+			return nil, p.errorf("expected ')'")
+		}
+
+		return node, nil
+
+	case rest[0] == '"' || rest[0] == '\'':
+		str, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+
+		return conditionLiteral{value: str}, nil
+
+	case conditionNumberRegex.MatchString(rest):
+		digits := conditionNumberRegex.FindString(rest)
+
+		number, err := strconv.ParseFloat(digits, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number: %s", err)
+		}
+
+		p.pos += len(digits)
+
+		return conditionLiteral{value: number}, nil
+	}
+
+	start := p.pos
+
+	ident := conditionIdentRegex.FindString(rest)
+	if ident == "" {
+		r, _ := utf8.DecodeRuneInString(rest)
+		return nil, p.errorf("unexpected '%c'", r)
+	}
+
+	p.pos += len(ident)
+
+	switch ident {
+	case "true":
+		return conditionLiteral{value: true}, nil
+	case "false":
+		return conditionLiteral{value: false}, nil
+	case "null":
+		return conditionLiteral{value: nil}, nil
+	}
+
+	p.skipSpace()
+
+	if p.consume("(") {
+		return p.parseCall(ident, start)
+	}
+
+	var path []string
+	for p.consume(".") {
+		segment := conditionSegmentRegex.FindString(p.str[p.pos:])
+		if segment == "" {
+			return nil, p.errorf("expected a field name after '.'")
+		}
+
+		path = append(path, segment)
+		p.pos += len(segment)
+	}
+
+	switch ident {
+	case "vars":
+		if len(path) == 0 {
+			return nil, p.errorfAt(start, "expected a var name, as in 'vars.NAME'")
+		}
+
+	case "build":
+		if len(path) != 1 || !conditionBuildFields[path[0]] {
+			return nil, p.errorfAt(start, "expected one of build.team, build.pipeline, build.job, build.name or build.trigger")
+		}
+
+	case "steps":
+		if len(path) != 1 {
+			return nil, p.errorfAt(start, "expected a step name, as in 'steps.NAME'")
+		}
+
+	default:
+		return nil, p.errorfAt(start, "unknown reference '%s' (must start with vars, build or steps)", ident)
+	}
+
+	return conditionReference{root: ident, path: path}, nil
+}
+
+func (p *conditionParser) parseCall(fn string, start int) (conditionNode, error) {
+	arity, found := conditionFunctions[fn]
+	if !found {
+		return nil, p.errorfAt(start, "unknown function '%s'", fn)
+	}
+
+	call := conditionCall{fn: fn}
+
+	p.skipSpace()
+
+	if !p.consume(")") {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			call.args = append(call.args, arg)
+
+			p.skipSpace()
+
+			if p.consume(")") {
+				break
+			}
+
+			if !p.consume(",") {
+				return nil, p.errorf("expected ',' or ')'")
+			}
+		}
+	}
+
+	if len(call.args) != arity {
+		return nil, p.errorfAt(start, "%s expects %d arguments, got %d", fn, arity, len(call.args))
+	}
+
+	if fn == "matches" {
+		if pattern, ok := call.args[1].(conditionLiteral); ok {
+			str, ok := pattern.value.(string)
+			if !ok {
+				return nil, p.errorfAt(start, "matches expects a string pattern")
+			}
+
+			re, err := regexp.Compile(str)
+			if err != nil {
+				return nil, p.errorfAt(start, "invalid pattern: %s", err)
+			}
+
+			call.regexp = re
+		}
+	}
+
+	return call, nil
+}
+
+func (p *conditionParser) parseString() (string, error) {
+	quote := p.str[p.pos]
+
+	for i := p.pos + 1; i < len(p.str); i++ {
+		switch p.str[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+
+		case quote:
+			raw := p.str[p.pos : i+1]
+
+			if quote == '\'' {
+				p.pos = i + 1
+				return raw[1 : len(raw)-1], nil
+			}
+
+			value, err := strconv.Unquote(raw)
+			if err != nil {
+				return "", p.errorf("invalid string: %s", err)
+			}
+
+			p.pos = i + 1
+			return value, nil
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *conditionParser) skipSpace() {
+	for p.pos < len(p.str) && strings.ContainsRune(" \t\n\r", rune(p.str[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *conditionParser) consume(token string) bool {
+	if strings.HasPrefix(p.str[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+
+	return false
+}
+
+func (p *conditionParser) errorf(format string, args ...interface{}) error {
+	return p.errorfAt(p.pos, format, args...)
+}
+
+func (p *conditionParser) errorfAt(pos int, format string, args ...interface{}) error {
+	return ConditionSyntaxError{
+		Condition: p.str,
+		Position:  utf8.RuneCountInString(p.str[:pos]) + 1,
+		Message:   fmt.Sprintf(format, args...),
+	}
+}
+
+func conditionTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) != 0
+	case map[string]interface{}:
+		return len(v) != 0
+	case map[interface{}]interface{}:
+		return len(v) != 0
+	}
+
+	if number, ok := conditionNumber(value); ok {
+		return number != 0
+	}
+
+	return true
+}
+
+func conditionEqual(left, right interface{}) bool {
+	if l, ok := conditionNumber(left); ok {
+		r, ok := conditionNumber(right)
+		return ok && l == r
+	}
+
+	return reflect.DeepEqual(left, right)
+}
+
+// conditionNumber converts the number types found in vars loaded from JSON
+// or YAML files to float64.
+func conditionNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func conditionTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}, map[interface{}]interface{}:
+		return "map"
+	}
+
+	if _, ok := conditionNumber(value); ok {
+		return "number"
+	}
+
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,113 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Condition", func() {
+	var ctx atc.ConditionContext
+
+	BeforeEach(func() {
+		localVars := map[string]interface{}{
+			"branch":  "release/6.5",
+			"version": map[string]interface{}{"major": float64(6), "tags": []interface{}{"rc", "lts"}},
+			"empty":   "",
+		}
+
+		statuses := map[string]string{
+			"unit":   "succeeded",
+			"deploy": "failed",
+		}
+
+		ctx = atc.ConditionContext{
+			Build: atc.ConditionBuild{
+				Team:     "main",
+				Pipeline: "some-pipeline",
+				Job:      "some-job",
+				Name:     "42",
+				Trigger:  "manual",
+			},
+			LocalVar: func(name string) (interface{}, bool) {
+				value, found := localVars[name]
+				return value, found
+			},
+			StepStatus: func(name string) (string, bool) {
+				status, found := statuses[name]
+				return status, found
+			},
+		}
+	})
+
+	DescribeTable("evaluating",
+		func(condition string, expected bool) {
+			parsed, err := atc.ParseCondition(condition)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := parsed.Evaluate(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("literal", `true`, true),
+		Entry("build metadata", `build.team == "main" && build.job == 'some-job'`, true),
+		Entry("trigger", `build.trigger != "manual"`, false),
+		Entry("local var", `vars.branch == "release/6.5"`, true),
+		Entry("missing local var", `vars.missing`, false),
+		Entry("missing local var compared to null", `vars.missing == null`, true),
+		Entry("empty local var", `!vars.empty`, true),
+		Entry("local var field", `vars.version.major >= 6`, true),
+		Entry("local var index", `vars.version.tags.1 == "lts"`, true),
+		Entry("missing local var field", `vars.branch.major == null`, true),
+		Entry("step status", `steps.unit == "succeeded"`, true),
+		Entry("failed step status", `steps.deploy == "succeeded"`, false),
+		Entry("pending step status", `steps.lint == "pending"`, true),
+		Entry("or", `steps.deploy == "succeeded" || build.name == "42"`, true),
+		Entry("precedence of && over ||", `true || false && false`, true),
+		Entry("parentheses", `(true || false) && false`, false),
+		Entry("not", `!(build.team == "main")`, false),
+		Entry("starts_with", `starts_with(vars.branch, "release/")`, true),
+		Entry("starts_with a missing var", `starts_with(vars.missing, "release/")`, false),
+		Entry("ends_with", `ends_with(build.pipeline, "-pipeline")`, true),
+		Entry("contains a substring", `contains(vars.branch, "6.5")`, true),
+		Entry("contains an element", `contains(vars.version.tags, "rc")`, true),
+		Entry("matches", `matches(vars.branch, "^release/[0-9.]+$")`, true),
+		Entry("string comparison", `build.name < "5"`, true),
+		Entry("identifiers with dashes", `steps.some-step == "pending"`, true),
+	)
+
+	DescribeTable("parsing invalid conditions",
+		func(condition string, position int, message string) {
+			_, err := atc.ParseCondition(condition)
+			Expect(err).To(Equal(atc.ConditionSyntaxError{
+				Condition: condition,
+				Position:  position,
+				Message:   message,
+			}))
+		},
+		Entry("empty", ``, 1, "unexpected end of condition"),
+		Entry("dangling operator", `true &&`, 8, "unexpected end of condition"),
+		Entry("unclosed parenthesis", `(true`, 6, "expected ')'"),
+		Entry("unterminated string", `vars.branch == "main`, 16, "unterminated string"),
+		Entry("trailing tokens", `true false`, 6, "unexpected 'f'"),
+		Entry("unknown reference", `branch == "main"`, 1, "unknown reference 'branch' (must start with vars, build or steps)"),
+		Entry("unknown build field", `build.id == 1`, 1, "expected one of build.team, build.pipeline, build.job, build.name or build.trigger"),
+		Entry("var without a name", `vars == 1`, 1, "expected a var name, as in 'vars.NAME'"),
+		Entry("unknown function", `lower(build.team)`, 1, "unknown function 'lower'"),
+		Entry("wrong number of arguments", `contains(build.team)`, 1, "contains expects 2 arguments, got 1"),
+		Entry("invalid pattern", `matches(build.team, "(")`, 1, "invalid pattern: error parsing regexp: missing closing ): `(`"),
+	)
+
+	It("errors when comparing values of different types", func() {
+		parsed, err := atc.ParseCondition(`build.name > 10`)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = parsed.Evaluate(ctx)
+		Expect(err).To(Equal(atc.ConditionEvaluationError{
+			Condition: `build.name > 10`,
+			Message:   "cannot compare string and number with '>'",
+		}))
+	})
+})
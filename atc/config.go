@@ -171,29 +171,31 @@ func (c VarSourceConfigs) OrderByDependency() (VarSourceConfigs, error) {
 }
 
 type ResourceConfig struct {
-	Name         string  `json:"name"`
-	Public       bool    `json:"public,omitempty"`
-	WebhookToken string  `json:"webhook_token,omitempty"`
-	Type         string  `json:"type"`
-	Source       Source  `json:"source"`
-	CheckEvery   string  `json:"check_every,omitempty"`
-	CheckTimeout string  `json:"check_timeout,omitempty"`
-	Tags         Tags    `json:"tags,omitempty"`
-	Version      Version `json:"version,omitempty"`
-	Icon         string  `json:"icon,omitempty"`
+	Name           string          `json:"name"`
+	Public         bool            `json:"public,omitempty"`
+	WebhookToken   string          `json:"webhook_token,omitempty"`
+	Type           string          `json:"type"`
+	Source         Source          `json:"source"`
+	CheckEvery     string          `json:"check_every,omitempty"`
+	CheckTimeout   string          `json:"check_timeout,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+	Version        Version         `json:"version,omitempty"`
+	Icon           string          `json:"icon,omitempty"`
 }
 
 type ResourceType struct {
-	Name                 string `json:"name"`
-	Type                 string `json:"type"`
-	Source               Source `json:"source"`
-	Privileged           bool   `json:"privileged,omitempty"`
-	CheckEvery           string `json:"check_every,omitempty"`
-	Tags                 Tags   `json:"tags,omitempty"`
-	Params               Params `json:"params,omitempty"`
-	CheckSetupError      string `json:"check_setup_error,omitempty"`
-	CheckError           string `json:"check_error,omitempty"`
-	UniqueVersionHistory bool   `json:"unique_version_history,omitempty"`
+	Name                 string          `json:"name"`
+	Type                 string          `json:"type"`
+	Source               Source          `json:"source"`
+	Privileged           bool            `json:"privileged,omitempty"`
+	CheckEvery           string          `json:"check_every,omitempty"`
+	Tags                 Tags            `json:"tags,omitempty"`
+	WorkerSelector       *WorkerSelector `json:"worker_selector,omitempty"`
+	Params               Params          `json:"params,omitempty"`
+	CheckSetupError      string          `json:"check_setup_error,omitempty"`
+	CheckError           string          `json:"check_error,omitempty"`
+	UniqueVersionHistory bool            `json:"unique_version_history,omitempty"`
 }
 
 type ResourceTypes []ResourceType
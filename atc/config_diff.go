@@ -255,7 +255,7 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 	varSourceDiffs := diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources))
 	if len(varSourceDiffs) > 0 {
 		diffExists = true
-		fmt.Println("variable source:")
+		fmt.Fprintln(out, "variable source:")
 
 		for _, diff := range varSourceDiffs {
 			diff.Render(indent, "variable source")
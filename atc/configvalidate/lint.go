@@ -0,0 +1,265 @@
+package configvalidate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// LintRule checks a pipeline config for something which is valid but likely
+// to be a mistake or a bad practice.
+type LintRule struct {
+	ID          string
+	Description string
+	Severity    atc.LintSeverity
+	Hint        string
+
+	Check func(atc.Config) []LintViolation
+}
+
+// LintViolation is a single place in the config where a rule is violated.
+type LintViolation struct {
+	Location string
+	Message  string
+}
+
+// MinimumCheckEvery is the check interval below which resources are reported
+// by the check-every-too-frequent rule.
+const MinimumCheckEvery = time.Minute
+
+var AllLintRules = []LintRule{
+	{
+		ID:          "deploy-without-passed",
+		Description: "deploy job has no passed constraints",
+		Severity:    atc.LintSeverityWarning,
+		Hint:        "add 'passed' to the job's get steps so that only versions which made it through the pipeline are deployed",
+		Check:       checkDeployWithoutPassed,
+	},
+	{
+		ID:          "resource-without-icon",
+		Description: "resource has no icon",
+		Severity:    atc.LintSeverityInfo,
+		Hint:        "set 'icon' to the name of a Material Design icon",
+		Check:       checkResourceWithoutIcon,
+	},
+	{
+		ID:          "privileged-task",
+		Description: "task runs privileged",
+		Severity:    atc.LintSeverityWarning,
+		Hint:        "remove 'privileged: true' unless the task really needs full capabilities on the worker",
+		Check:       checkPrivilegedTask,
+	},
+	{
+		ID:          "check-every-too-frequent",
+		Description: fmt.Sprintf("resource is checked more often than every %s", MinimumCheckEvery),
+		Severity:    atc.LintSeverityWarning,
+		Hint:        fmt.Sprintf("raise 'check_every' to at least %s, or configure a webhook for the resource", MinimumCheckEvery),
+		Check:       checkCheckEveryTooFrequent,
+	},
+	{
+		ID:          "unpinned-image-resource",
+		Description: "task image_resource has no version",
+		Severity:    atc.LintSeverityWarning,
+		Hint:        "pin the image by setting 'version' on the image_resource, e.g. to a digest",
+		Check:       checkUnpinnedImageResource,
+	},
+}
+
+// ValidateLintRules returns an error if the rules configure unknown rule IDs
+// or severities.
+func ValidateLintRules(rules atc.LintRules) error {
+	known := map[string]bool{}
+	for _, rule := range AllLintRules {
+		known[rule.ID] = true
+	}
+
+	var errorMessages []string
+	for id, severity := range rules {
+		if !known[id] {
+			errorMessages = append(errorMessages, fmt.Sprintf("unknown lint rule '%s'", id))
+		}
+
+		if err := severity.Validate(); err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+	}
+
+	sort.Strings(errorMessages)
+
+	return compositeErr(errorMessages)
+}
+
+// Lint runs all lint rules which are not turned off against the config,
+// using the configured severity of each rule in place of its default.
+func Lint(c atc.Config, rules atc.LintRules) []atc.LintFinding {
+	findings := []atc.LintFinding{}
+
+	for _, rule := range AllLintRules {
+		severity := rule.Severity
+		if configured, found := rules[rule.ID]; found {
+			severity = configured
+		}
+
+		if severity == atc.LintSeverityOff {
+			continue
+		}
+
+		for _, violation := range rule.Check(c) {
+			findings = append(findings, atc.LintFinding{
+				Rule:     rule.ID,
+				Severity: severity,
+				Location: violation.Location,
+				Message:  violation.Message,
+				Hint:     rule.Hint,
+			})
+		}
+	}
+
+	return findings
+}
+
+// LintWarnings converts the findings into config warnings, returning the
+// messages of findings with error severity separately. Findings with info
+// severity are left out.
+func LintWarnings(findings []atc.LintFinding) ([]atc.ConfigWarning, []string) {
+	warnings := []atc.ConfigWarning{}
+	errorMessages := []string{}
+
+	for _, finding := range findings {
+		if finding.Severity == atc.LintSeverityInfo {
+			continue
+		}
+
+		message := fmt.Sprintf("%s: %s", finding.Location, finding.Message)
+
+		if finding.Severity == atc.LintSeverityError {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s (%s)", message, finding.Rule))
+			continue
+		}
+
+		warnings = append(warnings, atc.ConfigWarning{
+			Type:    "lint",
+			Message: message,
+			Rule:    finding.Rule,
+			Hint:    finding.Hint,
+		})
+	}
+
+	return warnings, errorMessages
+}
+
+func checkDeployWithoutPassed(c atc.Config) []LintViolation {
+	var violations []LintViolation
+
+	for _, job := range c.Jobs {
+		if !strings.Contains(job.Name, "deploy") {
+			continue
+		}
+
+		gets := 0
+		constrained := false
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				gets++
+				if len(step.Passed) > 0 {
+					constrained = true
+				}
+
+				return nil
+			},
+		})
+
+		if gets > 0 && !constrained {
+			violations = append(violations, LintViolation{
+				Location: fmt.Sprintf("jobs.%s", job.Name),
+				Message:  fmt.Sprintf("job '%s' deploys inputs which have no passed constraints", job.Name),
+			})
+		}
+	}
+
+	return violations
+}
+
+func checkResourceWithoutIcon(c atc.Config) []LintViolation {
+	var violations []LintViolation
+
+	for _, resource := range c.Resources {
+		if resource.Icon == "" {
+			violations = append(violations, LintViolation{
+				Location: fmt.Sprintf("resources.%s", resource.Name),
+				Message:  fmt.Sprintf("resource '%s' has no icon", resource.Name),
+			})
+		}
+	}
+
+	return violations
+}
+
+func checkPrivilegedTask(c atc.Config) []LintViolation {
+	var violations []LintViolation
+
+	for _, job := range c.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.Privileged {
+					violations = append(violations, LintViolation{
+						Location: fmt.Sprintf("jobs.%s.plan.task(%s)", job.Name, step.Name),
+						Message:  fmt.Sprintf("task '%s' runs privileged", step.Name),
+					})
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return violations
+}
+
+func checkCheckEveryTooFrequent(c atc.Config) []LintViolation {
+	var violations []LintViolation
+
+	for _, resource := range c.Resources {
+		if resource.CheckEvery == "" || resource.CheckEvery == "never" {
+			continue
+		}
+
+		interval, err := time.ParseDuration(resource.CheckEvery)
+		if err != nil {
+			continue
+		}
+
+		if interval < MinimumCheckEvery {
+			violations = append(violations, LintViolation{
+				Location: fmt.Sprintf("resources.%s", resource.Name),
+				Message:  fmt.Sprintf("resource '%s' is checked every %s", resource.Name, interval),
+			})
+		}
+	}
+
+	return violations
+}
+
+func checkUnpinnedImageResource(c atc.Config) []LintViolation {
+	var violations []LintViolation
+
+	for _, job := range c.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.Config != nil && step.Config.ImageResource != nil && step.Config.ImageResource.Version == nil {
+					violations = append(violations, LintViolation{
+						Location: fmt.Sprintf("jobs.%s.plan.task(%s)", job.Name, step.Name),
+						Message:  fmt.Sprintf("task '%s' uses an image_resource without a version", step.Name),
+					})
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,191 @@
+package configvalidate_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lint", func() {
+	var (
+		config atc.Config
+		rules  atc.LintRules
+
+		findings []atc.LintFinding
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{
+					Name:       "some-resource",
+					Type:       "some-type",
+					Icon:       "github",
+					CheckEvery: "10s",
+				},
+				{
+					Name:       "some-other-resource",
+					Type:       "some-type",
+					CheckEvery: "never",
+				},
+			},
+
+			Jobs: atc.JobConfigs{
+				{
+					Name: "build",
+					PlanSequence: []atc.Step{
+						{
+							Config: &atc.GetStep{
+								Name: "some-resource",
+							},
+						},
+						{
+							Config: &atc.TaskStep{
+								Name:       "unit",
+								Privileged: true,
+								Config: &atc.TaskConfig{
+									ImageResource: &atc.ImageResource{
+										Type:    "registry-image",
+										Source:  atc.Source{"repository": "golang"},
+										Version: atc.Version{"digest": "sha256:abc"},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "deploy-prod",
+					PlanSequence: []atc.Step{
+						{
+							Config: &atc.GetStep{
+								Name: "some-resource",
+							},
+						},
+						{
+							Config: &atc.TaskStep{
+								Name: "deploy",
+								Config: &atc.TaskConfig{
+									ImageResource: &atc.ImageResource{
+										Type:   "registry-image",
+										Source: atc.Source{"repository": "alpine"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		rules = atc.LintRules{}
+	})
+
+	JustBeforeEach(func() {
+		findings = configvalidate.Lint(config, rules)
+	})
+
+	It("reports findings of all rules with their default severity", func() {
+		Expect(findings).To(ConsistOf(
+			atc.LintFinding{
+				Rule:     "deploy-without-passed",
+				Severity: atc.LintSeverityWarning,
+				Location: "jobs.deploy-prod",
+				Message:  "job 'deploy-prod' deploys inputs which have no passed constraints",
+				Hint:     "add 'passed' to the job's get steps so that only versions which made it through the pipeline are deployed",
+			},
+			atc.LintFinding{
+				Rule:     "resource-without-icon",
+				Severity: atc.LintSeverityInfo,
+				Location: "resources.some-other-resource",
+				Message:  "resource 'some-other-resource' has no icon",
+				Hint:     "set 'icon' to the name of a Material Design icon",
+			},
+			atc.LintFinding{
+				Rule:     "privileged-task",
+				Severity: atc.LintSeverityWarning,
+				Location: "jobs.build.plan.task(unit)",
+				Message:  "task 'unit' runs privileged",
+				Hint:     "remove 'privileged: true' unless the task really needs full capabilities on the worker",
+			},
+			atc.LintFinding{
+				Rule:     "check-every-too-frequent",
+				Severity: atc.LintSeverityWarning,
+				Location: "resources.some-resource",
+				Message:  "resource 'some-resource' is checked every 10s",
+				Hint:     "raise 'check_every' to at least 1m0s, or configure a webhook for the resource",
+			},
+			atc.LintFinding{
+				Rule:     "unpinned-image-resource",
+				Severity: atc.LintSeverityWarning,
+				Location: "jobs.deploy-prod.plan.task(deploy)",
+				Message:  "task 'deploy' uses an image_resource without a version",
+				Hint:     "pin the image by setting 'version' on the image_resource, e.g. to a digest",
+			},
+		))
+	})
+
+	Context("when the deploy job has passed constraints", func() {
+		BeforeEach(func() {
+			config.Jobs[1].PlanSequence[0].Config.(*atc.GetStep).Passed = []string{"build"}
+		})
+
+		It("does not report it", func() {
+			for _, finding := range findings {
+				Expect(finding.Rule).ToNot(Equal("deploy-without-passed"))
+			}
+		})
+	})
+
+	Context("when rules are configured", func() {
+		BeforeEach(func() {
+			rules = atc.LintRules{
+				"privileged-task":          atc.LintSeverityError,
+				"resource-without-icon":    atc.LintSeverityOff,
+				"check-every-too-frequent": atc.LintSeverityOff,
+				"deploy-without-passed":    atc.LintSeverityOff,
+			}
+		})
+
+		It("uses the configured severities", func() {
+			Expect(findings).To(HaveLen(2))
+			Expect(findings[0].Rule).To(Equal("privileged-task"))
+			Expect(findings[0].Severity).To(Equal(atc.LintSeverityError))
+			Expect(findings[1].Rule).To(Equal("unpinned-image-resource"))
+			Expect(findings[1].Severity).To(Equal(atc.LintSeverityWarning))
+		})
+
+		It("converts findings into warnings and errors", func() {
+			warnings, errorMessages := configvalidate.LintWarnings(findings)
+			Expect(warnings).To(Equal([]atc.ConfigWarning{
+				{
+					Type:    "lint",
+					Message: "jobs.deploy-prod.plan.task(deploy): task 'deploy' uses an image_resource without a version",
+					Rule:    "unpinned-image-resource",
+					Hint:    "pin the image by setting 'version' on the image_resource, e.g. to a digest",
+				},
+			}))
+			Expect(errorMessages).To(Equal([]string{
+				"jobs.build.plan.task(unit): task 'unit' runs privileged (privileged-task)",
+			}))
+		})
+	})
+})
+
+var _ = Describe("ValidateLintRules", func() {
+	It("accepts known rules and severities", func() {
+		Expect(configvalidate.ValidateLintRules(atc.LintRules{
+			"privileged-task": atc.LintSeverityError,
+		})).To(Succeed())
+	})
+
+	It("rejects unknown rules and severities", func() {
+		err := configvalidate.ValidateLintRules(atc.LintRules{
+			"bogus":           atc.LintSeverityError,
+			"privileged-task": "fatal",
+		})
+		Expect(err).To(MatchError("unknown lint rule 'bogus'\nunknown lint severity 'fatal' (must be one of error, warning, info, off)"))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc"
@@ -252,6 +253,21 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			)
 		}
 
+		if job.BuildTimeout != "" {
+			timeout, err := time.ParseDuration(job.BuildTimeout)
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has invalid build_timeout '%s'", job.BuildTimeout),
+				)
+			} else if timeout <= 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has non-positive build_timeout '%s'", job.BuildTimeout),
+				)
+			}
+		}
+
 		if job.BuildLogRetention != nil {
 			if job.BuildLogRetention.Builds < 0 {
 				errorMessages = append(
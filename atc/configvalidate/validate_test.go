@@ -715,6 +715,31 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has an invalid build_timeout", func() {
+			BeforeEach(func() {
+				job.BuildTimeout = "forever"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has invalid build_timeout 'forever'"))
+			})
+		})
+
+		Context("when a job has a non-positive build_timeout", func() {
+			BeforeEach(func() {
+				job.BuildTimeout = "0s"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has non-positive build_timeout '0s'"))
+			})
+		})
+
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
 				job.BuildLogsToRetain = -1
@@ -757,6 +782,49 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a get step has an invalid filter", func() {
+			BeforeEach(func() {
+				job.PlanSequence = append(job.PlanSequence, atc.Step{
+					Config: &atc.GetStep{
+						Name: "some-resource",
+						Filter: &atc.VersionFilter{
+							Version: map[string]string{"ref": "("},
+							Semver:  &atc.SemverFilter{Field: "tag", Range: ">=latest"},
+						},
+					},
+				})
+
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).filter: invalid regular expression for version field 'ref'"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).filter: invalid semver range '>=latest'"))
+			})
+		})
+
+		Context("when a get step filters a pinned version", func() {
+			BeforeEach(func() {
+				job.PlanSequence = append(job.PlanSequence, atc.Step{
+					Config: &atc.GetStep{
+						Name:    "some-resource",
+						Version: &atc.VersionConfig{Pinned: atc.Version{"ref": "abc"}},
+						Filter: &atc.VersionFilter{
+							Semver: &atc.SemverFilter{Field: "tag", Range: "^1.2"},
+						},
+					},
+				})
+
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).filter: cannot be combined with a pinned version"))
+			})
+		})
+
 		Context("when a job has duplicate inputs with different resources", func() {
 			BeforeEach(func() {
 				job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1497,6 +1565,86 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an acquire modifier has a lock with no name", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.AcquireStep{
+							Step: &atc.PutStep{
+								Name: "some-resource",
+							},
+							Locks: atc.LockConfigs{{Name: "some-lock"}, {Size: 2}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].acquire[1]: no name specified"))
+				})
+			})
+
+			Context("when an if modifier has an invalid condition", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.IfStep{
+							Step: &atc.PutStep{
+								Name: "some-resource",
+							},
+							Condition: `vars.branch == "main" &&`,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].if: unexpected end of condition (at position 25)"))
+				})
+			})
+
+			Context("when an if modifier references an unknown function", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.IfStep{
+							Step: &atc.PutStep{
+								Name: "some-resource",
+							},
+							Condition: `lowercase(build.team) == "main"`,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].if: unknown function 'lowercase' (at position 1)"))
+				})
+			})
+
+			Context("when a release modifier specifies the size of a lock", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.ReleaseStep{
+							Step: &atc.PutStep{
+								Name: "some-resource",
+							},
+							Locks: atc.LockConfigs{{Name: "some-lock", Size: 2}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].release[0]: size can only be specified when acquiring"))
+				})
+			})
+
 			Context("when a retry plan has a negative attempts number", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1552,6 +1700,41 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a job's input's passed constraints reference a job in another pipeline", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:   "some-resource",
+							Passed: []string{"other-pipeline/some-job", "other-team/other-pipeline/some-job"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed constraints contain a malformed job reference", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:   "some-resource",
+							Passed: []string{"other-pipeline/"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed: invalid job reference 'other-pipeline/'"))
+				})
+			})
+
 			Context("when a job's input's passed constraints references a valid job that has the resource as an output", func() {
 				BeforeEach(func() {
 					config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence, atc.Step{
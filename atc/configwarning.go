@@ -3,4 +3,6 @@ package atc
 type ConfigWarning struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
+	Rule    string `json:"rule,omitempty"`
+	Hint    string `json:"hint,omitempty"`
 }
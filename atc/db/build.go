@@ -81,13 +81,17 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		r.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.partial_rerun_of,
+		pr.name,
+		b.rerun_from_step
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
 	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id").
-	JoinClause("LEFT OUTER JOIN builds r ON r.id = b.rerun_of")
+	JoinClause("LEFT OUTER JOIN builds r ON r.id = b.rerun_of").
+	JoinClause("LEFT OUTER JOIN builds pr ON pr.id = b.partial_rerun_of")
 
 var minMaxIdQuery = psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").
 	From("builds as b")
@@ -125,6 +129,10 @@ type Build interface {
 	RerunOfName() string
 	RerunNumber() int
 
+	PartialRerunOf() int
+	PartialRerunOfName() string
+	RerunFromStep() string
+
 	Reload() (bool, error)
 
 	ResourcesChecked() (bool, error)
@@ -140,11 +148,17 @@ type Build interface {
 	SetInterceptible(bool) error
 
 	Events(uint) (EventSource, error)
+	FilteredEvents(uint, EventsFilter) (EventSource, error)
 	SaveEvent(event atc.Event) error
 
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
+	SaveStepResult(planID atc.PlanID, key string, result atc.StepResult) error
+	StepResults() (map[string]atc.StepResult, error)
+
+	DurationEstimate() (DurationEstimate, bool, error)
+
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
@@ -184,6 +198,10 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	partialRerunOf     int
+	partialRerunOfName string
+	rerunFromStep      string
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -245,6 +263,10 @@ func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
 
+func (b *build) PartialRerunOf() int        { return b.partialRerunOf }
+func (b *build) PartialRerunOfName() string { return b.partialRerunOfName }
+func (b *build) RerunFromStep() string      { return b.rerunFromStep }
+
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
 		RunWith(b.conn).
@@ -435,6 +457,11 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	err = releaseBuildLockPools(tx, b.id)
+	if err != nil {
+		return err
+	}
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = tx.Exec(`WITH caches AS (
 			SELECT resource_cache_id, build_id
@@ -849,6 +876,19 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 }
 
 func (b *build) Events(from uint) (EventSource, error) {
+	return b.FilteredEvents(from, EventsFilter{})
+}
+
+// FilteredEvents streams the events matching the filter. The position from
+// which to start, like the ids of the events, counts only matching events.
+func (b *build) FilteredEvents(from uint, filter EventsFilter) (EventSource, error) {
+	table := b.eventsTable()
+
+	after, err := b.eventIDBefore(table, from, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
 		return true, nil
 	})
@@ -856,20 +896,73 @@ func (b *build) Events(from uint) (EventSource, error) {
 		return nil, err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
 	return newBuildEventSource(
 		b.id,
 		table,
 		b.conn,
 		notifier,
-		from,
+		after,
+		filter,
 	), nil
 }
 
+// eventIDBefore finds the id of the event after which a stream starting at
+// the given position among the matching events begins, or -1 if it begins
+// with the first event. Tailed streams starting at the beginning instead
+// begin at the last filter.Tail matching events.
+func (b *build) eventIDBefore(table string, from uint, filter EventsFilter) (int64, error) {
+	order, offset := "ASC", int(from)-1
+	if filter.Tail > 0 && from == 0 {
+		order, offset = "DESC", filter.Tail
+	} else if from == 0 {
+		return -1, nil
+	}
+
+	conds, args := filter.conditions(3)
+
+	var eventID int64
+	err := b.conn.QueryRow(`
+		SELECT event_id
+		FROM `+table+`
+		WHERE build_id = $1`+conds+`
+		ORDER BY event_id `+order+`
+		OFFSET $2
+		LIMIT 1`,
+		append([]interface{}{b.id, offset}, args...)...,
+	).Scan(&eventID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return 0, err
+		}
+
+		if order == "DESC" {
+			// there are fewer matching events than the tail
+			return -1, nil
+		}
+
+		// the position is past the end, so start with the next event
+		err = b.conn.QueryRow(`
+			SELECT COALESCE(MAX(event_id), -1)
+			FROM `+table+`
+			WHERE build_id = $1`,
+			b.id,
+		).Scan(&eventID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return eventID, nil
+}
+
+func (b *build) eventsTable() string {
+	if b.pipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+	}
+
+	return fmt.Sprintf("team_build_events_%d", b.teamID)
+}
+
 func (b *build) SaveEvent(event atc.Event) error {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -941,6 +1034,70 @@ func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	return artifacts, nil
 }
 
+func (b *build) SaveStepResult(planID atc.PlanID, key string, result atc.StepResult) error {
+	payload, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_step_results").
+		Columns("build_id", "plan_id", "key", "result").
+		Values(b.id, string(planID), key, payload).
+		Suffix("ON CONFLICT (build_id, plan_id) DO UPDATE SET key = EXCLUDED.key, result = EXCLUDED.result").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// StepResults returns the recorded step results by key. Keys which more than
+// one step of the build recorded a result under are left out, as it is not
+// known which step of a later build they would belong to.
+func (b *build) StepResults() (map[string]atc.StepResult, error) {
+	rows, err := psql.Select("key", "result").
+		From("build_step_results").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	results := map[string]atc.StepResult{}
+	ambiguous := map[string]bool{}
+	for rows.Next() {
+		var (
+			key     string
+			payload []byte
+		)
+
+		err = rows.Scan(&key, &payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := results[key]; found {
+			ambiguous[key] = true
+			continue
+		}
+
+		var result atc.StepResult
+		err = json.Unmarshal(payload, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		results[key] = result
+	}
+
+	for key := range ambiguous {
+		delete(results, key)
+	}
+
+	return results, nil
+}
+
 func (b *build) SaveOutput(
 	resourceType string,
 	source atc.Source,
@@ -1492,10 +1649,10 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber, partialRerunOf             sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
-		nonce, spanContext                                                  sql.NullString
+		nonce, spanContext, partialRerunOfName, rerunFromStep               sql.NullString
 		drained, aborted, completed                                         bool
 		status                                                              string
 	)
@@ -1528,6 +1685,9 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&partialRerunOf,
+		&partialRerunOfName,
+		&rerunFromStep,
 	)
 	if err != nil {
 		return err
@@ -1549,6 +1709,9 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.partialRerunOf = int(partialRerunOf.Int64)
+	b.partialRerunOfName = partialRerunOfName.String
+	b.rerunFromStep = rerunFromStep.String
 
 	var (
 		noncense      *string
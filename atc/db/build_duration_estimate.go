@@ -0,0 +1,213 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+// durationEstimateBuilds is the number of recent succeeded builds of a job
+// from which durations are estimated.
+const durationEstimateBuilds = 10
+
+// DurationEstimate is how long a build, and each of its steps, usually takes,
+// based on the median durations of recent succeeded builds of its job.
+type DurationEstimate struct {
+	Build time.Duration
+
+	// Steps is keyed by the step keys returned by atc.PublicPlanSteps.
+	Steps map[string]time.Duration
+}
+
+// stepTimingEvents are the events which mark the beginning and end of a step.
+var stepTimingEvents = []string{
+	string(event.EventTypeInitialize),
+	string(event.EventTypeInitializeGet),
+	string(event.EventTypeInitializePut),
+	string(event.EventTypeInitializeTask),
+	string(event.EventTypeFinish),
+	string(event.EventTypeFinishGet),
+	string(event.EventTypeFinishPut),
+	string(event.EventTypeFinishTask),
+}
+
+// DurationEstimate estimates the duration of the build from the recent
+// succeeded builds of its job. It is not found for one-off builds, or if the
+// job has no succeeded builds.
+func (b *build) DurationEstimate() (DurationEstimate, bool, error) {
+	if b.jobID == 0 {
+		return DurationEstimate{}, false, nil
+	}
+
+	rows, err := psql.Select("id", "start_time", "end_time", "public_plan").
+		From("builds").
+		Where(sq.Eq{
+			"job_id": b.jobID,
+			"status": BuildStatusSucceeded,
+		}).
+		Where(sq.NotEq{
+			"start_time": nil,
+			"end_time":   nil,
+		}).
+		OrderBy("id DESC").
+		Limit(durationEstimateBuilds).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return DurationEstimate{}, false, err
+	}
+
+	defer Close(rows)
+
+	var (
+		buildIDs       []int
+		buildDurations []time.Duration
+	)
+
+	stepKeys := map[int]map[atc.PlanID]string{}
+	for rows.Next() {
+		var (
+			id                 int
+			startTime, endTime time.Time
+			publicPlan         sql.NullString
+		)
+
+		err = rows.Scan(&id, &startTime, &endTime, &publicPlan)
+		if err != nil {
+			return DurationEstimate{}, false, err
+		}
+
+		buildIDs = append(buildIDs, id)
+		buildDurations = append(buildDurations, endTime.Sub(startTime))
+
+		if publicPlan.Valid {
+			stepKeys[id], err = atc.PublicPlanSteps(json.RawMessage(publicPlan.String))
+			if err != nil {
+				return DurationEstimate{}, false, err
+			}
+		}
+	}
+
+	if len(buildIDs) == 0 {
+		return DurationEstimate{}, false, nil
+	}
+
+	stepDurations, err := b.stepDurations(buildIDs, stepKeys)
+	if err != nil {
+		return DurationEstimate{}, false, err
+	}
+
+	estimate := DurationEstimate{
+		Build: medianDuration(buildDurations),
+		Steps: map[string]time.Duration{},
+	}
+
+	for key, durations := range stepDurations {
+		estimate.Steps[key] = medianDuration(durations)
+	}
+
+	return estimate, true, nil
+}
+
+// stepDurations collects the durations of each step of the given builds, from
+// the first event which initializes the step to the last event which
+// finishes it.
+func (b *build) stepDurations(buildIDs []int, stepKeys map[int]map[atc.PlanID]string) (map[string][]time.Duration, error) {
+	rows, err := psql.Select("build_id", "payload").
+		From(fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)).
+		Where(sq.Expr("build_id = ANY(?)", pq.Array(buildIDs))).
+		Where(sq.Eq{"type": stepTimingEvents}).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	type stepTiming struct {
+		first, last int64
+	}
+
+	timings := map[int]map[atc.PlanID]*stepTiming{}
+	for rows.Next() {
+		var (
+			buildID int
+			payload []byte
+		)
+
+		err = rows.Scan(&buildID, &payload)
+		if err != nil {
+			return nil, err
+		}
+
+		var ev struct {
+			Origin event.Origin `json:"origin"`
+			Time   int64        `json:"time"`
+		}
+
+		err = json.Unmarshal(payload, &ev)
+		if err != nil {
+			return nil, err
+		}
+
+		if ev.Origin.ID == "" || ev.Time == 0 {
+			continue
+		}
+
+		if timings[buildID] == nil {
+			timings[buildID] = map[atc.PlanID]*stepTiming{}
+		}
+
+		planID := atc.PlanID(ev.Origin.ID)
+
+		timing, found := timings[buildID][planID]
+		if !found {
+			timings[buildID][planID] = &stepTiming{first: ev.Time, last: ev.Time}
+			continue
+		}
+
+		if ev.Time < timing.first {
+			timing.first = ev.Time
+		}
+
+		if ev.Time > timing.last {
+			timing.last = ev.Time
+		}
+	}
+
+	durations := map[string][]time.Duration{}
+	for buildID, steps := range timings {
+		for planID, timing := range steps {
+			key, found := stepKeys[buildID][planID]
+			if !found {
+				continue
+			}
+
+			durations[key] = append(durations[key], time.Duration(timing.last-timing.first)*time.Second)
+		}
+	}
+
+	return durations, nil
+}
+
+func medianDuration(durations []time.Duration) time.Duration {
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+
+	return sorted[middle]
+}
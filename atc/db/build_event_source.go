@@ -3,15 +3,65 @@ package db
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
 )
 
 var ErrEndOfBuildEventStream = errors.New("end of build event stream")
 var ErrBuildEventStreamClosed = errors.New("build event stream closed")
 
+// EventsFilter narrows down the events of a build. Events which do not
+// have an origin or a time, such as the build's status, always match.
+type EventsFilter struct {
+	Origins []string
+	Since   time.Time
+	Until   time.Time
+
+	// Tail starts the stream at the last Tail matching events.
+	Tail int
+}
+
+func (filter EventsFilter) IsZero() bool {
+	return len(filter.Origins) == 0 &&
+		filter.Since.IsZero() &&
+		filter.Until.IsZero() &&
+		filter.Tail == 0
+}
+
+func (filter EventsFilter) conditions(nextArg int) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+
+	if len(filter.Origins) > 0 {
+		conds = append(conds, fmt.Sprintf("(payload::jsonb->'origin' IS NULL OR payload::jsonb->'origin'->>'id' = ANY($%d))", nextArg))
+		args = append(args, pq.Array(filter.Origins))
+		nextArg++
+	}
+
+	if !filter.Since.IsZero() {
+		conds = append(conds, fmt.Sprintf("(payload::jsonb->'time' IS NULL OR (payload::jsonb->>'time')::bigint >= $%d)", nextArg))
+		args = append(args, filter.Since.Unix())
+		nextArg++
+	}
+
+	if !filter.Until.IsZero() {
+		conds = append(conds, fmt.Sprintf("(payload::jsonb->'time' IS NULL OR (payload::jsonb->>'time')::bigint <= $%d)", nextArg))
+		args = append(args, filter.Until.Unix())
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+
+	return " AND " + strings.Join(conds, " AND "), args
+}
+
 //go:generate counterfeiter . EventSource
 
 type EventSource interface {
@@ -24,7 +74,8 @@ func newBuildEventSource(
 	table string,
 	conn Conn,
 	notifier Notifier,
-	from uint,
+	after int64,
+	filter EventsFilter,
 ) *buildEventSource {
 	wg := new(sync.WaitGroup)
 
@@ -35,6 +86,7 @@ func newBuildEventSource(
 		conn: conn,
 
 		notifier: notifier,
+		filter:   filter,
 
 		events: make(chan event.Envelope, 2000),
 		stop:   make(chan struct{}),
@@ -42,7 +94,7 @@ func newBuildEventSource(
 	}
 
 	wg.Add(1)
-	go source.collectEvents(from)
+	go source.collectEvents(after)
 
 	return source
 }
@@ -53,6 +105,7 @@ type buildEventSource struct {
 
 	conn     Conn
 	notifier Notifier
+	filter   EventsFilter
 
 	events chan event.Envelope
 	stop   chan struct{}
@@ -82,7 +135,9 @@ func (source *buildEventSource) Close() error {
 	return source.notifier.Close()
 }
 
-func (source *buildEventSource) collectEvents(cursor uint) {
+// collectEvents pages through the events with ids after the given one,
+// remembering the id of the last event it has seen.
+func (source *buildEventSource) collectEvents(after int64) {
 	defer source.wg.Done()
 
 	var batchSize = cap(source.events)
@@ -116,14 +171,16 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 			return
 		}
 
+		conds, args := source.filter.conditions(4)
+
 		rows, err := tx.Query(`
-			SELECT type, version, payload
+			SELECT event_id, type, version, payload
 			FROM `+source.table+`
 			WHERE build_id = $1
+			AND event_id > $2`+conds+`
 			ORDER BY event_id ASC
-			OFFSET $2
 			LIMIT $3
-		`, source.buildID, cursor, batchSize)
+		`, append([]interface{}{source.buildID, after, batchSize}, args...)...)
 		if err != nil {
 			source.err = err
 			close(source.events)
@@ -135,10 +192,8 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		for rows.Next() {
 			rowsReturned++
 
-			cursor++
-
 			var t, v, p string
-			err := rows.Scan(&t, &v, &p)
+			err := rows.Scan(&after, &t, &v, &p)
 			if err != nil {
 				_ = rows.Close()
 
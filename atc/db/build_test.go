@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -643,6 +644,105 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("FilteredEvents", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for i, origin := range []event.OriginID{"a", "b", "a", "b", "a"} {
+				err = build.SaveEvent(event.Log{
+					Time:    int64(100 + i),
+					Origin:  event.Origin{ID: origin},
+					Payload: fmt.Sprintf("%s-%d", origin, i),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		payloads := func(events db.EventSource) []string {
+			var logs []string
+			for {
+				ev, err := events.Next()
+				if err != nil {
+					Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+					return logs
+				}
+
+				if ev.Event != event.EventTypeLog {
+					continue
+				}
+
+				var log event.Log
+				Expect(json.Unmarshal(*ev.Data, &log)).To(Succeed())
+				logs = append(logs, log.Payload)
+			}
+		}
+
+		It("returns only events from the given origins, and events without an origin", func() {
+			events, err := build.FilteredEvents(0, db.EventsFilter{Origins: []string{"a"}})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			Expect(payloads(events)).To(Equal([]string{"a-0", "a-2", "a-4"}))
+		})
+
+		It("returns only events within the time range", func() {
+			events, err := build.FilteredEvents(0, db.EventsFilter{
+				Since: time.Unix(101, 0),
+				Until: time.Unix(103, 0),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			Expect(payloads(events)).To(Equal([]string{"b-1", "a-2", "b-3"}))
+		})
+
+		It("counts the offset within the matching events", func() {
+			events, err := build.FilteredEvents(1, db.EventsFilter{Origins: []string{"b"}})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			Expect(payloads(events)).To(Equal([]string{"b-3"}))
+		})
+
+		It("starts at the last matching events when tailing", func() {
+			events, err := build.FilteredEvents(0, db.EventsFilter{
+				Origins: []string{"a"},
+				Tail:    3,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			// the finishing status event is the last of the three
+			Expect(payloads(events)).To(Equal([]string{"a-2", "a-4"}))
+		})
+
+		It("starts at the first matching event when tailing more events than match", func() {
+			events, err := build.FilteredEvents(0, db.EventsFilter{
+				Origins: []string{"b"},
+				Tail:    100,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			Expect(payloads(events)).To(Equal([]string{"b-1", "b-3"}))
+		})
+
+		It("returns no earlier events when starting past the matching events", func() {
+			events, err := build.FilteredEvents(100, db.EventsFilter{Origins: []string{"b"}})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(events)
+
+			Expect(payloads(events)).To(BeEmpty())
+		})
+	})
+
 	Describe("SaveEvent", func() {
 		It("saves and propagates events correctly", func() {
 			build, err := team.CreateOneOffBuild()
@@ -722,6 +822,139 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SaveStepResult", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the saved results by key", func() {
+			err := build.SaveStepResult("1", "get:some-input", atc.StepResult{
+				Outputs: map[string]string{"some-input": "some-handle"},
+				Version: atc.Version{"some": "version"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStepResult("2", "task:some-task", atc.StepResult{})
+			Expect(err).NotTo(HaveOccurred())
+
+			results, err := build.StepResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal(map[string]atc.StepResult{
+				"get:some-input": {
+					Outputs: map[string]string{"some-input": "some-handle"},
+					Version: atc.Version{"some": "version"},
+				},
+				"task:some-task": {},
+			}))
+		})
+
+		It("overwrites the result of the same step", func() {
+			err := build.SaveStepResult("1", "get:some-input", atc.StepResult{Version: atc.Version{"some": "version"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStepResult("1", "get:some-input", atc.StepResult{Version: atc.Version{"some": "other-version"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			results, err := build.StepResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveKeyWithValue("get:some-input", atc.StepResult{Version: atc.Version{"some": "other-version"}}))
+		})
+
+		It("leaves out keys shared by more than one step", func() {
+			err := build.SaveStepResult("1", "task:some-task", atc.StepResult{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStepResult("2", "task:some-task", atc.StepResult{})
+			Expect(err).NotTo(HaveOccurred())
+
+			results, err := build.StepResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+	})
+
+	Describe("DurationEstimate", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			start := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+			buildDurations := []int{60, 120, 90}
+			taskDurations := []int64{30, 50, 40}
+
+			for i := range buildDurations {
+				previous, err := defaultJob.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				planID := atc.PlanID(fmt.Sprintf("%d", i+1))
+
+				started, err := previous.Start(atc.Plan{
+					ID:   planID,
+					Task: &atc.TaskPlan{Name: "unit"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				taskStart := start.Unix() + 10
+				err = previous.SaveEvent(event.InitializeTask{
+					Origin: event.Origin{ID: event.OriginID(planID)},
+					Time:   taskStart,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = previous.SaveEvent(event.FinishTask{
+					Origin: event.Origin{ID: event.OriginID(planID)},
+					Time:   taskStart + taskDurations[i],
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = previous.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = dbConn.Exec(`UPDATE builds SET start_time = $2, end_time = $3 WHERE id = $1`,
+					previous.ID(),
+					start,
+					start.Add(time.Duration(buildDurations[i])*time.Second),
+				)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			failed, err := defaultJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = failed.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+
+			build, err = defaultJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the median durations of the job's succeeded builds and their steps", func() {
+			estimate, found, err := build.DurationEstimate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(estimate).To(Equal(db.DurationEstimate{
+				Build: 90 * time.Second,
+				Steps: map[string]time.Duration{
+					"task:unit": 40 * time.Second,
+				},
+			}))
+		})
+
+		It("is not found for one-off builds", func() {
+			oneOff, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := oneOff.DurationEstimate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Describe("SaveOutput", func() {
 		var pipeline db.Pipeline
 		var job db.Job
@@ -2024,11 +2257,11 @@ var _ = Describe("Build", func() {
 				err = otherResourceConfigScope.SaveVersions(nil, []atc.Version{atc.Version{"version": "v1"}})
 				Expect(err).ToNot(HaveOccurred())
 
-				versions, _, found, err = resource.Versions(db.Page{Limit: 3}, nil)
+				versions, _, found, err = resource.Versions(db.Page{Limit: 3}, nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				otherVersions, _, found, err = otherResource.Versions(db.Page{Limit: 3}, nil)
+				otherVersions, _, found, err = otherResource.Versions(db.Page{Limit: 3}, nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
@@ -2287,11 +2520,11 @@ var _ = Describe("Build", func() {
 				err = otherResourceConfigScope.SaveVersions(nil, []atc.Version{atc.Version{"version": "v1"}})
 				Expect(err).ToNot(HaveOccurred())
 
-				versions, _, found, err = resource.Versions(db.Page{Limit: 3}, nil)
+				versions, _, found, err = resource.Versions(db.Page{Limit: 3}, nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				otherVersions, _, found, err = otherResource.Versions(db.Page{Limit: 3}, nil)
+				otherVersions, _, found, err = otherResource.Versions(db.Page{Limit: 3}, nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
@@ -27,6 +27,7 @@ type Checkable interface {
 	Type() string
 	Source() atc.Source
 	Tags() atc.Tags
+	WorkerSelector() *atc.WorkerSelector
 	CheckEvery() string
 	CheckTimeout() string
 	LastCheckEndTime() time.Time
@@ -200,12 +201,13 @@ func (c *checkFactory) TryCreateCheck(ctx context.Context, checkable Checkable,
 
 	plan := atc.Plan{
 		Check: &atc.CheckPlan{
-			Name:        checkable.Name(),
-			Type:        checkable.Type(),
-			Source:      checkable.Source(),
-			Tags:        checkable.Tags(),
-			Timeout:     timeout.String(),
-			FromVersion: fromVersion,
+			Name:           checkable.Name(),
+			Type:           checkable.Type(),
+			Source:         checkable.Source(),
+			Tags:           checkable.Tags(),
+			WorkerSelector: checkable.WorkerSelector(),
+			Timeout:        timeout.String(),
+			FromVersion:    fromVersion,
 
 			VersionedResourceTypes: filteredTypes,
 		},
@@ -8,7 +8,7 @@ import (
 	"github.com/lib/pq"
 )
 
-var componentsQuery = psql.Select("c.id, c.name, c.interval, c.last_ran, c.paused").
+var componentsQuery = psql.Select("c.id, c.name, c.interval, c.last_ran, c.paused, c.last_duration, c.last_error, c.last_ran_by").
 	From("components c")
 
 //go:generate counterfeiter . Component
@@ -20,9 +20,17 @@ type Component interface {
 	LastRan() time.Time
 	Paused() bool
 
+	LastDuration() time.Duration
+	LastError() string
+	LastRanBy() string
+
 	Reload() (bool, error)
 	IntervalElapsed() bool
 	UpdateLastRan() error
+	RecordRun(duration time.Duration, runErr error) error
+
+	Pause() error
+	Unpause() error
 }
 
 type component struct {
@@ -32,6 +40,12 @@ type component struct {
 	lastRan  time.Time
 	paused   bool
 
+	lastDuration time.Duration
+	lastError    string
+	lastRanBy    string
+
+	atcName string
+
 	conn Conn
 }
 
@@ -41,6 +55,10 @@ func (c *component) Interval() time.Duration { return c.interval }
 func (c *component) LastRan() time.Time      { return c.lastRan }
 func (c *component) Paused() bool            { return c.paused }
 
+func (c *component) LastDuration() time.Duration { return c.lastDuration }
+func (c *component) LastError() string           { return c.lastError }
+func (c *component) LastRanBy() string           { return c.lastRanBy }
+
 func (c *component) Reload() (bool, error) {
 	row := componentsQuery.Where(sq.Eq{"c.id": c.id}).
 		RunWith(c.conn).
@@ -76,10 +94,62 @@ func (c *component) UpdateLastRan() error {
 	return nil
 }
 
+// RecordRun records how long the component's most recent run took, how it
+// failed, if it did, and which ATC ran it.
+func (c *component) RecordRun(duration time.Duration, runErr error) error {
+	var lastError sql.NullString
+	if runErr != nil {
+		lastError = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+
+	_, err := psql.Update("components").
+		Set("last_duration", duration.String()).
+		Set("last_error", lastError).
+		Set("last_ran_by", c.atcName).
+		Where(sq.Eq{
+			"id": c.id,
+		}).
+		RunWith(c.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *component) Pause() error {
+	return c.setPaused(true)
+}
+
+func (c *component) Unpause() error {
+	return c.setPaused(false)
+}
+
+func (c *component) setPaused(paused bool) error {
+	_, err := psql.Update("components").
+		Set("paused", paused).
+		Where(sq.Eq{
+			"id": c.id,
+		}).
+		RunWith(c.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	c.paused = paused
+
+	return nil
+}
+
 func scanComponent(c *component, row scannable) error {
 	var (
-		lastRan  pq.NullTime
-		interval string
+		lastRan      pq.NullTime
+		interval     string
+		lastDuration sql.NullString
+		lastError    sql.NullString
+		lastRanBy    sql.NullString
 	)
 
 	err := row.Scan(
@@ -88,17 +158,30 @@ func scanComponent(c *component, row scannable) error {
 		&interval,
 		&lastRan,
 		&c.paused,
+		&lastDuration,
+		&lastError,
+		&lastRanBy,
 	)
 	if err != nil {
 		return err
 	}
 
 	c.lastRan = lastRan.Time
+	c.lastError = lastError.String
+	c.lastRanBy = lastRanBy.String
 
 	c.interval, err = time.ParseDuration(interval)
 	if err != nil {
 		return err
 	}
 
+	c.lastDuration = 0
+	if lastDuration.Valid {
+		c.lastDuration, err = time.ParseDuration(lastDuration.String)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
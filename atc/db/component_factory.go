@@ -12,21 +12,27 @@ import (
 type ComponentFactory interface {
 	CreateOrUpdate(atc.Component) (Component, error)
 	Find(string) (Component, bool, error)
+	All() ([]Component, error)
 }
 
 type componentFactory struct {
-	conn Conn
+	conn    Conn
+	atcName string
 }
 
-func NewComponentFactory(conn Conn) ComponentFactory {
+// NewComponentFactory returns a factory whose components record atcName as
+// the ATC which ran them.
+func NewComponentFactory(conn Conn, atcName string) ComponentFactory {
 	return &componentFactory{
-		conn: conn,
+		conn:    conn,
+		atcName: atcName,
 	}
 }
 
 func (f *componentFactory) Find(componentName string) (Component, bool, error) {
 	component := &component{
-		conn: f.conn,
+		atcName: f.atcName,
+		conn:    f.conn,
 	}
 
 	row := componentsQuery.
@@ -45,6 +51,35 @@ func (f *componentFactory) Find(componentName string) (Component, bool, error) {
 	return component, true, nil
 }
 
+func (f *componentFactory) All() ([]Component, error) {
+	rows, err := componentsQuery.
+		OrderBy("c.name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var components []Component
+	for rows.Next() {
+		component := &component{
+			atcName: f.atcName,
+			conn:    f.conn,
+		}
+
+		err = scanComponent(component, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, component)
+	}
+
+	return components, rows.Err()
+}
+
 func (f *componentFactory) CreateOrUpdate(c atc.Component) (Component, error) {
 	tx, err := f.conn.Begin()
 	if err != nil {
@@ -54,7 +89,8 @@ func (f *componentFactory) CreateOrUpdate(c atc.Component) (Component, error) {
 	defer Rollback(tx)
 
 	obj := &component{
-		conn: f.conn,
+		atcName: f.atcName,
+		conn:    f.conn,
 	}
 
 	row := psql.Insert("components").
@@ -62,7 +98,7 @@ func (f *componentFactory) CreateOrUpdate(c atc.Component) (Component, error) {
 		Values(c.Name, c.Interval.String()).
 		Suffix(`
 			ON CONFLICT (name) DO UPDATE SET interval=EXCLUDED.interval
-			RETURNING id, name, interval, last_ran, paused
+			RETURNING id, name, interval, last_ran, paused, last_duration, last_error, last_ran_by
 		`).
 		RunWith(tx).
 		QueryRow()
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"sort"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -67,4 +68,28 @@ var _ = Describe("ComponentFactory", func() {
 			Expect(foundComponent.Interval()).To(Equal(interval + 1))
 		})
 	})
+
+	Describe("All", func() {
+		BeforeEach(func() {
+			_, err := componentFactory.CreateOrUpdate(atc.Component{Name: "some-component", Interval: time.Second})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = componentFactory.CreateOrUpdate(atc.Component{Name: "another-component", Interval: time.Minute})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns every component ordered by name", func() {
+			components, err := componentFactory.All()
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, component := range components {
+				names = append(names, component.Name())
+			}
+
+			Expect(names).To(ContainElement("some-component"))
+			Expect(names).To(ContainElement("another-component"))
+			Expect(sort.StringsAreSorted(names)).To(BeTrue())
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"errors"
 	"time"
 
 	"github.com/concourse/concourse/atc/db"
@@ -73,4 +74,63 @@ var _ = Describe("Component", func() {
 			Expect(lastRan).To(BeTemporally("~", time.Now(), time.Second))
 		})
 	})
+
+	Describe("RecordRun", func() {
+		Context("when the run succeeded", func() {
+			BeforeEach(func() {
+				err = component.RecordRun(1500*time.Millisecond, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("records the duration and the ATC which ran it", func() {
+				Expect(component.LastDuration()).To(Equal(1500 * time.Millisecond))
+				Expect(component.LastError()).To(BeEmpty())
+				Expect(component.LastRanBy()).To(Equal("some-atc"))
+			})
+		})
+
+		Context("when the run failed", func() {
+			BeforeEach(func() {
+				err = component.RecordRun(time.Second, errors.New("disaster"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("records the error", func() {
+				Expect(component.LastError()).To(Equal("disaster"))
+			})
+
+			Context("and a later run succeeds", func() {
+				BeforeEach(func() {
+					err = component.RecordRun(time.Second, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("clears the error", func() {
+					Expect(component.LastError()).To(BeEmpty())
+				})
+			})
+		})
+	})
+
+	Describe("Pause and Unpause", func() {
+		BeforeEach(func() {
+			err = component.Pause()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("pauses the component", func() {
+			Expect(component.Paused()).To(BeTrue())
+		})
+
+		Context("when unpaused", func() {
+			BeforeEach(func() {
+				err = component.Unpause()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("unpauses the component", func() {
+				Expect(component.Paused()).To(BeFalse())
+			})
+		})
+	})
 })
@@ -44,11 +44,16 @@ var (
 	resourceConfigFactory               db.ResourceConfigFactory
 	resourceCacheFactory                db.ResourceCacheFactory
 	taskCacheFactory                    db.TaskCacheFactory
+	taskResultCacheFactory              db.TaskResultCacheFactory
+	hijackSessionFactory                db.HijackSessionFactory
 	checkFactory                        db.CheckFactory
 	workerBaseResourceTypeFactory       db.WorkerBaseResourceTypeFactory
 	workerTaskCacheFactory              db.WorkerTaskCacheFactory
 	userFactory                         db.UserFactory
 	dbWall                              db.Wall
+	dbMaintenance                       db.Maintenance
+	lockPoolFactory                     db.LockPoolFactory
+	teamQuotaFactory                    db.TeamQuotaFactory
 	fakeClock                           dbfakes.FakeClock
 
 	defaultWorkerResourceType atc.WorkerResourceType
@@ -104,7 +109,7 @@ var _ = BeforeEach(func() {
 
 	fakeSecrets = new(credsfakes.FakeSecrets)
 	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
-	componentFactory = db.NewComponentFactory(dbConn)
+	componentFactory = db.NewComponentFactory(dbConn, "some-atc")
 	buildFactory = db.NewBuildFactory(dbConn, lockFactory, 5*time.Minute, 5*time.Minute)
 	volumeRepository = db.NewVolumeRepository(dbConn)
 	containerRepository = db.NewContainerRepository(dbConn)
@@ -115,11 +120,16 @@ var _ = BeforeEach(func() {
 	resourceConfigFactory = db.NewResourceConfigFactory(dbConn, lockFactory)
 	resourceCacheFactory = db.NewResourceCacheFactory(dbConn, lockFactory)
 	taskCacheFactory = db.NewTaskCacheFactory(dbConn)
+	taskResultCacheFactory = db.NewTaskResultCacheFactory(dbConn)
+	hijackSessionFactory = db.NewHijackSessionFactory(dbConn)
 	checkFactory = db.NewCheckFactory(dbConn, lockFactory, fakeSecrets, fakeVarSourcePool, time.Minute)
 	workerBaseResourceTypeFactory = db.NewWorkerBaseResourceTypeFactory(dbConn)
 	workerTaskCacheFactory = db.NewWorkerTaskCacheFactory(dbConn)
 	userFactory = db.NewUserFactory(dbConn)
 	dbWall = db.NewWall(dbConn, &fakeClock)
+	dbMaintenance = db.NewMaintenance(dbConn)
+	lockPoolFactory = db.NewLockPoolFactory(dbConn)
+	teamQuotaFactory = db.NewTeamQuotaFactory(dbConn)
 
 	var err error
 	defaultTeam, err = teamFactory.CreateTeam(atc.Team{Name: "default-team"})
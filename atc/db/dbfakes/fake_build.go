@@ -107,6 +107,20 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	DurationEstimateStub        func() (db.DurationEstimate, bool, error)
+	durationEstimateMutex       sync.RWMutex
+	durationEstimateArgsForCall []struct {
+	}
+	durationEstimateReturns struct {
+		result1 db.DurationEstimate
+		result2 bool
+		result3 error
+	}
+	durationEstimateReturnsOnCall map[int]struct {
+		result1 db.DurationEstimate
+		result2 bool
+		result3 error
+	}
 	EndTimeStub        func() time.Time
 	endTimeMutex       sync.RWMutex
 	endTimeArgsForCall []struct {
@@ -130,6 +144,20 @@ type FakeBuild struct {
 		result1 db.EventSource
 		result2 error
 	}
+	FilteredEventsStub        func(uint, db.EventsFilter) (db.EventSource, error)
+	filteredEventsMutex       sync.RWMutex
+	filteredEventsArgsForCall []struct {
+		arg1 uint
+		arg2 db.EventsFilter
+	}
+	filteredEventsReturns struct {
+		result1 db.EventSource
+		result2 error
+	}
+	filteredEventsReturnsOnCall map[int]struct {
+		result1 db.EventSource
+		result2 error
+	}
 	FinishStub        func(db.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -294,6 +322,26 @@ type FakeBuild struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PartialRerunOfStub        func() int
+	partialRerunOfMutex       sync.RWMutex
+	partialRerunOfArgsForCall []struct {
+	}
+	partialRerunOfReturns struct {
+		result1 int
+	}
+	partialRerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PartialRerunOfNameStub        func() string
+	partialRerunOfNameMutex       sync.RWMutex
+	partialRerunOfNameArgsForCall []struct {
+	}
+	partialRerunOfNameReturns struct {
+		result1 string
+	}
+	partialRerunOfNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -384,6 +432,16 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunFromStepStub        func() string
+	rerunFromStepMutex       sync.RWMutex
+	rerunFromStepArgsForCall []struct {
+	}
+	rerunFromStepReturns struct {
+		result1 string
+	}
+	rerunFromStepReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -479,6 +537,19 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveStepResultStub        func(atc.PlanID, string, atc.StepResult) error
+	saveStepResultMutex       sync.RWMutex
+	saveStepResultArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.StepResult
+	}
+	saveStepResultReturns struct {
+		result1 error
+	}
+	saveStepResultReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -554,6 +625,18 @@ type FakeBuild struct {
 	statusReturnsOnCall map[int]struct {
 		result1 db.BuildStatus
 	}
+	StepResultsStub        func() (map[string]atc.StepResult, error)
+	stepResultsMutex       sync.RWMutex
+	stepResultsArgsForCall []struct {
+	}
+	stepResultsReturns struct {
+		result1 map[string]atc.StepResult
+		result2 error
+	}
+	stepResultsReturnsOnCall map[int]struct {
+		result1 map[string]atc.StepResult
+		result2 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -989,6 +1072,64 @@ func (fake *FakeBuild) DeleteReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) DurationEstimate() (db.DurationEstimate, bool, error) {
+	fake.durationEstimateMutex.Lock()
+	ret, specificReturn := fake.durationEstimateReturnsOnCall[len(fake.durationEstimateArgsForCall)]
+	fake.durationEstimateArgsForCall = append(fake.durationEstimateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DurationEstimate", []interface{}{})
+	fake.durationEstimateMutex.Unlock()
+	if fake.DurationEstimateStub != nil {
+		return fake.DurationEstimateStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.durationEstimateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) DurationEstimateCallCount() int {
+	fake.durationEstimateMutex.RLock()
+	defer fake.durationEstimateMutex.RUnlock()
+	return len(fake.durationEstimateArgsForCall)
+}
+
+func (fake *FakeBuild) DurationEstimateCalls(stub func() (db.DurationEstimate, bool, error)) {
+	fake.durationEstimateMutex.Lock()
+	defer fake.durationEstimateMutex.Unlock()
+	fake.DurationEstimateStub = stub
+}
+
+func (fake *FakeBuild) DurationEstimateReturns(result1 db.DurationEstimate, result2 bool, result3 error) {
+	fake.durationEstimateMutex.Lock()
+	defer fake.durationEstimateMutex.Unlock()
+	fake.DurationEstimateStub = nil
+	fake.durationEstimateReturns = struct {
+		result1 db.DurationEstimate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) DurationEstimateReturnsOnCall(i int, result1 db.DurationEstimate, result2 bool, result3 error) {
+	fake.durationEstimateMutex.Lock()
+	defer fake.durationEstimateMutex.Unlock()
+	fake.DurationEstimateStub = nil
+	if fake.durationEstimateReturnsOnCall == nil {
+		fake.durationEstimateReturnsOnCall = make(map[int]struct {
+			result1 db.DurationEstimate
+			result2 bool
+			result3 error
+		})
+	}
+	fake.durationEstimateReturnsOnCall[i] = struct {
+		result1 db.DurationEstimate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) EndTime() time.Time {
 	fake.endTimeMutex.Lock()
 	ret, specificReturn := fake.endTimeReturnsOnCall[len(fake.endTimeArgsForCall)]
@@ -1104,6 +1245,70 @@ func (fake *FakeBuild) EventsReturnsOnCall(i int, result1 db.EventSource, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) FilteredEvents(arg1 uint, arg2 db.EventsFilter) (db.EventSource, error) {
+	fake.filteredEventsMutex.Lock()
+	ret, specificReturn := fake.filteredEventsReturnsOnCall[len(fake.filteredEventsArgsForCall)]
+	fake.filteredEventsArgsForCall = append(fake.filteredEventsArgsForCall, struct {
+		arg1 uint
+		arg2 db.EventsFilter
+	}{arg1, arg2})
+	fake.recordInvocation("FilteredEvents", []interface{}{arg1, arg2})
+	fake.filteredEventsMutex.Unlock()
+	if fake.FilteredEventsStub != nil {
+		return fake.FilteredEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.filteredEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) FilteredEventsCallCount() int {
+	fake.filteredEventsMutex.RLock()
+	defer fake.filteredEventsMutex.RUnlock()
+	return len(fake.filteredEventsArgsForCall)
+}
+
+func (fake *FakeBuild) FilteredEventsCalls(stub func(uint, db.EventsFilter) (db.EventSource, error)) {
+	fake.filteredEventsMutex.Lock()
+	defer fake.filteredEventsMutex.Unlock()
+	fake.FilteredEventsStub = stub
+}
+
+func (fake *FakeBuild) FilteredEventsArgsForCall(i int) (uint, db.EventsFilter) {
+	fake.filteredEventsMutex.RLock()
+	defer fake.filteredEventsMutex.RUnlock()
+	argsForCall := fake.filteredEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) FilteredEventsReturns(result1 db.EventSource, result2 error) {
+	fake.filteredEventsMutex.Lock()
+	defer fake.filteredEventsMutex.Unlock()
+	fake.FilteredEventsStub = nil
+	fake.filteredEventsReturns = struct {
+		result1 db.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) FilteredEventsReturnsOnCall(i int, result1 db.EventSource, result2 error) {
+	fake.filteredEventsMutex.Lock()
+	defer fake.filteredEventsMutex.Unlock()
+	fake.FilteredEventsStub = nil
+	if fake.filteredEventsReturnsOnCall == nil {
+		fake.filteredEventsReturnsOnCall = make(map[int]struct {
+			result1 db.EventSource
+			result2 error
+		})
+	}
+	fake.filteredEventsReturnsOnCall[i] = struct {
+		result1 db.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
 	fake.finishMutex.Lock()
 	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
@@ -1955,6 +2160,110 @@ func (fake *FakeBuild) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PartialRerunOf() int {
+	fake.partialRerunOfMutex.Lock()
+	ret, specificReturn := fake.partialRerunOfReturnsOnCall[len(fake.partialRerunOfArgsForCall)]
+	fake.partialRerunOfArgsForCall = append(fake.partialRerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PartialRerunOf", []interface{}{})
+	fake.partialRerunOfMutex.Unlock()
+	if fake.PartialRerunOfStub != nil {
+		return fake.PartialRerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.partialRerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PartialRerunOfCallCount() int {
+	fake.partialRerunOfMutex.RLock()
+	defer fake.partialRerunOfMutex.RUnlock()
+	return len(fake.partialRerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) PartialRerunOfCalls(stub func() int) {
+	fake.partialRerunOfMutex.Lock()
+	defer fake.partialRerunOfMutex.Unlock()
+	fake.PartialRerunOfStub = stub
+}
+
+func (fake *FakeBuild) PartialRerunOfReturns(result1 int) {
+	fake.partialRerunOfMutex.Lock()
+	defer fake.partialRerunOfMutex.Unlock()
+	fake.PartialRerunOfStub = nil
+	fake.partialRerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PartialRerunOfReturnsOnCall(i int, result1 int) {
+	fake.partialRerunOfMutex.Lock()
+	defer fake.partialRerunOfMutex.Unlock()
+	fake.PartialRerunOfStub = nil
+	if fake.partialRerunOfReturnsOnCall == nil {
+		fake.partialRerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.partialRerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PartialRerunOfName() string {
+	fake.partialRerunOfNameMutex.Lock()
+	ret, specificReturn := fake.partialRerunOfNameReturnsOnCall[len(fake.partialRerunOfNameArgsForCall)]
+	fake.partialRerunOfNameArgsForCall = append(fake.partialRerunOfNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PartialRerunOfName", []interface{}{})
+	fake.partialRerunOfNameMutex.Unlock()
+	if fake.PartialRerunOfNameStub != nil {
+		return fake.PartialRerunOfNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.partialRerunOfNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PartialRerunOfNameCallCount() int {
+	fake.partialRerunOfNameMutex.RLock()
+	defer fake.partialRerunOfNameMutex.RUnlock()
+	return len(fake.partialRerunOfNameArgsForCall)
+}
+
+func (fake *FakeBuild) PartialRerunOfNameCalls(stub func() string) {
+	fake.partialRerunOfNameMutex.Lock()
+	defer fake.partialRerunOfNameMutex.Unlock()
+	fake.PartialRerunOfNameStub = stub
+}
+
+func (fake *FakeBuild) PartialRerunOfNameReturns(result1 string) {
+	fake.partialRerunOfNameMutex.Lock()
+	defer fake.partialRerunOfNameMutex.Unlock()
+	fake.PartialRerunOfNameStub = nil
+	fake.partialRerunOfNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) PartialRerunOfNameReturnsOnCall(i int, result1 string) {
+	fake.partialRerunOfNameMutex.Lock()
+	defer fake.partialRerunOfNameMutex.Unlock()
+	fake.PartialRerunOfNameStub = nil
+	if fake.partialRerunOfNameReturnsOnCall == nil {
+		fake.partialRerunOfNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.partialRerunOfNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2386,6 +2695,58 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunFromStep() string {
+	fake.rerunFromStepMutex.Lock()
+	ret, specificReturn := fake.rerunFromStepReturnsOnCall[len(fake.rerunFromStepArgsForCall)]
+	fake.rerunFromStepArgsForCall = append(fake.rerunFromStepArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunFromStep", []interface{}{})
+	fake.rerunFromStepMutex.Unlock()
+	if fake.RerunFromStepStub != nil {
+		return fake.RerunFromStepStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunFromStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunFromStepCallCount() int {
+	fake.rerunFromStepMutex.RLock()
+	defer fake.rerunFromStepMutex.RUnlock()
+	return len(fake.rerunFromStepArgsForCall)
+}
+
+func (fake *FakeBuild) RerunFromStepCalls(stub func() string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = stub
+}
+
+func (fake *FakeBuild) RerunFromStepReturns(result1 string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = nil
+	fake.rerunFromStepReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunFromStepReturnsOnCall(i int, result1 string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = nil
+	if fake.rerunFromStepReturnsOnCall == nil {
+		fake.rerunFromStepReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rerunFromStepReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -2841,6 +3202,68 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveStepResult(arg1 atc.PlanID, arg2 string, arg3 atc.StepResult) error {
+	fake.saveStepResultMutex.Lock()
+	ret, specificReturn := fake.saveStepResultReturnsOnCall[len(fake.saveStepResultArgsForCall)]
+	fake.saveStepResultArgsForCall = append(fake.saveStepResultArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.StepResult
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveStepResult", []interface{}{arg1, arg2, arg3})
+	fake.saveStepResultMutex.Unlock()
+	if fake.SaveStepResultStub != nil {
+		return fake.SaveStepResultStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveStepResultReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveStepResultCallCount() int {
+	fake.saveStepResultMutex.RLock()
+	defer fake.saveStepResultMutex.RUnlock()
+	return len(fake.saveStepResultArgsForCall)
+}
+
+func (fake *FakeBuild) SaveStepResultCalls(stub func(atc.PlanID, string, atc.StepResult) error) {
+	fake.saveStepResultMutex.Lock()
+	defer fake.saveStepResultMutex.Unlock()
+	fake.SaveStepResultStub = stub
+}
+
+func (fake *FakeBuild) SaveStepResultArgsForCall(i int) (atc.PlanID, string, atc.StepResult) {
+	fake.saveStepResultMutex.RLock()
+	defer fake.saveStepResultMutex.RUnlock()
+	argsForCall := fake.saveStepResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) SaveStepResultReturns(result1 error) {
+	fake.saveStepResultMutex.Lock()
+	defer fake.saveStepResultMutex.Unlock()
+	fake.SaveStepResultStub = nil
+	fake.saveStepResultReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveStepResultReturnsOnCall(i int, result1 error) {
+	fake.saveStepResultMutex.Lock()
+	defer fake.saveStepResultMutex.Unlock()
+	fake.SaveStepResultStub = nil
+	if fake.saveStepResultReturnsOnCall == nil {
+		fake.saveStepResultReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStepResultReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -3232,6 +3655,61 @@ func (fake *FakeBuild) StatusReturnsOnCall(i int, result1 db.BuildStatus) {
 	}{result1}
 }
 
+func (fake *FakeBuild) StepResults() (map[string]atc.StepResult, error) {
+	fake.stepResultsMutex.Lock()
+	ret, specificReturn := fake.stepResultsReturnsOnCall[len(fake.stepResultsArgsForCall)]
+	fake.stepResultsArgsForCall = append(fake.stepResultsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StepResults", []interface{}{})
+	fake.stepResultsMutex.Unlock()
+	if fake.StepResultsStub != nil {
+		return fake.StepResultsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.stepResultsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) StepResultsCallCount() int {
+	fake.stepResultsMutex.RLock()
+	defer fake.stepResultsMutex.RUnlock()
+	return len(fake.stepResultsArgsForCall)
+}
+
+func (fake *FakeBuild) StepResultsCalls(stub func() (map[string]atc.StepResult, error)) {
+	fake.stepResultsMutex.Lock()
+	defer fake.stepResultsMutex.Unlock()
+	fake.StepResultsStub = stub
+}
+
+func (fake *FakeBuild) StepResultsReturns(result1 map[string]atc.StepResult, result2 error) {
+	fake.stepResultsMutex.Lock()
+	defer fake.stepResultsMutex.Unlock()
+	fake.StepResultsStub = nil
+	fake.stepResultsReturns = struct {
+		result1 map[string]atc.StepResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) StepResultsReturnsOnCall(i int, result1 map[string]atc.StepResult, result2 error) {
+	fake.stepResultsMutex.Lock()
+	defer fake.stepResultsMutex.Unlock()
+	fake.StepResultsStub = nil
+	if fake.stepResultsReturnsOnCall == nil {
+		fake.stepResultsReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.StepResult
+			result2 error
+		})
+	}
+	fake.stepResultsReturnsOnCall[i] = struct {
+		result1 map[string]atc.StepResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -3353,10 +3831,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.artifactsMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.durationEstimateMutex.RLock()
+	defer fake.durationEstimateMutex.RUnlock()
 	fake.endTimeMutex.RLock()
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.filteredEventsMutex.RLock()
+	defer fake.filteredEventsMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
@@ -3389,6 +3871,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.partialRerunOfMutex.RLock()
+	defer fake.partialRerunOfMutex.RUnlock()
+	fake.partialRerunOfNameMutex.RLock()
+	defer fake.partialRerunOfNameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -3405,6 +3891,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunFromStepMutex.RLock()
+	defer fake.rerunFromStepMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -3421,6 +3909,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveStepResultMutex.RLock()
+	defer fake.saveStepResultMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
@@ -3435,6 +3925,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.startTimeMutex.RUnlock()
 	fake.statusMutex.RLock()
 	defer fake.statusMutex.RUnlock()
+	fake.stepResultsMutex.RLock()
+	defer fake.stepResultsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
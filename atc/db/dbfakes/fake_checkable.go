@@ -189,6 +189,16 @@ type FakeCheckable struct {
 	typeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WorkerSelectorStub        func() *atc.WorkerSelector
+	workerSelectorMutex       sync.RWMutex
+	workerSelectorArgsForCall []struct {
+	}
+	workerSelectorReturns struct {
+		result1 *atc.WorkerSelector
+	}
+	workerSelectorReturnsOnCall map[int]struct {
+		result1 *atc.WorkerSelector
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1103,6 +1113,58 @@ func (fake *FakeCheckable) TypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeCheckable) WorkerSelector() *atc.WorkerSelector {
+	fake.workerSelectorMutex.Lock()
+	ret, specificReturn := fake.workerSelectorReturnsOnCall[len(fake.workerSelectorArgsForCall)]
+	fake.workerSelectorArgsForCall = append(fake.workerSelectorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerSelector", []interface{}{})
+	fake.workerSelectorMutex.Unlock()
+	if fake.WorkerSelectorStub != nil {
+		return fake.WorkerSelectorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerSelectorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckable) WorkerSelectorCallCount() int {
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
+	return len(fake.workerSelectorArgsForCall)
+}
+
+func (fake *FakeCheckable) WorkerSelectorCalls(stub func() *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = stub
+}
+
+func (fake *FakeCheckable) WorkerSelectorReturns(result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	fake.workerSelectorReturns = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
+func (fake *FakeCheckable) WorkerSelectorReturnsOnCall(i int, result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	if fake.workerSelectorReturnsOnCall == nil {
+		fake.workerSelectorReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerSelector
+		})
+	}
+	fake.workerSelectorReturnsOnCall[i] = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
 func (fake *FakeCheckable) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1140,6 +1202,8 @@ func (fake *FakeCheckable) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -39,6 +39,26 @@ type FakeComponent struct {
 	intervalElapsedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LastDurationStub        func() time.Duration
+	lastDurationMutex       sync.RWMutex
+	lastDurationArgsForCall []struct {
+	}
+	lastDurationReturns struct {
+		result1 time.Duration
+	}
+	lastDurationReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	LastErrorStub        func() string
+	lastErrorMutex       sync.RWMutex
+	lastErrorArgsForCall []struct {
+	}
+	lastErrorReturns struct {
+		result1 string
+	}
+	lastErrorReturnsOnCall map[int]struct {
+		result1 string
+	}
 	LastRanStub        func() time.Time
 	lastRanMutex       sync.RWMutex
 	lastRanArgsForCall []struct {
@@ -49,6 +69,16 @@ type FakeComponent struct {
 	lastRanReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LastRanByStub        func() string
+	lastRanByMutex       sync.RWMutex
+	lastRanByArgsForCall []struct {
+	}
+	lastRanByReturns struct {
+		result1 string
+	}
+	lastRanByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -59,6 +89,16 @@ type FakeComponent struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PauseStub        func() error
+	pauseMutex       sync.RWMutex
+	pauseArgsForCall []struct {
+	}
+	pauseReturns struct {
+		result1 error
+	}
+	pauseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PausedStub        func() bool
 	pausedMutex       sync.RWMutex
 	pausedArgsForCall []struct {
@@ -69,6 +109,18 @@ type FakeComponent struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RecordRunStub        func(time.Duration, error) error
+	recordRunMutex       sync.RWMutex
+	recordRunArgsForCall []struct {
+		arg1 time.Duration
+		arg2 error
+	}
+	recordRunReturns struct {
+		result1 error
+	}
+	recordRunReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -81,6 +133,16 @@ type FakeComponent struct {
 		result1 bool
 		result2 error
 	}
+	UnpauseStub        func() error
+	unpauseMutex       sync.RWMutex
+	unpauseArgsForCall []struct {
+	}
+	unpauseReturns struct {
+		result1 error
+	}
+	unpauseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateLastRanStub        func() error
 	updateLastRanMutex       sync.RWMutex
 	updateLastRanArgsForCall []struct {
@@ -251,6 +313,110 @@ func (fake *FakeComponent) IntervalElapsedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeComponent) LastDuration() time.Duration {
+	fake.lastDurationMutex.Lock()
+	ret, specificReturn := fake.lastDurationReturnsOnCall[len(fake.lastDurationArgsForCall)]
+	fake.lastDurationArgsForCall = append(fake.lastDurationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastDuration", []interface{}{})
+	fake.lastDurationMutex.Unlock()
+	if fake.LastDurationStub != nil {
+		return fake.LastDurationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastDurationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) LastDurationCallCount() int {
+	fake.lastDurationMutex.RLock()
+	defer fake.lastDurationMutex.RUnlock()
+	return len(fake.lastDurationArgsForCall)
+}
+
+func (fake *FakeComponent) LastDurationCalls(stub func() time.Duration) {
+	fake.lastDurationMutex.Lock()
+	defer fake.lastDurationMutex.Unlock()
+	fake.LastDurationStub = stub
+}
+
+func (fake *FakeComponent) LastDurationReturns(result1 time.Duration) {
+	fake.lastDurationMutex.Lock()
+	defer fake.lastDurationMutex.Unlock()
+	fake.LastDurationStub = nil
+	fake.lastDurationReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeComponent) LastDurationReturnsOnCall(i int, result1 time.Duration) {
+	fake.lastDurationMutex.Lock()
+	defer fake.lastDurationMutex.Unlock()
+	fake.LastDurationStub = nil
+	if fake.lastDurationReturnsOnCall == nil {
+		fake.lastDurationReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.lastDurationReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeComponent) LastError() string {
+	fake.lastErrorMutex.Lock()
+	ret, specificReturn := fake.lastErrorReturnsOnCall[len(fake.lastErrorArgsForCall)]
+	fake.lastErrorArgsForCall = append(fake.lastErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastError", []interface{}{})
+	fake.lastErrorMutex.Unlock()
+	if fake.LastErrorStub != nil {
+		return fake.LastErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) LastErrorCallCount() int {
+	fake.lastErrorMutex.RLock()
+	defer fake.lastErrorMutex.RUnlock()
+	return len(fake.lastErrorArgsForCall)
+}
+
+func (fake *FakeComponent) LastErrorCalls(stub func() string) {
+	fake.lastErrorMutex.Lock()
+	defer fake.lastErrorMutex.Unlock()
+	fake.LastErrorStub = stub
+}
+
+func (fake *FakeComponent) LastErrorReturns(result1 string) {
+	fake.lastErrorMutex.Lock()
+	defer fake.lastErrorMutex.Unlock()
+	fake.LastErrorStub = nil
+	fake.lastErrorReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeComponent) LastErrorReturnsOnCall(i int, result1 string) {
+	fake.lastErrorMutex.Lock()
+	defer fake.lastErrorMutex.Unlock()
+	fake.LastErrorStub = nil
+	if fake.lastErrorReturnsOnCall == nil {
+		fake.lastErrorReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.lastErrorReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeComponent) LastRan() time.Time {
 	fake.lastRanMutex.Lock()
 	ret, specificReturn := fake.lastRanReturnsOnCall[len(fake.lastRanArgsForCall)]
@@ -303,6 +469,58 @@ func (fake *FakeComponent) LastRanReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeComponent) LastRanBy() string {
+	fake.lastRanByMutex.Lock()
+	ret, specificReturn := fake.lastRanByReturnsOnCall[len(fake.lastRanByArgsForCall)]
+	fake.lastRanByArgsForCall = append(fake.lastRanByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastRanBy", []interface{}{})
+	fake.lastRanByMutex.Unlock()
+	if fake.LastRanByStub != nil {
+		return fake.LastRanByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastRanByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) LastRanByCallCount() int {
+	fake.lastRanByMutex.RLock()
+	defer fake.lastRanByMutex.RUnlock()
+	return len(fake.lastRanByArgsForCall)
+}
+
+func (fake *FakeComponent) LastRanByCalls(stub func() string) {
+	fake.lastRanByMutex.Lock()
+	defer fake.lastRanByMutex.Unlock()
+	fake.LastRanByStub = stub
+}
+
+func (fake *FakeComponent) LastRanByReturns(result1 string) {
+	fake.lastRanByMutex.Lock()
+	defer fake.lastRanByMutex.Unlock()
+	fake.LastRanByStub = nil
+	fake.lastRanByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeComponent) LastRanByReturnsOnCall(i int, result1 string) {
+	fake.lastRanByMutex.Lock()
+	defer fake.lastRanByMutex.Unlock()
+	fake.LastRanByStub = nil
+	if fake.lastRanByReturnsOnCall == nil {
+		fake.lastRanByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.lastRanByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeComponent) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -355,6 +573,58 @@ func (fake *FakeComponent) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeComponent) Pause() error {
+	fake.pauseMutex.Lock()
+	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
+	fake.pauseArgsForCall = append(fake.pauseArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Pause", []interface{}{})
+	fake.pauseMutex.Unlock()
+	if fake.PauseStub != nil {
+		return fake.PauseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pauseReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) PauseCallCount() int {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	return len(fake.pauseArgsForCall)
+}
+
+func (fake *FakeComponent) PauseCalls(stub func() error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = stub
+}
+
+func (fake *FakeComponent) PauseReturns(result1 error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = nil
+	fake.pauseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeComponent) PauseReturnsOnCall(i int, result1 error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = nil
+	if fake.pauseReturnsOnCall == nil {
+		fake.pauseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pauseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeComponent) Paused() bool {
 	fake.pausedMutex.Lock()
 	ret, specificReturn := fake.pausedReturnsOnCall[len(fake.pausedArgsForCall)]
@@ -407,6 +677,67 @@ func (fake *FakeComponent) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeComponent) RecordRun(arg1 time.Duration, arg2 error) error {
+	fake.recordRunMutex.Lock()
+	ret, specificReturn := fake.recordRunReturnsOnCall[len(fake.recordRunArgsForCall)]
+	fake.recordRunArgsForCall = append(fake.recordRunArgsForCall, struct {
+		arg1 time.Duration
+		arg2 error
+	}{arg1, arg2})
+	fake.recordInvocation("RecordRun", []interface{}{arg1, arg2})
+	fake.recordRunMutex.Unlock()
+	if fake.RecordRunStub != nil {
+		return fake.RecordRunStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordRunReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) RecordRunCallCount() int {
+	fake.recordRunMutex.RLock()
+	defer fake.recordRunMutex.RUnlock()
+	return len(fake.recordRunArgsForCall)
+}
+
+func (fake *FakeComponent) RecordRunCalls(stub func(time.Duration, error) error) {
+	fake.recordRunMutex.Lock()
+	defer fake.recordRunMutex.Unlock()
+	fake.RecordRunStub = stub
+}
+
+func (fake *FakeComponent) RecordRunArgsForCall(i int) (time.Duration, error) {
+	fake.recordRunMutex.RLock()
+	defer fake.recordRunMutex.RUnlock()
+	argsForCall := fake.recordRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeComponent) RecordRunReturns(result1 error) {
+	fake.recordRunMutex.Lock()
+	defer fake.recordRunMutex.Unlock()
+	fake.RecordRunStub = nil
+	fake.recordRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeComponent) RecordRunReturnsOnCall(i int, result1 error) {
+	fake.recordRunMutex.Lock()
+	defer fake.recordRunMutex.Unlock()
+	fake.RecordRunStub = nil
+	if fake.recordRunReturnsOnCall == nil {
+		fake.recordRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeComponent) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -462,6 +793,58 @@ func (fake *FakeComponent) ReloadReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeComponent) Unpause() error {
+	fake.unpauseMutex.Lock()
+	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
+	fake.unpauseArgsForCall = append(fake.unpauseArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Unpause", []interface{}{})
+	fake.unpauseMutex.Unlock()
+	if fake.UnpauseStub != nil {
+		return fake.UnpauseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unpauseReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeComponent) UnpauseCallCount() int {
+	fake.unpauseMutex.RLock()
+	defer fake.unpauseMutex.RUnlock()
+	return len(fake.unpauseArgsForCall)
+}
+
+func (fake *FakeComponent) UnpauseCalls(stub func() error) {
+	fake.unpauseMutex.Lock()
+	defer fake.unpauseMutex.Unlock()
+	fake.UnpauseStub = stub
+}
+
+func (fake *FakeComponent) UnpauseReturns(result1 error) {
+	fake.unpauseMutex.Lock()
+	defer fake.unpauseMutex.Unlock()
+	fake.UnpauseStub = nil
+	fake.unpauseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeComponent) UnpauseReturnsOnCall(i int, result1 error) {
+	fake.unpauseMutex.Lock()
+	defer fake.unpauseMutex.Unlock()
+	fake.UnpauseStub = nil
+	if fake.unpauseReturnsOnCall == nil {
+		fake.unpauseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unpauseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeComponent) UpdateLastRan() error {
 	fake.updateLastRanMutex.Lock()
 	ret, specificReturn := fake.updateLastRanReturnsOnCall[len(fake.updateLastRanArgsForCall)]
@@ -523,14 +906,26 @@ func (fake *FakeComponent) Invocations() map[string][][]interface{} {
 	defer fake.intervalMutex.RUnlock()
 	fake.intervalElapsedMutex.RLock()
 	defer fake.intervalElapsedMutex.RUnlock()
+	fake.lastDurationMutex.RLock()
+	defer fake.lastDurationMutex.RUnlock()
+	fake.lastErrorMutex.RLock()
+	defer fake.lastErrorMutex.RUnlock()
 	fake.lastRanMutex.RLock()
 	defer fake.lastRanMutex.RUnlock()
+	fake.lastRanByMutex.RLock()
+	defer fake.lastRanByMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
+	fake.recordRunMutex.RLock()
+	defer fake.recordRunMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.unpauseMutex.RLock()
+	defer fake.unpauseMutex.RUnlock()
 	fake.updateLastRanMutex.RLock()
 	defer fake.updateLastRanMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -99,6 +99,19 @@ type FakeJob struct {
 		result1 int64
 		result2 error
 	}
+	ClearTaskResultCacheStub        func(string) (int64, error)
+	clearTaskResultCacheMutex       sync.RWMutex
+	clearTaskResultCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearTaskResultCacheReturns struct {
+		result1 int64
+		result2 error
+	}
+	clearTaskResultCacheReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	ConfigStub        func() (atc.JobConfig, error)
 	configMutex       sync.RWMutex
 	configArgsForCall []struct {
@@ -270,6 +283,20 @@ type FakeJob struct {
 		result1 []atc.JobOutput
 		result2 error
 	}
+	PartialRerunBuildStub        func(db.Build, string) (db.Build, error)
+	partialRerunBuildMutex       sync.RWMutex
+	partialRerunBuildArgsForCall []struct {
+		arg1 db.Build
+		arg2 string
+	}
+	partialRerunBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	partialRerunBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	PauseStub        func() error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
@@ -864,6 +891,69 @@ func (fake *FakeJob) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ClearTaskResultCache(arg1 string) (int64, error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	ret, specificReturn := fake.clearTaskResultCacheReturnsOnCall[len(fake.clearTaskResultCacheArgsForCall)]
+	fake.clearTaskResultCacheArgsForCall = append(fake.clearTaskResultCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearTaskResultCache", []interface{}{arg1})
+	fake.clearTaskResultCacheMutex.Unlock()
+	if fake.ClearTaskResultCacheStub != nil {
+		return fake.ClearTaskResultCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearTaskResultCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) ClearTaskResultCacheCallCount() int {
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
+	return len(fake.clearTaskResultCacheArgsForCall)
+}
+
+func (fake *FakeJob) ClearTaskResultCacheCalls(stub func(string) (int64, error)) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = stub
+}
+
+func (fake *FakeJob) ClearTaskResultCacheArgsForCall(i int) string {
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
+	argsForCall := fake.clearTaskResultCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) ClearTaskResultCacheReturns(result1 int64, result2 error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = nil
+	fake.clearTaskResultCacheReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) ClearTaskResultCacheReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = nil
+	if fake.clearTaskResultCacheReturnsOnCall == nil {
+		fake.clearTaskResultCacheReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.clearTaskResultCacheReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Config() (atc.JobConfig, error) {
 	fake.configMutex.Lock()
 	ret, specificReturn := fake.configReturnsOnCall[len(fake.configArgsForCall)]
@@ -1682,6 +1772,70 @@ func (fake *FakeJob) OutputsReturnsOnCall(i int, result1 []atc.JobOutput, result
 	}{result1, result2}
 }
 
+func (fake *FakeJob) PartialRerunBuild(arg1 db.Build, arg2 string) (db.Build, error) {
+	fake.partialRerunBuildMutex.Lock()
+	ret, specificReturn := fake.partialRerunBuildReturnsOnCall[len(fake.partialRerunBuildArgsForCall)]
+	fake.partialRerunBuildArgsForCall = append(fake.partialRerunBuildArgsForCall, struct {
+		arg1 db.Build
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PartialRerunBuild", []interface{}{arg1, arg2})
+	fake.partialRerunBuildMutex.Unlock()
+	if fake.PartialRerunBuildStub != nil {
+		return fake.PartialRerunBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.partialRerunBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) PartialRerunBuildCallCount() int {
+	fake.partialRerunBuildMutex.RLock()
+	defer fake.partialRerunBuildMutex.RUnlock()
+	return len(fake.partialRerunBuildArgsForCall)
+}
+
+func (fake *FakeJob) PartialRerunBuildCalls(stub func(db.Build, string) (db.Build, error)) {
+	fake.partialRerunBuildMutex.Lock()
+	defer fake.partialRerunBuildMutex.Unlock()
+	fake.PartialRerunBuildStub = stub
+}
+
+func (fake *FakeJob) PartialRerunBuildArgsForCall(i int) (db.Build, string) {
+	fake.partialRerunBuildMutex.RLock()
+	defer fake.partialRerunBuildMutex.RUnlock()
+	argsForCall := fake.partialRerunBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) PartialRerunBuildReturns(result1 db.Build, result2 error) {
+	fake.partialRerunBuildMutex.Lock()
+	defer fake.partialRerunBuildMutex.Unlock()
+	fake.PartialRerunBuildStub = nil
+	fake.partialRerunBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) PartialRerunBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.partialRerunBuildMutex.Lock()
+	defer fake.partialRerunBuildMutex.Unlock()
+	fake.PartialRerunBuildStub = nil
+	if fake.partialRerunBuildReturnsOnCall == nil {
+		fake.partialRerunBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.partialRerunBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Pause() error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
@@ -2749,6 +2903,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithTimeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -2779,6 +2935,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.nameMutex.RUnlock()
 	fake.outputsMutex.RLock()
 	defer fake.outputsMutex.RUnlock()
+	fake.partialRerunBuildMutex.RLock()
+	defer fake.partialRerunBuildMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
@@ -251,6 +251,19 @@ type FakePipeline struct {
 		result2 bool
 		result3 error
 	}
+	JobStatsStub        func(time.Time) ([]atc.JobStats, error)
+	jobStatsMutex       sync.RWMutex
+	jobStatsArgsForCall []struct {
+		arg1 time.Time
+	}
+	jobStatsReturns struct {
+		result1 []atc.JobStats
+		result2 error
+	}
+	jobStatsReturnsOnCall map[int]struct {
+		result1 []atc.JobStats
+		result2 error
+	}
 	JobsStub        func() (db.Jobs, error)
 	jobsMutex       sync.RWMutex
 	jobsArgsForCall []struct {
@@ -502,6 +515,20 @@ type FakePipeline struct {
 		result1 vars.Variables
 		result2 error
 	}
+	VisibleCausalityStub        func(int, []string) ([]db.Cause, error)
+	visibleCausalityMutex       sync.RWMutex
+	visibleCausalityArgsForCall []struct {
+		arg1 int
+		arg2 []string
+	}
+	visibleCausalityReturns struct {
+		result1 []db.Cause
+		result2 error
+	}
+	visibleCausalityReturnsOnCall map[int]struct {
+		result1 []db.Cause
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1659,6 +1686,69 @@ func (fake *FakePipeline) JobReturnsOnCall(i int, result1 db.Job, result2 bool,
 	}{result1, result2, result3}
 }
 
+func (fake *FakePipeline) JobStats(arg1 time.Time) ([]atc.JobStats, error) {
+	fake.jobStatsMutex.Lock()
+	ret, specificReturn := fake.jobStatsReturnsOnCall[len(fake.jobStatsArgsForCall)]
+	fake.jobStatsArgsForCall = append(fake.jobStatsArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("JobStats", []interface{}{arg1})
+	fake.jobStatsMutex.Unlock()
+	if fake.JobStatsStub != nil {
+		return fake.JobStatsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.jobStatsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) JobStatsCallCount() int {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	return len(fake.jobStatsArgsForCall)
+}
+
+func (fake *FakePipeline) JobStatsCalls(stub func(time.Time) ([]atc.JobStats, error)) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = stub
+}
+
+func (fake *FakePipeline) JobStatsArgsForCall(i int) time.Time {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	argsForCall := fake.jobStatsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) JobStatsReturns(result1 []atc.JobStats, result2 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	fake.jobStatsReturns = struct {
+		result1 []atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) JobStatsReturnsOnCall(i int, result1 []atc.JobStats, result2 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	if fake.jobStatsReturnsOnCall == nil {
+		fake.jobStatsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobStats
+			result2 error
+		})
+	}
+	fake.jobStatsReturnsOnCall[i] = struct {
+		result1 []atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Jobs() (db.Jobs, error) {
 	fake.jobsMutex.Lock()
 	ret, specificReturn := fake.jobsReturnsOnCall[len(fake.jobsArgsForCall)]
@@ -2857,6 +2947,75 @@ func (fake *FakePipeline) VariablesReturnsOnCall(i int, result1 vars.Variables,
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) VisibleCausality(arg1 int, arg2 []string) ([]db.Cause, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.visibleCausalityMutex.Lock()
+	ret, specificReturn := fake.visibleCausalityReturnsOnCall[len(fake.visibleCausalityArgsForCall)]
+	fake.visibleCausalityArgsForCall = append(fake.visibleCausalityArgsForCall, struct {
+		arg1 int
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("VisibleCausality", []interface{}{arg1, arg2Copy})
+	fake.visibleCausalityMutex.Unlock()
+	if fake.VisibleCausalityStub != nil {
+		return fake.VisibleCausalityStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.visibleCausalityReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) VisibleCausalityCallCount() int {
+	fake.visibleCausalityMutex.RLock()
+	defer fake.visibleCausalityMutex.RUnlock()
+	return len(fake.visibleCausalityArgsForCall)
+}
+
+func (fake *FakePipeline) VisibleCausalityCalls(stub func(int, []string) ([]db.Cause, error)) {
+	fake.visibleCausalityMutex.Lock()
+	defer fake.visibleCausalityMutex.Unlock()
+	fake.VisibleCausalityStub = stub
+}
+
+func (fake *FakePipeline) VisibleCausalityArgsForCall(i int) (int, []string) {
+	fake.visibleCausalityMutex.RLock()
+	defer fake.visibleCausalityMutex.RUnlock()
+	argsForCall := fake.visibleCausalityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePipeline) VisibleCausalityReturns(result1 []db.Cause, result2 error) {
+	fake.visibleCausalityMutex.Lock()
+	defer fake.visibleCausalityMutex.Unlock()
+	fake.VisibleCausalityStub = nil
+	fake.visibleCausalityReturns = struct {
+		result1 []db.Cause
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) VisibleCausalityReturnsOnCall(i int, result1 []db.Cause, result2 error) {
+	fake.visibleCausalityMutex.Lock()
+	defer fake.visibleCausalityMutex.Unlock()
+	fake.VisibleCausalityStub = nil
+	if fake.visibleCausalityReturnsOnCall == nil {
+		fake.visibleCausalityReturnsOnCall = make(map[int]struct {
+			result1 []db.Cause
+			result2 error
+		})
+	}
+	fake.visibleCausalityReturnsOnCall[i] = struct {
+		result1 []db.Cause
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2900,6 +3059,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
 	fake.jobsMutex.RLock()
 	defer fake.jobsMutex.RUnlock()
 	fake.lastUpdatedMutex.RLock()
@@ -2942,6 +3103,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.varSourcesMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.visibleCausalityMutex.RLock()
+	defer fake.visibleCausalityMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -412,11 +412,12 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
-	VersionsStub        func(db.Page, atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error)
+	VersionsStub        func(db.Page, atc.Version, *atc.VersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error)
 	versionsMutex       sync.RWMutex
 	versionsArgsForCall []struct {
 		arg1 db.Page
 		arg2 atc.Version
+		arg3 *atc.VersionFilter
 	}
 	versionsReturns struct {
 		result1 []atc.ResourceVersion
@@ -440,6 +441,16 @@ type FakeResource struct {
 	webhookTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WorkerSelectorStub        func() *atc.WorkerSelector
+	workerSelectorMutex       sync.RWMutex
+	workerSelectorArgsForCall []struct {
+	}
+	workerSelectorReturns struct {
+		result1 *atc.WorkerSelector
+	}
+	workerSelectorReturnsOnCall map[int]struct {
+		result1 *atc.WorkerSelector
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -2472,17 +2483,18 @@ func (fake *FakeResource) UpdateMetadataReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
-func (fake *FakeResource) Versions(arg1 db.Page, arg2 atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error) {
+func (fake *FakeResource) Versions(arg1 db.Page, arg2 atc.Version, arg3 *atc.VersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error) {
 	fake.versionsMutex.Lock()
 	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
 	fake.versionsArgsForCall = append(fake.versionsArgsForCall, struct {
 		arg1 db.Page
 		arg2 atc.Version
-	}{arg1, arg2})
-	fake.recordInvocation("Versions", []interface{}{arg1, arg2})
+		arg3 *atc.VersionFilter
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Versions", []interface{}{arg1, arg2, arg3})
 	fake.versionsMutex.Unlock()
 	if fake.VersionsStub != nil {
-		return fake.VersionsStub(arg1, arg2)
+		return fake.VersionsStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4
@@ -2497,17 +2509,17 @@ func (fake *FakeResource) VersionsCallCount() int {
 	return len(fake.versionsArgsForCall)
 }
 
-func (fake *FakeResource) VersionsCalls(stub func(db.Page, atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error)) {
+func (fake *FakeResource) VersionsCalls(stub func(db.Page, atc.Version, *atc.VersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error)) {
 	fake.versionsMutex.Lock()
 	defer fake.versionsMutex.Unlock()
 	fake.VersionsStub = stub
 }
 
-func (fake *FakeResource) VersionsArgsForCall(i int) (db.Page, atc.Version) {
+func (fake *FakeResource) VersionsArgsForCall(i int) (db.Page, atc.Version, *atc.VersionFilter) {
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
 	argsForCall := fake.versionsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeResource) VersionsReturns(result1 []atc.ResourceVersion, result2 db.Pagination, result3 bool, result4 error) {
@@ -2594,6 +2606,58 @@ func (fake *FakeResource) WebhookTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) WorkerSelector() *atc.WorkerSelector {
+	fake.workerSelectorMutex.Lock()
+	ret, specificReturn := fake.workerSelectorReturnsOnCall[len(fake.workerSelectorArgsForCall)]
+	fake.workerSelectorArgsForCall = append(fake.workerSelectorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerSelector", []interface{}{})
+	fake.workerSelectorMutex.Unlock()
+	if fake.WorkerSelectorStub != nil {
+		return fake.WorkerSelectorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerSelectorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WorkerSelectorCallCount() int {
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
+	return len(fake.workerSelectorArgsForCall)
+}
+
+func (fake *FakeResource) WorkerSelectorCalls(stub func() *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = stub
+}
+
+func (fake *FakeResource) WorkerSelectorReturns(result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	fake.workerSelectorReturns = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
+func (fake *FakeResource) WorkerSelectorReturnsOnCall(i int, result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	if fake.workerSelectorReturnsOnCall == nil {
+		fake.workerSelectorReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerSelector
+		})
+	}
+	fake.workerSelectorReturnsOnCall[i] = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
 func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2675,6 +2739,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.versionsMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -281,6 +281,16 @@ type FakeResourceType struct {
 	versionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	WorkerSelectorStub        func() *atc.WorkerSelector
+	workerSelectorMutex       sync.RWMutex
+	workerSelectorArgsForCall []struct {
+	}
+	workerSelectorReturns struct {
+		result1 *atc.WorkerSelector
+	}
+	workerSelectorReturnsOnCall map[int]struct {
+		result1 *atc.WorkerSelector
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1666,6 +1676,58 @@ func (fake *FakeResourceType) VersionReturnsOnCall(i int, result1 atc.Version) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) WorkerSelector() *atc.WorkerSelector {
+	fake.workerSelectorMutex.Lock()
+	ret, specificReturn := fake.workerSelectorReturnsOnCall[len(fake.workerSelectorArgsForCall)]
+	fake.workerSelectorArgsForCall = append(fake.workerSelectorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerSelector", []interface{}{})
+	fake.workerSelectorMutex.Unlock()
+	if fake.WorkerSelectorStub != nil {
+		return fake.WorkerSelectorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerSelectorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) WorkerSelectorCallCount() int {
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
+	return len(fake.workerSelectorArgsForCall)
+}
+
+func (fake *FakeResourceType) WorkerSelectorCalls(stub func() *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = stub
+}
+
+func (fake *FakeResourceType) WorkerSelectorReturns(result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	fake.workerSelectorReturns = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
+func (fake *FakeResourceType) WorkerSelectorReturnsOnCall(i int, result1 *atc.WorkerSelector) {
+	fake.workerSelectorMutex.Lock()
+	defer fake.workerSelectorMutex.Unlock()
+	fake.WorkerSelectorStub = nil
+	if fake.workerSelectorReturnsOnCall == nil {
+		fake.workerSelectorReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerSelector
+		})
+	}
+	fake.workerSelectorReturnsOnCall[i] = struct {
+		result1 *atc.WorkerSelector
+	}{result1}
+}
+
 func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1721,6 +1783,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.uniqueVersionHistoryMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
+	fake.workerSelectorMutex.RLock()
+	defer fake.workerSelectorMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
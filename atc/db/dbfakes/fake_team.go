@@ -253,6 +253,18 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	LintRulesStub        func() (atc.LintRules, error)
+	lintRulesMutex       sync.RWMutex
+	lintRulesArgsForCall []struct {
+	}
+	lintRulesReturns struct {
+		result1 atc.LintRules
+		result2 error
+	}
+	lintRulesReturnsOnCall map[int]struct {
+		result1 atc.LintRules
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -328,6 +340,18 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	QuotasStub        func() (atc.TeamQuotas, error)
+	quotasMutex       sync.RWMutex
+	quotasArgsForCall []struct {
+	}
+	quotasReturns struct {
+		result1 atc.TeamQuotas
+		result2 error
+	}
+	quotasReturnsOnCall map[int]struct {
+		result1 atc.TeamQuotas
+		result2 error
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -371,6 +395,17 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdateLintRulesStub        func(atc.LintRules) error
+	updateLintRulesMutex       sync.RWMutex
+	updateLintRulesArgsForCall []struct {
+		arg1 atc.LintRules
+	}
+	updateLintRulesReturns struct {
+		result1 error
+	}
+	updateLintRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -382,6 +417,30 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateQuotasStub        func(atc.TeamQuotas) error
+	updateQuotasMutex       sync.RWMutex
+	updateQuotasArgsForCall []struct {
+		arg1 atc.TeamQuotas
+	}
+	updateQuotasReturns struct {
+		result1 error
+	}
+	updateQuotasReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateSettingsStub        func(atc.TeamAuth, atc.LintRules, *atc.TeamQuotas) error
+	updateSettingsMutex       sync.RWMutex
+	updateSettingsArgsForCall []struct {
+		arg1 atc.TeamAuth
+		arg2 atc.LintRules
+		arg3 *atc.TeamQuotas
+	}
+	updateSettingsReturns struct {
+		result1 error
+	}
+	updateSettingsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -1501,6 +1560,61 @@ func (fake *FakeTeam) IsContainerWithinTeamReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) LintRules() (atc.LintRules, error) {
+	fake.lintRulesMutex.Lock()
+	ret, specificReturn := fake.lintRulesReturnsOnCall[len(fake.lintRulesArgsForCall)]
+	fake.lintRulesArgsForCall = append(fake.lintRulesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LintRules", []interface{}{})
+	fake.lintRulesMutex.Unlock()
+	if fake.LintRulesStub != nil {
+		return fake.LintRulesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.lintRulesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) LintRulesCallCount() int {
+	fake.lintRulesMutex.RLock()
+	defer fake.lintRulesMutex.RUnlock()
+	return len(fake.lintRulesArgsForCall)
+}
+
+func (fake *FakeTeam) LintRulesCalls(stub func() (atc.LintRules, error)) {
+	fake.lintRulesMutex.Lock()
+	defer fake.lintRulesMutex.Unlock()
+	fake.LintRulesStub = stub
+}
+
+func (fake *FakeTeam) LintRulesReturns(result1 atc.LintRules, result2 error) {
+	fake.lintRulesMutex.Lock()
+	defer fake.lintRulesMutex.Unlock()
+	fake.LintRulesStub = nil
+	fake.lintRulesReturns = struct {
+		result1 atc.LintRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) LintRulesReturnsOnCall(i int, result1 atc.LintRules, result2 error) {
+	fake.lintRulesMutex.Lock()
+	defer fake.lintRulesMutex.Unlock()
+	fake.LintRulesStub = nil
+	if fake.lintRulesReturnsOnCall == nil {
+		fake.lintRulesReturnsOnCall = make(map[int]struct {
+			result1 atc.LintRules
+			result2 error
+		})
+	}
+	fake.lintRulesReturnsOnCall[i] = struct {
+		result1 atc.LintRules
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1860,6 +1974,61 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Quotas() (atc.TeamQuotas, error) {
+	fake.quotasMutex.Lock()
+	ret, specificReturn := fake.quotasReturnsOnCall[len(fake.quotasArgsForCall)]
+	fake.quotasArgsForCall = append(fake.quotasArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Quotas", []interface{}{})
+	fake.quotasMutex.Unlock()
+	if fake.QuotasStub != nil {
+		return fake.QuotasStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.quotasReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) QuotasCallCount() int {
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
+	return len(fake.quotasArgsForCall)
+}
+
+func (fake *FakeTeam) QuotasCalls(stub func() (atc.TeamQuotas, error)) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = stub
+}
+
+func (fake *FakeTeam) QuotasReturns(result1 atc.TeamQuotas, result2 error) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	fake.quotasReturns = struct {
+		result1 atc.TeamQuotas
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) QuotasReturnsOnCall(i int, result1 atc.TeamQuotas, result2 error) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	if fake.quotasReturnsOnCall == nil {
+		fake.quotasReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamQuotas
+			result2 error
+		})
+	}
+	fake.quotasReturnsOnCall[i] = struct {
+		result1 atc.TeamQuotas
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -2053,6 +2222,66 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdateLintRules(arg1 atc.LintRules) error {
+	fake.updateLintRulesMutex.Lock()
+	ret, specificReturn := fake.updateLintRulesReturnsOnCall[len(fake.updateLintRulesArgsForCall)]
+	fake.updateLintRulesArgsForCall = append(fake.updateLintRulesArgsForCall, struct {
+		arg1 atc.LintRules
+	}{arg1})
+	fake.recordInvocation("UpdateLintRules", []interface{}{arg1})
+	fake.updateLintRulesMutex.Unlock()
+	if fake.UpdateLintRulesStub != nil {
+		return fake.UpdateLintRulesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateLintRulesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateLintRulesCallCount() int {
+	fake.updateLintRulesMutex.RLock()
+	defer fake.updateLintRulesMutex.RUnlock()
+	return len(fake.updateLintRulesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateLintRulesCalls(stub func(atc.LintRules) error) {
+	fake.updateLintRulesMutex.Lock()
+	defer fake.updateLintRulesMutex.Unlock()
+	fake.UpdateLintRulesStub = stub
+}
+
+func (fake *FakeTeam) UpdateLintRulesArgsForCall(i int) atc.LintRules {
+	fake.updateLintRulesMutex.RLock()
+	defer fake.updateLintRulesMutex.RUnlock()
+	argsForCall := fake.updateLintRulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateLintRulesReturns(result1 error) {
+	fake.updateLintRulesMutex.Lock()
+	defer fake.updateLintRulesMutex.Unlock()
+	fake.UpdateLintRulesStub = nil
+	fake.updateLintRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateLintRulesReturnsOnCall(i int, result1 error) {
+	fake.updateLintRulesMutex.Lock()
+	defer fake.updateLintRulesMutex.Unlock()
+	fake.UpdateLintRulesStub = nil
+	if fake.updateLintRulesReturnsOnCall == nil {
+		fake.updateLintRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateLintRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2113,6 +2342,128 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateQuotas(arg1 atc.TeamQuotas) error {
+	fake.updateQuotasMutex.Lock()
+	ret, specificReturn := fake.updateQuotasReturnsOnCall[len(fake.updateQuotasArgsForCall)]
+	fake.updateQuotasArgsForCall = append(fake.updateQuotasArgsForCall, struct {
+		arg1 atc.TeamQuotas
+	}{arg1})
+	fake.recordInvocation("UpdateQuotas", []interface{}{arg1})
+	fake.updateQuotasMutex.Unlock()
+	if fake.UpdateQuotasStub != nil {
+		return fake.UpdateQuotasStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateQuotasReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateQuotasCallCount() int {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	return len(fake.updateQuotasArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateQuotasCalls(stub func(atc.TeamQuotas) error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = stub
+}
+
+func (fake *FakeTeam) UpdateQuotasArgsForCall(i int) atc.TeamQuotas {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	argsForCall := fake.updateQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateQuotasReturns(result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	fake.updateQuotasReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateQuotasReturnsOnCall(i int, result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	if fake.updateQuotasReturnsOnCall == nil {
+		fake.updateQuotasReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateQuotasReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateSettings(arg1 atc.TeamAuth, arg2 atc.LintRules, arg3 *atc.TeamQuotas) error {
+	fake.updateSettingsMutex.Lock()
+	ret, specificReturn := fake.updateSettingsReturnsOnCall[len(fake.updateSettingsArgsForCall)]
+	fake.updateSettingsArgsForCall = append(fake.updateSettingsArgsForCall, struct {
+		arg1 atc.TeamAuth
+		arg2 atc.LintRules
+		arg3 *atc.TeamQuotas
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateSettings", []interface{}{arg1, arg2, arg3})
+	fake.updateSettingsMutex.Unlock()
+	if fake.UpdateSettingsStub != nil {
+		return fake.UpdateSettingsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateSettingsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateSettingsCallCount() int {
+	fake.updateSettingsMutex.RLock()
+	defer fake.updateSettingsMutex.RUnlock()
+	return len(fake.updateSettingsArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateSettingsCalls(stub func(atc.TeamAuth, atc.LintRules, *atc.TeamQuotas) error) {
+	fake.updateSettingsMutex.Lock()
+	defer fake.updateSettingsMutex.Unlock()
+	fake.UpdateSettingsStub = stub
+}
+
+func (fake *FakeTeam) UpdateSettingsArgsForCall(i int) (atc.TeamAuth, atc.LintRules, *atc.TeamQuotas) {
+	fake.updateSettingsMutex.RLock()
+	defer fake.updateSettingsMutex.RUnlock()
+	argsForCall := fake.updateSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) UpdateSettingsReturns(result1 error) {
+	fake.updateSettingsMutex.Lock()
+	defer fake.updateSettingsMutex.Unlock()
+	fake.UpdateSettingsStub = nil
+	fake.updateSettingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateSettingsReturnsOnCall(i int, result1 error) {
+	fake.updateSettingsMutex.Lock()
+	defer fake.updateSettingsMutex.Unlock()
+	fake.UpdateSettingsStub = nil
+	if fake.updateSettingsReturnsOnCall == nil {
+		fake.updateSettingsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateSettingsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2131,6 +2482,8 @@ func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 }
 
 func (fake *FakeTeam) WorkersCallCount() int {
+	fake.updateSettingsMutex.RLock()
+	defer fake.updateSettingsMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	return len(fake.workersArgsForCall)
@@ -2207,6 +2560,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isCheckContainerMutex.RUnlock()
 	fake.isContainerWithinTeamMutex.RLock()
 	defer fake.isContainerWithinTeamMutex.RUnlock()
+	fake.lintRulesMutex.RLock()
+	defer fake.lintRulesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
@@ -2219,14 +2574,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updateLintRulesMutex.RLock()
+	defer fake.updateLintRulesMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
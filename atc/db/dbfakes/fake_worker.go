@@ -42,6 +42,16 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	AllocatableStub        func() atc.ResourceRequests
+	allocatableMutex       sync.RWMutex
+	allocatableArgsForCall []struct {
+	}
+	allocatableReturns struct {
+		result1 atc.ResourceRequests
+	}
+	allocatableReturnsOnCall map[int]struct {
+		result1 atc.ResourceRequests
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -62,6 +72,32 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CommitRequestsStub        func(db.ContainerOwner, atc.ResourceRequests) (bool, error)
+	commitRequestsMutex       sync.RWMutex
+	commitRequestsArgsForCall []struct {
+		arg1 db.ContainerOwner
+		arg2 atc.ResourceRequests
+	}
+	commitRequestsReturns struct {
+		result1 bool
+		result2 error
+	}
+	commitRequestsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CommittedRequestsStub        func() (atc.ResourceRequests, error)
+	committedRequestsMutex       sync.RWMutex
+	committedRequestsArgsForCall []struct {
+	}
+	committedRequestsReturns struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}
+	committedRequestsReturnsOnCall map[int]struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}
 	CreateContainerStub        func(db.ContainerOwner, db.ContainerMetadata) (db.CreatingContainer, error)
 	createContainerMutex       sync.RWMutex
 	createContainerArgsForCall []struct {
@@ -171,6 +207,16 @@ type FakeWorker struct {
 	increaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -201,6 +247,16 @@ type FakeWorker struct {
 	noProxyReturnsOnCall map[int]struct {
 		result1 string
 	}
+	P2PURLStub        func() string
+	p2PURLMutex       sync.RWMutex
+	p2PURLArgsForCall []struct {
+	}
+	p2PURLReturns struct {
+		result1 string
+	}
+	p2PURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PlatformStub        func() string
 	platformMutex       sync.RWMutex
 	platformArgsForCall []struct {
@@ -221,6 +277,17 @@ type FakeWorker struct {
 	pruneReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseRequestsStub        func(db.ContainerOwner) error
+	releaseRequestsMutex       sync.RWMutex
+	releaseRequestsArgsForCall []struct {
+		arg1 db.ContainerOwner
+	}
+	releaseRequestsReturns struct {
+		result1 error
+	}
+	releaseRequestsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -327,6 +394,26 @@ type FakeWorker struct {
 	versionReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	VolumeStoreCapacityStub        func() int64
+	volumeStoreCapacityMutex       sync.RWMutex
+	volumeStoreCapacityArgsForCall []struct {
+	}
+	volumeStoreCapacityReturns struct {
+		result1 int64
+	}
+	volumeStoreCapacityReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	VolumeStoreUsedStub        func() int64
+	volumeStoreUsedMutex       sync.RWMutex
+	volumeStoreUsedArgsForCall []struct {
+	}
+	volumeStoreUsedReturns struct {
+		result1 int64
+	}
+	volumeStoreUsedReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -490,6 +577,58 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Allocatable() atc.ResourceRequests {
+	fake.allocatableMutex.Lock()
+	ret, specificReturn := fake.allocatableReturnsOnCall[len(fake.allocatableArgsForCall)]
+	fake.allocatableArgsForCall = append(fake.allocatableArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Allocatable", []interface{}{})
+	fake.allocatableMutex.Unlock()
+	if fake.AllocatableStub != nil {
+		return fake.AllocatableStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableCallCount() int {
+	fake.allocatableMutex.RLock()
+	defer fake.allocatableMutex.RUnlock()
+	return len(fake.allocatableArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableCalls(stub func() atc.ResourceRequests) {
+	fake.allocatableMutex.Lock()
+	defer fake.allocatableMutex.Unlock()
+	fake.AllocatableStub = stub
+}
+
+func (fake *FakeWorker) AllocatableReturns(result1 atc.ResourceRequests) {
+	fake.allocatableMutex.Lock()
+	defer fake.allocatableMutex.Unlock()
+	fake.AllocatableStub = nil
+	fake.allocatableReturns = struct {
+		result1 atc.ResourceRequests
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableReturnsOnCall(i int, result1 atc.ResourceRequests) {
+	fake.allocatableMutex.Lock()
+	defer fake.allocatableMutex.Unlock()
+	fake.AllocatableStub = nil
+	if fake.allocatableReturnsOnCall == nil {
+		fake.allocatableReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceRequests
+		})
+	}
+	fake.allocatableReturnsOnCall[i] = struct {
+		result1 atc.ResourceRequests
+	}{result1}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -594,6 +733,127 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) CommitRequests(arg1 db.ContainerOwner, arg2 atc.ResourceRequests) (bool, error) {
+	fake.commitRequestsMutex.Lock()
+	ret, specificReturn := fake.commitRequestsReturnsOnCall[len(fake.commitRequestsArgsForCall)]
+	fake.commitRequestsArgsForCall = append(fake.commitRequestsArgsForCall, struct {
+		arg1 db.ContainerOwner
+		arg2 atc.ResourceRequests
+	}{arg1, arg2})
+	fake.recordInvocation("CommitRequests", []interface{}{arg1, arg2})
+	fake.commitRequestsMutex.Unlock()
+	if fake.CommitRequestsStub != nil {
+		return fake.CommitRequestsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.commitRequestsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CommitRequestsCallCount() int {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	return len(fake.commitRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) CommitRequestsCalls(stub func(db.ContainerOwner, atc.ResourceRequests) (bool, error)) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = stub
+}
+
+func (fake *FakeWorker) CommitRequestsArgsForCall(i int) (db.ContainerOwner, atc.ResourceRequests) {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	argsForCall := fake.commitRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) CommitRequestsReturns(result1 bool, result2 error) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = nil
+	fake.commitRequestsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommitRequestsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = nil
+	if fake.commitRequestsReturnsOnCall == nil {
+		fake.commitRequestsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.commitRequestsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommittedRequests() (atc.ResourceRequests, error) {
+	fake.committedRequestsMutex.Lock()
+	ret, specificReturn := fake.committedRequestsReturnsOnCall[len(fake.committedRequestsArgsForCall)]
+	fake.committedRequestsArgsForCall = append(fake.committedRequestsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CommittedRequests", []interface{}{})
+	fake.committedRequestsMutex.Unlock()
+	if fake.CommittedRequestsStub != nil {
+		return fake.CommittedRequestsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.committedRequestsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CommittedRequestsCallCount() int {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	fake.committedRequestsMutex.RLock()
+	defer fake.committedRequestsMutex.RUnlock()
+	return len(fake.committedRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) CommittedRequestsCalls(stub func() (atc.ResourceRequests, error)) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = stub
+}
+
+func (fake *FakeWorker) CommittedRequestsReturns(result1 atc.ResourceRequests, result2 error) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = nil
+	fake.committedRequestsReturns = struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommittedRequestsReturnsOnCall(i int, result1 atc.ResourceRequests, result2 error) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = nil
+	if fake.committedRequestsReturnsOnCall == nil {
+		fake.committedRequestsReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceRequests
+			result2 error
+		})
+	}
+	fake.committedRequestsReturnsOnCall[i] = struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.ContainerMetadata) (db.CreatingContainer, error) {
 	fake.createContainerMutex.Lock()
 	ret, specificReturn := fake.createContainerReturnsOnCall[len(fake.createContainerArgsForCall)]
@@ -1140,6 +1400,58 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
@@ -1296,6 +1608,58 @@ func (fake *FakeWorker) NoProxyReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) P2PURL() string {
+	fake.p2PURLMutex.Lock()
+	ret, specificReturn := fake.p2PURLReturnsOnCall[len(fake.p2PURLArgsForCall)]
+	fake.p2PURLArgsForCall = append(fake.p2PURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("P2PURL", []interface{}{})
+	fake.p2PURLMutex.Unlock()
+	if fake.P2PURLStub != nil {
+		return fake.P2PURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.p2PURLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) P2PURLCallCount() int {
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
+	return len(fake.p2PURLArgsForCall)
+}
+
+func (fake *FakeWorker) P2PURLCalls(stub func() string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = stub
+}
+
+func (fake *FakeWorker) P2PURLReturns(result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	fake.p2PURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) P2PURLReturnsOnCall(i int, result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	if fake.p2PURLReturnsOnCall == nil {
+		fake.p2PURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.p2PURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) Platform() string {
 	fake.platformMutex.Lock()
 	ret, specificReturn := fake.platformReturnsOnCall[len(fake.platformArgsForCall)]
@@ -1400,6 +1764,66 @@ func (fake *FakeWorker) PruneReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ReleaseRequests(arg1 db.ContainerOwner) error {
+	fake.releaseRequestsMutex.Lock()
+	ret, specificReturn := fake.releaseRequestsReturnsOnCall[len(fake.releaseRequestsArgsForCall)]
+	fake.releaseRequestsArgsForCall = append(fake.releaseRequestsArgsForCall, struct {
+		arg1 db.ContainerOwner
+	}{arg1})
+	fake.recordInvocation("ReleaseRequests", []interface{}{arg1})
+	fake.releaseRequestsMutex.Unlock()
+	if fake.ReleaseRequestsStub != nil {
+		return fake.ReleaseRequestsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseRequestsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ReleaseRequestsCallCount() int {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
+	return len(fake.releaseRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) ReleaseRequestsCalls(stub func(db.ContainerOwner) error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = stub
+}
+
+func (fake *FakeWorker) ReleaseRequestsArgsForCall(i int) db.ContainerOwner {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
+	argsForCall := fake.releaseRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) ReleaseRequestsReturns(result1 error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = nil
+	fake.releaseRequestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ReleaseRequestsReturnsOnCall(i int, result1 error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = nil
+	if fake.releaseRequestsReturnsOnCall == nil {
+		fake.releaseRequestsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseRequestsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -1418,6 +1842,8 @@ func (fake *FakeWorker) Reload() (bool, error) {
 }
 
 func (fake *FakeWorker) ReloadCallCount() int {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	return len(fake.reloadArgsForCall)
@@ -1929,6 +2355,110 @@ func (fake *FakeWorker) VersionReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) VolumeStoreCapacity() int64 {
+	fake.volumeStoreCapacityMutex.Lock()
+	ret, specificReturn := fake.volumeStoreCapacityReturnsOnCall[len(fake.volumeStoreCapacityArgsForCall)]
+	fake.volumeStoreCapacityArgsForCall = append(fake.volumeStoreCapacityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VolumeStoreCapacity", []interface{}{})
+	fake.volumeStoreCapacityMutex.Unlock()
+	if fake.VolumeStoreCapacityStub != nil {
+		return fake.VolumeStoreCapacityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.volumeStoreCapacityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) VolumeStoreCapacityCallCount() int {
+	fake.volumeStoreCapacityMutex.RLock()
+	defer fake.volumeStoreCapacityMutex.RUnlock()
+	return len(fake.volumeStoreCapacityArgsForCall)
+}
+
+func (fake *FakeWorker) VolumeStoreCapacityCalls(stub func() int64) {
+	fake.volumeStoreCapacityMutex.Lock()
+	defer fake.volumeStoreCapacityMutex.Unlock()
+	fake.VolumeStoreCapacityStub = stub
+}
+
+func (fake *FakeWorker) VolumeStoreCapacityReturns(result1 int64) {
+	fake.volumeStoreCapacityMutex.Lock()
+	defer fake.volumeStoreCapacityMutex.Unlock()
+	fake.VolumeStoreCapacityStub = nil
+	fake.volumeStoreCapacityReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) VolumeStoreCapacityReturnsOnCall(i int, result1 int64) {
+	fake.volumeStoreCapacityMutex.Lock()
+	defer fake.volumeStoreCapacityMutex.Unlock()
+	fake.VolumeStoreCapacityStub = nil
+	if fake.volumeStoreCapacityReturnsOnCall == nil {
+		fake.volumeStoreCapacityReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.volumeStoreCapacityReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) VolumeStoreUsed() int64 {
+	fake.volumeStoreUsedMutex.Lock()
+	ret, specificReturn := fake.volumeStoreUsedReturnsOnCall[len(fake.volumeStoreUsedArgsForCall)]
+	fake.volumeStoreUsedArgsForCall = append(fake.volumeStoreUsedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VolumeStoreUsed", []interface{}{})
+	fake.volumeStoreUsedMutex.Unlock()
+	if fake.VolumeStoreUsedStub != nil {
+		return fake.VolumeStoreUsedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.volumeStoreUsedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) VolumeStoreUsedCallCount() int {
+	fake.volumeStoreUsedMutex.RLock()
+	defer fake.volumeStoreUsedMutex.RUnlock()
+	return len(fake.volumeStoreUsedArgsForCall)
+}
+
+func (fake *FakeWorker) VolumeStoreUsedCalls(stub func() int64) {
+	fake.volumeStoreUsedMutex.Lock()
+	defer fake.volumeStoreUsedMutex.Unlock()
+	fake.VolumeStoreUsedStub = stub
+}
+
+func (fake *FakeWorker) VolumeStoreUsedReturns(result1 int64) {
+	fake.volumeStoreUsedMutex.Lock()
+	defer fake.volumeStoreUsedMutex.Unlock()
+	fake.VolumeStoreUsedStub = nil
+	fake.volumeStoreUsedReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) VolumeStoreUsedReturnsOnCall(i int, result1 int64) {
+	fake.volumeStoreUsedMutex.Lock()
+	defer fake.volumeStoreUsedMutex.Unlock()
+	fake.VolumeStoreUsedStub = nil
+	if fake.volumeStoreUsedReturnsOnCall == nil {
+		fake.volumeStoreUsedReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.volumeStoreUsedReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1938,10 +2468,14 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.allocatableMutex.RLock()
+	defer fake.allocatableMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.committedRequestsMutex.RLock()
+	defer fake.committedRequestsMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
@@ -1962,12 +2496,16 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
 	defer fake.noProxyMutex.RUnlock()
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
 	fake.platformMutex.RLock()
 	defer fake.platformMutex.RUnlock()
 	fake.pruneMutex.RLock()
@@ -1992,6 +2530,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
+	fake.volumeStoreCapacityMutex.RLock()
+	defer fake.volumeStoreCapacityMutex.RUnlock()
+	fake.volumeStoreUsedMutex.RLock()
+	defer fake.volumeStoreUsedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,191 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . HijackSessionFactory
+
+// HijackSessionFactory stores the recordings of hijacked and intercepted
+// sessions for auditing.
+type HijackSessionFactory interface {
+	Start(teamID int, session atc.HijackSession) (int, error)
+	AppendRecording(id int, chunk []byte) error
+	Finish(id int, exitStatus *int) error
+
+	HijackSessions(teamID int) ([]atc.HijackSession, error)
+	Recording(teamID int, id int) ([]byte, bool, error)
+
+	RemoveExpired(retention time.Duration) (int, error)
+}
+
+type hijackSessionFactory struct {
+	conn Conn
+}
+
+func NewHijackSessionFactory(conn Conn) HijackSessionFactory {
+	return &hijackSessionFactory{
+		conn: conn,
+	}
+}
+
+// Start records the start of a session, returning its ID.
+func (f *hijackSessionFactory) Start(teamID int, session atc.HijackSession) (int, error) {
+	container, err := json.Marshal(session.Container)
+	if err != nil {
+		return 0, err
+	}
+
+	args := session.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	argsPayload, err := json.Marshal(args)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = psql.Insert("hijack_sessions").
+		Columns("team_id", "user_name", "container", "path", "args").
+		Values(teamID, session.User, string(container), session.Path, string(argsPayload)).
+		Suffix("RETURNING id").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// AppendRecording appends to the session's recording, which is saved in
+// chunks while the session runs.
+func (f *hijackSessionFactory) AppendRecording(id int, chunk []byte) error {
+	_, err := psql.Update("hijack_sessions").
+		Set("recording", sq.Expr("COALESCE(recording, ''::bytea) || ?", chunk)).
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+// Finish records the end of a session. The exit status is nil if the process
+// did not exit, e.g. because the connection was lost.
+func (f *hijackSessionFactory) Finish(id int, exitStatus *int) error {
+	_, err := psql.Update("hijack_sessions").
+		Set("ended_at", sq.Expr("now()")).
+		Set("exit_status", exitStatus).
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+// HijackSessions returns the team's sessions, most recent first. Recordings
+// are left out; see Recording.
+func (f *hijackSessionFactory) HijackSessions(teamID int) ([]atc.HijackSession, error) {
+	rows, err := psql.Select("s.id", "t.name", "s.user_name", "s.container", "s.path", "s.args", "s.started_at", "s.ended_at", "s.exit_status").
+		From("hijack_sessions s").
+		Join("teams t ON t.id = s.team_id").
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.started_at DESC", "s.id DESC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	sessions := []atc.HijackSession{}
+	for rows.Next() {
+		var session atc.HijackSession
+		var container, args []byte
+		var startedAt time.Time
+		var endedAt sql.NullTime
+		var exitStatus sql.NullInt64
+
+		err = rows.Scan(&session.ID, &session.TeamName, &session.User, &container, &session.Path, &args, &startedAt, &endedAt, &exitStatus)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(container, &session.Container)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(args, &session.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		session.StartTime = startedAt.Unix()
+
+		if endedAt.Valid {
+			session.EndTime = endedAt.Time.Unix()
+		}
+
+		if exitStatus.Valid {
+			status := int(exitStatus.Int64)
+			session.ExitStatus = &status
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// Recording returns the asciicast recorded for a session so far. It returns
+// false if the team has no such session or nothing has been recorded yet.
+func (f *hijackSessionFactory) Recording(teamID int, id int) ([]byte, bool, error) {
+	var recording []byte
+	err := psql.Select("recording").
+		From("hijack_sessions").
+		Where(sq.Eq{
+			"id":      id,
+			"team_id": teamID,
+		}).
+		Where(sq.NotEq{"recording": nil}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&recording)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return recording, true, nil
+}
+
+// RemoveExpired removes sessions which ended, or started if they never ended,
+// longer ago than the retention period.
+func (f *hijackSessionFactory) RemoveExpired(retention time.Duration) (int, error) {
+	result, err := psql.Delete("hijack_sessions").
+		Where(sq.Expr("COALESCE(ended_at, started_at) < NOW() - ?::interval", fmt.Sprintf("%.0f seconds", retention.Seconds()))).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
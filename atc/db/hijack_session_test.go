@@ -0,0 +1,160 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HijackSessionFactory", func() {
+	var (
+		session   atc.HijackSession
+		sessionID int
+	)
+
+	BeforeEach(func() {
+		session = atc.HijackSession{
+			User: "some-user",
+			Container: atc.HijackSessionContainer{
+				Handle:       "some-handle",
+				WorkerName:   "some-worker",
+				Type:         "task",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				BuildID:      42,
+				BuildName:    "3",
+				StepName:     "some-task",
+			},
+			Path: "bash",
+			Args: []string{"-l"},
+		}
+
+		var err error
+		sessionID, err = hijackSessionFactory.Start(defaultTeam.ID(), session)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("HijackSessions", func() {
+		It("returns the team's sessions", func() {
+			sessions, err := hijackSessionFactory.HijackSessions(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+
+			Expect(sessions[0].ID).To(Equal(sessionID))
+			Expect(sessions[0].TeamName).To(Equal(defaultTeam.Name()))
+			Expect(sessions[0].User).To(Equal("some-user"))
+			Expect(sessions[0].Container).To(Equal(session.Container))
+			Expect(sessions[0].Path).To(Equal("bash"))
+			Expect(sessions[0].Args).To(Equal([]string{"-l"}))
+			Expect(sessions[0].StartTime).ToNot(BeZero())
+			Expect(sessions[0].EndTime).To(BeZero())
+			Expect(sessions[0].ExitStatus).To(BeNil())
+		})
+
+		It("does not return other teams' sessions", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			sessions, err := hijackSessionFactory.HijackSessions(otherTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(BeEmpty())
+		})
+
+		Context("when the session has finished", func() {
+			BeforeEach(func() {
+				status := 1
+				err := hijackSessionFactory.Finish(sessionID, &status)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns its end time and exit status", func() {
+				sessions, err := hijackSessionFactory.HijackSessions(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sessions).To(HaveLen(1))
+				Expect(sessions[0].EndTime).ToNot(BeZero())
+				Expect(sessions[0].ExitStatus).ToNot(BeNil())
+				Expect(*sessions[0].ExitStatus).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("Recording", func() {
+		Context("when nothing has been recorded", func() {
+			It("is not found", func() {
+				_, found, err := hijackSessionFactory.Recording(defaultTeam.ID(), sessionID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the recording has been saved in chunks", func() {
+			BeforeEach(func() {
+				err := hijackSessionFactory.AppendRecording(sessionID, []byte("some-"))
+				Expect(err).ToNot(HaveOccurred())
+
+				err = hijackSessionFactory.AppendRecording(sessionID, []byte("recording"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the recording so far, even if the session has not finished", func() {
+				recording, found, err := hijackSessionFactory.Recording(defaultTeam.ID(), sessionID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(string(recording)).To(Equal("some-recording"))
+			})
+
+			Context("when the session has finished", func() {
+				BeforeEach(func() {
+					err := hijackSessionFactory.Finish(sessionID, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps the recording", func() {
+					recording, found, err := hijackSessionFactory.Recording(defaultTeam.ID(), sessionID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(string(recording)).To(Equal("some-recording"))
+				})
+			})
+
+			It("is not found for other teams", func() {
+				otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, found, err := hijackSessionFactory.Recording(otherTeam.ID(), sessionID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RemoveExpired", func() {
+		var staleSessionID int
+
+		BeforeEach(func() {
+			var err error
+			staleSessionID, err = hijackSessionFactory.Start(defaultTeam.ID(), session)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = hijackSessionFactory.Finish(staleSessionID, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec("UPDATE hijack_sessions SET ended_at = NOW() - '2 days'::interval WHERE id = $1", staleSessionID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes sessions which ended before the retention period", func() {
+			removed, err := hijackSessionFactory.RemoveExpired(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			sessions, err := hijackSessionFactory.HijackSessions(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].ID).To(Equal(sessionID))
+		})
+	})
+})
@@ -26,8 +26,26 @@ type InputConfig struct {
 	Passed          JobSet
 	UseEveryVersion bool
 	PinnedVersion   atc.Version
+	Filter          *atc.VersionFilter
 	ResourceID      int
 	JobID           int
+
+	// PassedResourceIDs maps passed jobs in other pipelines to the resource in
+	// their pipeline with the same resource config as this input's resource.
+	// Their build outputs are recorded against that resource rather than ours,
+	// and are matched to our versions by their version_md5, so this does not
+	// depend on the resources sharing a resource config scope.
+	PassedResourceIDs map[int]int
+}
+
+// ResourceIDForPassedJob returns the ID of the resource that the given passed
+// job records this input's versions against.
+func (cfg InputConfig) ResourceIDForPassedJob(passedJobID int) int {
+	if resourceID, found := cfg.PassedResourceIDs[passedJobID]; found {
+		return resourceID
+	}
+
+	return cfg.ResourceID
 }
 
 func (cfgs InputConfigs) String() string {
@@ -81,6 +99,7 @@ type Job interface {
 	ScheduleBuild(Build) (bool, error)
 	CreateBuild() (Build, error)
 	RerunBuild(Build) (Build, error)
+	PartialRerunBuild(build Build, fromStep string) (Build, error)
 
 	RequestSchedule() error
 	UpdateLastScheduled(time.Time) error
@@ -98,6 +117,7 @@ type Job interface {
 	SaveNextInputMapping(inputMapping InputMapping, inputsDetermined bool) error
 
 	ClearTaskCache(string, string) (int64, error)
+	ClearTaskResultCache(string) (int64, error)
 
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
 
@@ -224,13 +244,13 @@ func (j *job) Config() (atc.JobConfig, error) {
 }
 
 func (j *job) AlgorithmInputs() (InputConfigs, error) {
-	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger").
+	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger", "ji.filter").
 		From("job_inputs ji").
 		LeftJoin("resource_pins rp ON rp.resource_id = ji.resource_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger").
+		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger, ji.filter").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -240,12 +260,12 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 	var inputs InputConfigs
 	for rows.Next() {
 		var passedJobs []sql.NullInt64
-		var configVersionString, pinnedVersionString sql.NullString
+		var configVersionString, pinnedVersionString, filterString sql.NullString
 		var inputName string
 		var resourceID int
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger)
+		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger, &filterString)
 		if err != nil {
 			return nil, err
 		}
@@ -264,6 +284,13 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 			}
 		}
 
+		if filterString.Valid {
+			err = json.Unmarshal([]byte(filterString.String), &inputConfig.Filter)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		var version *atc.VersionConfig
 		if configVersionString.Valid {
 			version = &atc.VersionConfig{}
@@ -293,18 +320,81 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 		inputs = append(inputs, inputConfig)
 	}
 
+	err = j.loadPassedResourceIDs(inputs)
+	if err != nil {
+		return nil, err
+	}
+
 	return inputs, nil
 }
 
+func (j *job) loadPassedResourceIDs(inputs InputConfigs) error {
+	rows, err := j.conn.Query(`
+		SELECT DISTINCT ON (ji.name, ji.passed_job_id) ji.name, ji.passed_job_id, pr.id
+		FROM job_inputs ji
+		JOIN jobs pj ON pj.id = ji.passed_job_id
+		JOIN resources r ON r.id = ji.resource_id
+		JOIN resources pr ON pr.pipeline_id = pj.pipeline_id
+			AND pr.resource_config_id = r.resource_config_id
+		WHERE ji.job_id = $1
+		AND pj.pipeline_id != $2
+		AND pr.active
+		AND (
+			EXISTS (SELECT 1 FROM job_inputs pji WHERE pji.job_id = pj.id AND pji.resource_id = pr.id)
+			OR EXISTS (SELECT 1 FROM job_outputs pjo WHERE pjo.job_id = pj.id AND pjo.resource_id = pr.id)
+		)
+		ORDER BY ji.name, ji.passed_job_id, pr.id`, j.id, j.pipelineID)
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var inputName string
+		var passedJobID, passedResourceID int
+
+		err = rows.Scan(&inputName, &passedJobID, &passedResourceID)
+		if err != nil {
+			return err
+		}
+
+		for i, input := range inputs {
+			if input.Name != inputName {
+				continue
+			}
+
+			if inputs[i].PassedResourceIDs == nil {
+				inputs[i].PassedResourceIDs = map[int]int{}
+			}
+
+			inputs[i].PassedResourceIDs[passedJobID] = passedResourceID
+		}
+	}
+
+	return nil
+}
+
 func (j *job) Inputs() ([]atc.JobInput, error) {
-	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
+	passedJobName := sq.Expr(`array_agg(
+		CASE
+			WHEN p.pipeline_id = ? THEN p.name
+			WHEN pp.team_id = ? THEN pp.name || '/' || p.name
+			ELSE pt.name || '/' || pp.name || '/' || p.name
+		END ORDER BY p.id)`, j.pipelineID, j.teamID)
+
+	rows, err := psql.Select("ji.name", "r.name").
+		Column(passedJobName).
+		Columns("ji.trigger", "ji.version", "ji.filter").
 		From("job_inputs ji").
 		Join("resources r ON r.id = ji.resource_id").
 		LeftJoin("jobs p ON p.id = ji.passed_job_id").
+		LeftJoin("pipelines pp ON pp.id = p.pipeline_id").
+		LeftJoin("teams pt ON pt.id = pp.team_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version").
+		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version, ji.filter").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -314,15 +404,23 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 	var inputs []atc.JobInput
 	for rows.Next() {
 		var passedString []sql.NullString
-		var versionString sql.NullString
+		var versionString, filterString sql.NullString
 		var inputName, resourceName string
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString)
+		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString, &filterString)
 		if err != nil {
 			return nil, err
 		}
 
+		var filter *atc.VersionFilter
+		if filterString.Valid {
+			err = json.Unmarshal([]byte(filterString.String), &filter)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		var version *atc.VersionConfig
 		if versionString.Valid {
 			version = &atc.VersionConfig{}
@@ -344,6 +442,7 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 			Resource: resourceName,
 			Trigger:  trigger,
 			Version:  version,
+			Filter:   filter,
 			Passed:   passed,
 		})
 	}
@@ -550,6 +649,15 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 		return false, nil
 	}
 
+	quotaReached, err := j.isTeamBuildsQuotaReached(tx, build.ID())
+	if err != nil {
+		return false, err
+	}
+
+	if quotaReached {
+		return false, nil
+	}
+
 	reached, err := j.isMaxInFlightReached(tx, build.ID())
 	if err != nil {
 		return false, err
@@ -822,8 +930,22 @@ func (j *job) CreateBuild() (Build, error) {
 }
 
 func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	return j.rerunBuild(buildToRerun, map[string]interface{}{})
+}
+
+// PartialRerunBuild creates a rerun which reuses the results of the steps of
+// the build to rerun which come before the given step. If no step is given,
+// the results of all of the steps which succeeded are reused.
+func (j *job) PartialRerunBuild(buildToRerun Build, fromStep string) (Build, error) {
+	return j.rerunBuild(buildToRerun, map[string]interface{}{
+		"partial_rerun_of": buildToRerun.ID(),
+		"rerun_from_step":  fromStep,
+	})
+}
+
+func (j *job) rerunBuild(buildToRerun Build, vals map[string]interface{}) (Build, error) {
 	for {
-		rerunBuild, err := j.tryRerunBuild(buildToRerun)
+		rerunBuild, err := j.tryRerunBuild(buildToRerun, vals)
 		if err != nil {
 			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
 				continue
@@ -836,7 +958,7 @@ func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 	}
 }
 
-func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
+func (j *job) tryRerunBuild(buildToRerun Build, vals map[string]interface{}) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -854,8 +976,7 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		return nil, err
 	}
 
-	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, rerunBuild, map[string]interface{}{
+	buildVals := map[string]interface{}{
 		"name":         rerunBuildName,
 		"job_id":       j.id,
 		"pipeline_id":  j.pipelineID,
@@ -863,7 +984,14 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		"status":       BuildStatusPending,
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
-	})
+	}
+
+	for name, value := range vals {
+		buildVals[name] = value
+	}
+
+	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, rerunBuild, buildVals)
 	if err != nil {
 		return nil, err
 	}
@@ -926,6 +1054,21 @@ func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	return rowsDeleted, tx.Commit()
 }
 
+func (j *job) ClearTaskResultCache(stepName string) (int64, error) {
+	sqlResult, err := psql.Delete("task_result_caches").
+		Where(sq.Eq{
+			"job_id":    j.id,
+			"step_name": stepName,
+		}).
+		RunWith(j.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return sqlResult.RowsAffected()
+}
+
 func (j *job) AcquireSchedulingLock(logger lager.Logger) (lock.Lock, bool, error) {
 	return j.lockFactory.Acquire(
 		logger.Session("lock", lager.Data{
@@ -936,6 +1079,42 @@ func (j *job) AcquireSchedulingLock(logger lager.Logger) (lock.Lock, bool, error
 	)
 }
 
+// isTeamBuildsQuotaReached returns true if the job's team is already running
+// as many builds as its concurrent builds quota allows. The team stays locked
+// until the transaction ends, so that builds scheduled at the same time cannot
+// exceed the quota together.
+func (j *job) isTeamBuildsQuotaReached(tx Tx, buildID int) (bool, error) {
+	quotas, err := lockTeamQuotas(tx, j.teamID)
+	if err != nil {
+		return false, err
+	}
+
+	if quotas.MaxConcurrentBuilds == 0 {
+		return false, nil
+	}
+
+	var running int
+	err = psql.Select("COUNT(*)").
+		From("builds").
+		Where(sq.Eq{
+			"team_id":   j.teamID,
+			"completed": false,
+		}).
+		Where(sq.Or{
+			sq.Eq{"scheduled": true},
+			sq.Eq{"status": BuildStatusStarted},
+		}).
+		Where(sq.NotEq{"id": buildID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&running)
+	if err != nil {
+		return false, err
+	}
+
+	return running >= quotas.MaxConcurrentBuilds, nil
+}
+
 func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, error) {
 	if j.maxInFlight == 0 {
 		return false, nil
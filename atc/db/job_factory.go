@@ -364,7 +364,7 @@ func (d dashboardFactory) fetchJobInputs() (map[int][]atc.DashboardJobInput, err
 		Join("pipelines p ON p.id = j.pipeline_id").
 		Join("teams tm ON tm.id = p.team_id").
 		Join("resources r ON r.id = i.resource_id").
-		LeftJoin("jobs jp ON jp.id = i.passed_job_id").
+		LeftJoin("jobs jp ON jp.id = i.passed_job_id AND jp.pipeline_id = j.pipeline_id").
 		Where(sq.Eq{
 			"j.active": true,
 		}).
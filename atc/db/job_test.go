@@ -645,6 +645,36 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("PartialRerunBuild", func() {
+		var buildToRerun db.Build
+
+		BeforeEach(func() {
+			var err error
+			buildToRerun, err = job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a rerun which records the build and step to rerun from", func() {
+			rerunBuild, err := job.PartialRerunBuild(buildToRerun, "some-step")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rerunBuild.Name()).To(Equal(fmt.Sprintf("%s.1", buildToRerun.Name())))
+			Expect(rerunBuild.RerunOf()).To(Equal(buildToRerun.ID()))
+
+			build, found, err := job.Build(rerunBuild.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.PartialRerunOf()).To(Equal(buildToRerun.ID()))
+			Expect(build.PartialRerunOfName()).To(Equal(buildToRerun.Name()))
+			Expect(build.RerunFromStep()).To(Equal("some-step"))
+		})
+
+		It("does not mark regular reruns as partial", func() {
+			rerunBuild, err := job.RerunBuild(buildToRerun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rerunBuild.PartialRerunOf()).To(BeZero())
+		})
+	})
+
 	Describe("ScheduleBuild", func() {
 		var (
 			schedulingBuild            db.Build
@@ -898,6 +928,27 @@ var _ = Describe("Job", func() {
 							Expect(schedulingBuild.IsScheduled()).To(BeTrue())
 						})
 					})
+
+					Context("when the team is running as many builds as its quota allows", func() {
+						BeforeEach(func() {
+							err := team.UpdateQuotas(atc.TeamQuotas{MaxConcurrentBuilds: 1})
+							Expect(err).ToNot(HaveOccurred())
+
+							runningBuild, err := job.CreateBuild()
+							Expect(err).ToNot(HaveOccurred())
+
+							scheduled, err := job.ScheduleBuild(runningBuild)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(scheduled).To(BeTrue())
+						})
+
+						It("returns false", func() {
+							Expect(schedulingErr).ToNot(HaveOccurred())
+							Expect(scheduleFound).To(BeFalse())
+							Expect(reloadFound).To(BeTrue())
+							Expect(schedulingBuild.IsScheduled()).To(BeFalse())
+						})
+					})
 				})
 
 				Context("when the build does not exist", func() {
@@ -1346,7 +1397,7 @@ var _ = Describe("Job", func() {
 			)
 			Expect(err).NotTo(HaveOccurred())
 
-			reversions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil)
+			reversions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1497,7 +1548,7 @@ var _ = Describe("Job", func() {
 			}, resourceConfigScope.ResourceConfig(), atc.VersionedResourceTypes{})
 			Expect(err).NotTo(HaveOccurred())
 
-			reversions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil)
+			reversions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -2247,6 +2298,61 @@ var _ = Describe("Job", func() {
 			})
 		})
 
+		Context("when the input has a version filter", func() {
+			BeforeEach(func() {
+				var err error
+				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:     "some-filtered-input",
+										Resource: "some-resource",
+										Filter: &atc.VersionFilter{
+											Metadata: map[string]string{"branch": "master"},
+											Semver:   &atc.SemverFilter{Field: "tag", Range: "^1.2"},
+										},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				inputsJob, found, err = inputsPipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("returns the filter with the input", func() {
+				someResource, found, err := inputsPipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(inputs).To(Equal(db.InputConfigs{
+					{
+						Name:       "some-filtered-input",
+						JobID:      inputsJob.ID(),
+						ResourceID: someResource.ID(),
+						Filter: &atc.VersionFilter{
+							Metadata: map[string]string{"branch": "master"},
+							Semver:   &atc.SemverFilter{Field: "tag", Range: "^1.2"},
+						},
+					},
+				}))
+			})
+		})
+
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				var err error
@@ -2755,6 +2861,175 @@ var _ = Describe("Job", func() {
 				},
 			}))
 		})
+
+		Context("when an input is constrained by jobs in other pipelines", func() {
+			var upstreamConfig atc.Config
+
+			BeforeEach(func() {
+				upstreamConfig = atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.PutStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}
+
+				_, _, err := team.SavePipeline("upstream-pipeline", upstreamConfig, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "upstream-team"})
+				Expect(err).ToNot(HaveOccurred())
+
+				otherPipeline, _, err := otherTeam.SavePipeline("other-upstream-pipeline", upstreamConfig, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = otherPipeline.Expose()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the qualified names of the passed jobs", func() {
+				pipeline, _, err := team.SavePipeline("downstream-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-pipeline/upstream-job", "upstream-team/other-upstream-pipeline/upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				job, found, err := pipeline.Job("downstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				inputs, err := job.Inputs()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(inputs).To(Equal([]atc.JobInput{
+					{
+						Name:     "some-resource",
+						Resource: "some-resource",
+						Passed:   []string{"upstream-pipeline/upstream-job", "upstream-team/other-upstream-pipeline/upstream-job"},
+					},
+				}))
+			})
+
+			It("maps the passed job to its resource with the same resource config without global resources", func() {
+				pipeline, _, err := team.SavePipeline("downstream-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-pipeline/upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				upstreamPipeline, found, err := team.Pipeline("upstream-pipeline")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				upstreamJob, found, err := upstreamPipeline.Job("upstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				upstreamResource, found, err := upstreamPipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				downstreamResource, found, err := pipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				upstreamScope, err := upstreamResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				downstreamScope, err := downstreamResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(upstreamScope.ID()).ToNot(Equal(downstreamScope.ID()))
+
+				job, found, err := pipeline.Job("downstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				inputs, err := job.AlgorithmInputs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(inputs).To(HaveLen(1))
+				Expect(inputs[0].ResourceIDForPassedJob(upstreamJob.ID())).To(Equal(upstreamResource.ID()))
+			})
+
+			It("fails to save when a passed job in another team's pipeline is not exposed", func() {
+				otherTeam, found, err := teamFactory.FindTeam("upstream-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, _, err = otherTeam.SavePipeline("hidden-pipeline", upstreamConfig, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = team.SavePipeline("downstream-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-team/hidden-pipeline/upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false)
+				Expect(err).To(Equal(db.PassedJobNotFoundError{Job: "upstream-team/hidden-pipeline/upstream-job"}))
+			})
+		})
 	})
 
 	Describe("Outputs", func() {
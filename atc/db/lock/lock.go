@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 )
@@ -24,6 +25,20 @@ const (
 	LockTypeJobScheduling
 )
 
+// LockTypeNames maps each type of lock to a name suitable for display and
+// for use as a metric attribute.
+var LockTypeNames = map[int]string{
+	LockTypeResourceConfigChecking: "ResourceConfigChecking",
+	LockTypeBuildTracking:          "BuildTracking",
+	LockTypeJobScheduling:          "JobScheduling",
+	LockTypeBatch:                  "Batch",
+	LockTypeVolumeCreating:         "VolumeCreating",
+	LockTypeContainerCreating:      "ContainerCreating",
+	LockTypeDatabaseMigration:      "DatabaseMigration",
+	LockTypeActiveTasks:            "ActiveTasks",
+	LockTypeResourceScanning:       "ResourceScanning",
+}
+
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
 
 func NewBuildTrackingLockID(buildID int) LockID {
@@ -58,10 +73,46 @@ func NewJobSchedulingLockID(jobID int) LockID {
 	return LockID{LockTypeJobScheduling, jobID}
 }
 
+// NewLockIDFromAdvisoryLock decodes the ID of an advisory lock as reported by
+// pg_locks. Locks taken with a single (bigint) key are reported with an
+// objsubid of 1 and the key split across classid and objid; locks taken with
+// two (int) keys are reported with an objsubid of 2.
+func NewLockIDFromAdvisoryLock(classID uint32, objID uint32, objSubID int) LockID {
+	if objSubID == 2 {
+		return LockID{int(int32(classID)), int(int32(objID))}
+	}
+
+	return LockID{int(int64(classID)<<32 | int64(objID))}
+}
+
+// AdvisoryLock encodes the ID as reported by pg_locks, the inverse of
+// NewLockIDFromAdvisoryLock.
+func (l LockID) AdvisoryLock() (uint32, uint32, int) {
+	if len(l) == 2 {
+		return uint32(int32(l[0])), uint32(int32(l[1])), 2
+	}
+
+	key := int64(l[0])
+	return uint32(key >> 32), uint32(key), 1
+}
+
 //go:generate counterfeiter . LockFactory
 
 type LockFactory interface {
 	Acquire(logger lager.Logger, ids LockID) (Lock, bool, error)
+
+	// AcquiredAt returns when the lock was acquired, if it is currently held
+	// through this factory.
+	AcquiredAt(id LockID) (time.Time, bool)
+
+	// ForceRelease releases a lock held through this factory on behalf of its
+	// holder, whose own release then fails with ErrLostLock. It returns false
+	// if the lock is not held through this factory.
+	ForceRelease(logger lager.Logger, id LockID) (bool, error)
+
+	// SessionID returns the ID of the database session holding the locks
+	// acquired through this factory.
+	SessionID() (int, error)
 }
 
 type lockFactory struct {
@@ -70,15 +121,19 @@ type lockFactory struct {
 	acquireMutex *sync.Mutex
 
 	acquireFunc LogFunc
-	releaseFunc LogFunc
+	releaseFunc ReleaseLogFunc
 }
 
 type LogFunc func(logger lager.Logger, id LockID)
 
+// ReleaseLogFunc is called when a lock is released, along with how long it was
+// held.
+type ReleaseLogFunc func(logger lager.Logger, id LockID, held time.Duration)
+
 func NewLockFactory(
 	conn *sql.DB,
 	acquire LogFunc,
-	release LogFunc,
+	release ReleaseLogFunc,
 ) LockFactory {
 	return &lockFactory{
 		db: &lockDB{
@@ -88,7 +143,7 @@ func NewLockFactory(
 		acquireFunc: acquire,
 		releaseFunc: release,
 		locks: lockRepo{
-			locks: map[string]bool{},
+			locks: map[string]*lock{},
 			mutex: &sync.Mutex{},
 		},
 		acquireMutex: &sync.Mutex{},
@@ -99,12 +154,12 @@ func NewTestLockFactory(db LockDB) LockFactory {
 	return &lockFactory{
 		db: db,
 		locks: lockRepo{
-			locks: map[string]bool{},
+			locks: map[string]*lock{},
 			mutex: &sync.Mutex{},
 		},
 		acquireMutex: &sync.Mutex{},
 		acquireFunc:  func(logger lager.Logger, id LockID) {},
-		releaseFunc:  func(logger lager.Logger, id LockID) {},
+		releaseFunc:  func(logger lager.Logger, id LockID, held time.Duration) {},
 	}
 }
 
@@ -131,6 +186,46 @@ func (f *lockFactory) Acquire(logger lager.Logger, id LockID) (Lock, bool, error
 	return l, true, nil
 }
 
+func (f *lockFactory) AcquiredAt(id LockID) (time.Time, bool) {
+	return f.locks.AcquiredAt(id)
+}
+
+func (f *lockFactory) ForceRelease(logger lager.Logger, id LockID) (bool, error) {
+	f.acquireMutex.Lock()
+	defer f.acquireMutex.Unlock()
+
+	logger = logger.Session("force-release", lager.Data{"id": id})
+
+	held, found := f.locks.Take(id)
+	if !found {
+		logger.Debug("not-held-locally")
+		return false, nil
+	}
+
+	released, err := f.db.Release(id)
+	if err != nil {
+		logger.Error("failed-to-release-in-db", err)
+
+		// leave the lock to its holder
+		f.locks.Register(held)
+
+		return false, err
+	}
+
+	if !released {
+		logger.Error("failed-to-release", ErrLostLock)
+		return false, nil
+	}
+
+	f.releaseFunc(logger, id, time.Since(held.acquiredAt))
+
+	return true, nil
+}
+
+func (f *lockFactory) SessionID() (int, error) {
+	return f.db.SessionID()
+}
+
 //go:generate counterfeiter . Lock
 
 type Lock interface {
@@ -142,10 +237,12 @@ type Lock interface {
 type LockDB interface {
 	Acquire(id LockID) (bool, error)
 	Release(id LockID) (bool, error)
+	SessionID() (int, error)
 }
 
 type lock struct {
-	id LockID
+	id         LockID
+	acquiredAt time.Time
 
 	logger       lager.Logger
 	db           LockDB
@@ -153,7 +250,7 @@ type lock struct {
 	acquireMutex *sync.Mutex
 
 	acquired LogFunc
-	released LogFunc
+	released ReleaseLogFunc
 }
 
 func (l *lock) Acquire() (bool, error) {
@@ -178,7 +275,8 @@ func (l *lock) Acquire() (bool, error) {
 		return false, nil
 	}
 
-	l.locks.Register(l.id)
+	l.acquiredAt = time.Now()
+	l.locks.Register(l)
 
 	l.acquired(logger, l.id)
 
@@ -186,21 +284,29 @@ func (l *lock) Acquire() (bool, error) {
 }
 
 func (l *lock) Release() error {
+	l.acquireMutex.Lock()
+	defer l.acquireMutex.Unlock()
+
 	logger := l.logger.Session("release", lager.Data{"id": l.id})
 
+	if !l.locks.Unregister(l) {
+		// the lock was forcibly released, and may since have been acquired
+		// again on the same session, so it must not be released in the db
+		logger.Error("failed-to-release-forcibly-released", ErrLostLock)
+		return ErrLostLock
+	}
+
 	released, err := l.db.Release(l.id)
 	if err != nil {
 		logger.Error("failed-to-release-in-db-but-continuing-anyway", err)
 	}
 
-	l.locks.Unregister(l.id)
-
 	if !released {
 		logger.Error("failed-to-release", ErrLostLock)
 		return ErrLostLock
 	}
 
-	l.released(logger, l.id)
+	l.released(logger, l.id, time.Since(l.acquiredAt))
 
 	return nil
 }
@@ -236,8 +342,21 @@ func (db *lockDB) Release(id LockID) (bool, error) {
 	return released, nil
 }
 
+func (db *lockDB) SessionID() (int, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	var sessionID int
+	err := db.conn.QueryRow(`SELECT pg_backend_pid()`).Scan(&sessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sessionID, nil
+}
+
 type lockRepo struct {
-	locks map[string]bool
+	locks map[string]*lock
 	mutex *sync.Mutex
 }
 
@@ -251,18 +370,50 @@ func (lr lockRepo) IsRegistered(id LockID) bool {
 	return false
 }
 
-func (lr lockRepo) Register(id LockID) {
+func (lr lockRepo) Register(l *lock) {
 	lr.mutex.Lock()
 	defer lr.mutex.Unlock()
 
-	lr.locks[id.toKey()] = true
+	lr.locks[l.id.toKey()] = l
 }
 
-func (lr lockRepo) Unregister(id LockID) {
+func (lr lockRepo) AcquiredAt(id LockID) (time.Time, bool) {
 	lr.mutex.Lock()
 	defer lr.mutex.Unlock()
 
-	delete(lr.locks, id.toKey())
+	l, ok := lr.locks[id.toKey()]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return l.acquiredAt, true
+}
+
+// Unregister removes the lock, returning false if it is no longer the one
+// registered for its ID.
+func (lr lockRepo) Unregister(l *lock) bool {
+	lr.mutex.Lock()
+	defer lr.mutex.Unlock()
+
+	if lr.locks[l.id.toKey()] != l {
+		return false
+	}
+
+	delete(lr.locks, l.id.toKey())
+	return true
+}
+
+// Take removes and returns the lock registered for the ID, if any.
+func (lr lockRepo) Take(id LockID) (*lock, bool) {
+	lr.mutex.Lock()
+	defer lr.mutex.Unlock()
+
+	l, ok := lr.locks[id.toKey()]
+	if ok {
+		delete(lr.locks, id.toKey())
+	}
+
+	return l, ok
 }
 
 type LockID []int
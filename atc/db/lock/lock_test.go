@@ -29,8 +29,9 @@ var _ = Describe("Locks", func() {
 		team        db.Team
 		teamFactory db.TeamFactory
 
-		logger      *lagertest.TestLogger
-		fakeLogFunc = func(logger lager.Logger, id lock.LockID) {}
+		logger             *lagertest.TestLogger
+		fakeLogFunc        = func(logger lager.Logger, id lock.LockID) {}
+		fakeReleaseLogFunc = func(logger lager.Logger, id lock.LockID, held time.Duration) {}
 	)
 
 	BeforeEach(func() {
@@ -41,7 +42,7 @@ var _ = Describe("Locks", func() {
 
 		logger = lagertest.NewTestLogger("test")
 
-		lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeLogFunc)
+		lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeReleaseLogFunc)
 
 		dbConn = postgresRunner.OpenConn()
 		teamFactory = db.NewTeamFactory(dbConn, lockFactory)
@@ -116,11 +117,95 @@ var _ = Describe("Locks", func() {
 			Expect(acquired).To(BeFalse())
 		})
 
+		It("tracks when held locks were acquired", func() {
+			before := time.Now()
+
+			var acquired bool
+			var err error
+			dbLock, acquired, err = lockFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			acquiredAt, held := lockFactory.AcquiredAt(lock.LockID{42})
+			Expect(held).To(BeTrue())
+			Expect(acquiredAt).To(BeTemporally(">=", before))
+
+			err = dbLock.Release()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, held = lockFactory.AcquiredAt(lock.LockID{42})
+			Expect(held).To(BeFalse())
+		})
+
+		It("reports how long a lock was held when releasing it", func() {
+			var released []time.Duration
+			releasingFactory := lock.NewLockFactory(
+				postgresRunner.OpenSingleton(),
+				fakeLogFunc,
+				func(logger lager.Logger, id lock.LockID, held time.Duration) {
+					released = append(released, held)
+				},
+			)
+
+			heldLock, acquired, err := releasingFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			time.Sleep(100 * time.Millisecond)
+
+			err = heldLock.Release()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(released).To(HaveLen(1))
+			Expect(released[0]).To(BeNumerically(">=", 100*time.Millisecond))
+		})
+
+		It("can decode held locks from pg_locks", func() {
+			var acquired bool
+			var err error
+			dbLock, acquired, err = lockFactory.Acquire(logger, lock.NewJobSchedulingLockID(56))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			migrationLock, acquired, err := lockFactory.Acquire(logger, lock.NewDatabaseMigrationLockID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			defer migrationLock.Release()
+
+			taskLock, acquired, err := lockFactory.Acquire(logger, lock.NewTaskLockID("some-task"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			defer taskLock.Release()
+
+			rows, err := dbConn.Query(`SELECT classid, objid, objsubid FROM pg_locks WHERE locktype = 'advisory'`)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer rows.Close()
+
+			var ids []lock.LockID
+			for rows.Next() {
+				var classID, objID uint32
+				var objSubID int
+				err = rows.Scan(&classID, &objID, &objSubID)
+				Expect(err).NotTo(HaveOccurred())
+
+				ids = append(ids, lock.NewLockIDFromAdvisoryLock(classID, objID, objSubID))
+			}
+
+			Expect(ids).To(ConsistOf(
+				lock.NewJobSchedulingLockID(56),
+				lock.NewDatabaseMigrationLockID(),
+				lock.NewTaskLockID("some-task"),
+			))
+		})
+
 		Context("when another connection is holding the lock", func() {
 			var lockFactory2 lock.LockFactory
 
 			BeforeEach(func() {
-				lockFactory2 = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeLogFunc)
+				lockFactory2 = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeReleaseLogFunc)
 			})
 
 			It("does not acquire the lock", func() {
@@ -244,6 +329,85 @@ var _ = Describe("Locks", func() {
 		})
 	})
 
+	Describe("ForceRelease", func() {
+		var (
+			otherLockFactory lock.LockFactory
+			unrelatedLock    lock.Lock
+		)
+
+		BeforeEach(func() {
+			otherLockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeReleaseLogFunc)
+
+			var acquired bool
+			var err error
+			dbLock, acquired, err = lockFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			unrelatedLock, acquired, err = lockFactory.Acquire(logger, lock.LockID{43})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			_ = unrelatedLock.Release()
+		})
+
+		It("releases only the given lock", func() {
+			released, err := lockFactory.ForceRelease(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(BeTrue())
+
+			otherLock, acquired, err := otherLockFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			defer otherLock.Release()
+
+			_, acquired, err = otherLockFactory.Acquire(logger, lock.LockID{43})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("fails the holder's own release without releasing the lock again", func() {
+			_, err := lockFactory.ForceRelease(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+
+			reacquired, acquired, err := lockFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			defer reacquired.Release()
+
+			Expect(dbLock.Release()).To(Equal(lock.ErrLostLock))
+			dbLock = nil
+
+			_, acquired, err = otherLockFactory.Acquire(logger, lock.LockID{42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		Context("when the lock is not held through the factory", func() {
+			It("returns false", func() {
+				released, err := otherLockFactory.ForceRelease(logger, lock.LockID{42})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("AdvisoryLock", func() {
+		It("encodes the ID as reported by pg_locks", func() {
+			for _, id := range []lock.LockID{
+				lock.NewJobSchedulingLockID(56),
+				lock.NewDatabaseMigrationLockID(),
+				lock.NewTaskLockID("some-task"),
+			} {
+				Expect(lock.NewLockIDFromAdvisoryLock(id.AdvisoryLock())).To(Equal(id))
+			}
+		})
+	})
+
 	Describe("taking out a lock on build tracking", func() {
 		var build db.Build
 
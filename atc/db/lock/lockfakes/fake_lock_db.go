@@ -34,6 +34,18 @@ type FakeLockDB struct {
 		result1 bool
 		result2 error
 	}
+	SessionIDStub        func() (int, error)
+	sessionIDMutex       sync.RWMutex
+	sessionIDArgsForCall []struct {
+	}
+	sessionIDReturns struct {
+		result1 int
+		result2 error
+	}
+	sessionIDReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -164,6 +176,61 @@ func (fake *FakeLockDB) ReleaseReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeLockDB) SessionID() (int, error) {
+	fake.sessionIDMutex.Lock()
+	ret, specificReturn := fake.sessionIDReturnsOnCall[len(fake.sessionIDArgsForCall)]
+	fake.sessionIDArgsForCall = append(fake.sessionIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SessionID", []interface{}{})
+	fake.sessionIDMutex.Unlock()
+	if fake.SessionIDStub != nil {
+		return fake.SessionIDStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.sessionIDReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLockDB) SessionIDCallCount() int {
+	fake.sessionIDMutex.RLock()
+	defer fake.sessionIDMutex.RUnlock()
+	return len(fake.sessionIDArgsForCall)
+}
+
+func (fake *FakeLockDB) SessionIDCalls(stub func() (int, error)) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = stub
+}
+
+func (fake *FakeLockDB) SessionIDReturns(result1 int, result2 error) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = nil
+	fake.sessionIDReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLockDB) SessionIDReturnsOnCall(i int, result1 int, result2 error) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = nil
+	if fake.sessionIDReturnsOnCall == nil {
+		fake.sessionIDReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.sessionIDReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLockDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -171,6 +238,8 @@ func (fake *FakeLockDB) Invocations() map[string][][]interface{} {
 	defer fake.acquireMutex.RUnlock()
 	fake.releaseMutex.RLock()
 	defer fake.releaseMutex.RUnlock()
+	fake.sessionIDMutex.RLock()
+	defer fake.sessionIDMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package lockfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -25,6 +26,45 @@ type FakeLockFactory struct {
 		result2 bool
 		result3 error
 	}
+	AcquiredAtStub        func(lock.LockID) (time.Time, bool)
+	acquiredAtMutex       sync.RWMutex
+	acquiredAtArgsForCall []struct {
+		arg1 lock.LockID
+	}
+	acquiredAtReturns struct {
+		result1 time.Time
+		result2 bool
+	}
+	acquiredAtReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 bool
+	}
+	ForceReleaseStub        func(lager.Logger, lock.LockID) (bool, error)
+	forceReleaseMutex       sync.RWMutex
+	forceReleaseArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 lock.LockID
+	}
+	forceReleaseReturns struct {
+		result1 bool
+		result2 error
+	}
+	forceReleaseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SessionIDStub        func() (int, error)
+	sessionIDMutex       sync.RWMutex
+	sessionIDArgsForCall []struct {
+	}
+	sessionIDReturns struct {
+		result1 int
+		result2 error
+	}
+	sessionIDReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -96,11 +136,199 @@ func (fake *FakeLockFactory) AcquireReturnsOnCall(i int, result1 lock.Lock, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLockFactory) AcquiredAt(arg1 lock.LockID) (time.Time, bool) {
+	fake.acquiredAtMutex.Lock()
+	ret, specificReturn := fake.acquiredAtReturnsOnCall[len(fake.acquiredAtArgsForCall)]
+	fake.acquiredAtArgsForCall = append(fake.acquiredAtArgsForCall, struct {
+		arg1 lock.LockID
+	}{arg1})
+	fake.recordInvocation("AcquiredAt", []interface{}{arg1})
+	fake.acquiredAtMutex.Unlock()
+	if fake.AcquiredAtStub != nil {
+		return fake.AcquiredAtStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.acquiredAtReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLockFactory) AcquiredAtCallCount() int {
+	fake.acquiredAtMutex.RLock()
+	defer fake.acquiredAtMutex.RUnlock()
+	return len(fake.acquiredAtArgsForCall)
+}
+
+func (fake *FakeLockFactory) AcquiredAtCalls(stub func(lock.LockID) (time.Time, bool)) {
+	fake.acquiredAtMutex.Lock()
+	defer fake.acquiredAtMutex.Unlock()
+	fake.AcquiredAtStub = stub
+}
+
+func (fake *FakeLockFactory) AcquiredAtArgsForCall(i int) lock.LockID {
+	fake.acquiredAtMutex.RLock()
+	defer fake.acquiredAtMutex.RUnlock()
+	argsForCall := fake.acquiredAtArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLockFactory) AcquiredAtReturns(result1 time.Time, result2 bool) {
+	fake.acquiredAtMutex.Lock()
+	defer fake.acquiredAtMutex.Unlock()
+	fake.AcquiredAtStub = nil
+	fake.acquiredAtReturns = struct {
+		result1 time.Time
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeLockFactory) AcquiredAtReturnsOnCall(i int, result1 time.Time, result2 bool) {
+	fake.acquiredAtMutex.Lock()
+	defer fake.acquiredAtMutex.Unlock()
+	fake.AcquiredAtStub = nil
+	if fake.acquiredAtReturnsOnCall == nil {
+		fake.acquiredAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 bool
+		})
+	}
+	fake.acquiredAtReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeLockFactory) ForceRelease(arg1 lager.Logger, arg2 lock.LockID) (bool, error) {
+	fake.forceReleaseMutex.Lock()
+	ret, specificReturn := fake.forceReleaseReturnsOnCall[len(fake.forceReleaseArgsForCall)]
+	fake.forceReleaseArgsForCall = append(fake.forceReleaseArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 lock.LockID
+	}{arg1, arg2})
+	fake.recordInvocation("ForceRelease", []interface{}{arg1, arg2})
+	fake.forceReleaseMutex.Unlock()
+	if fake.ForceReleaseStub != nil {
+		return fake.ForceReleaseStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.forceReleaseReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLockFactory) ForceReleaseCallCount() int {
+	fake.forceReleaseMutex.RLock()
+	defer fake.forceReleaseMutex.RUnlock()
+	return len(fake.forceReleaseArgsForCall)
+}
+
+func (fake *FakeLockFactory) ForceReleaseCalls(stub func(lager.Logger, lock.LockID) (bool, error)) {
+	fake.forceReleaseMutex.Lock()
+	defer fake.forceReleaseMutex.Unlock()
+	fake.ForceReleaseStub = stub
+}
+
+func (fake *FakeLockFactory) ForceReleaseArgsForCall(i int) (lager.Logger, lock.LockID) {
+	fake.forceReleaseMutex.RLock()
+	defer fake.forceReleaseMutex.RUnlock()
+	argsForCall := fake.forceReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLockFactory) ForceReleaseReturns(result1 bool, result2 error) {
+	fake.forceReleaseMutex.Lock()
+	defer fake.forceReleaseMutex.Unlock()
+	fake.ForceReleaseStub = nil
+	fake.forceReleaseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLockFactory) ForceReleaseReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.forceReleaseMutex.Lock()
+	defer fake.forceReleaseMutex.Unlock()
+	fake.ForceReleaseStub = nil
+	if fake.forceReleaseReturnsOnCall == nil {
+		fake.forceReleaseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.forceReleaseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLockFactory) SessionID() (int, error) {
+	fake.sessionIDMutex.Lock()
+	ret, specificReturn := fake.sessionIDReturnsOnCall[len(fake.sessionIDArgsForCall)]
+	fake.sessionIDArgsForCall = append(fake.sessionIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SessionID", []interface{}{})
+	fake.sessionIDMutex.Unlock()
+	if fake.SessionIDStub != nil {
+		return fake.SessionIDStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.sessionIDReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLockFactory) SessionIDCallCount() int {
+	fake.sessionIDMutex.RLock()
+	defer fake.sessionIDMutex.RUnlock()
+	return len(fake.sessionIDArgsForCall)
+}
+
+func (fake *FakeLockFactory) SessionIDCalls(stub func() (int, error)) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = stub
+}
+
+func (fake *FakeLockFactory) SessionIDReturns(result1 int, result2 error) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = nil
+	fake.sessionIDReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLockFactory) SessionIDReturnsOnCall(i int, result1 int, result2 error) {
+	fake.sessionIDMutex.Lock()
+	defer fake.sessionIDMutex.Unlock()
+	fake.SessionIDStub = nil
+	if fake.sessionIDReturnsOnCall == nil {
+		fake.sessionIDReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.sessionIDReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLockFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.acquireMutex.RLock()
 	defer fake.acquireMutex.RUnlock()
+	fake.acquiredAtMutex.RLock()
+	defer fake.acquiredAtMutex.RUnlock()
+	fake.forceReleaseMutex.RLock()
+	defer fake.forceReleaseMutex.RUnlock()
+	fake.sessionIDMutex.RLock()
+	defer fake.sessionIDMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
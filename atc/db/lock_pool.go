@@ -0,0 +1,219 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . LockPoolFactory
+
+// LockPoolFactory manages the team-scoped lock pools acquired and released by
+// builds through the `acquire:` and `release:` step modifiers.
+type LockPoolFactory interface {
+	Acquire(teamID int, buildID int, config atc.LockConfig) (bool, error)
+	Release(teamID int, buildID int, name string) error
+
+	LockPools(teamID int) ([]atc.LockPool, error)
+	ForceRelease(teamID int, name string, buildID int) (bool, error)
+}
+
+type lockPoolFactory struct {
+	conn Conn
+}
+
+func NewLockPoolFactory(conn Conn) LockPoolFactory {
+	return &lockPoolFactory{
+		conn: conn,
+	}
+}
+
+// Acquire attempts to acquire a lock from the pool for the build, creating the
+// pool if it does not exist yet. The pool is resized to the configured size,
+// so the most recent acquirer's configuration wins.
+//
+// Acquiring a lock that the build already holds succeeds immediately.
+func (f *lockPoolFactory) Acquire(teamID int, buildID int, config atc.LockConfig) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	// upserting the pool locks its row for the rest of the transaction, which
+	// serializes concurrent attempts to acquire from the same pool
+	var poolID, size int
+	err = psql.Insert("lock_pools").
+		Columns("team_id", "name", "size").
+		Values(teamID, config.Name, config.PoolSize()).
+		Suffix("ON CONFLICT (team_id, name) DO UPDATE SET size = EXCLUDED.size RETURNING id, size").
+		RunWith(tx).
+		QueryRow().
+		Scan(&poolID, &size)
+	if err != nil {
+		return false, err
+	}
+
+	var held bool
+	var holders int
+	err = psql.Select().
+		Column(sq.Expr("COALESCE(bool_or(build_id = ?), false)", buildID)).
+		Column("COUNT(*)").
+		From("lock_pool_holders").
+		Where(sq.Eq{"lock_pool_id": poolID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&held, &holders)
+	if err != nil {
+		return false, err
+	}
+
+	acquired := held
+	if !held && holders < size {
+		_, err = psql.Insert("lock_pool_holders").
+			Columns("lock_pool_id", "build_id").
+			Values(poolID, buildID).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+
+		acquired = true
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return acquired, nil
+}
+
+// Release releases the build's lock from the pool. Releasing a lock that the
+// build does not hold is a no-op.
+func (f *lockPoolFactory) Release(teamID int, buildID int, name string) error {
+	_, err := psql.Delete("lock_pool_holders h").
+		Suffix("USING lock_pools p").
+		Where(sq.Expr("h.lock_pool_id = p.id")).
+		Where(sq.Eq{
+			"p.team_id":  teamID,
+			"p.name":     name,
+			"h.build_id": buildID,
+		}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *lockPoolFactory) LockPools(teamID int) ([]atc.LockPool, error) {
+	rows, err := psql.Select("p.name", "p.size", "t.name", "h.build_id", "b.name", "j.name", "pl.name", "h.acquired_at").
+		From("lock_pools p").
+		Join("teams t ON t.id = p.team_id").
+		LeftJoin("lock_pool_holders h ON h.lock_pool_id = p.id").
+		LeftJoin("builds b ON b.id = h.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines pl ON pl.id = b.pipeline_id").
+		Where(sq.Eq{"p.team_id": teamID}).
+		OrderBy("p.name ASC", "h.acquired_at ASC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	pools := []atc.LockPool{}
+	for rows.Next() {
+		var pool atc.LockPool
+		var buildID sql.NullInt64
+		var buildName, jobName, pipelineName sql.NullString
+		var acquiredAt sql.NullTime
+
+		err = rows.Scan(&pool.Name, &pool.Size, &pool.TeamName, &buildID, &buildName, &jobName, &pipelineName, &acquiredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pools) == 0 || pools[len(pools)-1].Name != pool.Name {
+			pool.Holders = []atc.LockPoolHolder{}
+			pools = append(pools, pool)
+		}
+
+		if !buildID.Valid {
+			continue
+		}
+
+		current := &pools[len(pools)-1]
+		current.Holders = append(current.Holders, atc.LockPoolHolder{
+			BuildID:      int(buildID.Int64),
+			BuildName:    buildName.String,
+			JobName:      jobName.String,
+			PipelineName: pipelineName.String,
+			TeamName:     pool.TeamName,
+			AcquiredAt:   acquiredAt.Time.Unix(),
+		})
+	}
+
+	return pools, nil
+}
+
+// ForceRelease releases a lock regardless of the build holding it. If buildID
+// is 0, every holder of the pool is released. It returns false if the pool
+// does not exist.
+func (f *lockPoolFactory) ForceRelease(teamID int, name string, buildID int) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var poolID int
+	err = psql.Select("id").
+		From("lock_pools").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&poolID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	query := psql.Delete("lock_pool_holders").
+		Where(sq.Eq{"lock_pool_id": poolID})
+
+	if buildID != 0 {
+		query = query.Where(sq.Eq{"build_id": buildID})
+	}
+
+	_, err = query.RunWith(tx).Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func releaseBuildLockPools(tx Tx, buildID int) error {
+	_, err := psql.Delete("lock_pool_holders").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	return err
+}
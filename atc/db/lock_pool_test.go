@@ -0,0 +1,183 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LockPoolFactory", func() {
+	var (
+		build      db.Build
+		otherBuild db.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		otherBuild, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Acquire", func() {
+		It("acquires a mutex for only one build at a time", func() {
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			acquired, err = lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("succeeds again for a build which already holds the lock", func() {
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			acquired, err = lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+		})
+
+		It("allows as many holders as the size of the pool", func() {
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-pool", Size: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			acquired, err = lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-pool", Size: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			thirdBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			acquired, err = lockPoolFactory.Acquire(defaultTeam.ID(), thirdBuild.ID(), atc.LockConfig{Name: "some-pool", Size: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("scopes pools to teams", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTeamBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			acquired, err = lockPoolFactory.Acquire(otherTeam.ID(), otherTeamBuild.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+		})
+	})
+
+	Describe("Release", func() {
+		BeforeEach(func() {
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+		})
+
+		It("allows another build to acquire the lock", func() {
+			err := lockPoolFactory.Release(defaultTeam.ID(), build.ID(), "some-lock")
+			Expect(err).ToNot(HaveOccurred())
+
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+		})
+
+		It("does nothing when the build does not hold the lock", func() {
+			err := lockPoolFactory.Release(defaultTeam.ID(), otherBuild.ID(), "some-lock")
+			Expect(err).ToNot(HaveOccurred())
+
+			acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-lock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		Context("when the build finishes", func() {
+			It("releases all of its locks", func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+
+				acquired, err := lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-lock"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(acquired).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("LockPools", func() {
+		BeforeEach(func() {
+			_, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-pool", Size: 3})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "other-lock"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lockPoolFactory.Release(defaultTeam.ID(), build.ID(), "other-lock")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the team's pools along with their holders", func() {
+			pools, err := lockPoolFactory.LockPools(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pools).To(HaveLen(2))
+
+			Expect(pools[0].Name).To(Equal("other-lock"))
+			Expect(pools[0].Size).To(Equal(1))
+			Expect(pools[0].Holders).To(BeEmpty())
+
+			Expect(pools[1].Name).To(Equal("some-pool"))
+			Expect(pools[1].TeamName).To(Equal("default-team"))
+			Expect(pools[1].Size).To(Equal(3))
+			Expect(pools[1].Holders).To(HaveLen(1))
+			Expect(pools[1].Holders[0].BuildID).To(Equal(build.ID()))
+		})
+	})
+
+	Describe("ForceRelease", func() {
+		BeforeEach(func() {
+			_, err := lockPoolFactory.Acquire(defaultTeam.ID(), build.ID(), atc.LockConfig{Name: "some-pool", Size: 2})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = lockPoolFactory.Acquire(defaultTeam.ID(), otherBuild.ID(), atc.LockConfig{Name: "some-pool", Size: 2})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("releases the given build's lock", func() {
+			found, err := lockPoolFactory.ForceRelease(defaultTeam.ID(), "some-pool", build.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			pools, err := lockPoolFactory.LockPools(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pools[0].Holders).To(HaveLen(1))
+			Expect(pools[0].Holders[0].BuildID).To(Equal(otherBuild.ID()))
+		})
+
+		It("releases every holder when no build is given", func() {
+			found, err := lockPoolFactory.ForceRelease(defaultTeam.ID(), "some-pool", 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			pools, err := lockPoolFactory.LockPools(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pools[0].Holders).To(BeEmpty())
+		})
+
+		It("returns false when the pool does not exist", func() {
+			found, err := lockPoolFactory.ForceRelease(defaultTeam.ID(), "bogus-pool", 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
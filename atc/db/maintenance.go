@@ -0,0 +1,138 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . Maintenance
+
+// Maintenance toggles the cluster-wide maintenance mode, during which the
+// components which start new work (scheduling, checking) are not run.
+type Maintenance interface {
+	Enable(message string) error
+	Disable() error
+
+	Enabled() (bool, error)
+	Status() (atc.Maintenance, error)
+}
+
+type maintenance struct {
+	conn Conn
+}
+
+func NewMaintenance(conn Conn) Maintenance {
+	return &maintenance{
+		conn: conn,
+	}
+}
+
+// Enable enables maintenance mode. Enabling it while it is already enabled
+// only updates the message, keeping the time at which maintenance began.
+func (m *maintenance) Enable(message string) error {
+	tx, err := m.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("maintenance").
+		Set("message", message).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		_, err = psql.Insert("maintenance").
+			Columns("message").
+			Values(message).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (m *maintenance) Disable() error {
+	_, err := psql.Delete("maintenance").RunWith(m.conn).Exec()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *maintenance) Enabled() (bool, error) {
+	var enabled bool
+	err := m.conn.QueryRow(`SELECT EXISTS (SELECT 1 FROM maintenance)`).Scan(&enabled)
+	if err != nil {
+		return false, err
+	}
+
+	return enabled, nil
+}
+
+// Status returns whether maintenance mode is enabled along with the number of
+// builds which are still running or waiting to be started.
+func (m *maintenance) Status() (atc.Maintenance, error) {
+	var status atc.Maintenance
+
+	var startedAt sql.NullTime
+	err := psql.Select("message", "started_at").
+		From("maintenance").
+		Limit(1).
+		RunWith(m.conn).
+		QueryRow().
+		Scan(&status.Message, &startedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return atc.Maintenance{}, err
+	}
+
+	if startedAt.Valid {
+		status.Enabled = true
+		status.StartTime = startedAt.Time.Unix()
+	}
+
+	rows, err := psql.Select("status", "COUNT(*)").
+		From("builds").
+		Where(sq.Eq{"status": []string{string(BuildStatusStarted), string(BuildStatusPending)}}).
+		GroupBy("status").
+		RunWith(m.conn).
+		Query()
+	if err != nil {
+		return atc.Maintenance{}, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var buildStatus string
+		var count int
+		err = rows.Scan(&buildStatus, &count)
+		if err != nil {
+			return atc.Maintenance{}, err
+		}
+
+		switch BuildStatus(buildStatus) {
+		case BuildStatusStarted:
+			status.RunningBuilds = count
+		case BuildStatusPending:
+			status.PendingBuilds = count
+		}
+	}
+
+	return status, rows.Err()
+}
@@ -0,0 +1,102 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance", func() {
+	Context("when maintenance is not enabled", func() {
+		It("is not enabled", func() {
+			enabled, err := dbMaintenance.Enabled()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+
+			status, err := dbMaintenance.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Enabled).To(BeFalse())
+			Expect(status.Message).To(BeEmpty())
+			Expect(status.StartTime).To(BeZero())
+		})
+	})
+
+	Context("when maintenance is enabled", func() {
+		BeforeEach(func() {
+			err := dbMaintenance.Enable("upgrading postgres")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("is enabled", func() {
+			enabled, err := dbMaintenance.Enabled()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+
+			status, err := dbMaintenance.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Enabled).To(BeTrue())
+			Expect(status.Message).To(Equal("upgrading postgres"))
+			Expect(status.StartTime).ToNot(BeZero())
+		})
+
+		Context("when it is enabled again", func() {
+			var startTime int64
+
+			BeforeEach(func() {
+				status, err := dbMaintenance.Status()
+				Expect(err).ToNot(HaveOccurred())
+				startTime = status.StartTime
+
+				err = dbMaintenance.Enable("still upgrading postgres")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("updates the message but keeps the start time", func() {
+				status, err := dbMaintenance.Status()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(status.Message).To(Equal("still upgrading postgres"))
+				Expect(status.StartTime).To(Equal(startTime))
+
+				var count int
+				err = dbConn.QueryRow("SELECT COUNT(*) FROM maintenance").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+		})
+
+		Context("when it is disabled", func() {
+			BeforeEach(func() {
+				err := dbMaintenance.Disable()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is not enabled", func() {
+				enabled, err := dbMaintenance.Enabled()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(enabled).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Status", func() {
+		BeforeEach(func() {
+			_, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = started.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the running and pending builds", func() {
+			status, err := dbMaintenance.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.RunningBuilds).To(Equal(1))
+			Expect(status.PendingBuilds).To(Equal(1))
+			Expect(status.Drained()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,4 @@
+BEGIN;
+  DROP TABLE lock_pool_holders;
+  DROP TABLE lock_pools;
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+  CREATE TABLE lock_pools (
+      id serial PRIMARY KEY,
+      team_id integer REFERENCES teams(id) ON DELETE CASCADE NOT NULL,
+      name text NOT NULL,
+      size integer NOT NULL DEFAULT 1
+  );
+
+  CREATE UNIQUE INDEX lock_pools_team_id_name_key ON lock_pools (team_id, name);
+
+  CREATE TABLE lock_pool_holders (
+      lock_pool_id integer REFERENCES lock_pools(id) ON DELETE CASCADE NOT NULL,
+      build_id integer REFERENCES builds(id) ON DELETE CASCADE NOT NULL,
+      acquired_at timestamp with time zone NOT NULL DEFAULT now(),
+      PRIMARY KEY (lock_pool_id, build_id)
+  );
+
+  CREATE INDEX lock_pool_holders_build_id_idx ON lock_pool_holders (build_id);
+COMMIT;
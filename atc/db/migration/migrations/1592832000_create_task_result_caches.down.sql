@@ -0,0 +1,4 @@
+BEGIN;
+  DROP TABLE task_result_cache_outputs;
+  DROP TABLE task_result_caches;
+COMMIT;
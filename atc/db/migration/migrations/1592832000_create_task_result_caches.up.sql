@@ -0,0 +1,26 @@
+BEGIN;
+  CREATE TABLE task_result_caches (
+      id serial PRIMARY KEY,
+      job_id integer REFERENCES jobs(id) ON DELETE CASCADE NOT NULL,
+      step_name text NOT NULL,
+      key text NOT NULL,
+      exit_status integer NOT NULL,
+      build_id integer REFERENCES builds(id) ON DELETE SET NULL,
+      created_at timestamp with time zone NOT NULL DEFAULT now(),
+      last_used timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE UNIQUE INDEX task_result_caches_job_id_step_name_key_key ON task_result_caches (job_id, step_name, key);
+
+  CREATE TABLE task_result_cache_outputs (
+      task_result_cache_id integer REFERENCES task_result_caches(id) ON DELETE CASCADE NOT NULL,
+      name text NOT NULL,
+      key text NOT NULL,
+      volume_handle text NOT NULL,
+      worker_artifact_id integer REFERENCES worker_artifacts(id) ON DELETE CASCADE NOT NULL,
+      PRIMARY KEY (task_result_cache_id, name)
+  );
+
+  CREATE INDEX task_result_cache_outputs_volume_handle_idx ON task_result_cache_outputs (volume_handle);
+  CREATE INDEX task_result_cache_outputs_worker_artifact_id_idx ON task_result_cache_outputs (worker_artifact_id);
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP TABLE build_step_results;
+
+  ALTER TABLE builds
+    DROP COLUMN partial_rerun_of,
+    DROP COLUMN rerun_from_step;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN partial_rerun_of integer REFERENCES builds(id) ON DELETE SET NULL,
+    ADD COLUMN rerun_from_step text;
+
+  CREATE TABLE build_step_results (
+      build_id integer REFERENCES builds(id) ON DELETE CASCADE NOT NULL,
+      plan_id text NOT NULL,
+      key text NOT NULL,
+      result json NOT NULL,
+      PRIMARY KEY (build_id, plan_id)
+  );
+COMMIT;
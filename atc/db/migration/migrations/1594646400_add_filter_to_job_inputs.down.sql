@@ -0,0 +1,5 @@
+BEGIN;
+  DROP FUNCTION semver_key(text);
+
+  ALTER TABLE job_inputs DROP COLUMN filter;
+COMMIT;
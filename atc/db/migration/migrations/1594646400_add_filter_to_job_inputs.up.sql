@@ -0,0 +1,22 @@
+BEGIN;
+  ALTER TABLE job_inputs ADD COLUMN filter text;
+
+  -- keep in sync with atc.ParseSemverKey
+  CREATE OR REPLACE FUNCTION semver_key(v text) RETURNS bigint[] AS $$
+  DECLARE
+    m text[];
+  BEGIN
+    SELECT regexp_matches(v, '^v?(\d{1,18})(?:\.(\d{1,18}))?(?:\.(\d{1,18}))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$') INTO m;
+    IF m IS NULL THEN
+      RETURN NULL;
+    END IF;
+
+    RETURN ARRAY[
+      m[1]::bigint,
+      COALESCE(m[2], '0')::bigint,
+      COALESCE(m[3], '0')::bigint,
+      CASE WHEN m[4] IS NULL THEN 1 ELSE 0 END
+    ];
+  END;
+  $$ LANGUAGE plpgsql IMMUTABLE;
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE worker_task_caches DROP COLUMN last_used;
+
+  ALTER TABLE worker_resource_caches DROP COLUMN last_used;
+
+  ALTER TABLE workers
+    DROP COLUMN volume_store_capacity,
+    DROP COLUMN volume_store_used;
+COMMIT;
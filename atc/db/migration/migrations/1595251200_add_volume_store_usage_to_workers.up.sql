@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN volume_store_capacity bigint NOT NULL DEFAULT 0,
+    ADD COLUMN volume_store_used bigint NOT NULL DEFAULT 0;
+
+  ALTER TABLE worker_resource_caches ADD COLUMN last_used timestamp with time zone NOT NULL DEFAULT now();
+
+  ALTER TABLE worker_task_caches ADD COLUMN last_used timestamp with time zone NOT NULL DEFAULT now();
+COMMIT;
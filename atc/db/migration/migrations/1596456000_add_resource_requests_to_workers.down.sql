@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN allocatable_cpu,
+    DROP COLUMN allocatable_memory,
+    DROP COLUMN committed_cpu,
+    DROP COLUMN committed_memory;
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE hijack_sessions (
+      id serial PRIMARY KEY,
+      team_id integer REFERENCES teams(id) ON DELETE CASCADE NOT NULL,
+      user_name text NOT NULL,
+      container jsonb NOT NULL DEFAULT '{}',
+      path text NOT NULL,
+      args jsonb NOT NULL DEFAULT '[]',
+      started_at timestamp with time zone NOT NULL DEFAULT now(),
+      ended_at timestamp with time zone,
+      exit_status integer,
+      recording bytea
+  );
+
+  CREATE INDEX hijack_sessions_team_id_idx ON hijack_sessions (team_id);
+  CREATE INDEX hijack_sessions_started_at_idx ON hijack_sessions (started_at);
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  CREATE TABLE maintenance (
+      message text NOT NULL DEFAULT '',
+      started_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
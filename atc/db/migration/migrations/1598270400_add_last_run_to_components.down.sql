@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE components
+      DROP COLUMN last_duration,
+      DROP COLUMN last_error,
+      DROP COLUMN last_ran_by;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE components
+      ADD COLUMN last_duration text,
+      ADD COLUMN last_error text,
+      ADD COLUMN last_ran_by text;
+COMMIT;
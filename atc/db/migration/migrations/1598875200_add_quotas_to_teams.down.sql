@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE teams DROP COLUMN quotas;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE system_lock_releases;
+COMMIT;
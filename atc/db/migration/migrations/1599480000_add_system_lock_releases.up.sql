@@ -0,0 +1,7 @@
+BEGIN;
+  CREATE TABLE system_lock_releases (
+    session_id integer NOT NULL,
+    lock_id integer[] NOT NULL,
+    PRIMARY KEY (session_id, lock_id)
+  );
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE build_quota_waits;
+COMMIT;
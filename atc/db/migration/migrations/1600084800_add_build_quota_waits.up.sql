@@ -0,0 +1,9 @@
+BEGIN;
+  CREATE TABLE build_quota_waits (
+    build_id integer PRIMARY KEY REFERENCES builds (id) ON DELETE CASCADE,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    expires_at timestamp with time zone NOT NULL
+  );
+
+  CREATE INDEX build_quota_waits_team_id_idx ON build_quota_waits (team_id);
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN committed_cpu bigint NOT NULL DEFAULT 0,
+    ADD COLUMN committed_memory bigint NOT NULL DEFAULT 0;
+
+  DROP TABLE worker_resource_commitments;
+COMMIT;
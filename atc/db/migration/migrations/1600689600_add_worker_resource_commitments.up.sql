@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE worker_resource_commitments (
+    worker_name text NOT NULL REFERENCES workers (name) ON DELETE CASCADE,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    cpu bigint NOT NULL DEFAULT 0,
+    memory bigint NOT NULL DEFAULT 0,
+    UNIQUE (build_id, plan_id)
+  );
+
+  CREATE INDEX worker_resource_commitments_worker_name_idx ON worker_resource_commitments (worker_name);
+
+  ALTER TABLE workers
+    DROP COLUMN committed_cpu,
+    DROP COLUMN committed_memory;
+COMMIT;
@@ -0,0 +1,38 @@
+BEGIN;
+  -- keep in sync with atc.ParseSemverKey
+  CREATE OR REPLACE FUNCTION semver_key(v text) RETURNS bigint[] AS $$
+  DECLARE
+    m text[];
+    key bigint[];
+    identifier text;
+  BEGIN
+    SELECT regexp_matches(v, '^v?(\d{1,18})(?:\.(\d{1,18}))?(?:\.(\d{1,18}))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$') INTO m;
+    IF m IS NULL THEN
+      RETURN NULL;
+    END IF;
+
+    key := ARRAY[
+      m[1]::bigint,
+      COALESCE(m[2], '0')::bigint,
+      COALESCE(m[3], '0')::bigint,
+      CASE WHEN m[4] IS NULL THEN 1 ELSE 0 END
+    ];
+
+    IF m[4] IS NOT NULL THEN
+      FOREACH identifier IN ARRAY string_to_array(substr(m[4], 2), '.') LOOP
+        IF identifier ~ '^\d{1,18}$' THEN
+          key := key || ARRAY[0, identifier::bigint];
+        ELSE
+          key := key || 1::bigint;
+          FOR i IN 1..length(identifier) LOOP
+            key := key || ascii(substr(identifier, i, 1))::bigint;
+          END LOOP;
+          key := key || 0::bigint;
+        END IF;
+      END LOOP;
+    END IF;
+
+    RETURN key;
+  END;
+  $$ LANGUAGE plpgsql IMMUTABLE;
+COMMIT;
@@ -2,6 +2,7 @@ package migration_test
 
 import (
 	"database/sql"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 
@@ -15,14 +16,15 @@ import (
 
 var _ = Describe("OpenHelper", func() {
 	var (
-		err         error
-		db          *sql.DB
-		lockDB      *sql.DB
-		lockFactory lock.LockFactory
-		strategy    encryption.Strategy
-		bindata     *migrationfakes.FakeBindata
-		openHelper  *migration.OpenHelper
-		fakeLogFunc = func(logger lager.Logger, id lock.LockID) {}
+		err                error
+		db                 *sql.DB
+		lockDB             *sql.DB
+		lockFactory        lock.LockFactory
+		strategy           encryption.Strategy
+		bindata            *migrationfakes.FakeBindata
+		openHelper         *migration.OpenHelper
+		fakeLogFunc        = func(logger lager.Logger, id lock.LockID) {}
+		fakeReleaseLogFunc = func(logger lager.Logger, id lock.LockID, held time.Duration) {}
 	)
 
 	JustBeforeEach(func() {
@@ -32,7 +34,7 @@ var _ = Describe("OpenHelper", func() {
 		lockDB, err = sql.Open("postgres", postgresRunner.DataSourceName())
 		Expect(err).NotTo(HaveOccurred())
 
-		lockFactory = lock.NewLockFactory(lockDB, fakeLogFunc, fakeLogFunc)
+		lockFactory = lock.NewLockFactory(lockDB, fakeLogFunc, fakeReleaseLogFunc)
 		strategy = encryption.NewNoEncryption()
 		openHelper = migration.NewOpenHelper("postgres", postgresRunner.DataSourceName(), lockFactory, strategy)
 
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/concourse/concourse/atc"
@@ -31,8 +32,11 @@ func (e ErrResourceNotFound) Error() string {
 //go:generate counterfeiter . Pipeline
 
 type Cause struct {
-	ResourceVersionID int `json:"resource_version_id"`
-	BuildID           int `json:"build_id"`
+	ResourceVersionID int    `json:"resource_version_id"`
+	BuildID           int    `json:"build_id"`
+	JobName           string `json:"job_name"`
+	PipelineName      string `json:"pipeline_name"`
+	TeamName          string `json:"team_name"`
 }
 
 type Pipeline interface {
@@ -53,6 +57,10 @@ type Pipeline interface {
 	Reload() (bool, error)
 
 	Causality(versionedResourceID int) ([]Cause, error)
+
+	// VisibleCausality is like Causality, but leaves out builds of other
+	// pipelines unless they are public or belong to one of the given teams.
+	VisibleCausality(versionedResourceID int, teamNames []string) ([]Cause, error)
 	ResourceVersion(resourceConfigVersionID int) (atc.ResourceVersion, bool, error)
 
 	GetBuildsWithVersionAsInput(int, int) ([]Build, error)
@@ -79,6 +87,7 @@ type Pipeline interface {
 	Job(name string) (Job, bool, error)
 	Jobs() (Jobs, error)
 	Dashboard() (atc.Dashboard, error)
+	JobStats(since time.Time) ([]atc.JobStats, error)
 
 	Expose() error
 	Hide() error
@@ -151,49 +160,64 @@ func (p *pipeline) Paused() bool                     { return p.paused }
 func (p *pipeline) Archived() bool                   { return p.archived }
 func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
 
-// IMPORTANT: This method is broken with the new resource config versions changes
-func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
+// Causality returns the builds which used the given resource version as an
+// input, followed transitively through the builds that passed it on via
+// `passed:` constraints. Downstream builds may belong to other pipelines, whose
+// resources are matched by resource config and version rather than by scope.
+func (p *pipeline) Causality(resourceConfigVersionID int) ([]Cause, error) {
+	return p.causality(resourceConfigVersionID, "")
+}
+
+func (p *pipeline) VisibleCausality(resourceConfigVersionID int, teamNames []string) ([]Cause, error) {
+	return p.causality(
+		resourceConfigVersionID,
+		"WHERE bpl.id = $2 OR bpl.public OR t.name = ANY($3)",
+		pq.Array(teamNames),
+	)
+}
+
+func (p *pipeline) causality(resourceConfigVersionID int, condition string, args ...interface{}) ([]Cause, error) {
 	rows, err := p.conn.Query(`
-		WITH RECURSIVE causality(versioned_resource_id, build_id) AS (
-				SELECT bi.versioned_resource_id, bi.build_id
-				FROM build_inputs bi
-				WHERE bi.versioned_resource_id = $1
+		WITH RECURSIVE causality(resource_config_version_id, build_id) AS (
+				SELECT rcv.id, i.build_id
+				FROM resource_config_versions rcv
+				JOIN resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id
+				JOIN build_resource_config_version_inputs i ON i.resource_id = r.id AND i.version_md5 = rcv.version_md5
+				WHERE rcv.id = $1
+				AND r.pipeline_id = $2
 			UNION
-				SELECT bi.versioned_resource_id, bi.build_id
-				FROM causality t
-				INNER JOIN build_outputs bo ON bo.build_id = t.build_id
-				INNER JOIN build_inputs bi ON bi.versioned_resource_id = bo.versioned_resource_id
-				INNER JOIN builds b ON b.id = bi.build_id
-				AND NOT EXISTS (
-					SELECT 1
-					FROM build_outputs obo
-					INNER JOIN builds ob ON ob.id = obo.build_id
-					WHERE obo.build_id < bi.build_id
-					AND ob.job_id = b.job_id
-					AND obo.versioned_resource_id = bi.versioned_resource_id
-				)
+				SELECT c.resource_config_version_id, bp.to_build_id
+				FROM causality c
+				JOIN build_pipes bp ON bp.from_build_id = c.build_id
+				JOIN resource_config_versions rcv ON rcv.id = c.resource_config_version_id
+				JOIN resource_config_scopes rcs ON rcs.id = rcv.resource_config_scope_id
+				JOIN build_resource_config_version_inputs i ON i.build_id = bp.to_build_id AND i.version_md5 = rcv.version_md5
+				JOIN resources r ON r.id = i.resource_id AND r.resource_config_id = rcs.resource_config_id
 		)
-		SELECT c.versioned_resource_id, c.build_id
+		SELECT c.resource_config_version_id, c.build_id, j.name, bpl.name, t.name
 		FROM causality c
-		INNER JOIN builds b ON b.id = c.build_id
-		ORDER BY b.start_time ASC, c.versioned_resource_id ASC
-	`, versionedResourceID)
+		JOIN builds b ON b.id = c.build_id
+		JOIN jobs j ON j.id = b.job_id
+		JOIN pipelines bpl ON bpl.id = j.pipeline_id
+		JOIN teams t ON t.id = bpl.team_id
+		`+condition+`
+		ORDER BY b.start_time ASC, c.build_id ASC
+	`, append([]interface{}{resourceConfigVersionID, p.id}, args...)...)
 	if err != nil {
 		return nil, err
 	}
 
+	defer Close(rows)
+
 	var causality []Cause
 	for rows.Next() {
-		var vrID, buildID int
-		err := rows.Scan(&vrID, &buildID)
+		var cause Cause
+		err := rows.Scan(&cause.ResourceVersionID, &cause.BuildID, &cause.JobName, &cause.PipelineName, &cause.TeamName)
 		if err != nil {
 			return nil, err
 		}
 
-		causality = append(causality, Cause{
-			ResourceVersionID: vrID,
-			BuildID:           buildID,
-		})
+		causality = append(causality, cause)
 	}
 
 	return causality, nil
@@ -587,6 +611,97 @@ func (p *pipeline) Dashboard() (atc.Dashboard, error) {
 	return dashboard, nil
 }
 
+// JobStats summarizes the builds of each of the pipeline's jobs which
+// finished since the given time.
+//
+// A recovery is measured from the end of the first build to fail or error
+// after a success (or the start of the period) to the end of the next build to
+// succeed. Builds are grouped into these streaks by the number of builds which
+// succeeded before them.
+func (p *pipeline) JobStats(since time.Time) ([]atc.JobStats, error) {
+	rows, err := p.conn.Query(`
+		WITH finished AS (
+			SELECT b.job_id, b.status, b.start_time, b.end_time, b.manually_triggered, b.rerun_of,
+				count(*) FILTER (WHERE b.status = 'succeeded') OVER (
+					PARTITION BY b.job_id ORDER BY b.id
+					ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+				) AS streak
+			FROM builds b
+			WHERE b.pipeline_id = $1
+			AND b.job_id IS NOT NULL
+			AND b.end_time >= $2
+			AND b.status IN ('succeeded', 'failed', 'errored', 'aborted')
+		), streaks AS (
+			SELECT job_id,
+				min(end_time) FILTER (WHERE status IN ('failed', 'errored')) AS failed_at,
+				min(end_time) FILTER (WHERE status = 'succeeded') AS recovered_at
+			FROM finished
+			GROUP BY job_id, streak
+		), recoveries AS (
+			SELECT job_id,
+				avg(extract(epoch FROM recovered_at - failed_at)) AS mean,
+				count(*) AS recoveries
+			FROM streaks
+			WHERE failed_at IS NOT NULL
+			AND recovered_at IS NOT NULL
+			GROUP BY job_id
+		), stats AS (
+			SELECT job_id,
+				count(*) AS builds,
+				count(*) FILTER (WHERE status = 'succeeded') AS succeeded,
+				count(*) FILTER (WHERE status = 'failed') AS failed,
+				count(*) FILTER (WHERE status = 'errored') AS errored,
+				count(*) FILTER (WHERE status = 'aborted') AS aborted,
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM end_time - start_time)) AS p50,
+				percentile_cont(0.95) WITHIN GROUP (ORDER BY extract(epoch FROM end_time - start_time)) AS p95,
+				count(*) FILTER (WHERE rerun_of IS NULL AND NOT manually_triggered) AS scheduled,
+				count(*) FILTER (WHERE rerun_of IS NULL AND manually_triggered) AS manual,
+				count(*) FILTER (WHERE rerun_of IS NOT NULL) AS rerun
+			FROM finished
+			GROUP BY job_id
+		)
+		SELECT j.name,
+			coalesce(s.builds, 0), coalesce(s.succeeded, 0), coalesce(s.failed, 0), coalesce(s.errored, 0), coalesce(s.aborted, 0),
+			coalesce(s.p50, 0), coalesce(s.p95, 0),
+			coalesce(r.mean, 0), coalesce(r.recoveries, 0),
+			coalesce(s.scheduled, 0), coalesce(s.manual, 0), coalesce(s.rerun, 0)
+		FROM jobs j
+		LEFT JOIN stats s ON s.job_id = j.id
+		LEFT JOIN recoveries r ON r.job_id = j.id
+		WHERE j.pipeline_id = $1
+		AND j.active
+		ORDER BY j.id ASC
+	`, p.id, since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	stats := []atc.JobStats{}
+	for rows.Next() {
+		var job atc.JobStats
+		err := rows.Scan(
+			&job.JobName,
+			&job.Builds, &job.Succeeded, &job.Failed, &job.Errored, &job.Aborted,
+			&job.DurationP50, &job.DurationP95,
+			&job.MeanTimeToRecovery, &job.Recoveries,
+			&job.Triggers.Scheduled, &job.Triggers.Manual, &job.Triggers.Rerun,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if completed := job.Builds - job.Aborted; completed > 0 {
+			job.SuccessRate = float64(job.Succeeded) / float64(completed)
+		}
+
+		stats = append(stats, job)
+	}
+
+	return stats, nil
+}
+
 func (p *pipeline) Pause() error {
 	_, err := psql.Update("pipelines").
 		Set("paused", true).
@@ -1209,6 +1209,88 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("JobStats", func() {
+		var start time.Time
+
+		BeforeEach(func() {
+			start = time.Now().Add(-time.Hour).Truncate(time.Second)
+
+			job, found, err := pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			finishBuild := func(build db.Build, status db.BuildStatus, startOffset, endOffset int) {
+				err := build.Finish(status)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(`UPDATE builds SET start_time = $2, end_time = $3 WHERE id = $1`,
+					build.ID(),
+					start.Add(time.Duration(startOffset)*time.Second),
+					start.Add(time.Duration(endOffset)*time.Second),
+				)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			oldBuild, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(oldBuild, db.BuildStatusFailed, -200, -100)
+
+			statuses := []db.BuildStatus{db.BuildStatusFailed, db.BuildStatusFailed, db.BuildStatusSucceeded, db.BuildStatusAborted}
+			offsets := [][2]int{{0, 60}, {100, 160}, {200, 320}, {400, 410}}
+
+			var build db.Build
+			for i, status := range statuses {
+				build, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+				finishBuild(build, status, offsets[i][0], offsets[i][1])
+			}
+
+			rerun, err := job.RerunBuild(build)
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(rerun, db.BuildStatusSucceeded, 500, 530)
+		})
+
+		It("summarizes the builds which finished since the given time", func() {
+			stats, err := pipeline.JobStats(start)
+			Expect(err).ToNot(HaveOccurred())
+
+			var jobStats atc.JobStats
+			for _, s := range stats {
+				if s.JobName == "job-name" {
+					jobStats = s
+				}
+			}
+
+			Expect(jobStats).To(Equal(atc.JobStats{
+				JobName:   "job-name",
+				Builds:    5,
+				Succeeded: 2,
+				Failed:    2,
+				Aborted:   1,
+
+				SuccessRate: 0.5,
+
+				DurationP50: 60,
+				DurationP95: 108,
+
+				MeanTimeToRecovery: 260,
+				Recoveries:         1,
+
+				Triggers: atc.JobTriggerStats{
+					Manual: 4,
+					Rerun:  1,
+				},
+			}))
+		})
+
+		It("includes jobs without builds", func() {
+			stats, err := pipeline.JobStats(start)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(stats).To(ContainElement(atc.JobStats{JobName: "some-other-job"}))
+		})
+	})
+
 	Describe("Dashboard", func() {
 		It("returns a Dashboard object with a DashboardJob corresponding to each configured job", func() {
 			job, found, err := pipeline.Job("job-name")
@@ -2089,6 +2171,97 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("VisibleCausality", func() {
+		var (
+			otherPipeline db.Pipeline
+			rcvID         int
+			upstreamID    int
+			downstreamID  int
+		)
+
+		BeforeEach(func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherPipeline, _, err = otherTeam.SavePipeline("other-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{{Name: "other-job"}},
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "source"},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			scope, err := resource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = scope.SaveVersions(nil, []atc.Version{{"version": "1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			rcv, found, err := scope.FindVersion(atc.Version{"version": "1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			rcvID = rcv.ID()
+
+			otherResource, found, err := otherPipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			_, err = otherResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			upstreamID = runBuildWithInput(job, resource.ID(), []int{})
+
+			otherJob, found, err := otherPipeline.Job("other-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			downstreamID = runBuildWithInput(otherJob, otherResource.ID(), []int{upstreamID})
+		})
+
+		It("leaves out builds of private pipelines belonging to other teams", func() {
+			causality, err := pipeline.VisibleCausality(rcvID, []string{"some-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(Equal([]db.Cause{
+				{ResourceVersionID: rcvID, BuildID: upstreamID, JobName: "job-name", PipelineName: "fake-pipeline", TeamName: "some-team"},
+			}))
+		})
+
+		It("includes builds of pipelines belonging to the given teams", func() {
+			causality, err := pipeline.VisibleCausality(rcvID, []string{"some-team", "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(HaveLen(2))
+			Expect(causality[1].BuildID).To(Equal(downstreamID))
+		})
+
+		It("includes builds of public pipelines", func() {
+			err := otherPipeline.Expose()
+			Expect(err).ToNot(HaveOccurred())
+
+			causality, err := pipeline.VisibleCausality(rcvID, []string{"some-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(HaveLen(2))
+			Expect(causality[1].TeamName).To(Equal("other-team"))
+		})
+
+		It("returns every build through Causality", func() {
+			causality, err := pipeline.Causality(rcvID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(HaveLen(2))
+		})
+	})
+
 	Describe("Variables", func() {
 		var (
 			fakeGlobalSecrets *credsfakes.FakeSecrets
@@ -2187,3 +2360,27 @@ var _ = Describe("Pipeline", func() {
 func intptr(i int) *int {
 	return &i
 }
+
+func runBuildWithInput(job db.Job, resourceID int, passedBuildIDs []int) int {
+	err := job.SaveNextInputMapping(db.InputMapping{
+		"some-input": db.InputResult{
+			Input: &db.AlgorithmInput{
+				AlgorithmVersion: db.AlgorithmVersion{
+					Version:    db.ResourceVersion(convertToMD5(atc.Version{"version": "1"})),
+					ResourceID: resourceID,
+				},
+				FirstOccurrence: true,
+			},
+			PassedBuildIDs: passedBuildIDs,
+		}}, true)
+	Expect(err).ToNot(HaveOccurred())
+
+	build, err := job.CreateBuild()
+	Expect(err).ToNot(HaveOccurred())
+
+	_, found, err := build.AdoptInputsAndPipes()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+
+	return build.ID()
+}
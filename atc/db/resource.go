@@ -34,6 +34,7 @@ type Resource interface {
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
 	Tags() atc.Tags
+	WorkerSelector() *atc.WorkerSelector
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
@@ -50,7 +51,7 @@ type Resource interface {
 	CurrentPinnedVersion() atc.Version
 
 	ResourceConfigVersionID(atc.Version) (int, bool, error)
-	Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	Versions(page Page, versionFilter atc.Version, filter *atc.VersionFilter) ([]atc.ResourceVersion, Pagination, bool, error)
 	SaveUncheckedVersion(atc.Version, ResourceConfigMetadataFields, ResourceConfig, atc.VersionedResourceTypes) (bool, error)
 	UpdateMetadata(atc.Version, ResourceConfigMetadataFields) (bool, error)
 
@@ -109,6 +110,7 @@ type resource struct {
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
 	tags                  atc.Tags
+	workerSelector        *atc.WorkerSelector
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
@@ -149,42 +151,44 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			Public:       r.Public(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.ConfigPinnedVersion(),
-			Icon:         r.Icon(),
+			Name:           r.Name(),
+			Public:         r.Public(),
+			WebhookToken:   r.WebhookToken(),
+			Type:           r.Type(),
+			Source:         r.Source(),
+			CheckEvery:     r.CheckEvery(),
+			Tags:           r.Tags(),
+			WorkerSelector: r.WorkerSelector(),
+			Version:        r.ConfigPinnedVersion(),
+			Icon:           r.Icon(),
 		})
 	}
 
 	return configs
 }
 
-func (r *resource) ID() int                          { return r.id }
-func (r *resource) Name() string                     { return r.name }
-func (r *resource) Public() bool                     { return r.public }
-func (r *resource) TeamID() int                      { return r.teamID }
-func (r *resource) TeamName() string                 { return r.teamName }
-func (r *resource) Type() string                     { return r.type_ }
-func (r *resource) Source() atc.Source               { return r.source }
-func (r *resource) CheckEvery() string               { return r.checkEvery }
-func (r *resource) CheckTimeout() string             { return r.checkTimeout }
-func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
-func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
-func (r *resource) Tags() atc.Tags                   { return r.tags }
-func (r *resource) CheckSetupError() error           { return r.checkSetupError }
-func (r *resource) CheckError() error                { return r.checkError }
-func (r *resource) WebhookToken() string             { return r.webhookToken }
-func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
-func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
-func (r *resource) PinComment() string               { return r.pinComment }
-func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
-func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
-func (r *resource) Icon() string                     { return r.icon }
+func (r *resource) ID() int                             { return r.id }
+func (r *resource) Name() string                        { return r.name }
+func (r *resource) Public() bool                        { return r.public }
+func (r *resource) TeamID() int                         { return r.teamID }
+func (r *resource) TeamName() string                    { return r.teamName }
+func (r *resource) Type() string                        { return r.type_ }
+func (r *resource) Source() atc.Source                  { return r.source }
+func (r *resource) CheckEvery() string                  { return r.checkEvery }
+func (r *resource) CheckTimeout() string                { return r.checkTimeout }
+func (r *resource) LastCheckStartTime() time.Time       { return r.lastCheckStartTime }
+func (r *resource) LastCheckEndTime() time.Time         { return r.lastCheckEndTime }
+func (r *resource) Tags() atc.Tags                      { return r.tags }
+func (r *resource) WorkerSelector() *atc.WorkerSelector { return r.workerSelector }
+func (r *resource) CheckSetupError() error              { return r.checkSetupError }
+func (r *resource) CheckError() error                   { return r.checkError }
+func (r *resource) WebhookToken() string                { return r.webhookToken }
+func (r *resource) ConfigPinnedVersion() atc.Version    { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version       { return r.apiPinnedVersion }
+func (r *resource) PinComment() string                  { return r.pinComment }
+func (r *resource) ResourceConfigID() int               { return r.resourceConfigID }
+func (r *resource) ResourceConfigScopeID() int          { return r.resourceConfigScopeID }
+func (r *resource) Icon() string                        { return r.icon }
 
 func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" }
 
@@ -394,7 +398,7 @@ func (r *resource) CurrentPinnedVersion() atc.Version {
 	return nil
 }
 
-func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error) {
+func (r *resource) Versions(page Page, versionFilter atc.Version, filter *atc.VersionFilter) ([]atc.ResourceVersion, Pagination, bool, error) {
 	tx, err := r.conn.Begin()
 	if err != nil {
 		return nil, Pagination{}, false, err
@@ -425,30 +429,35 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 		filterJSON = string(filterBytes)
 	}
 
+	pagedFilter, pagedFilterArgs, err := versionFilterSQL("v", filter, 5)
+	if err != nil {
+		return nil, Pagination{}, false, err
+	}
+
 	var rows *sql.Rows
 	if page.Until != 0 {
 		rows, err = tx.Query(fmt.Sprintf(`
 			SELECT sub.*
 				FROM (
 						%s
-					AND version @> $4
+					AND version @> $4%s
 					AND v.check_order > (SELECT check_order FROM resource_config_versions WHERE id = $2)
 				ORDER BY v.check_order ASC
 				LIMIT $3
 			) sub
 			ORDER BY sub.check_order DESC
-		`, query), r.id, page.Until, page.Limit, filterJSON)
+		`, query, pagedFilter), append([]interface{}{r.id, page.Until, page.Limit, filterJSON}, pagedFilterArgs...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 	} else if page.Since != 0 {
 		rows, err = tx.Query(fmt.Sprintf(`
 			%s
-				AND version @> $4
+				AND version @> $4%s
 				AND v.check_order < (SELECT check_order FROM resource_config_versions WHERE id = $2)
 			ORDER BY v.check_order DESC
 			LIMIT $3
-		`, query), r.id, page.Since, page.Limit, filterJSON)
+		`, query, pagedFilter), append([]interface{}{r.id, page.Since, page.Limit, filterJSON}, pagedFilterArgs...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
@@ -457,34 +466,39 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 			SELECT sub.*
 				FROM (
 						%s
-					AND version @> $4
+					AND version @> $4%s
 					AND v.check_order >= (SELECT check_order FROM resource_config_versions WHERE id = $2)
 				ORDER BY v.check_order ASC
 				LIMIT $3
 			) sub
 			ORDER BY sub.check_order DESC
-		`, query), r.id, page.To, page.Limit, filterJSON)
+		`, query, pagedFilter), append([]interface{}{r.id, page.To, page.Limit, filterJSON}, pagedFilterArgs...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 	} else if page.From != 0 {
 		rows, err = tx.Query(fmt.Sprintf(`
 			%s
-				AND version @> $4
+				AND version @> $4%s
 				AND v.check_order <= (SELECT check_order FROM resource_config_versions WHERE id = $2)
 			ORDER BY v.check_order DESC
 			LIMIT $3
-		`, query), r.id, page.From, page.Limit, filterJSON)
+		`, query, pagedFilter), append([]interface{}{r.id, page.From, page.Limit, filterJSON}, pagedFilterArgs...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 	} else {
+		latestFilter, latestFilterArgs, err := versionFilterSQL("v", filter, 4)
+		if err != nil {
+			return nil, Pagination{}, false, err
+		}
+
 		rows, err = tx.Query(fmt.Sprintf(`
 			%s
-			AND version @> $3
+			AND version @> $3%s
 			ORDER BY v.check_order DESC
 			LIMIT $2
-		`, query), r.id, page.Limit, filterJSON)
+		`, query, latestFilter), append([]interface{}{r.id, page.Limit, filterJSON}, latestFilterArgs...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
@@ -773,6 +787,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkEvery = config.CheckEvery
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
+	r.workerSelector = config.WorkerSelector
 	r.webhookToken = config.WebhookToken
 	r.icon = config.Icon
 
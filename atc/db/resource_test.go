@@ -252,7 +252,7 @@ var _ = Describe("Resource", func() {
 			It("successfully disables the version", func() {
 				Expect(disableErr).ToNot(HaveOccurred())
 
-				versions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil)
+				versions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(versions).To(HaveLen(1))
@@ -285,7 +285,7 @@ var _ = Describe("Resource", func() {
 				It("successfully enables the version", func() {
 					Expect(enableErr).ToNot(HaveOccurred())
 
-					versions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil)
+					versions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(versions).To(HaveLen(1))
@@ -1043,7 +1043,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("return version that matches field filter", func() {
-					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter)
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(result)).To(Equal(1))
@@ -1057,7 +1057,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("return no version", func() {
-					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter)
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(result)).To(Equal(0))
@@ -1070,7 +1070,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("return version", func() {
-					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter)
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(result)).To(Equal(1))
@@ -1084,12 +1084,49 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("return no version", func() {
-					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter)
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, filter, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(result)).To(Equal(0))
 				})
 			})
+
+			Context("when a version field must match a regular expression", func() {
+				It("returns the matching versions", func() {
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, nil, &atc.VersionFilter{
+						Version: map[string]string{"commit": "^v[01]$"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(result).To(HaveLen(2))
+					Expect(result[0].Version).To(Equal(resourceVersions[1].Version))
+					Expect(result[1].Version).To(Equal(resourceVersions[0].Version))
+				})
+			})
+
+			Context("when a version field must be within a semver range", func() {
+				It("returns the matching versions", func() {
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, nil, &atc.VersionFilter{
+						Semver: &atc.SemverFilter{Field: "ref", Range: ">=1"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(result).To(HaveLen(2))
+					Expect(result[0].Version).To(Equal(resourceVersions[2].Version))
+					Expect(result[1].Version).To(Equal(resourceVersions[1].Version))
+				})
+			})
+
+			Context("when the version must have metadata", func() {
+				It("returns no versions without it", func() {
+					result, _, found, err := resource.Versions(db.Page{Limit: 10}, nil, &atc.VersionFilter{
+						Metadata: map[string]string{"branch": "master"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(result).To(BeEmpty())
+				})
+			})
 		})
 
 		Context("when resource has versions created in order of check order", func() {
@@ -1161,7 +1198,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with no since/until", func() {
 				It("returns the first page, with the given limit, and a next page", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -1174,7 +1211,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a since that places it in the middle of the builds", func() {
 				It("returns the builds, with previous/next pages", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Since: resourceVersions[6].ID, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Since: resourceVersions[6].ID, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -1187,7 +1224,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a since that places it at the end of the builds", func() {
 				It("returns the builds, with previous/next pages", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Since: resourceVersions[2].ID, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Since: resourceVersions[2].ID, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -1200,7 +1237,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with an until that places it in the middle of the builds", func() {
 				It("returns the builds, with previous/next pages", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Until: resourceVersions[6].ID, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Until: resourceVersions[6].ID, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -1213,7 +1250,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a until that places it at the beginning of the builds", func() {
 				It("returns the builds, with previous/next pages", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Until: resourceVersions[7].ID, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Until: resourceVersions[7].ID, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -1234,7 +1271,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns the metadata in the version history", func() {
-					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -1249,7 +1286,7 @@ var _ = Describe("Resource", func() {
 					err = resourceScope.SaveVersions(nil, []atc.Version{resourceVersions[9].Version})
 					Expect(err).ToNot(HaveOccurred())
 
-					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, atc.Version{})
+					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, atc.Version{}, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -1261,7 +1298,7 @@ var _ = Describe("Resource", func() {
 					newMetadata := []db.ResourceConfigMetadataField{{Name: "name-new", Value: "value-new"}}
 					_, err := resource.SaveUncheckedVersion(atc.Version(resourceVersions[9].Version), newMetadata, resourceScope.ResourceConfig(), atc.VersionedResourceTypes{})
 
-					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, atc.Version{})
+					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, atc.Version{}, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -1279,7 +1316,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns a disabled version", func() {
-					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(ConsistOf([]atc.ResourceVersion{resourceVersions[9]}))
@@ -1298,7 +1335,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns a version with metadata updated", func() {
-					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := resource.Versions(db.Page{Limit: 1}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -1375,7 +1412,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with no since/until", func() {
 				It("returns versions ordered by check order", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 4}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 4}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(4))
@@ -1390,7 +1427,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a since", func() {
 				It("returns the builds, with previous/next pages excluding since", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Since: 3, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Since: 3, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
@@ -1403,7 +1440,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with from", func() {
 				It("returns the builds, with previous/next pages including from", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{From: 2, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{From: 2, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
@@ -1416,7 +1453,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a until", func() {
 				It("returns the builds, with previous/next pages excluding until", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{Until: 1, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{Until: 1, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
@@ -1429,7 +1466,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with to", func() {
 				It("returns the builds, with previous/next pages including to", func() {
-					historyPage, pagination, found, err := resource.Versions(db.Page{To: 4, Limit: 2}, nil)
+					historyPage, pagination, found, err := resource.Versions(db.Page{To: 4, Limit: 2}, nil, nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
@@ -1470,7 +1507,7 @@ var _ = Describe("Resource", func() {
 			})
 
 			It("does not return the version", func() {
-				historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 2}, nil)
+				historyPage, pagination, found, err := resource.Versions(db.Page{Limit: 2}, nil, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(historyPage).To(BeNil())
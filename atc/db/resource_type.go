@@ -36,6 +36,7 @@ type ResourceType interface {
 	Source() atc.Source
 	Params() atc.Params
 	Tags() atc.Tags
+	WorkerSelector() *atc.WorkerSelector
 	CheckEvery() string
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
@@ -95,6 +96,7 @@ func (resourceTypes ResourceTypes) Deserialize() atc.VersionedResourceTypes {
 				Privileged:           t.Privileged(),
 				CheckEvery:           t.CheckEvery(),
 				Tags:                 t.Tags(),
+				WorkerSelector:       t.WorkerSelector(),
 				Params:               t.Params(),
 				UniqueVersionHistory: t.UniqueVersionHistory(),
 			},
@@ -116,6 +118,7 @@ func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 			Privileged:           r.Privileged(),
 			CheckEvery:           r.CheckEvery(),
 			Tags:                 r.Tags(),
+			WorkerSelector:       r.WorkerSelector(),
 			Params:               r.Params(),
 			UniqueVersionHistory: r.UniqueVersionHistory(),
 		})
@@ -168,6 +171,7 @@ type resourceType struct {
 	source                atc.Source
 	params                atc.Params
 	tags                  atc.Tags
+	workerSelector        *atc.WorkerSelector
 	version               atc.Version
 	checkEvery            string
 	lastCheckStartTime    time.Time
@@ -177,23 +181,24 @@ type resourceType struct {
 	uniqueVersionHistory  bool
 }
 
-func (t *resourceType) ID() int                       { return t.id }
-func (t *resourceType) TeamID() int                   { return t.teamID }
-func (t *resourceType) TeamName() string              { return t.teamName }
-func (t *resourceType) Name() string                  { return t.name }
-func (t *resourceType) Type() string                  { return t.type_ }
-func (t *resourceType) Privileged() bool              { return t.privileged }
-func (t *resourceType) CheckEvery() string            { return t.checkEvery }
-func (t *resourceType) CheckTimeout() string          { return "" }
-func (r *resourceType) LastCheckStartTime() time.Time { return r.lastCheckStartTime }
-func (r *resourceType) LastCheckEndTime() time.Time   { return r.lastCheckEndTime }
-func (t *resourceType) Source() atc.Source            { return t.source }
-func (t *resourceType) Params() atc.Params            { return t.params }
-func (t *resourceType) Tags() atc.Tags                { return t.tags }
-func (t *resourceType) CheckSetupError() error        { return t.checkSetupError }
-func (t *resourceType) CheckError() error             { return t.checkError }
-func (t *resourceType) UniqueVersionHistory() bool    { return t.uniqueVersionHistory }
-func (t *resourceType) ResourceConfigScopeID() int    { return t.resourceConfigScopeID }
+func (t *resourceType) ID() int                             { return t.id }
+func (t *resourceType) TeamID() int                         { return t.teamID }
+func (t *resourceType) TeamName() string                    { return t.teamName }
+func (t *resourceType) Name() string                        { return t.name }
+func (t *resourceType) Type() string                        { return t.type_ }
+func (t *resourceType) Privileged() bool                    { return t.privileged }
+func (t *resourceType) CheckEvery() string                  { return t.checkEvery }
+func (t *resourceType) CheckTimeout() string                { return "" }
+func (r *resourceType) LastCheckStartTime() time.Time       { return r.lastCheckStartTime }
+func (r *resourceType) LastCheckEndTime() time.Time         { return r.lastCheckEndTime }
+func (t *resourceType) Source() atc.Source                  { return t.source }
+func (t *resourceType) Params() atc.Params                  { return t.params }
+func (t *resourceType) Tags() atc.Tags                      { return t.tags }
+func (t *resourceType) WorkerSelector() *atc.WorkerSelector { return t.workerSelector }
+func (t *resourceType) CheckSetupError() error              { return t.checkSetupError }
+func (t *resourceType) CheckError() error                   { return t.checkError }
+func (t *resourceType) UniqueVersionHistory() bool          { return t.uniqueVersionHistory }
+func (t *resourceType) ResourceConfigScopeID() int          { return t.resourceConfigScopeID }
 
 func (t *resourceType) Version() atc.Version              { return t.version }
 func (t *resourceType) CurrentPinnedVersion() atc.Version { return nil }
@@ -331,6 +336,7 @@ func scanResourceType(t *resourceType, row scannable) error {
 	t.params = config.Params
 	t.privileged = config.Privileged
 	t.tags = config.Tags
+	t.workerSelector = config.WorkerSelector
 	t.checkEvery = config.CheckEvery
 	t.uniqueVersionHistory = config.UniqueVersionHistory
 
@@ -0,0 +1,368 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . SystemLockFactory
+
+// SystemLockFactory inspects the advisory locks held by the ATCs, which are
+// otherwise only visible through pg_locks.
+type SystemLockFactory interface {
+	SystemLocks() ([]atc.SystemLock, error)
+
+	// RequestRelease asks the ATC whose session holds the lock to release it,
+	// returning false if the session does not hold the lock.
+	RequestRelease(sessionID int, id lock.LockID) (bool, error)
+
+	// ReleaseRequested releases the locks this ATC has been asked to.
+	ReleaseRequested(logger lager.Logger) error
+}
+
+type systemLockFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+	atcName     string
+}
+
+// NewSystemLockFactory returns a SystemLockFactory for the locks of the whole
+// cluster. Hold durations are only known for locks acquired through
+// lockFactory, i.e. by the ATC named atcName.
+func NewSystemLockFactory(conn Conn, lockFactory lock.LockFactory, atcName string) SystemLockFactory {
+	return &systemLockFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+		atcName:     atcName,
+	}
+}
+
+func (f *systemLockFactory) SystemLocks() ([]atc.SystemLock, error) {
+	rows, err := f.conn.Query(`
+		SELECT l.classid, l.objid, l.objsubid, l.pid,
+			COALESCE(a.application_name, ''), COALESCE(host(a.client_addr), '')
+		FROM pg_locks l
+		LEFT JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'advisory'
+		AND l.granted
+		AND l.database = (SELECT oid FROM pg_database WHERE datname = current_database())
+		ORDER BY l.pid, l.classid, l.objid
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	now := time.Now()
+
+	locks := []atc.SystemLock{}
+	ids := []lock.LockID{}
+	for rows.Next() {
+		var classID, objID uint32
+		var objSubID int
+		var systemLock atc.SystemLock
+
+		err = rows.Scan(&classID, &objID, &objSubID, &systemLock.SessionID, &systemLock.ATC, &systemLock.ClientAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		id := lock.NewLockIDFromAdvisoryLock(classID, objID, objSubID)
+
+		systemLock.ID = id
+		systemLock.Type = lockTypeName(id)
+
+		if systemLock.ATC == f.atcName {
+			acquiredAt, held := f.lockFactory.AcquiredAt(id)
+			if held {
+				systemLock.HoldDuration = now.Sub(acquiredAt).Seconds()
+			}
+		}
+
+		locks = append(locks, systemLock)
+		ids = append(ids, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		locks[i].Object, err = f.describe(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return locks, nil
+}
+
+// RequestRelease records the request and notifies the ATCs, as advisory
+// locks can only be released by the session holding them. Only the requested
+// lock is released; the ATC's other locks are unaffected.
+func (f *systemLockFactory) RequestRelease(sessionID int, id lock.LockID) (bool, error) {
+	classID, objID, objSubID := id.AdvisoryLock()
+
+	var held bool
+	err := f.conn.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM pg_locks
+			WHERE locktype = 'advisory'
+			AND granted
+			AND pid = $1
+			AND classid = $2
+			AND objid = $3
+			AND objsubid = $4
+		)
+	`, sessionID, classID, objID, objSubID).Scan(&held)
+	if err != nil {
+		return false, err
+	}
+
+	if !held {
+		return false, nil
+	}
+
+	_, err = psql.Insert("system_lock_releases").
+		Columns("session_id", "lock_id").
+		Values(sessionID, pq.Array(id)).
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = f.conn.Bus().Notify(atc.SystemLockReleaseChannel)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (f *systemLockFactory) ReleaseRequested(logger lager.Logger) error {
+	sessionID, err := f.lockFactory.SessionID()
+	if err != nil {
+		return err
+	}
+
+	// requests for sessions which have since ended will never be handled
+	_, err = f.conn.Exec(`
+		DELETE FROM system_lock_releases
+		WHERE session_id NOT IN (SELECT pid FROM pg_stat_activity)
+	`)
+	if err != nil {
+		return err
+	}
+
+	rows, err := psql.Delete("system_lock_releases").
+		Where(sq.Eq{"session_id": sessionID}).
+		Suffix("RETURNING lock_id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	ids := []lock.LockID{}
+	for rows.Next() {
+		var id []int64
+		err = rows.Scan(pq.Array(&id))
+		if err != nil {
+			return err
+		}
+
+		lockID := make(lock.LockID, len(id))
+		for i := range id {
+			lockID[i] = int(id[i])
+		}
+
+		ids = append(ids, lockID)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		released, err := f.lockFactory.ForceRelease(logger, id)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("released-on-request", lager.Data{"id": id, "released": released})
+	}
+
+	return nil
+}
+
+// lockTypeName decodes the type of the lock. Locks guarding an object are
+// taken with their type and the object's ID as keys, and locks without one
+// with just their type.
+func lockTypeName(id lock.LockID) string {
+	if name, ok := lock.LockTypeNames[id[0]]; ok {
+		return name
+	}
+
+	return "Unknown"
+}
+
+// describe returns a description of the object guarded by the lock, or an
+// empty string if there is none or it no longer exists.
+func (f *systemLockFactory) describe(id lock.LockID) (string, error) {
+	if len(id) != 2 {
+		return "", nil
+	}
+
+	var (
+		description string
+		err         error
+	)
+
+	switch id[0] {
+	case lock.LockTypeBuildTracking:
+		description, err = f.describeBuild(id[1])
+	case lock.LockTypeJobScheduling:
+		description, err = f.describeJob(id[1])
+	case lock.LockTypeResourceConfigChecking:
+		description, err = f.describeResourceConfig(id[1])
+	case lock.LockTypeVolumeCreating:
+		description, err = f.describeVolume(id[1])
+	case lock.LockTypeBatch:
+		description, err = f.describeTask(id)
+	}
+
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	return description, err
+}
+
+func (f *systemLockFactory) describeBuild(buildID int) (string, error) {
+	var buildName string
+	var jobName, pipelineName sql.NullString
+	err := psql.Select("b.name", "j.name", "p.name").
+		From("builds b").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		Where(sq.Eq{"b.id": buildID}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&buildName, &jobName, &pipelineName)
+	if err != nil {
+		return "", err
+	}
+
+	if !jobName.Valid {
+		return fmt.Sprintf("build %d (one-off)", buildID), nil
+	}
+
+	return fmt.Sprintf("build %d (%s/%s #%s)", buildID, pipelineName.String, jobName.String, buildName), nil
+}
+
+func (f *systemLockFactory) describeJob(jobID int) (string, error) {
+	var jobName, pipelineName string
+	err := psql.Select("j.name", "p.name").
+		From("jobs j").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Where(sq.Eq{"j.id": jobID}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&jobName, &pipelineName)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("job %s/%s", pipelineName, jobName), nil
+}
+
+func (f *systemLockFactory) describeResourceConfig(resourceConfigID int) (string, error) {
+	rows, err := psql.Select("p.name", "r.name").
+		From("resources r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Where(sq.Eq{"r.resource_config_id": resourceConfigID}).
+		OrderBy("p.name", "r.name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return "", err
+	}
+
+	defer Close(rows)
+
+	var resources []string
+	for rows.Next() {
+		var pipelineName, resourceName string
+		err = rows.Scan(&pipelineName, &resourceName)
+		if err != nil {
+			return "", err
+		}
+
+		resources = append(resources, pipelineName+"/"+resourceName)
+	}
+
+	description := fmt.Sprintf("resource config %d", resourceConfigID)
+	if len(resources) > 0 {
+		description += " (" + strings.Join(resources, ", ") + ")"
+	}
+
+	return description, rows.Err()
+}
+
+func (f *systemLockFactory) describeVolume(volumeID int) (string, error) {
+	var handle, workerName string
+	err := psql.Select("handle", "worker_name").
+		From("volumes").
+		Where(sq.Eq{"id": volumeID}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&handle, &workerName)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("volume %s on %s", handle, workerName), nil
+}
+
+// describeTask finds the component whose name hashes to the lock's ID. Other
+// tasks, such as fetching a resource, cannot be identified.
+func (f *systemLockFactory) describeTask(id lock.LockID) (string, error) {
+	rows, err := psql.Select("name").
+		From("components").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return "", err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return "", err
+		}
+
+		if lock.NewTaskLockID(name)[1] == id[1] {
+			return "component " + name, nil
+		}
+	}
+
+	return "", rows.Err()
+}
@@ -0,0 +1,204 @@
+package db_test
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/lock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SystemLockFactory", func() {
+	var (
+		lockConn          *sql.DB
+		atcLockFactory    lock.LockFactory
+		systemLockFactory db.SystemLockFactory
+
+		heldLocks []lock.Lock
+	)
+
+	BeforeEach(func() {
+		var err error
+		lockConn, err = sql.Open("postgres", postgresRunner.DataSourceName()+" application_name=some-atc")
+		Expect(err).NotTo(HaveOccurred())
+
+		lockConn.SetMaxOpenConns(1)
+
+		atcLockFactory = lock.NewLockFactory(
+			lockConn,
+			func(lager.Logger, lock.LockID) {},
+			func(lager.Logger, lock.LockID, time.Duration) {},
+		)
+
+		systemLockFactory = db.NewSystemLockFactory(dbConn, atcLockFactory, "some-atc")
+
+		heldLocks = nil
+	})
+
+	AfterEach(func() {
+		for _, heldLock := range heldLocks {
+			_ = heldLock.Release()
+		}
+
+		Expect(lockConn.Close()).To(Succeed())
+	})
+
+	acquire := func(id lock.LockID) {
+		heldLock, acquired, err := atcLockFactory.Acquire(logger, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acquired).To(BeTrue())
+
+		heldLocks = append(heldLocks, heldLock)
+	}
+
+	Describe("SystemLocks", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			acquire(lock.NewBuildTrackingLockID(build.ID()))
+			acquire(lock.NewJobSchedulingLockID(defaultJob.ID()))
+			acquire(lock.NewResourceScanningLockID())
+		})
+
+		It("lists the locks held along with what they guard", func() {
+			locks, err := systemLockFactory.SystemLocks()
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := range locks {
+				Expect(locks[i].ATC).To(Equal("some-atc"))
+				Expect(locks[i].SessionID).ToNot(BeZero())
+				Expect(locks[i].HoldDuration).To(BeNumerically(">", 0))
+
+				locks[i].SessionID = 0
+				locks[i].ATC = ""
+				locks[i].ClientAddr = ""
+				locks[i].HoldDuration = 0
+			}
+
+			Expect(locks).To(ConsistOf(
+				atc.SystemLock{
+					Type:   "BuildTracking",
+					ID:     []int{lock.LockTypeBuildTracking, build.ID()},
+					Object: fmt.Sprintf("build %d (%s/%s #%s)", build.ID(), defaultPipeline.Name(), defaultJob.Name(), build.Name()),
+				},
+				atc.SystemLock{
+					Type:   "JobScheduling",
+					ID:     []int{lock.LockTypeJobScheduling, defaultJob.ID()},
+					Object: fmt.Sprintf("job %s/%s", defaultPipeline.Name(), defaultJob.Name()),
+				},
+				atc.SystemLock{
+					Type: "ResourceScanning",
+					ID:   []int{lock.LockTypeResourceScanning},
+				},
+			))
+		})
+
+		Context("when the locks are held by another ATC", func() {
+			BeforeEach(func() {
+				systemLockFactory = db.NewSystemLockFactory(dbConn, lock.NewLockFactory(
+					postgresRunner.OpenSingleton(),
+					func(lager.Logger, lock.LockID) {},
+					func(lager.Logger, lock.LockID, time.Duration) {},
+				), "other-atc")
+			})
+
+			It("does not know how long they have been held", func() {
+				locks, err := systemLockFactory.SystemLocks()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(locks).To(HaveLen(3))
+
+				for _, systemLock := range locks {
+					Expect(systemLock.ATC).To(Equal("some-atc"))
+					Expect(systemLock.HoldDuration).To(BeZero())
+				}
+			})
+		})
+	})
+
+	Describe("RequestRelease", func() {
+		var sessionID int
+
+		BeforeEach(func() {
+			acquire(lock.NewJobSchedulingLockID(defaultJob.ID()))
+			acquire(lock.NewResourceScanningLockID())
+
+			var err error
+			sessionID, err = atcLockFactory.SessionID()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("releases only the requested lock once the ATC is notified", func() {
+			requested, err := systemLockFactory.RequestRelease(sessionID, lock.NewJobSchedulingLockID(defaultJob.ID()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requested).To(BeTrue())
+
+			locks, err := systemLockFactory.SystemLocks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(HaveLen(2))
+
+			err = systemLockFactory.ReleaseRequested(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			locks, err = systemLockFactory.SystemLocks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(HaveLen(1))
+			Expect(locks[0].Type).To(Equal("ResourceScanning"))
+
+			Expect(heldLocks[0].Release()).To(Equal(lock.ErrLostLock))
+		})
+
+		It("notifies the ATCs", func() {
+			notifier, err := dbConn.Bus().Listen(atc.SystemLockReleaseChannel)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer dbConn.Bus().Unlisten(atc.SystemLockReleaseChannel, notifier)
+
+			_, err = systemLockFactory.RequestRelease(sessionID, lock.NewResourceScanningLockID())
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(notifier).Should(Receive(BeTrue()))
+		})
+
+		Context("when the request is for another session", func() {
+			BeforeEach(func() {
+				otherLockFactory := lock.NewLockFactory(
+					postgresRunner.OpenSingleton(),
+					func(lager.Logger, lock.LockID) {},
+					func(lager.Logger, lock.LockID, time.Duration) {},
+				)
+
+				systemLockFactory = db.NewSystemLockFactory(dbConn, otherLockFactory, "other-atc")
+			})
+
+			It("is left to the session's ATC", func() {
+				_, err := systemLockFactory.RequestRelease(sessionID, lock.NewResourceScanningLockID())
+				Expect(err).NotTo(HaveOccurred())
+
+				err = systemLockFactory.ReleaseRequested(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				locks, err := systemLockFactory.SystemLocks()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(locks).To(HaveLen(2))
+			})
+		})
+
+		Context("when the session does not hold the lock", func() {
+			It("returns false", func() {
+				requested, err := systemLockFactory.RequestRelease(sessionID, lock.NewBuildTrackingLockID(1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requested).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . TaskResultCacheFactory
+
+// TaskResultCacheFactory stores the results of task steps configured with
+// `cache_result: true`, keyed by a hash of everything that went into running
+// the task.
+type TaskResultCacheFactory interface {
+	Find(jobID int, stepName string, key string) (TaskResultCache, bool, error)
+	Save(jobID int, stepName string, key string, buildID int, exitStatus int, outputs []TaskResultCacheOutput) error
+
+	OutputKey(volumeHandle string) (string, bool, error)
+
+	RemoveExpired(retention time.Duration) (int, error)
+}
+
+// TaskResultCache is the stored result of a task run.
+type TaskResultCache struct {
+	ID         int
+	BuildID    int
+	BuildName  string
+	ExitStatus int
+	Outputs    []TaskResultCacheOutput
+}
+
+// TaskResultCacheOutput is an output volume of a cached task result. The key
+// identifies the output's content so that tasks consuming it can themselves
+// be cached.
+type TaskResultCacheOutput struct {
+	Name             string
+	Key              string
+	VolumeHandle     string
+	WorkerArtifactID int
+}
+
+type taskResultCacheFactory struct {
+	conn Conn
+}
+
+func NewTaskResultCacheFactory(conn Conn) TaskResultCacheFactory {
+	return &taskResultCacheFactory{
+		conn: conn,
+	}
+}
+
+// Find looks up the cached result for the key, marking it as used so that it
+// is not garbage collected.
+func (f *taskResultCacheFactory) Find(jobID int, stepName string, key string) (TaskResultCache, bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return TaskResultCache{}, false, err
+	}
+
+	defer Rollback(tx)
+
+	var cache TaskResultCache
+	var buildID sql.NullInt64
+	var buildName sql.NullString
+	err = psql.Update("task_result_caches").
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{
+			"job_id":    jobID,
+			"step_name": stepName,
+			"key":       key,
+		}).
+		Suffix("RETURNING id, build_id, (SELECT b.name FROM builds b WHERE b.id = build_id), exit_status").
+		RunWith(tx).
+		QueryRow().
+		Scan(&cache.ID, &buildID, &buildName, &cache.ExitStatus)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TaskResultCache{}, false, nil
+		}
+
+		return TaskResultCache{}, false, err
+	}
+
+	if buildID.Valid {
+		cache.BuildID = int(buildID.Int64)
+		cache.BuildName = buildName.String
+	}
+
+	rows, err := psql.Select("name", "key", "volume_handle", "worker_artifact_id").
+		From("task_result_cache_outputs").
+		Where(sq.Eq{"task_result_cache_id": cache.ID}).
+		OrderBy("name").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return TaskResultCache{}, false, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var output TaskResultCacheOutput
+		err = rows.Scan(&output.Name, &output.Key, &output.VolumeHandle, &output.WorkerArtifactID)
+		if err != nil {
+			return TaskResultCache{}, false, err
+		}
+
+		cache.Outputs = append(cache.Outputs, output)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return TaskResultCache{}, false, err
+	}
+
+	return cache, true, nil
+}
+
+// Save stores the result of a task run, replacing any result previously
+// stored for the same key.
+func (f *taskResultCacheFactory) Save(jobID int, stepName string, key string, buildID int, exitStatus int, outputs []TaskResultCacheOutput) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var id int
+	err = psql.Insert("task_result_caches").
+		Columns("job_id", "step_name", "key", "build_id", "exit_status").
+		Values(jobID, stepName, key, buildID, exitStatus).
+		Suffix(`
+			ON CONFLICT (job_id, step_name, key) DO UPDATE SET
+				build_id = EXCLUDED.build_id,
+				exit_status = EXCLUDED.exit_status,
+				created_at = now(),
+				last_used = now()
+			RETURNING id
+		`).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("task_result_cache_outputs").
+		Where(sq.Eq{"task_result_cache_id": id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		_, err = psql.Insert("task_result_cache_outputs").
+			Columns("task_result_cache_id", "name", "key", "volume_handle", "worker_artifact_id").
+			Values(id, output.Name, output.Key, output.VolumeHandle, output.WorkerArtifactID).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// OutputKey returns the content key of a volume produced by a cached task.
+func (f *taskResultCacheFactory) OutputKey(volumeHandle string) (string, bool, error) {
+	var key string
+	err := psql.Select("key").
+		From("task_result_cache_outputs").
+		Where(sq.Eq{"volume_handle": volumeHandle}).
+		Limit(1).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	return key, true, nil
+}
+
+// RemoveExpired removes results that have not been used within the retention
+// period. Their output artifacts are left for the artifact collector.
+func (f *taskResultCacheFactory) RemoveExpired(retention time.Duration) (int, error) {
+	result, err := psql.Delete("task_result_caches").
+		Where(sq.Expr("last_used < NOW() - ?::interval", fmt.Sprintf("%.0f seconds", retention.Seconds()))).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
@@ -0,0 +1,149 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskResultCacheFactory", func() {
+	var (
+		build      db.Build
+		artifactID int
+		outputs    []db.TaskResultCacheOutput
+	)
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = dbConn.QueryRow("INSERT INTO worker_artifacts(name) VALUES('some-output') RETURNING id").Scan(&artifactID)
+		Expect(err).ToNot(HaveOccurred())
+
+		outputs = []db.TaskResultCacheOutput{
+			{
+				Name:             "some-output",
+				Key:              "some-output-key",
+				VolumeHandle:     "some-handle",
+				WorkerArtifactID: artifactID,
+			},
+		}
+	})
+
+	Describe("Find", func() {
+		Context("when there is no cached result", func() {
+			It("returns not found", func() {
+				_, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a result has been saved", func() {
+			BeforeEach(func() {
+				err := taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), 1, outputs)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the result", func() {
+				cache, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(cache.BuildID).To(Equal(build.ID()))
+				Expect(cache.BuildName).To(Equal(build.Name()))
+				Expect(cache.ExitStatus).To(Equal(1))
+				Expect(cache.Outputs).To(Equal(outputs))
+			})
+
+			It("does not return it for a different key", func() {
+				_, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-other-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("does not return it for a different step", func() {
+				_, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-other-task", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when it is saved again", func() {
+				BeforeEach(func() {
+					err := taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), 0, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("replaces the result", func() {
+					cache, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(cache.ExitStatus).To(Equal(0))
+					Expect(cache.Outputs).To(BeEmpty())
+				})
+			})
+
+			Context("when the result is cleared through the job", func() {
+				It("removes the result", func() {
+					rowsDeleted, err := defaultJob.ClearTaskResultCache("some-task")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rowsDeleted).To(Equal(int64(1)))
+
+					_, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("OutputKey", func() {
+		BeforeEach(func() {
+			err := taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), 0, outputs)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the key of a cached output volume", func() {
+			key, found, err := taskResultCacheFactory.OutputKey("some-handle")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(key).To(Equal("some-output-key"))
+		})
+
+		It("does not find other volumes", func() {
+			_, found, err := taskResultCacheFactory.OutputKey("some-other-handle")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("RemoveExpired", func() {
+		BeforeEach(func() {
+			err := taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), 0, outputs)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-stale-key", build.ID(), 0, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec("UPDATE task_result_caches SET last_used = NOW() - '2 days'::interval WHERE key = 'some-stale-key'")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes results which have not been used within the retention period", func() {
+			removed, err := taskResultCacheFactory.RemoveExpired(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			_, found, err := taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-stale-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = taskResultCacheFactory.Find(defaultJob.ID(), "some-task", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
+})
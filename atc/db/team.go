@@ -20,6 +20,14 @@ import (
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
 
+type PassedJobNotFoundError struct {
+	Job string
+}
+
+func (e PassedJobNotFoundError) Error() string {
+	return fmt.Sprintf("passed job '%s' not found or not exposed", e.Job)
+}
+
 //go:generate counterfeiter . Team
 
 type Team interface {
@@ -67,6 +75,16 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	LintRules() (atc.LintRules, error)
+	UpdateLintRules(atc.LintRules) error
+
+	Quotas() (atc.TeamQuotas, error)
+	UpdateQuotas(atc.TeamQuotas) error
+
+	// UpdateSettings updates the team's auth and lint rules, and its quotas
+	// unless they are nil, all at once.
+	UpdateSettings(atc.TeamAuth, atc.LintRules, *atc.TeamQuotas) error
 }
 
 type team struct {
@@ -719,6 +737,15 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	}
 	defer Rollback(tx)
 
+	err = t.updateProviderAuth(tx, auth)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) updateProviderAuth(tx Tx, auth atc.TeamAuth) error {
 	jsonEncodedProviderAuth, err := json.Marshal(auth)
 	if err != nil {
 		return err
@@ -730,14 +757,109 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		WHERE id = $2
 		RETURNING id, name, admin, auth, nonce
 	`
-	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
+	return t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
+}
+
+func (t *team) LintRules() (atc.LintRules, error) {
+	var rules sql.NullString
+	err := psql.Select("lint_rules").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&rules)
+	if err != nil {
+		return nil, err
+	}
+
+	lintRules := atc.LintRules{}
+	if rules.Valid {
+		err = json.Unmarshal([]byte(rules.String), &lintRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lintRules, nil
+}
+
+func (t *team) UpdateLintRules(rules atc.LintRules) error {
+	return updateTeamLintRules(t.conn, t.id, rules)
+}
+
+func (t *team) Quotas() (atc.TeamQuotas, error) {
+	return teamQuotas(t.conn, t.id)
+}
+
+func (t *team) UpdateQuotas(quotas atc.TeamQuotas) error {
+	return updateTeamQuotas(t.conn, t.id, quotas)
+}
+
+func (t *team) UpdateSettings(auth atc.TeamAuth, rules atc.LintRules, quotas *atc.TeamQuotas) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	err = t.updateProviderAuth(tx, auth)
+	if err != nil {
+		return err
+	}
+
+	err = updateTeamLintRules(tx, t.id, rules)
 	if err != nil {
 		return err
 	}
 
+	if quotas != nil {
+		err = updateTeamQuotas(tx, t.id, *quotas)
+		if err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
+func updateTeamLintRules(runner sq.Runner, teamID int, rules atc.LintRules) error {
+	var payload interface{}
+	if len(rules) > 0 {
+		encoded, err := json.Marshal(rules)
+		if err != nil {
+			return err
+		}
+
+		payload = string(encoded)
+	}
+
+	_, err := psql.Update("teams").
+		Set("lint_rules", payload).
+		Where(sq.Eq{"id": teamID}).
+		RunWith(runner).
+		Exec()
+	return err
+}
+
+func updateTeamQuotas(runner sq.Runner, teamID int, quotas atc.TeamQuotas) error {
+	var payload interface{}
+	if !quotas.IsZero() {
+		encoded, err := json.Marshal(quotas)
+		if err != nil {
+			return err
+		}
+
+		payload = string(encoded)
+	}
+
+	_, err := psql.Update("teams").
+		Set("quotas", payload).
+		Where(sq.Eq{"id": teamID}).
+		RunWith(runner).
+		Exec()
+	return err
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -1349,7 +1471,7 @@ func (t *team) insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID
 	for _, jobConfig := range jobConfigs {
 		err := jobConfig.StepConfig().Visit(atc.StepRecursor{
 			OnGet: func(step *atc.GetStep) error {
-				return insertJobInput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
+				return t.insertJobInput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
 			},
 			OnPut: func(step *atc.PutStep) error {
 				return insertJobOutput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
@@ -1363,22 +1485,41 @@ func (t *team) insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID
 	return nil
 }
 
-func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
+func (t *team) insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
+	var version sql.NullString
+	if step.Version != nil {
+		versionJSON, err := step.Version.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		version = sql.NullString{Valid: true, String: string(versionJSON)}
+	}
+
+	var filter sql.NullString
+	if !step.Filter.IsEmpty() {
+		filterJSON, err := json.Marshal(step.Filter)
+		if err != nil {
+			return err
+		}
+
+		filter = sql.NullString{Valid: true, String: string(filterJSON)}
+	}
+
 	if len(step.Passed) != 0 {
 		for _, passedJob := range step.Passed {
-			var version sql.NullString
-			if step.Version != nil {
-				versionJSON, err := step.Version.MarshalJSON()
+			passedJobID, found := jobNameToID[passedJob]
+			if !found {
+				var err error
+				passedJobID, err = t.findPassedJobID(tx, passedJob)
 				if err != nil {
 					return err
 				}
-
-				version = sql.NullString{Valid: true, String: string(versionJSON)}
 			}
 
 			_, err := psql.Insert("job_inputs").
-				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version").
-				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version).
+				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version", "filter").
+				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], passedJobID, step.Trigger, version, filter).
 				RunWith(tx).
 				Exec()
 			if err != nil {
@@ -1386,19 +1527,9 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 			}
 		}
 	} else {
-		var version sql.NullString
-		if step.Version != nil {
-			versionJSON, err := step.Version.MarshalJSON()
-			if err != nil {
-				return err
-			}
-
-			version = sql.NullString{Valid: true, String: string(versionJSON)}
-		}
-
 		_, err := psql.Insert("job_inputs").
-			Columns("name", "job_id", "resource_id", "trigger", "version").
-			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version).
+			Columns("name", "job_id", "resource_id", "trigger", "version", "filter").
+			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version, filter).
 			RunWith(tx).
 			Exec()
 		if err != nil {
@@ -1409,6 +1540,50 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 	return nil
 }
 
+// findPassedJobID looks up a job in another pipeline referenced by a `passed:`
+// constraint. Jobs in pipelines belonging to other teams may only be
+// referenced if their pipeline is exposed.
+func (t *team) findPassedJobID(tx Tx, ref string) (int, error) {
+	passedJob, valid := atc.ParsePassedJob(ref)
+	if !valid || passedJob.IsLocal() {
+		return 0, PassedJobNotFoundError{Job: ref}
+	}
+
+	teamName := passedJob.Team
+	if teamName == "" {
+		teamName = t.name
+	}
+
+	var jobID int
+	var public bool
+	err := psql.Select("j.id", "p.public").
+		From("jobs j").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		Where(sq.Eq{
+			"j.name":   passedJob.Job,
+			"j.active": true,
+			"p.name":   passedJob.Pipeline,
+			"t.name":   teamName,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&jobID, &public)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, PassedJobNotFoundError{Job: ref}
+		}
+
+		return 0, err
+	}
+
+	if teamName != t.name && !public {
+		return 0, PassedJobNotFoundError{Job: ref}
+	}
+
+	return jobID, nil
+}
+
 func insertJobOutput(tx Tx, step *atc.PutStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	_, err := psql.Insert("job_outputs").
 		Columns("name", "job_id", "resource_id").
@@ -0,0 +1,302 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . TeamQuotaFactory
+
+type TeamQuotaFactory interface {
+	Quotas(teamID int) (atc.TeamQuotas, error)
+	Usage(teamID int) (atc.TeamUsage, error)
+
+	// BuildUsage returns the containers and volumes used by a single build.
+	BuildUsage(buildID int) (atc.TeamUsage, error)
+
+	// AllUsage returns the usage and quotas of every team, keyed by team name.
+	AllUsage() (map[string]TeamQuotaUsage, error)
+
+	// WaitingForQuota records that the build is waiting for room in its
+	// team's container or volume quota until the wait expires. It returns
+	// true if the build should give up instead, because every other running
+	// build of the team is waiting as well so none of them will free up the
+	// quota. Only the newest of the waiting builds gives up, so that the
+	// others can carry on once it has.
+	WaitingForQuota(teamID int, buildID int, expires time.Duration) (bool, error)
+
+	// StopWaitingForQuota removes the build's wait.
+	StopWaitingForQuota(buildID int) error
+
+	// TryStartCheck marks the check as started, unless its team has already
+	// started as many checks in the last minute as its checks per minute
+	// quota allows, in which case false is returned. Manually triggered
+	// checks do not count towards the quota.
+	TryStartCheck(teamID int, checkID int) (bool, error)
+}
+
+type TeamQuotaUsage struct {
+	Quotas atc.TeamQuotas
+	Usage  atc.TeamUsage
+}
+
+type teamQuotaFactory struct {
+	conn Conn
+}
+
+func NewTeamQuotaFactory(conn Conn) TeamQuotaFactory {
+	return &teamQuotaFactory{
+		conn: conn,
+	}
+}
+
+func (f *teamQuotaFactory) Quotas(teamID int) (atc.TeamQuotas, error) {
+	return teamQuotas(f.conn, teamID)
+}
+
+func (f *teamQuotaFactory) Usage(teamID int) (atc.TeamUsage, error) {
+	var usage atc.TeamUsage
+	err := teamUsageQuery.
+		Where(sq.Eq{"t.id": teamID}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&usage.RunningBuilds, &usage.Containers, &usage.Volumes)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.TeamUsage{}, nil
+		}
+		return atc.TeamUsage{}, err
+	}
+
+	return usage, nil
+}
+
+func (f *teamQuotaFactory) BuildUsage(buildID int) (atc.TeamUsage, error) {
+	var usage atc.TeamUsage
+	err := psql.Select().
+		Column(sq.Expr(`(SELECT COUNT(*) FROM containers c WHERE c.build_id = ?)`, buildID)).
+		Column(sq.Expr(`(SELECT COUNT(*) FROM volumes v JOIN containers c ON c.id = v.container_id WHERE c.build_id = ?)`, buildID)).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&usage.Containers, &usage.Volumes)
+	if err != nil {
+		return atc.TeamUsage{}, err
+	}
+
+	return usage, nil
+}
+
+func (f *teamQuotaFactory) AllUsage() (map[string]TeamQuotaUsage, error) {
+	rows, err := teamUsageQuery.
+		Columns("t.name", "t.quotas").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	all := map[string]TeamQuotaUsage{}
+	for rows.Next() {
+		var (
+			name   string
+			quotas sql.NullString
+			usage  TeamQuotaUsage
+		)
+
+		err = rows.Scan(&usage.Usage.RunningBuilds, &usage.Usage.Containers, &usage.Usage.Volumes, &name, &quotas)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Quotas, err = unmarshalTeamQuotas(quotas)
+		if err != nil {
+			return nil, err
+		}
+
+		all[name] = usage
+	}
+
+	return all, nil
+}
+
+func (f *teamQuotaFactory) WaitingForQuota(teamID int, buildID int, expires time.Duration) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("build_quota_waits").
+		Columns("build_id", "team_id", "expires_at").
+		Values(buildID, teamID, sq.Expr("NOW() + ?::interval", fmt.Sprintf("%.0f seconds", expires.Seconds()))).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET expires_at = EXCLUDED.expires_at").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	var notWaiting, newestWaiting int
+	err = psql.Select().
+		Column(sq.Expr(`(
+			SELECT COUNT(*) FROM builds b
+			WHERE b.team_id = ? AND b.status = 'started' AND NOT b.completed
+			AND NOT EXISTS (SELECT 1 FROM build_quota_waits w WHERE w.build_id = b.id AND w.expires_at > NOW())
+		)`, teamID)).
+		Column(sq.Expr(`(
+			SELECT COALESCE(MAX(w.build_id), 0) FROM build_quota_waits w
+			JOIN builds b ON b.id = w.build_id
+			WHERE w.team_id = ? AND w.expires_at > NOW() AND NOT b.completed
+		)`, teamID)).
+		RunWith(tx).
+		QueryRow().
+		Scan(&notWaiting, &newestWaiting)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return notWaiting == 0 && newestWaiting == buildID, nil
+}
+
+func (f *teamQuotaFactory) StopWaitingForQuota(buildID int) error {
+	_, err := psql.Delete("build_quota_waits").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *teamQuotaFactory) TryStartCheck(teamID int, checkID int) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	quotas, err := lockTeamQuotas(tx, teamID)
+	if err != nil {
+		return false, err
+	}
+
+	if quotas.MaxChecksPerMinute != 0 {
+		var started int
+		err = psql.Select("COUNT(*)").
+			From("checks").
+			Where(sq.Expr("(metadata->>'team_id')::integer = ?", teamID)).
+			Where(sq.Eq{"manually_triggered": false}).
+			Where(sq.Expr("start_time > NOW() - interval '1 minute'")).
+			Where(sq.NotEq{"id": checkID}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&started)
+		if err != nil {
+			return false, err
+		}
+
+		if started >= quotas.MaxChecksPerMinute {
+			return false, nil
+		}
+	}
+
+	_, err = psql.Update("checks").
+		Set("start_time", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": checkID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// A build counts against its team's quota from the moment it is scheduled
+// until it completes. Containers, and the volumes in them, only count while
+// they are in use, i.e. those of builds which have not completed and check
+// containers. Containers of finished builds, e.g. those kept around for
+// hijacking a failed build, do not count.
+var teamUsageQuery = psql.Select(
+	`(SELECT COUNT(*) FROM builds b WHERE b.team_id = t.id AND NOT b.completed AND (b.scheduled OR b.status = 'started'))`,
+	`(SELECT COUNT(*) FROM containers c `+quotaContainersJoin+` WHERE c.team_id = t.id AND `+quotaContainersCondition+`)`,
+	`(SELECT COUNT(*) FROM volumes v JOIN containers c ON c.id = v.container_id `+quotaContainersJoin+` WHERE v.team_id = t.id AND `+quotaContainersCondition+`)`,
+).From("teams t")
+
+// image check and get containers belong to the build of the container they
+// fetch the image for
+const quotaContainersJoin = `LEFT JOIN containers p ON p.id = COALESCE(c.image_check_container_id, c.image_get_container_id)`
+
+const quotaContainersCondition = `(
+	c.resource_config_check_session_id IS NOT NULL
+	OR EXISTS (SELECT 1 FROM builds b WHERE b.id = COALESCE(c.build_id, p.build_id) AND NOT b.completed)
+)`
+
+func teamQuotas(conn Conn, teamID int) (atc.TeamQuotas, error) {
+	var quotas sql.NullString
+	err := psql.Select("quotas").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&quotas)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.TeamQuotas{}, nil
+		}
+		return atc.TeamQuotas{}, err
+	}
+
+	return unmarshalTeamQuotas(quotas)
+}
+
+// lockTeamQuotas returns the team's quotas and locks the team until the
+// transaction ends, so that anything admitted against the quotas at the same
+// time, even by different ATCs, is counted one after the other.
+func lockTeamQuotas(tx Tx, teamID int) (atc.TeamQuotas, error) {
+	var quotas sql.NullString
+	err := psql.Select("quotas").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		Suffix("FOR NO KEY UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&quotas)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.TeamQuotas{}, nil
+		}
+		return atc.TeamQuotas{}, err
+	}
+
+	return unmarshalTeamQuotas(quotas)
+}
+
+func unmarshalTeamQuotas(quotas sql.NullString) (atc.TeamQuotas, error) {
+	teamQuotas := atc.TeamQuotas{}
+	if quotas.Valid {
+		err := json.Unmarshal([]byte(quotas.String), &teamQuotas)
+		if err != nil {
+			return atc.TeamQuotas{}, err
+		}
+	}
+
+	return teamQuotas, nil
+}
@@ -0,0 +1,264 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamQuotaFactory", func() {
+	Describe("Quotas", func() {
+		It("returns the quotas saved on the team", func() {
+			err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxVolumes: 5})
+			Expect(err).ToNot(HaveOccurred())
+
+			quotas, err := teamQuotaFactory.Quotas(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quotas).To(Equal(atc.TeamQuotas{MaxVolumes: 5}))
+		})
+
+		It("returns no quotas for a team that does not exist", func() {
+			quotas, err := teamQuotaFactory.Quotas(12345)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quotas).To(BeZero())
+		})
+	})
+
+	Describe("TryStartCheck", func() {
+		var createCheck func(manuallyTriggered bool) db.Check
+
+		BeforeEach(func() {
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "some-base-resource-type",
+			}
+
+			_, err = brt.FindOrCreate(setupTx, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			resourceConfigScope, err := defaultResource.SetResourceConfig(atc.Source{"some": "repository"}, atc.VersionedResourceTypes{})
+			Expect(err).NotTo(HaveOccurred())
+
+			createCheck = func(manuallyTriggered bool) db.Check {
+				check, created, err := checkFactory.CreateCheck(
+					resourceConfigScope.ID(),
+					manuallyTriggered,
+					atc.Plan{},
+					db.CheckMetadata{
+						TeamID:             defaultTeam.ID(),
+						TeamName:           defaultTeam.Name(),
+						PipelineName:       defaultPipeline.Name(),
+						ResourceConfigID:   resourceConfigScope.ResourceConfig().ID(),
+						BaseResourceTypeID: resourceConfigScope.ResourceConfig().OriginBaseResourceType().ID,
+					},
+					map[string]string{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				return check
+			}
+		})
+
+		Context("when the team has no checks per minute quota", func() {
+			It("starts every check", func() {
+				for i := 0; i < 3; i++ {
+					started, err := teamQuotaFactory.TryStartCheck(defaultTeam.ID(), createCheck(false).ID())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(started).To(BeTrue())
+				}
+			})
+		})
+
+		Context("when the team has a checks per minute quota", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxChecksPerMinute: 1})
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := teamQuotaFactory.TryStartCheck(defaultTeam.ID(), createCheck(false).ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+
+			It("does not start more checks than the quota allows in a minute", func() {
+				check := createCheck(false)
+
+				started, err := teamQuotaFactory.TryStartCheck(defaultTeam.ID(), check.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeFalse())
+
+				_, err = check.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(check.StartTime()).To(BeZero())
+			})
+
+			It("starts checks again once the started ones are a minute old", func() {
+				_, err := dbConn.Exec(`UPDATE checks SET start_time = NOW() - interval '2 minutes'`)
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := teamQuotaFactory.TryStartCheck(defaultTeam.ID(), createCheck(false).ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+
+			It("does not count manually triggered checks", func() {
+				_, err := dbConn.Exec(`UPDATE checks SET manually_triggered = true`)
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := teamQuotaFactory.TryStartCheck(defaultTeam.ID(), createCheck(false).ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Usage", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultTeam.CreateStartedBuild(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			container, err := defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()),
+				db.ContainerMetadata{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "/some/path")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the team's running builds, containers and volumes", func() {
+			usage, err := teamQuotaFactory.Usage(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(atc.TeamUsage{
+				RunningBuilds: 1,
+				Containers:    1,
+				Volumes:       1,
+			}))
+		})
+
+		Context("when the build finishes", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("no longer counts it or its containers and volumes", func() {
+				usage, err := teamQuotaFactory.Usage(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage).To(BeZero())
+			})
+		})
+
+		Describe("WaitingForQuota", func() {
+			var otherBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				otherBuild, err = defaultTeam.CreateStartedBuild(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when another running build is not waiting", func() {
+				It("keeps waiting", func() {
+					deadlocked, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), build.ID(), time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deadlocked).To(BeFalse())
+				})
+			})
+
+			Context("when every other running build is waiting", func() {
+				BeforeEach(func() {
+					deadlocked, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), build.ID(), time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deadlocked).To(BeFalse())
+				})
+
+				It("gives up on the newest waiting build", func() {
+					deadlocked, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), otherBuild.ID(), time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deadlocked).To(BeTrue())
+
+					deadlocked, err = teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), build.ID(), time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deadlocked).To(BeFalse())
+				})
+
+				Context("when the other build stops waiting", func() {
+					BeforeEach(func() {
+						err := teamQuotaFactory.StopWaitingForQuota(build.ID())
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("keeps waiting", func() {
+						deadlocked, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), otherBuild.ID(), time.Minute)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(deadlocked).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when the other build's wait has expired", func() {
+				BeforeEach(func() {
+					_, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), build.ID(), -time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps waiting", func() {
+					deadlocked, err := teamQuotaFactory.WaitingForQuota(defaultTeam.ID(), otherBuild.ID(), time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deadlocked).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("BuildUsage", func() {
+			It("counts the build's containers and volumes", func() {
+				usage, err := teamQuotaFactory.BuildUsage(build.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage).To(Equal(atc.TeamUsage{
+					Containers: 1,
+					Volumes:    1,
+				}))
+			})
+
+			It("counts nothing for other builds", func() {
+				usage, err := teamQuotaFactory.BuildUsage(build.ID() + 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage).To(BeZero())
+			})
+		})
+
+		Describe("AllUsage", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxContainers: 3})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the usage and quotas of every team", func() {
+				all, err := teamQuotaFactory.AllUsage()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(all).To(HaveKeyWithValue(defaultTeam.Name(), db.TeamQuotaUsage{
+					Quotas: atc.TeamQuotas{MaxContainers: 3},
+					Usage: atc.TeamUsage{
+						RunningBuilds: 1,
+						Containers:    1,
+						Volumes:       1,
+					},
+				}))
+			})
+		})
+	})
+})
@@ -858,6 +858,85 @@ var _ = Describe("Team", func() {
 				})
 			})
 		})
+
+		Describe("LintRules", func() {
+			It("returns no rules by default", func() {
+				rules, err := team.LintRules()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(BeEmpty())
+			})
+
+			It("returns the rules that were saved", func() {
+				err := team.UpdateLintRules(atc.LintRules{"privileged-task": atc.LintSeverityError})
+				Expect(err).ToNot(HaveOccurred())
+
+				rules, err := team.LintRules()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(Equal(atc.LintRules{"privileged-task": atc.LintSeverityError}))
+
+				err = team.UpdateLintRules(nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				rules, err = team.LintRules()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(BeEmpty())
+			})
+		})
+
+		Describe("Quotas", func() {
+			It("returns no quotas by default", func() {
+				quotas, err := team.Quotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(BeZero())
+			})
+
+			It("returns the quotas that were saved", func() {
+				err := team.UpdateQuotas(atc.TeamQuotas{MaxConcurrentBuilds: 2, MaxContainers: 10})
+				Expect(err).ToNot(HaveOccurred())
+
+				quotas, err := team.Quotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(Equal(atc.TeamQuotas{MaxConcurrentBuilds: 2, MaxContainers: 10}))
+
+				err = team.UpdateQuotas(atc.TeamQuotas{})
+				Expect(err).ToNot(HaveOccurred())
+
+				quotas, err = team.Quotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(BeZero())
+			})
+		})
+
+		Describe("UpdateSettings", func() {
+			BeforeEach(func() {
+				err := team.UpdateQuotas(atc.TeamQuotas{MaxContainers: 10})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("saves the auth, lint rules and quotas", func() {
+				err := team.UpdateSettings(authProvider, atc.LintRules{"privileged-task": atc.LintSeverityError}, &atc.TeamQuotas{MaxConcurrentBuilds: 2})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.Auth()).To(Equal(authProvider))
+
+				rules, err := team.LintRules()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(Equal(atc.LintRules{"privileged-task": atc.LintSeverityError}))
+
+				quotas, err := team.Quotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(Equal(atc.TeamQuotas{MaxConcurrentBuilds: 2}))
+			})
+
+			It("leaves the quotas alone when none are given", func() {
+				err := team.UpdateSettings(authProvider, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				quotas, err := team.Quotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(Equal(atc.TeamQuotas{MaxContainers: 10}))
+			})
+		})
 	})
 
 	Describe("Pipelines", func() {
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// versionFilterClause returns a condition matching the rows of
+// resource_config_versions (aliased as table) which satisfy the filter.
+func versionFilterClause(table string, filter *atc.VersionFilter) (sq.Sqlizer, error) {
+	clause := sq.And{}
+	if filter.IsEmpty() {
+		return clause, nil
+	}
+
+	for _, name := range sortedKeys(filter.Metadata) {
+		field, err := json.Marshal([]atc.MetadataField{{Name: name, Value: filter.Metadata[name]}})
+		if err != nil {
+			return nil, err
+		}
+
+		clause = append(clause, sq.Expr(table+".metadata @> ?::jsonb", string(field)))
+	}
+
+	for _, name := range sortedKeys(filter.Version) {
+		clause = append(clause, sq.Expr(table+".version->>? ~ ?", name, filter.Version[name]))
+	}
+
+	if filter.Semver != nil {
+		semverRange, err := atc.ParseSemverRange(filter.Semver.Range)
+		if err != nil {
+			return nil, err
+		}
+
+		key := fmt.Sprintf("semver_key(%s.version->>?)", table)
+
+		alternatives := sq.Or{}
+		for _, comparators := range semverRange {
+			alternative := sq.And{}
+			for _, comparator := range comparators {
+				alternative = append(alternative, sq.Expr(
+					fmt.Sprintf("%s %s ?::bigint[]", key, comparator.Operator),
+					filter.Semver.Field, pq.Array([]int64(comparator.Key)),
+				))
+			}
+
+			alternatives = append(alternatives, alternative)
+		}
+
+		clause = append(clause, alternatives)
+	}
+
+	return clause, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// versionFilterSQL renders the filter as a condition to append to a raw
+// query, numbering its placeholders from firstArg.
+func versionFilterSQL(table string, filter *atc.VersionFilter, firstArg int) (string, []interface{}, error) {
+	if filter.IsEmpty() {
+		return "", nil, nil
+	}
+
+	clause, err := versionFilterClause(table, filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query, args, err := clause.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var numbered strings.Builder
+	arg := firstArg
+	for _, c := range query {
+		if c == '?' {
+			fmt.Fprintf(&numbered, "$%d", arg)
+			arg++
+			continue
+		}
+
+		numbered.WriteRune(c)
+	}
+
+	return " AND " + numbered.String(), args, nil
+}
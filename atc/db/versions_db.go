@@ -71,7 +71,37 @@ func (versions VersionsDB) VersionIsDisabled(ctx context.Context, resourceID int
 	return exists, nil
 }
 
-func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int) (ResourceVersion, bool, error) {
+// VersionMatchesFilter returns true if the version of the resource satisfies
+// the filter. Every version matches an empty filter.
+func (versions VersionsDB) VersionMatchesFilter(ctx context.Context, resourceID int, versionMD5 ResourceVersion, filter *atc.VersionFilter) (bool, error) {
+	if filter.IsEmpty() {
+		return true, nil
+	}
+
+	clause, err := versionFilterClause("rcv", filter)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("resource_config_versions rcv").
+		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
+		Where(sq.Eq{"rcv.version_md5": versionMD5}).
+		Where(clause).
+		Suffix(")").
+		RunWith(versions.conn).
+		QueryRowContext(ctx).
+		Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int, filter *atc.VersionFilter) (ResourceVersion, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, err
@@ -79,7 +109,7 @@ func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resource
 
 	defer tx.Rollback()
 
-	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, filter)
 	if err != nil {
 		return "", false, err
 	}
@@ -266,7 +296,12 @@ func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID
 	return version, true, err
 }
 
-func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int) (ResourceVersion, bool, bool, error) {
+func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int, filter *atc.VersionFilter) (ResourceVersion, bool, bool, error) {
+	clause, err := versionFilterClause("rcv", filter)
+	if err != nil {
+		return "", false, false, err
+	}
+
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, false, err
@@ -297,7 +332,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		LIMIT 1;`, jobID, resourceID).Scan(&checkOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, filter)
 			if err != nil {
 				return "", false, false, err
 			}
@@ -322,6 +357,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(clause).
 		Where(sq.Gt{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order ASC").
 		Limit(2).
@@ -356,6 +392,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(clause).
 		Where(sq.LtOrEq{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order DESC").
 		Limit(1).
@@ -540,9 +577,14 @@ func (versions VersionsDB) newerBuilds(ctx context.Context, jobID int, lastUsedB
 	return builds, nil
 }
 
-func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int) (ResourceVersion, bool, error) {
+func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int, filter *atc.VersionFilter) (ResourceVersion, bool, error) {
+	clause, err := versionFilterClause("rcv", filter)
+	if err != nil {
+		return "", false, err
+	}
+
 	var scopeID sql.NullInt64
-	err := psql.Select("resource_config_scope_id").
+	err = psql.Select("resource_config_scope_id").
 		From("resources").
 		Where(sq.Eq{"id": resourceID}).
 		RunWith(tx).
@@ -560,11 +602,12 @@ func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, r
 	}
 
 	var version ResourceVersion
-	err = psql.Select("version_md5").
-		From("resource_config_versions").
-		Where(sq.Eq{"resource_config_scope_id": scopeID}).
-		Where(sq.Expr("version_md5 NOT IN (SELECT version_md5 FROM resource_disabled_versions WHERE resource_id = ?)", resourceID)).
-		OrderBy("check_order DESC").
+	err = psql.Select("rcv.version_md5").
+		From("resource_config_versions rcv").
+		Where(sq.Eq{"rcv.resource_config_scope_id": scopeID}).
+		Where(sq.Expr("rcv.version_md5 NOT IN (SELECT version_md5 FROM resource_disabled_versions WHERE resource_id = ?)", resourceID)).
+		Where(clause).
+		OrderBy("rcv.check_order DESC").
 		Limit(1).
 		RunWith(tx).
 		QueryRowContext(ctx).
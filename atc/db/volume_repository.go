@@ -383,6 +383,11 @@ func (repository *volumeRepository) FindTaskCacheVolume(teamID int, workerName s
 		return nil, false, nil
 	}
 
+	err = touchWorkerCache(repository.conn, "worker_task_caches", usedWorkerTaskCache.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
 	return createdVolume, true, nil
 }
 
@@ -449,6 +454,11 @@ func (repository *volumeRepository) FindResourceCacheVolume(workerName string, r
 		return nil, false, nil
 	}
 
+	err = touchWorkerCache(repository.conn, "worker_resource_caches", workerResourceCache.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
 	return createdVolume, true, nil
 }
 
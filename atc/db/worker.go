@@ -54,6 +54,7 @@ type Worker interface {
 	State() WorkerState
 	GardenAddr() *string
 	BaggageclaimURL() *string
+	P2PURL() string
 	CertsPath() *string
 	ResourceCerts() (*UsedWorkerResourceCerts, bool, error)
 	HTTPProxyURL() string
@@ -61,9 +62,13 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	VolumeStoreCapacity() int64
+	VolumeStoreUsed() int64
+	Allocatable() atc.ResourceRequests
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() map[string]string
 	TeamID() int
 	TeamName() string
 	StartTime() time.Time
@@ -81,6 +86,10 @@ type Worker interface {
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
 
+	CommittedRequests() (atc.ResourceRequests, error)
+	CommitRequests(ContainerOwner, atc.ResourceRequests) (bool, error)
+	ReleaseRequests(ContainerOwner) error
+
 	FindContainer(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 }
@@ -88,26 +97,31 @@ type Worker interface {
 type worker struct {
 	conn Conn
 
-	name             string
-	version          *string
-	state            WorkerState
-	gardenAddr       *string
-	baggageclaimURL  *string
-	httpProxyURL     string
-	httpsProxyURL    string
-	noProxy          string
-	activeContainers int
-	activeVolumes    int
-	activeTasks      int
-	resourceTypes    []atc.WorkerResourceType
-	platform         string
-	tags             []string
-	teamID           int
-	teamName         string
-	startTime        time.Time
-	expiresAt        time.Time
-	certsPath        *string
-	ephemeral        bool
+	name                string
+	version             *string
+	state               WorkerState
+	gardenAddr          *string
+	baggageclaimURL     *string
+	p2pURL              string
+	httpProxyURL        string
+	httpsProxyURL       string
+	noProxy             string
+	activeContainers    int
+	activeVolumes       int
+	activeTasks         int
+	volumeStoreCapacity int64
+	volumeStoreUsed     int64
+	allocatable         atc.ResourceRequests
+	resourceTypes       []atc.WorkerResourceType
+	platform            string
+	tags                []string
+	labels              map[string]string
+	teamID              int
+	teamName            string
+	startTime           time.Time
+	expiresAt           time.Time
+	certsPath           *string
+	ephemeral           bool
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -116,15 +130,20 @@ func (worker *worker) State() WorkerState       { return worker.state }
 func (worker *worker) GardenAddr() *string      { return worker.gardenAddr }
 func (worker *worker) CertsPath() *string       { return worker.certsPath }
 func (worker *worker) BaggageclaimURL() *string { return worker.baggageclaimURL }
+func (worker *worker) P2PURL() string           { return worker.p2pURL }
 
 func (worker *worker) HTTPProxyURL() string                    { return worker.httpProxyURL }
 func (worker *worker) HTTPSProxyURL() string                   { return worker.httpsProxyURL }
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) VolumeStoreCapacity() int64              { return worker.volumeStoreCapacity }
+func (worker *worker) VolumeStoreUsed() int64                  { return worker.volumeStoreUsed }
+func (worker *worker) Allocatable() atc.ResourceRequests       { return worker.allocatable }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() map[string]string               { return worker.labels }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
@@ -428,3 +447,133 @@ func (worker *worker) DecreaseActiveTasks() error {
 
 	return nil
 }
+
+// CommittedRequests returns the requests committed against the worker by
+// steps of builds which are still running.
+func (worker *worker) CommittedRequests() (atc.ResourceRequests, error) {
+	return committedRequests(worker.conn, worker.name)
+}
+
+// CommitRequests commits the requests of the build step owning a container
+// against the worker, replacing any it had already committed, unless they
+// would exceed the worker's allocatable capacity, in which case nothing is
+// committed and false is returned.
+//
+// Committed requests only count for as long as the step's build is running,
+// so that they are not leaked if they are never released.
+func (worker *worker) CommitRequests(owner ContainerOwner, requests atc.ResourceRequests) (bool, error) {
+	tx, err := worker.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var cpu, memory int64
+	err = psql.Select("allocatable_cpu", "allocatable_memory").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("FOR NO KEY UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&cpu, &memory)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	ownerCols, err := owner.Create(tx, worker.name)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = psql.Delete("worker_resource_commitments").
+		Where(sq.Eq(ownerCols)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	committed, err := committedRequests(tx, worker.name)
+	if err != nil {
+		return false, err
+	}
+
+	allocatable := atc.ResourceRequests{
+		CPU:    atc.CPUQuantity(cpu),
+		Memory: atc.MemoryQuantity(memory),
+	}
+
+	if !requests.Fits(allocatable, committed) {
+		return false, nil
+	}
+
+	columns := []string{"worker_name", "cpu", "memory"}
+	values := []interface{}{worker.name, uint64(requests.CPU), uint64(requests.Memory)}
+	for column, value := range ownerCols {
+		columns = append(columns, column)
+		values = append(values, value)
+	}
+
+	_, err = psql.Insert("worker_resource_commitments").
+		Columns(columns...).
+		Values(values...).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReleaseRequests releases the requests committed by the build step owning
+// a container.
+func (worker *worker) ReleaseRequests(owner ContainerOwner) error {
+	ownerQuery, found, err := owner.Find(worker.conn)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	_, err = psql.Delete("worker_resource_commitments").
+		Where(ownerQuery).
+		Where(sq.Eq{"worker_name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	return err
+}
+
+func committedRequests(runner sq.Runner, workerName string) (atc.ResourceRequests, error) {
+	var cpu, memory int64
+	err := psql.Select("COALESCE(SUM(c.cpu), 0)", "COALESCE(SUM(c.memory), 0)").
+		From("worker_resource_commitments c").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.worker_name": workerName,
+			"b.completed":   false,
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&cpu, &memory)
+	if err != nil {
+		return atc.ResourceRequests{}, err
+	}
+
+	return atc.ResourceRequests{
+		CPU:    atc.CPUQuantity(cpu),
+		Memory: atc.MemoryQuantity(memory),
+	}, nil
+}
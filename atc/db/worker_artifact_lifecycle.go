@@ -24,6 +24,9 @@ func (lifecycle *artifactLifecycle) RemoveExpiredArtifacts() error {
 
 	_, err := psql.Delete("worker_artifacts").
 		Where(sq.Expr("created_at < NOW() - interval '12 hours'")).
+		// artifacts holding on to cached task results are expired along with
+		// the result instead
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM task_result_cache_outputs o WHERE o.worker_artifact_id = worker_artifacts.id)")).
 		RunWith(lifecycle.conn).
 		Exec()
 
@@ -51,5 +51,28 @@ var _ = Describe("WorkerArtifactLifecycle", func() {
 				Expect(count).To(Equal(1))
 			})
 		})
+
+		Context("when an expired artifact holds on to a cached task result", func() {
+			BeforeEach(func() {
+				var artifactID int
+				err := dbConn.QueryRow("INSERT INTO worker_artifacts(name, created_at) VALUES('some-name', NOW() - '13 hours'::interval) RETURNING id").Scan(&artifactID)
+				Expect(err).ToNot(HaveOccurred())
+
+				build, err := defaultJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = taskResultCacheFactory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), 0, []db.TaskResultCacheOutput{
+					{Name: "some-output", Key: "some-output-key", VolumeHandle: "some-handle", WorkerArtifactID: artifactID},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not remove the record", func() {
+				var count int
+				err := dbConn.QueryRow("SELECT count(*) from worker_artifacts").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+		})
 	})
 })
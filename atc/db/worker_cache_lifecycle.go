@@ -0,0 +1,166 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . WorkerCacheLifecycle
+
+// WorkerCacheLifecycle evicts resource and task caches from workers whose
+// volume stores are running out of space.
+type WorkerCacheLifecycle interface {
+	WorkersAboveVolumeStoreUsage(highWaterMark float64) ([]string, error)
+	EvictLeastRecentlyUsedCaches(workerName string, limit int) (EvictedCaches, error)
+}
+
+type EvictedCaches struct {
+	ResourceCaches int
+	TaskCaches     int
+}
+
+type workerCacheLifecycle struct {
+	conn Conn
+}
+
+func NewWorkerCacheLifecycle(conn Conn) WorkerCacheLifecycle {
+	return &workerCacheLifecycle{
+		conn: conn,
+	}
+}
+
+// WorkersAboveVolumeStoreUsage returns the names of the workers which have
+// used at least the given fraction of their volume store. Workers which do not
+// report their volume store capacity are never returned.
+func (lifecycle *workerCacheLifecycle) WorkersAboveVolumeStoreUsage(highWaterMark float64) ([]string, error) {
+	query, args, err := psql.Select("name").
+		From("workers").
+		Where(sq.Eq{"state": []string{
+			string(WorkerStateRunning),
+			string(WorkerStateLanding),
+			string(WorkerStateRetiring),
+		}}).
+		Where(sq.Gt{"volume_store_capacity": 0}).
+		Where(sq.Expr("volume_store_used >= volume_store_capacity * ?::double precision", highWaterMark)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
+// EvictLeastRecentlyUsedCaches removes up to limit of the worker's resource
+// and task caches, least recently used first. Their volumes are orphaned and
+// destroyed by the volume collector. Caches whose volumes have children, i.e.
+// are in use by a container, are not evicted.
+func (lifecycle *workerCacheLifecycle) EvictLeastRecentlyUsedCaches(workerName string, limit int) (EvictedCaches, error) {
+	tx, err := lifecycle.conn.Begin()
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := tx.Query(`
+		SELECT type, id FROM (
+			SELECT 'resource' AS type, wrc.id, wrc.last_used
+			FROM worker_resource_caches wrc
+			JOIN worker_base_resource_types wbrt ON wbrt.id = wrc.worker_base_resource_type_id
+			WHERE wbrt.worker_name = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM volumes v
+				JOIN volumes c ON c.parent_id = v.id
+				WHERE v.worker_resource_cache_id = wrc.id
+			)
+			UNION ALL
+			SELECT 'task' AS type, wtc.id, wtc.last_used
+			FROM worker_task_caches wtc
+			WHERE wtc.worker_name = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM volumes v
+				JOIN volumes c ON c.parent_id = v.id
+				WHERE v.worker_task_cache_id = wtc.id
+			)
+		) caches
+		ORDER BY last_used ASC
+		LIMIT $2
+	`, workerName, limit)
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	var resourceCacheIDs, taskCacheIDs []int
+	for rows.Next() {
+		var cacheType string
+		var id int
+		err = rows.Scan(&cacheType, &id)
+		if err != nil {
+			Close(rows)
+			return EvictedCaches{}, err
+		}
+
+		if cacheType == "resource" {
+			resourceCacheIDs = append(resourceCacheIDs, id)
+		} else {
+			taskCacheIDs = append(taskCacheIDs, id)
+		}
+	}
+
+	Close(rows)
+
+	var evicted EvictedCaches
+
+	if len(resourceCacheIDs) > 0 {
+		evicted.ResourceCaches, err = deleteWorkerCaches(tx, "worker_resource_caches", resourceCacheIDs)
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+	}
+
+	if len(taskCacheIDs) > 0 {
+		evicted.TaskCaches, err = deleteWorkerCaches(tx, "worker_task_caches", taskCacheIDs)
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	return evicted, nil
+}
+
+func deleteWorkerCaches(tx Tx, table string, ids []int) (int, error) {
+	result, err := psql.Delete(table).
+		Where(sq.Eq{"id": ids}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+// touchWorkerCache records that a worker's resource or task cache has been
+// used, so that it is evicted last.
+func touchWorkerCache(runner sq.Runner, table string, id int) error {
+	_, err := psql.Update(table).
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		RunWith(runner).
+		Exec()
+	return err
+}
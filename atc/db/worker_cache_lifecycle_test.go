@@ -0,0 +1,163 @@
+package db_test
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerCacheLifecycle", func() {
+	var lifecycle db.WorkerCacheLifecycle
+
+	BeforeEach(func() {
+		lifecycle = db.NewWorkerCacheLifecycle(dbConn)
+	})
+
+	Describe("WorkersAboveVolumeStoreUsage", func() {
+		BeforeEach(func() {
+			fullWorker := defaultWorkerPayload
+			fullWorker.VolumeStoreCapacity = 1000
+			fullWorker.VolumeStoreUsed = 950
+
+			_, err := workerFactory.HeartbeatWorker(fullWorker, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			roomyWorker := otherWorkerPayload
+			roomyWorker.VolumeStoreCapacity = 1000
+			roomyWorker.VolumeStoreUsed = 500
+
+			_, err = workerFactory.HeartbeatWorker(roomyWorker, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the workers at or above the high-water mark", func() {
+			workerNames, err := lifecycle.WorkersAboveVolumeStoreUsage(0.9)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workerNames).To(ConsistOf(defaultWorker.Name()))
+
+			workerNames, err = lifecycle.WorkersAboveVolumeStoreUsage(0.5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workerNames).To(ConsistOf(defaultWorker.Name(), otherWorker.Name()))
+		})
+
+		It("does not return workers which do not report their usage", func() {
+			_, err := workerFactory.HeartbeatWorker(otherWorkerPayload, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			workerNames, err := lifecycle.WorkersAboveVolumeStoreUsage(0.1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workerNames).To(ConsistOf(defaultWorker.Name()))
+		})
+	})
+
+	Describe("EvictLeastRecentlyUsedCaches", func() {
+		var cacheVolumes []db.CreatedVolume
+
+		BeforeEach(func() {
+			cacheVolumes = nil
+
+			for i := 0; i < 3; i++ {
+				taskCache, err := taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-task", fmt.Sprintf("some-path-%d", i))
+				Expect(err).ToNot(HaveOccurred())
+
+				uwtc, err := workerTaskCacheFactory.FindOrCreate(db.WorkerTaskCache{
+					WorkerName: defaultWorker.Name(),
+					TaskCache:  taskCache,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(
+					fmt.Sprintf("UPDATE worker_task_caches SET last_used = NOW() - '%d hours'::interval WHERE id = $1", 3-i),
+					uwtc.ID,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateTaskCacheVolume(defaultTeam.ID(), uwtc)
+				Expect(err).ToNot(HaveOccurred())
+
+				createdVolume, err := creatingVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				cacheVolumes = append(cacheVolumes, createdVolume)
+			}
+		})
+
+		taskCacheVolumes := func() []string {
+			rows, err := psql.Select("handle").
+				From("volumes").
+				Where(sq.NotEq{"worker_task_cache_id": nil}).
+				RunWith(dbConn).
+				Query()
+			Expect(err).ToNot(HaveOccurred())
+
+			defer rows.Close()
+
+			var handles []string
+			for rows.Next() {
+				var handle string
+				Expect(rows.Scan(&handle)).To(Succeed())
+				handles = append(handles, handle)
+			}
+
+			return handles
+		}
+
+		It("evicts the least recently used caches, up to the limit", func() {
+			evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(evicted).To(Equal(db.EvictedCaches{TaskCaches: 2}))
+
+			Expect(taskCacheVolumes()).To(ConsistOf(cacheVolumes[2].Handle()))
+		})
+
+		It("does not evict caches from other workers", func() {
+			evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(otherWorker.Name(), 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(evicted).To(Equal(db.EvictedCaches{}))
+
+			Expect(taskCacheVolumes()).To(HaveLen(3))
+		})
+
+		Context("when a cache has been used recently", func() {
+			BeforeEach(func() {
+				taskCache, err := taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-task", "some-path-0")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, found, err := volumeRepository.FindTaskCacheVolume(defaultTeam.ID(), defaultWorker.Name(), taskCache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("is evicted last", func() {
+				_, err := lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 2)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(taskCacheVolumes()).To(ConsistOf(cacheVolumes[0].Handle()))
+			})
+		})
+
+		Context("when a cache's volume is in use by a container", func() {
+			BeforeEach(func() {
+				_, err := psql.Insert("volumes").SetMap(map[string]interface{}{
+					"handle":       "some-child-handle",
+					"worker_name":  defaultWorker.Name(),
+					"state":        db.VolumeStateCreated,
+					"parent_id":    sq.Expr("(SELECT id FROM volumes WHERE handle = ?)", cacheVolumes[0].Handle()),
+					"parent_state": db.VolumeStateCreated,
+				}).RunWith(dbConn).Exec()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is not evicted", func() {
+				evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(db.EvictedCaches{TaskCaches: 2}))
+
+				Expect(taskCacheVolumes()).To(ConsistOf(cacheVolumes[0].Handle()))
+			})
+		})
+	})
+})
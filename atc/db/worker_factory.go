@@ -41,15 +41,21 @@ var workersQuery = psql.Select(`
 		w.addr,
 		w.state,
 		w.baggageclaim_url,
+		w.p2p_url,
 		w.certs_path,
 		w.http_proxy_url,
 		w.https_proxy_url,
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.volume_store_capacity,
+		w.volume_store_used,
+		w.allocatable_cpu,
+		w.allocatable_memory,
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
 		t.name,
 		w.team_id,
 		w.start_time,
@@ -124,22 +130,26 @@ func getWorkers(conn Conn, query sq.SelectBuilder) ([]Worker, error) {
 
 func scanWorker(worker *worker, row scannable) error {
 	var (
-		version       sql.NullString
-		addStr        sql.NullString
-		state         string
-		bcURLStr      sql.NullString
-		certsPathStr  sql.NullString
-		httpProxyURL  sql.NullString
-		httpsProxyURL sql.NullString
-		noProxy       sql.NullString
-		resourceTypes []byte
-		platform      sql.NullString
-		tags          []byte
-		teamName      sql.NullString
-		teamID        sql.NullInt64
-		startTime     pq.NullTime
-		expiresAt     pq.NullTime
-		ephemeral     sql.NullBool
+		version           sql.NullString
+		addStr            sql.NullString
+		state             string
+		bcURLStr          sql.NullString
+		p2pURL            sql.NullString
+		certsPathStr      sql.NullString
+		httpProxyURL      sql.NullString
+		httpsProxyURL     sql.NullString
+		noProxy           sql.NullString
+		resourceTypes     []byte
+		platform          sql.NullString
+		tags              []byte
+		allocatableCPU    int64
+		allocatableMemory int64
+		labels            sql.NullString
+		teamName          sql.NullString
+		teamID            sql.NullInt64
+		startTime         pq.NullTime
+		expiresAt         pq.NullTime
+		ephemeral         sql.NullBool
 	)
 
 	err := row.Scan(
@@ -148,15 +158,21 @@ func scanWorker(worker *worker, row scannable) error {
 		&addStr,
 		&state,
 		&bcURLStr,
+		&p2pURL,
 		&certsPathStr,
 		&httpProxyURL,
 		&httpsProxyURL,
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&worker.volumeStoreCapacity,
+		&worker.volumeStoreUsed,
+		&allocatableCPU,
+		&allocatableMemory,
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
 		&teamName,
 		&teamID,
 		&startTime,
@@ -179,11 +195,19 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.baggageclaimURL = &bcURLStr.String
 	}
 
+	if p2pURL.Valid {
+		worker.p2pURL = p2pURL.String
+	}
+
 	if certsPathStr.Valid {
 		worker.certsPath = &certsPathStr.String
 	}
 
 	worker.state = WorkerState(state)
+	worker.allocatable = atc.ResourceRequests{
+		CPU:    atc.CPUQuantity(allocatableCPU),
+		Memory: atc.MemoryQuantity(allocatableMemory),
+	}
 	worker.startTime = startTime.Time
 	worker.expiresAt = expiresAt.Time
 
@@ -220,7 +244,19 @@ func scanWorker(worker *worker, row scannable) error {
 		return err
 	}
 
-	return json.Unmarshal(tags, &worker.tags)
+	err = json.Unmarshal(tags, &worker.tags)
+	if err != nil {
+		return err
+	}
+
+	if labels.Valid {
+		err = json.Unmarshal([]byte(labels.String), &worker.labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
@@ -256,6 +292,8 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("volume_store_capacity", atcWorker.VolumeStoreCapacity).
+		Set("volume_store_used", atcWorker.VolumeStoreUsed).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -375,6 +413,22 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	var allocatable atc.ResourceRequests
+	if atcWorker.Allocatable != nil {
+		allocatable = *atcWorker.Allocatable
+	}
+
+	var labels *string
+	if len(atcWorker.Labels) > 0 {
+		payload, err := json.Marshal(atcWorker.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		labels = new(string)
+		*labels = string(payload)
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -398,10 +452,16 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		atcWorker.VolumeStoreCapacity,
+		atcWorker.VolumeStoreUsed,
+		uint64(allocatable.CPU),
+		uint64(allocatable.Memory),
 		resourceTypes,
 		tags,
+		labels,
 		atcWorker.Platform,
 		atcWorker.BaggageclaimURL,
+		atcWorker.P2PURL,
 		atcWorker.CertsPath,
 		atcWorker.HTTPProxyURL,
 		atcWorker.HTTPSProxyURL,
@@ -429,10 +489,16 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"volume_store_capacity",
+			"volume_store_used",
+			"allocatable_cpu",
+			"allocatable_memory",
 			"resource_types",
 			"tags",
+			"labels",
 			"platform",
 			"baggageclaim_url",
+			"p2p_url",
 			"certs_path",
 			"http_proxy_url",
 			"https_proxy_url",
@@ -454,10 +520,16 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				volume_store_capacity = ?,
+				volume_store_used = ?,
+				allocatable_cpu = ?,
+				allocatable_memory = ?,
 				resource_types = ?,
 				tags = ?,
+				labels = ?,
 				platform = ?,
 				baggageclaim_url = ?,
+				p2p_url = ?,
 				certs_path = ?,
 				http_proxy_url = ?,
 				https_proxy_url = ?,
@@ -491,25 +563,30 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 	}
 
 	savedWorker := &worker{
-		name:             atcWorker.Name,
-		version:          workerVersion,
-		state:            workerState,
-		gardenAddr:       &atcWorker.GardenAddr,
-		baggageclaimURL:  &atcWorker.BaggageclaimURL,
-		certsPath:        atcWorker.CertsPath,
-		httpProxyURL:     atcWorker.HTTPProxyURL,
-		httpsProxyURL:    atcWorker.HTTPSProxyURL,
-		noProxy:          atcWorker.NoProxy,
-		activeContainers: atcWorker.ActiveContainers,
-		activeVolumes:    atcWorker.ActiveVolumes,
-		resourceTypes:    atcWorker.ResourceTypes,
-		platform:         atcWorker.Platform,
-		tags:             atcWorker.Tags,
-		teamName:         atcWorker.Team,
-		teamID:           workerTeamID,
-		startTime:        time.Unix(atcWorker.StartTime, 0),
-		ephemeral:        atcWorker.Ephemeral,
-		conn:             conn,
+		name:                atcWorker.Name,
+		version:             workerVersion,
+		state:               workerState,
+		gardenAddr:          &atcWorker.GardenAddr,
+		baggageclaimURL:     &atcWorker.BaggageclaimURL,
+		p2pURL:              atcWorker.P2PURL,
+		certsPath:           atcWorker.CertsPath,
+		httpProxyURL:        atcWorker.HTTPProxyURL,
+		httpsProxyURL:       atcWorker.HTTPSProxyURL,
+		noProxy:             atcWorker.NoProxy,
+		activeContainers:    atcWorker.ActiveContainers,
+		activeVolumes:       atcWorker.ActiveVolumes,
+		resourceTypes:       atcWorker.ResourceTypes,
+		volumeStoreCapacity: atcWorker.VolumeStoreCapacity,
+		volumeStoreUsed:     atcWorker.VolumeStoreUsed,
+		allocatable:         allocatable,
+		platform:            atcWorker.Platform,
+		tags:                atcWorker.Tags,
+		labels:              atcWorker.Labels,
+		teamName:            atcWorker.Team,
+		teamID:              workerTeamID,
+		startTime:           time.Unix(atcWorker.StartTime, 0),
+		ephemeral:           atcWorker.Ephemeral,
+		conn:                conn,
 	}
 
 	workerBaseResourceTypeIDs := []int{}
@@ -22,6 +22,7 @@ var _ = Describe("WorkerFactory", func() {
 		atcWorker = atc.Worker{
 			GardenAddr:       "some-garden-addr",
 			BaggageclaimURL:  "some-bc-url",
+			P2PURL:           "some-p2p-url",
 			HTTPProxyURL:     "some-http-proxy-url",
 			HTTPSProxyURL:    "some-https-proxy-url",
 			NoProxy:          "some-no-proxy",
@@ -44,6 +45,7 @@ var _ = Describe("WorkerFactory", func() {
 			},
 			Platform:  "some-platform",
 			Tags:      atc.Tags{"some", "tags"},
+			Labels:    map[string]string{"zone": "us-east"},
 			Name:      "some-name",
 			StartTime: 1565367209,
 		}
@@ -221,6 +223,7 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.GardenAddr()).To(Equal("some-garden-addr"))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
+				Expect(foundWorker.P2PURL()).To(Equal("some-p2p-url"))
 				Expect(foundWorker.HTTPProxyURL()).To(Equal("some-http-proxy-url"))
 				Expect(foundWorker.HTTPSProxyURL()).To(Equal("some-https-proxy-url"))
 				Expect(foundWorker.NoProxy()).To(Equal("some-no-proxy"))
@@ -242,6 +245,7 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(map[string]string{"zone": "us-east"}))
 				Expect(foundWorker.StartTime().Unix()).To(Equal(int64(1565367209)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
@@ -435,6 +439,17 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("updates the volume store usage", func() {
+				atcWorker.VolumeStoreCapacity = 1000
+				atcWorker.VolumeStoreUsed = 400
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(foundWorker.VolumeStoreCapacity()).To(Equal(int64(1000)))
+				Expect(foundWorker.VolumeStoreUsed()).To(Equal(int64(400)))
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
@@ -436,4 +436,122 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("Resource requests", func() {
+		var (
+			build Build
+			owner ContainerOwner
+		)
+
+		BeforeEach(func() {
+			atcWorker.Allocatable = &atc.ResourceRequests{CPU: 2000, Memory: 4096}
+
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			build, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			owner = NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID())
+		})
+
+		It("has the allocatable capacity it registered with", func() {
+			Expect(worker.Allocatable()).To(Equal(atc.ResourceRequests{CPU: 2000, Memory: 4096}))
+		})
+
+		Context("when the worker registers", func() {
+			It("has no committed requests", func() {
+				committed, err := worker.CommittedRequests()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(committed).To(BeZero())
+			})
+		})
+
+		Context("when requests within its capacity are committed", func() {
+			var committed bool
+
+			BeforeEach(func() {
+				var err error
+				committed, err = worker.CommitRequests(owner, atc.ResourceRequests{CPU: 1500, Memory: 1024})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("adds them to the committed requests", func() {
+				Expect(committed).To(BeTrue())
+
+				requests, err := worker.CommittedRequests()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(requests).To(Equal(atc.ResourceRequests{CPU: 1500, Memory: 1024}))
+			})
+
+			Context("when more requests than the remaining capacity are committed", func() {
+				It("does not commit them", func() {
+					otherOwner := NewBuildStepContainerOwner(build.ID(), "other-plan", defaultTeam.ID())
+
+					committed, err := worker.CommitRequests(otherOwner, atc.ResourceRequests{CPU: 1000})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(committed).To(BeFalse())
+
+					requests, err := worker.CommittedRequests()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(requests).To(Equal(atc.ResourceRequests{CPU: 1500, Memory: 1024}))
+				})
+			})
+
+			Context("when the same step commits its requests again", func() {
+				It("replaces the requests it had committed", func() {
+					committed, err := worker.CommitRequests(owner, atc.ResourceRequests{CPU: 2000})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(committed).To(BeTrue())
+
+					requests, err := worker.CommittedRequests()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(requests).To(Equal(atc.ResourceRequests{CPU: 2000}))
+				})
+			})
+
+			Context("when the requests are released", func() {
+				BeforeEach(func() {
+					err := worker.ReleaseRequests(owner)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes them from the committed requests", func() {
+					requests, err := worker.CommittedRequests()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(requests).To(BeZero())
+				})
+			})
+
+			Context("when the build finishes without releasing them", func() {
+				BeforeEach(func() {
+					err := build.Finish(BuildStatusErrored)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("no longer counts them", func() {
+					requests, err := worker.CommittedRequests()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(requests).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the worker has unlimited capacity", func() {
+			BeforeEach(func() {
+				atcWorker.Allocatable = nil
+
+				var err error
+				worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("commits any requests", func() {
+				committed, err := worker.CommitRequests(owner, atc.ResourceRequests{CPU: 64000, Memory: 1 << 40})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(committed).To(BeTrue())
+			})
+		})
+	})
 })
@@ -25,6 +25,9 @@ type StepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.CheckDelegate) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
+	AcquireStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate, exec.Step) exec.Step
+	ReleaseStep(atc.Plan, exec.StepMetadata, exec.Step) exec.Step
+	IfStep(atc.Plan, atc.ConditionBuild, exec.BuildStepDelegate, exec.Step) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 }
@@ -143,6 +146,18 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildDoStep(build, plan, credVarsTracker)
 	}
 
+	if plan.If != nil {
+		return builder.buildIfStep(build, plan, credVarsTracker)
+	}
+
+	if plan.Acquire != nil {
+		return builder.buildAcquireStep(build, plan, credVarsTracker)
+	}
+
+	if plan.Release != nil {
+		return builder.buildReleaseStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Timeout != nil {
 		return builder.buildTimeoutStep(build, plan, credVarsTracker)
 	}
@@ -253,6 +268,69 @@ func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan, cred
 	return exec.Timeout(step, plan.Timeout.Duration)
 }
 
+func (builder *stepBuilder) buildIfStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan.If.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+
+	trigger := "scheduled"
+	if build.RerunOf() != 0 {
+		trigger = "rerun"
+	} else if build.IsManuallyTriggered() {
+		trigger = "manual"
+	}
+
+	// the skipped event is shown in place of the nested step
+	return builder.stepFactory.IfStep(
+		plan,
+		atc.ConditionBuild{
+			Team:     build.TeamName(),
+			Pipeline: build.PipelineName(),
+			Job:      build.JobName(),
+			Name:     build.Name(),
+			Trigger:  trigger,
+		},
+		builder.delegateFactory.BuildStepDelegate(build, innerPlan.ID, credVarsTracker),
+		step,
+	)
+}
+
+func (builder *stepBuilder) buildAcquireStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan.Acquire.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	// output from waiting on locks is shown in the log of the nested step
+	return builder.stepFactory.AcquireStep(
+		plan,
+		stepMetadata,
+		builder.delegateFactory.BuildStepDelegate(build, innerPlan.ID, credVarsTracker),
+		step,
+	)
+}
+
+func (builder *stepBuilder) buildReleaseStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan.Release.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	return builder.stepFactory.ReleaseStep(
+		plan,
+		stepMetadata,
+		step,
+	)
+}
+
 func (builder *stepBuilder) buildTryStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 	innerPlan := plan.Try.Step
 	innerPlan.Attempts = plan.Attempts
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
 )
 
 type StepBuilder interface {
@@ -552,6 +553,118 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that acquires and releases locks", func() {
+						var (
+							taskPlan    atc.Plan
+							releasePlan atc.Plan
+							fakeTask    *execfakes.FakeStep
+							fakeRelease *execfakes.FakeStep
+						)
+
+						BeforeEach(func() {
+							taskPlan = planFactory.NewPlan(atc.TaskPlan{
+								Name:       "some-task",
+								ConfigPath: "some-input/build.yml",
+							})
+
+							releasePlan = planFactory.NewPlan(atc.ReleasePlan{
+								Step:  taskPlan,
+								Locks: atc.LockConfigs{{Name: "some-lock"}},
+							})
+
+							expectedPlan = planFactory.NewPlan(atc.AcquirePlan{
+								Step:  releasePlan,
+								Locks: atc.LockConfigs{{Name: "some-lock"}},
+							})
+
+							fakeTask = new(execfakes.FakeStep)
+							fakeStepFactory.TaskStepReturns(fakeTask)
+
+							fakeRelease = new(execfakes.FakeStep)
+							fakeStepFactory.ReleaseStepReturns(fakeRelease)
+						})
+
+						It("wraps the nested steps", func() {
+							plan, stepMetadata, nestedStep := fakeStepFactory.ReleaseStepArgsForCall(0)
+							Expect(plan).To(Equal(releasePlan))
+							Expect(stepMetadata).To(Equal(expectedMetadata))
+							Expect(nestedStep).To(Equal(fakeTask))
+
+							plan, stepMetadata, _, nestedStep = fakeStepFactory.AcquireStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadata))
+							Expect(nestedStep).To(Equal(fakeRelease))
+						})
+
+						It("shows output from waiting in the nested step", func() {
+							_, planID, _ := fakeDelegateFactory.BuildStepDelegateArgsForCall(0)
+							Expect(planID).To(Equal(releasePlan.ID))
+						})
+					})
+
+					Context("that runs a step conditionally", func() {
+						var (
+							taskPlan atc.Plan
+							fakeTask *execfakes.FakeStep
+						)
+
+						BeforeEach(func() {
+							taskPlan = planFactory.NewPlan(atc.TaskPlan{
+								Name:       "some-task",
+								ConfigPath: "some-input/build.yml",
+							})
+
+							expectedPlan = planFactory.NewPlan(atc.IfPlan{
+								Step:      taskPlan,
+								Condition: `vars.branch == "main"`,
+							})
+
+							fakeTask = new(execfakes.FakeStep)
+							fakeStepFactory.TaskStepReturns(fakeTask)
+						})
+
+						It("wraps the nested step", func() {
+							plan, build, _, nestedStep := fakeStepFactory.IfStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(build).To(Equal(atc.ConditionBuild{
+								Team:     "some-team",
+								Pipeline: fakePipeline.Name(),
+								Job:      "some-job",
+								Name:     "42",
+								Trigger:  "scheduled",
+							}))
+							Expect(nestedStep).To(Equal(fakeTask))
+						})
+
+						It("shows the skipped event in place of the nested step", func() {
+							_, planID, _ := fakeDelegateFactory.BuildStepDelegateArgsForCall(0)
+							Expect(planID).To(Equal(taskPlan.ID))
+						})
+
+						Context("when the build was triggered manually", func() {
+							BeforeEach(func() {
+								fakeBuild.IsManuallyTriggeredReturns(true)
+							})
+
+							It("evaluates the condition with a manual trigger", func() {
+								_, build, _, _ := fakeStepFactory.IfStepArgsForCall(0)
+								Expect(build.Trigger).To(Equal("manual"))
+							})
+						})
+
+						Context("when the build is a rerun", func() {
+							BeforeEach(func() {
+								fakeBuild.IsManuallyTriggeredReturns(true)
+								fakeBuild.RerunOfReturns(41)
+							})
+
+							It("evaluates the condition with a rerun trigger", func() {
+								_, build, _, _ := fakeStepFactory.IfStepArgsForCall(0)
+								Expect(build.Trigger).To(Equal("rerun"))
+							})
+						})
+					})
+
 					Context("that contains a load_var step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.LoadVarPlan{
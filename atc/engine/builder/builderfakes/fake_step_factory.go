@@ -11,6 +11,20 @@ import (
 )
 
 type FakeStepFactory struct {
+	AcquireStepStub        func(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate, exec.Step) exec.Step
+	acquireStepMutex       sync.RWMutex
+	acquireStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.BuildStepDelegate
+		arg4 exec.Step
+	}
+	acquireStepReturns struct {
+		result1 exec.Step
+	}
+	acquireStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	ArtifactInputStepStub        func(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	artifactInputStepMutex       sync.RWMutex
 	artifactInputStepArgsForCall []struct {
@@ -65,6 +79,20 @@ type FakeStepFactory struct {
 	getStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	IfStepStub        func(atc.Plan, atc.ConditionBuild, exec.BuildStepDelegate, exec.Step) exec.Step
+	ifStepMutex       sync.RWMutex
+	ifStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 atc.ConditionBuild
+		arg3 exec.BuildStepDelegate
+		arg4 exec.Step
+	}
+	ifStepReturns struct {
+		result1 exec.Step
+	}
+	ifStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	LoadVarStepStub        func(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
 	loadVarStepMutex       sync.RWMutex
 	loadVarStepArgsForCall []struct {
@@ -92,6 +120,19 @@ type FakeStepFactory struct {
 	putStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	ReleaseStepStub        func(atc.Plan, exec.StepMetadata, exec.Step) exec.Step
+	releaseStepMutex       sync.RWMutex
+	releaseStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.Step
+	}
+	releaseStepReturns struct {
+		result1 exec.Step
+	}
+	releaseStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	SetPipelineStepStub        func(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
 	setPipelineStepMutex       sync.RWMutex
 	setPipelineStepArgsForCall []struct {
@@ -123,6 +164,69 @@ type FakeStepFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStepFactory) AcquireStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.BuildStepDelegate, arg4 exec.Step) exec.Step {
+	fake.acquireStepMutex.Lock()
+	ret, specificReturn := fake.acquireStepReturnsOnCall[len(fake.acquireStepArgsForCall)]
+	fake.acquireStepArgsForCall = append(fake.acquireStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.BuildStepDelegate
+		arg4 exec.Step
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("AcquireStep", []interface{}{arg1, arg2, arg3, arg4})
+	fake.acquireStepMutex.Unlock()
+	if fake.AcquireStepStub != nil {
+		return fake.AcquireStepStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.acquireStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) AcquireStepCallCount() int {
+	fake.acquireStepMutex.RLock()
+	defer fake.acquireStepMutex.RUnlock()
+	return len(fake.acquireStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) AcquireStepCalls(stub func(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate, exec.Step) exec.Step) {
+	fake.acquireStepMutex.Lock()
+	defer fake.acquireStepMutex.Unlock()
+	fake.AcquireStepStub = stub
+}
+
+func (fake *FakeStepFactory) AcquireStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, exec.BuildStepDelegate, exec.Step) {
+	fake.acquireStepMutex.RLock()
+	defer fake.acquireStepMutex.RUnlock()
+	argsForCall := fake.acquireStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStepFactory) AcquireStepReturns(result1 exec.Step) {
+	fake.acquireStepMutex.Lock()
+	defer fake.acquireStepMutex.Unlock()
+	fake.AcquireStepStub = nil
+	fake.acquireStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) AcquireStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.acquireStepMutex.Lock()
+	defer fake.acquireStepMutex.Unlock()
+	fake.AcquireStepStub = nil
+	if fake.acquireStepReturnsOnCall == nil {
+		fake.acquireStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.acquireStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) ArtifactInputStep(arg1 atc.Plan, arg2 db.Build, arg3 exec.BuildStepDelegate) exec.Step {
 	fake.artifactInputStepMutex.Lock()
 	ret, specificReturn := fake.artifactInputStepReturnsOnCall[len(fake.artifactInputStepArgsForCall)]
@@ -373,6 +477,69 @@ func (fake *FakeStepFactory) GetStepReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeStepFactory) IfStep(arg1 atc.Plan, arg2 atc.ConditionBuild, arg3 exec.BuildStepDelegate, arg4 exec.Step) exec.Step {
+	fake.ifStepMutex.Lock()
+	ret, specificReturn := fake.ifStepReturnsOnCall[len(fake.ifStepArgsForCall)]
+	fake.ifStepArgsForCall = append(fake.ifStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 atc.ConditionBuild
+		arg3 exec.BuildStepDelegate
+		arg4 exec.Step
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("IfStep", []interface{}{arg1, arg2, arg3, arg4})
+	fake.ifStepMutex.Unlock()
+	if fake.IfStepStub != nil {
+		return fake.IfStepStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.ifStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) IfStepCallCount() int {
+	fake.ifStepMutex.RLock()
+	defer fake.ifStepMutex.RUnlock()
+	return len(fake.ifStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) IfStepCalls(stub func(atc.Plan, atc.ConditionBuild, exec.BuildStepDelegate, exec.Step) exec.Step) {
+	fake.ifStepMutex.Lock()
+	defer fake.ifStepMutex.Unlock()
+	fake.IfStepStub = stub
+}
+
+func (fake *FakeStepFactory) IfStepArgsForCall(i int) (atc.Plan, atc.ConditionBuild, exec.BuildStepDelegate, exec.Step) {
+	fake.ifStepMutex.RLock()
+	defer fake.ifStepMutex.RUnlock()
+	argsForCall := fake.ifStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStepFactory) IfStepReturns(result1 exec.Step) {
+	fake.ifStepMutex.Lock()
+	defer fake.ifStepMutex.Unlock()
+	fake.IfStepStub = nil
+	fake.ifStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) IfStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.ifStepMutex.Lock()
+	defer fake.ifStepMutex.Unlock()
+	fake.IfStepStub = nil
+	if fake.ifStepReturnsOnCall == nil {
+		fake.ifStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.ifStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) LoadVarStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.BuildStepDelegate) exec.Step {
 	fake.loadVarStepMutex.Lock()
 	ret, specificReturn := fake.loadVarStepReturnsOnCall[len(fake.loadVarStepArgsForCall)]
@@ -498,6 +665,68 @@ func (fake *FakeStepFactory) PutStepReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeStepFactory) ReleaseStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.Step) exec.Step {
+	fake.releaseStepMutex.Lock()
+	ret, specificReturn := fake.releaseStepReturnsOnCall[len(fake.releaseStepArgsForCall)]
+	fake.releaseStepArgsForCall = append(fake.releaseStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.Step
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ReleaseStep", []interface{}{arg1, arg2, arg3})
+	fake.releaseStepMutex.Unlock()
+	if fake.ReleaseStepStub != nil {
+		return fake.ReleaseStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) ReleaseStepCallCount() int {
+	fake.releaseStepMutex.RLock()
+	defer fake.releaseStepMutex.RUnlock()
+	return len(fake.releaseStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) ReleaseStepCalls(stub func(atc.Plan, exec.StepMetadata, exec.Step) exec.Step) {
+	fake.releaseStepMutex.Lock()
+	defer fake.releaseStepMutex.Unlock()
+	fake.ReleaseStepStub = stub
+}
+
+func (fake *FakeStepFactory) ReleaseStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, exec.Step) {
+	fake.releaseStepMutex.RLock()
+	defer fake.releaseStepMutex.RUnlock()
+	argsForCall := fake.releaseStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStepFactory) ReleaseStepReturns(result1 exec.Step) {
+	fake.releaseStepMutex.Lock()
+	defer fake.releaseStepMutex.Unlock()
+	fake.ReleaseStepStub = nil
+	fake.releaseStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) ReleaseStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.releaseStepMutex.Lock()
+	defer fake.releaseStepMutex.Unlock()
+	fake.ReleaseStepStub = nil
+	if fake.releaseStepReturnsOnCall == nil {
+		fake.releaseStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.releaseStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) SetPipelineStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.BuildStepDelegate) exec.Step {
 	fake.setPipelineStepMutex.Lock()
 	ret, specificReturn := fake.setPipelineStepReturnsOnCall[len(fake.setPipelineStepArgsForCall)]
@@ -626,6 +855,8 @@ func (fake *FakeStepFactory) TaskStepReturnsOnCall(i int, result1 exec.Step) {
 func (fake *FakeStepFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acquireStepMutex.RLock()
+	defer fake.acquireStepMutex.RUnlock()
 	fake.artifactInputStepMutex.RLock()
 	defer fake.artifactInputStepMutex.RUnlock()
 	fake.artifactOutputStepMutex.RLock()
@@ -634,10 +865,14 @@ func (fake *FakeStepFactory) Invocations() map[string][][]interface{} {
 	defer fake.checkStepMutex.RUnlock()
 	fake.getStepMutex.RLock()
 	defer fake.getStepMutex.RUnlock()
+	fake.ifStepMutex.RLock()
+	defer fake.ifStepMutex.RUnlock()
 	fake.loadVarStepMutex.RLock()
 	defer fake.loadVarStepMutex.RUnlock()
 	fake.putStepMutex.RLock()
 	defer fake.putStepMutex.RUnlock()
+	fake.releaseStepMutex.RLock()
+	defer fake.releaseStepMutex.RUnlock()
 	fake.setPipelineStepMutex.RLock()
 	defer fake.setPipelineStepMutex.RUnlock()
 	fake.taskStepMutex.RLock()
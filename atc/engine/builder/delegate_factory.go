@@ -2,6 +2,7 @@ package builder
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 	"time"
@@ -234,19 +235,52 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 }
 
 func NewTaskDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.TaskDelegate {
+	stepDelegate := NewBuildStepDelegate(build, planID, credVarsTracker, clock)
+
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+		BuildStepDelegate: stepDelegate,
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
-		build:       build,
+		stepDelegate:   stepDelegate,
+		eventOrigin:    event.Origin{ID: event.OriginID(planID)},
+		build:          build,
+		serviceWriters: map[event.Origin]io.Writer{},
 	}
 }
 
 type taskDelegate struct {
 	exec.BuildStepDelegate
-	config      atc.TaskConfig
-	build       db.Build
-	eventOrigin event.Origin
+	stepDelegate   *buildStepDelegate
+	config         atc.TaskConfig
+	build          db.Build
+	eventOrigin    event.Origin
+	serviceWriters map[event.Origin]io.Writer
+}
+
+// ServiceStdout returns a writer for the stdout of the named sidecar service.
+// Service output is saved under its own origin so that it can be told apart
+// from the task's output.
+func (d *taskDelegate) ServiceStdout(name string) io.Writer {
+	return d.serviceWriter(name, event.OriginSourceStdout)
+}
+
+// ServiceStderr returns a writer for the stderr of the named sidecar service.
+func (d *taskDelegate) ServiceStderr(name string) io.Writer {
+	return d.serviceWriter(name, event.OriginSourceStderr)
+}
+
+func (d *taskDelegate) serviceWriter(name string, source event.OriginSource) io.Writer {
+	origin := event.Origin{
+		ID:     event.OriginID(fmt.Sprintf("%s/services/%s", d.eventOrigin.ID, name)),
+		Source: source,
+	}
+
+	writer, found := d.serviceWriters[origin]
+	if !found {
+		writer = d.stepDelegate.eventWriter(origin)
+		d.serviceWriters[origin] = writer
+	}
+
+	return writer
 }
 
 func (d *taskDelegate) SetTaskConfig(config atc.TaskConfig) {
@@ -267,6 +301,37 @@ func (d *taskDelegate) Initializing(logger lager.Logger) {
 	logger.Info("initializing")
 }
 
+func (d *taskDelegate) WaitingForWorker(logger lager.Logger, reason string) {
+	err := d.build.SaveEvent(event.WaitingForWorker{
+		Origin: d.eventOrigin,
+		Time:   time.Now().Unix(),
+		Reason: reason,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-worker-event", err)
+		return
+	}
+
+	logger.Info("waiting-for-worker", lager.Data{"reason": reason})
+}
+
+func (d *taskDelegate) ReusingCachedResult(logger lager.Logger, key string, cache db.TaskResultCache) {
+	err := d.build.SaveEvent(event.TaskResultCacheHit{
+		Origin:     d.eventOrigin,
+		Time:       time.Now().Unix(),
+		Key:        key,
+		BuildID:    cache.BuildID,
+		BuildName:  cache.BuildName,
+		ExitStatus: cache.ExitStatus,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-task-result-cache-hit-event", err)
+		return
+	}
+
+	logger.Info("reusing-cached-result", lager.Data{"key": key, "build": cache.BuildID})
+}
+
 func (d *taskDelegate) Starting(logger lager.Logger) {
 	err := d.build.SaveEvent(event.StartTask{
 		Origin:     d.eventOrigin,
@@ -286,6 +351,10 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	d.Stdout().(io.Closer).Close()
 	d.Stderr().(io.Closer).Close()
 
+	for _, writer := range d.serviceWriters {
+		writer.(io.Closer).Close()
+	}
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
@@ -336,6 +405,8 @@ func (*checkDelegate) Stdout() io.Writer                                 { retur
 func (*checkDelegate) Stderr() io.Writer                                 { return discardCloser{} }
 func (*checkDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
 func (*checkDelegate) Errored(lager.Logger, string)                      { return }
+func (*checkDelegate) SaveResult(lager.Logger, string, atc.StepResult)   { return }
+func (*checkDelegate) Skipped(lager.Logger, string)                      { return }
 
 func NewBuildStepDelegate(
 	build db.Build,
@@ -406,56 +477,41 @@ func (delegate *buildStepDelegate) RedactImageSource(source atc.Source) (atc.Sou
 
 func (delegate *buildStepDelegate) Stdout() io.Writer {
 	if delegate.stdout == nil {
-		if delegate.credVarsTracker.Enabled() {
-			delegate.stdout = newDBEventWriterWithSecretRedaction(
-				delegate.build,
-				event.Origin{
-					Source: event.OriginSourceStdout,
-					ID:     event.OriginID(delegate.planID),
-				},
-				delegate.clock,
-				delegate.buildOutputFilter,
-			)
-		} else {
-			delegate.stdout = newDBEventWriter(
-				delegate.build,
-				event.Origin{
-					Source: event.OriginSourceStdout,
-					ID:     event.OriginID(delegate.planID),
-				},
-				delegate.clock,
-			)
-		}
+		delegate.stdout = delegate.eventWriter(event.Origin{
+			Source: event.OriginSourceStdout,
+			ID:     event.OriginID(delegate.planID),
+		})
 	}
 	return delegate.stdout
 }
 
 func (delegate *buildStepDelegate) Stderr() io.Writer {
 	if delegate.stderr == nil {
-		if delegate.credVarsTracker.Enabled() {
-			delegate.stderr = newDBEventWriterWithSecretRedaction(
-				delegate.build,
-				event.Origin{
-					Source: event.OriginSourceStderr,
-					ID:     event.OriginID(delegate.planID),
-				},
-				delegate.clock,
-				delegate.buildOutputFilter,
-			)
-		} else {
-			delegate.stderr = newDBEventWriter(
-				delegate.build,
-				event.Origin{
-					Source: event.OriginSourceStderr,
-					ID:     event.OriginID(delegate.planID),
-				},
-				delegate.clock,
-			)
-		}
+		delegate.stderr = delegate.eventWriter(event.Origin{
+			Source: event.OriginSourceStderr,
+			ID:     event.OriginID(delegate.planID),
+		})
 	}
 	return delegate.stderr
 }
 
+func (delegate *buildStepDelegate) eventWriter(origin event.Origin) io.Writer {
+	if delegate.credVarsTracker.Enabled() {
+		return newDBEventWriterWithSecretRedaction(
+			delegate.build,
+			origin,
+			delegate.clock,
+			delegate.buildOutputFilter,
+		)
+	}
+
+	return newDBEventWriter(
+		delegate.build,
+		origin,
+		delegate.clock,
+	)
+}
+
 func (delegate *buildStepDelegate) Initializing(logger lager.Logger) {
 	err := delegate.build.SaveEvent(event.Initialize{
 		Origin: event.Origin{
@@ -471,6 +527,24 @@ func (delegate *buildStepDelegate) Initializing(logger lager.Logger) {
 	logger.Info("initializing")
 }
 
+func (delegate *buildStepDelegate) WaitingForQuota(logger lager.Logger, exceeded atc.QuotaExceeded) {
+	err := delegate.build.SaveEvent(event.QuotaExceeded{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:  time.Now().Unix(),
+		Quota: exceeded.Quota,
+		Limit: exceeded.Limit,
+		Usage: exceeded.Usage,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-quota-exceeded-event", err)
+		return
+	}
+
+	logger.Info("waiting-for-quota", lager.Data{"quota": exceeded.Quota, "limit": exceeded.Limit})
+}
+
 func (delegate *buildStepDelegate) Starting(logger lager.Logger) {
 	err := delegate.build.SaveEvent(event.Start{
 		Origin: event.Origin{
@@ -519,6 +593,29 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
+func (delegate *buildStepDelegate) Skipped(logger lager.Logger, condition string) {
+	err := delegate.build.SaveEvent(event.Skipped{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:      delegate.clock.Now().Unix(),
+		Condition: condition,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+		return
+	}
+
+	logger.Info("skipped")
+}
+
+func (delegate *buildStepDelegate) SaveResult(logger lager.Logger, key string, result atc.StepResult) {
+	err := delegate.build.SaveStepResult(delegate.planID, key, result)
+	if err != nil {
+		logger.Error("failed-to-save-step-result", err)
+	}
+}
+
 func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.WriteCloser {
 	return &dbEventWriter{
 		build:  build,
@@ -258,6 +258,44 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("WaitingForWorker", func() {
+			JustBeforeEach(func() {
+				delegate.WaitingForWorker(logger, "no worker has 500m cpu free")
+			})
+
+			It("saves an event with the reason", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				e := fakeBuild.SaveEventArgsForCall(0)
+				Expect(e.EventType()).To(Equal(atc.EventType("waiting-for-worker")))
+				Expect(e.(event.WaitingForWorker).Origin).To(Equal(event.Origin{ID: "some-plan-id"}))
+				Expect(e.(event.WaitingForWorker).Reason).To(Equal("no worker has 500m cpu free"))
+			})
+		})
+
+		Describe("ReusingCachedResult", func() {
+			JustBeforeEach(func() {
+				delegate.ReusingCachedResult(logger, "some-cache-key", db.TaskResultCache{
+					ID:         1,
+					BuildID:    42,
+					BuildName:  "7",
+					ExitStatus: 1,
+				})
+			})
+
+			It("saves an event with the source build and key", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				e := fakeBuild.SaveEventArgsForCall(0)
+				Expect(e).To(Equal(event.TaskResultCacheHit{
+					Origin:     event.Origin{ID: "some-plan-id"},
+					Time:       e.(event.TaskResultCacheHit).Time,
+					Key:        "some-cache-key",
+					BuildID:    42,
+					BuildName:  "7",
+					ExitStatus: 1,
+				}))
+			})
+		})
+
 		Describe("Starting", func() {
 			JustBeforeEach(func() {
 				delegate.Starting(logger)
@@ -287,6 +325,45 @@ var _ = Describe("DelegateFactory", func() {
 				event := fakeBuild.SaveEventArgsForCall(0)
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
+
+			Context("when a service has written partial output", func() {
+				BeforeEach(func() {
+					delegate.ServiceStderr("db").Write([]byte("shutting down"))
+				})
+
+				It("flushes the output before saving the event", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "shutting down",
+						Origin: event.Origin{
+							Source: event.OriginSourceStderr,
+							ID:     "some-plan-id/services/db",
+						},
+					}))
+					Expect(fakeBuild.SaveEventArgsForCall(1).EventType()).To(Equal(atc.EventType("finish-task")))
+				})
+			})
+		})
+
+		Describe("ServiceStdout", func() {
+			It("saves log events under the service's origin", func() {
+				writer := delegate.ServiceStdout("db")
+				Expect(delegate.ServiceStdout("db")).To(BeIdenticalTo(writer))
+
+				_, err := writer.Write([]byte("ready to accept connections\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+					Time:    123456789,
+					Payload: "ready to accept connections\n",
+					Origin: event.Origin{
+						Source: event.OriginSourceStdout,
+						ID:     "some-plan-id/services/db",
+					},
+				}))
+			})
 		})
 	})
 
@@ -368,6 +445,22 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("SaveResult", func() {
+			JustBeforeEach(func() {
+				delegate.SaveResult(logger, "task:some-task", atc.StepResult{
+					Outputs: map[string]string{"some-output": "some-handle"},
+				})
+			})
+
+			It("records the result of the step for the build", func() {
+				Expect(fakeBuild.SaveStepResultCallCount()).To(Equal(1))
+				planID, key, result := fakeBuild.SaveStepResultArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(key).To(Equal("task:some-task"))
+				Expect(result.Outputs).To(Equal(map[string]string{"some-output": "some-handle"}))
+			})
+		})
+
 		Describe("Stdout", func() {
 			var writer io.Writer
 
@@ -522,6 +615,23 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("Skipped", func() {
+			JustBeforeEach(func() {
+				delegate.Skipped(logger, `vars.branch == "main"`)
+			})
+
+			It("saves a skipped event with the condition", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Skipped{
+					Time:      123456789,
+					Condition: `vars.branch == "main"`,
+					Origin: event.Origin{
+						ID: "some-plan-id",
+					},
+				}))
+			})
+		})
+
 		Describe("No line buffer without secrets redaction", func() {
 			BeforeEach(func() {
 				credVars := vars.StaticVariables{}
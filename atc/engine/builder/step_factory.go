@@ -4,6 +4,7 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -13,6 +14,10 @@ import (
 	"github.com/concourse/concourse/atc/worker"
 )
 
+// lockPollInterval is how often a build waiting for a lock checks whether it
+// has become available.
+const lockPollInterval = 5 * time.Second
+
 type stepFactory struct {
 	pool                            worker.Pool
 	client                          worker.Client
@@ -20,9 +25,12 @@ type stepFactory struct {
 	teamFactory                     db.TeamFactory
 	resourceCacheFactory            db.ResourceCacheFactory
 	resourceConfigFactory           db.ResourceConfigFactory
+	lockPoolFactory                 db.LockPoolFactory
+	taskResultCacheFactory          db.TaskResultCacheFactory
 	defaultLimits                   atc.ContainerLimits
 	strategy                        worker.ContainerPlacementStrategy
 	lockFactory                     lock.LockFactory
+	lintRules                       atc.LintRules
 	enableRerunWhenWorkerDisappears bool
 }
 
@@ -33,9 +41,12 @@ func NewStepFactory(
 	teamFactory db.TeamFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	lockPoolFactory db.LockPoolFactory,
+	taskResultCacheFactory db.TaskResultCacheFactory,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	lintRules atc.LintRules,
 	enableRerunWhenWorkerDisappears bool,
 ) *stepFactory {
 	return &stepFactory{
@@ -45,9 +56,12 @@ func NewStepFactory(
 		teamFactory:                     teamFactory,
 		resourceCacheFactory:            resourceCacheFactory,
 		resourceConfigFactory:           resourceConfigFactory,
+		lockPoolFactory:                 lockPoolFactory,
+		taskResultCacheFactory:          taskResultCacheFactory,
 		defaultLimits:                   defaultLimits,
 		strategy:                        strategy,
 		lockFactory:                     lockFactory,
+		lintRules:                       lintRules,
 		enableRerunWhenWorkerDisappears: enableRerunWhenWorkerDisappears,
 	}
 }
@@ -148,6 +162,7 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegate,
 		factory.lockFactory,
+		factory.taskResultCacheFactory,
 	)
 
 	taskStep = exec.LogError(taskStep, delegate)
@@ -169,6 +184,7 @@ func (factory *stepFactory) SetPipelineStep(
 		delegate,
 		factory.teamFactory,
 		factory.client,
+		factory.lintRules,
 	)
 
 	spStep = exec.LogError(spStep, delegate)
@@ -178,6 +194,49 @@ func (factory *stepFactory) SetPipelineStep(
 	return spStep
 }
 
+func (factory *stepFactory) AcquireStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegate exec.BuildStepDelegate,
+	step exec.Step,
+) exec.Step {
+	return exec.Acquire(
+		step,
+		*plan.Acquire,
+		stepMetadata,
+		delegate,
+		factory.lockPoolFactory,
+		lockPollInterval,
+	)
+}
+
+func (factory *stepFactory) ReleaseStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	step exec.Step,
+) exec.Step {
+	return exec.Release(
+		step,
+		*plan.Release,
+		stepMetadata,
+		factory.lockPoolFactory,
+	)
+}
+
+func (factory *stepFactory) IfStep(
+	plan atc.Plan,
+	build atc.ConditionBuild,
+	delegate exec.BuildStepDelegate,
+	step exec.Step,
+) exec.Step {
+	return exec.If(
+		step,
+		*plan.If,
+		build,
+		delegate,
+	)
+}
+
 func (factory *stepFactory) LoadVarStep(
 	plan atc.Plan,
 	stepMetadata exec.StepMetadata,
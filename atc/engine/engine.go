@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/policy"
@@ -43,9 +44,21 @@ type StepBuilder interface {
 	BuildStepErrored(lager.Logger, db.Build, error)
 }
 
-func NewEngine(builder StepBuilder) Engine {
+// BuildTimeout configures how long builds may run before they are aborted.
+type BuildTimeout struct {
+	// Max is the maximum duration of every build. Jobs may configure a
+	// shorter build_timeout. Zero means no maximum.
+	Max time.Duration
+
+	// GracePeriod is how long on_abort and ensure hooks may run once a build
+	// has timed out. Zero means they are interrupted immediately.
+	GracePeriod time.Duration
+}
+
+func NewEngine(builder StepBuilder, timeout BuildTimeout) Engine {
 	return &engine{
 		builder:       builder,
+		timeout:       timeout,
 		release:       make(chan bool),
 		trackedStates: new(sync.Map),
 		waitGroup:     new(sync.WaitGroup),
@@ -54,6 +67,7 @@ func NewEngine(builder StepBuilder) Engine {
 
 type engine struct {
 	builder       StepBuilder
+	timeout       BuildTimeout
 	release       chan bool
 	trackedStates *sync.Map
 	waitGroup     *sync.WaitGroup
@@ -76,6 +90,7 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 	return NewBuild(
 		build,
 		engine.builder,
+		engine.timeout,
 		engine.release,
 		engine.trackedStates,
 		engine.waitGroup,
@@ -95,6 +110,7 @@ func (engine *engine) NewCheck(check db.Check) Runnable {
 func NewBuild(
 	build db.Build,
 	builder StepBuilder,
+	timeout BuildTimeout,
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
@@ -102,6 +118,7 @@ func NewBuild(
 	return &engineBuild{
 		build:   build,
 		builder: builder,
+		timeout: timeout,
 
 		release:       release,
 		trackedStates: trackedStates,
@@ -112,6 +129,7 @@ func NewBuild(
 type engineBuild struct {
 	build   db.Build
 	builder StepBuilder
+	timeout BuildTimeout
 
 	release       chan bool
 	trackedStates *sync.Map
@@ -198,6 +216,19 @@ func (b *engineBuild) Run(ctx context.Context) {
 
 	ctx, cancel := context.WithCancel(ctx)
 
+	hookCtx, cancelHooks := context.WithCancel(lagerctx.NewContext(context.Background(), logger))
+	defer cancelHooks()
+
+	var deadline <-chan time.Time
+
+	timeout := b.buildTimeout(logger)
+	if timeout != 0 {
+		timer := time.NewTimer(time.Until(b.build.StartTime().Add(timeout)))
+		defer timer.Stop()
+
+		deadline = timer.C
+	}
+
 	noleak := make(chan bool)
 	defer close(noleak)
 
@@ -207,6 +238,17 @@ func (b *engineBuild) Run(ctx context.Context) {
 		case <-notifier.Notify():
 			logger.Info("aborting")
 			cancel()
+		case <-deadline:
+			logger.Info("timed-out", lager.Data{"timeout": timeout.String()})
+			b.saveTimedOut(logger, timeout)
+			cancel()
+
+			select {
+			case <-noleak:
+			case <-time.After(b.timeout.GracePeriod):
+				logger.Info("interrupting-hooks")
+				cancelHooks()
+			}
 		}
 	}()
 
@@ -214,6 +256,7 @@ func (b *engineBuild) Run(ctx context.Context) {
 	go func() {
 		ctx := lagerctx.NewContext(ctx, logger)
 		ctx = policy.RecordTeamAndPipeline(ctx, b.build.TeamName(), b.build.PipelineName())
+		ctx = exec.WithHookContext(ctx, hookCtx)
 		done <- step.Run(ctx, state)
 	}()
 
@@ -251,6 +294,68 @@ func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 	}
 }
 
+// buildTimeout determines the duration after which the build is aborted: the
+// lesser of the job's build_timeout and the maximum build duration.
+func (b *engineBuild) buildTimeout(logger lager.Logger) time.Duration {
+	timeout := b.timeout.Max
+
+	if b.build.JobID() == 0 {
+		return timeout
+	}
+
+	pipeline, found, err := b.build.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		return timeout
+	}
+
+	if !found {
+		return timeout
+	}
+
+	job, found, err := pipeline.Job(b.build.JobName())
+	if err != nil {
+		logger.Error("failed-to-find-job", err)
+		return timeout
+	}
+
+	if !found {
+		return timeout
+	}
+
+	config, err := job.Config()
+	if err != nil {
+		logger.Error("failed-to-get-job-config", err)
+		return timeout
+	}
+
+	if config.BuildTimeout == "" {
+		return timeout
+	}
+
+	jobTimeout, err := time.ParseDuration(config.BuildTimeout)
+	if err != nil {
+		logger.Error("failed-to-parse-build-timeout", err)
+		return timeout
+	}
+
+	if timeout == 0 || jobTimeout < timeout {
+		timeout = jobTimeout
+	}
+
+	return timeout
+}
+
+func (b *engineBuild) saveTimedOut(logger lager.Logger, timeout time.Duration) {
+	err := b.build.SaveEvent(event.TimedOut{
+		Time:    time.Now().Unix(),
+		Timeout: timeout.String(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-timed-out-event", err)
+	}
+}
+
 func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	if err := b.build.Finish(db.BuildStatus(status)); err != nil {
 		logger.Error("failed-to-finish-build", err)
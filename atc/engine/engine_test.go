@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 var _ = Describe("Engine", func() {
@@ -47,7 +48,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, BuildTimeout{})
 		})
 
 		JustBeforeEach(func() {
@@ -66,7 +67,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, BuildTimeout{})
 		})
 
 		JustBeforeEach(func() {
@@ -81,19 +82,24 @@ var _ = Describe("Engine", func() {
 	Describe("Build", func() {
 		var (
 			build     Runnable
+			timeout   BuildTimeout
 			release   chan bool
 			waitGroup *sync.WaitGroup
 		)
 
 		BeforeEach(func() {
-
+			timeout = BuildTimeout{}
 			release = make(chan bool)
-			trackedStates := new(sync.Map)
 			waitGroup = new(sync.WaitGroup)
+		})
+
+		JustBeforeEach(func() {
+			trackedStates := new(sync.Map)
 
 			build = NewBuild(
 				fakeBuild,
 				fakeStepBuilder,
+				timeout,
 				release,
 				trackedStates,
 				waitGroup,
@@ -207,6 +213,82 @@ var _ = Describe("Engine", func() {
 								})
 							})
 
+							Context("when the build times out", func() {
+								BeforeEach(func() {
+									timeout.Max = 10 * time.Millisecond
+									timeout.GracePeriod = time.Hour
+
+									fakeBuild.StartTimeReturns(time.Now())
+
+									fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+										<-ctx.Done()
+										return ctx.Err()
+									}
+								})
+
+								It("saves a timed out event", func() {
+									waitGroup.Wait()
+									Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+									Expect(fakeBuild.SaveEventArgsForCall(0)).To(MatchFields(IgnoreExtras, Fields{
+										"Timeout": Equal("10ms"),
+									}))
+								})
+
+								It("aborts the build", func() {
+									waitGroup.Wait()
+									Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+									Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusAborted))
+								})
+
+								Context("when the job configures a shorter build timeout", func() {
+									var fakeJob *dbfakes.FakeJob
+
+									BeforeEach(func() {
+										timeout.Max = time.Hour
+
+										fakeJob = new(dbfakes.FakeJob)
+										fakeJob.ConfigReturns(atc.JobConfig{BuildTimeout: "20ms"}, nil)
+
+										fakePipeline := new(dbfakes.FakePipeline)
+										fakePipeline.JobReturns(fakeJob, true, nil)
+
+										fakeBuild.JobIDReturns(1)
+										fakeBuild.JobNameReturns("some-job")
+										fakeBuild.PipelineReturns(fakePipeline, true, nil)
+									})
+
+									It("times out after the job's build timeout", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveEventArgsForCall(0)).To(MatchFields(IgnoreExtras, Fields{
+											"Timeout": Equal("20ms"),
+										}))
+									})
+								})
+
+								Context("when hooks run for longer than the grace period", func() {
+									var fakeHook *execfakes.FakeStep
+
+									BeforeEach(func() {
+										timeout.GracePeriod = 10 * time.Millisecond
+
+										fakeHook = new(execfakes.FakeStep)
+										fakeHook.RunStub = func(ctx context.Context, state exec.RunState) error {
+											<-ctx.Done()
+											return ctx.Err()
+										}
+
+										fakeStepBuilder.BuildStepReturns(exec.Ensure(fakeStep, fakeHook), nil)
+									})
+
+									It("interrupts the hooks and aborts the build", func() {
+										waitGroup.Wait()
+										Expect(fakeHook.RunCallCount()).To(Equal(1))
+										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusAborted))
+									})
+								})
+							})
+
 							Context("when the build finishes without error", func() {
 								BeforeEach(func() {
 									fakeStep.RunReturns(nil)
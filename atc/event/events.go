@@ -191,3 +191,75 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type Skipped struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Condition string `json:"condition"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type TimedOut struct {
+	Time    int64  `json:"time"`
+	Timeout string `json:"timeout"`
+}
+
+func (TimedOut) EventType() atc.EventType  { return EventTypeTimedOut }
+func (TimedOut) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForWorker struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+	Reason string `json:"reason"`
+}
+
+func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
+func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
+
+// QuotaExceeded is sent when a build or step cannot proceed until its team is
+// using less of the named quota. Origin is empty when the whole build is
+// waiting to be scheduled.
+type QuotaExceeded struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+	Quota  string `json:"quota"`
+	Limit  int    `json:"limit"`
+	Usage  int    `json:"usage"`
+}
+
+func (QuotaExceeded) EventType() atc.EventType  { return EventTypeQuotaExceeded }
+func (QuotaExceeded) Version() atc.EventVersion { return "1.0" }
+
+// TaskResultCacheHit is sent when a task step reuses the exit status and
+// outputs cached by an earlier build rather than running.
+type TaskResultCacheHit struct {
+	Origin     Origin `json:"origin"`
+	Time       int64  `json:"time"`
+	Key        string `json:"key"`
+	BuildID    int    `json:"build_id"`
+	BuildName  string `json:"build_name"`
+	ExitStatus int    `json:"exit_status"`
+}
+
+func (TaskResultCacheHit) EventType() atc.EventType  { return EventTypeTaskResultCacheHit }
+func (TaskResultCacheHit) Version() atc.EventVersion { return "1.0" }
+
+type Progress struct {
+	Time            int64             `json:"time"`
+	EstimatedFinish int64             `json:"estimated_finish"`
+	Outliers        []ProgressOutlier `json:"outliers,omitempty"`
+}
+
+func (Progress) EventType() atc.EventType  { return EventTypeProgress }
+func (Progress) Version() atc.EventVersion { return "1.0" }
+
+// ProgressOutlier is a step which has been running for much longer than it
+// usually takes. Durations are in seconds.
+type ProgressOutlier struct {
+	Origin  Origin `json:"origin"`
+	Step    string `json:"step"`
+	Elapsed int64  `json:"elapsed"`
+	Usual   int64  `json:"usual"`
+}
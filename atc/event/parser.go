@@ -46,6 +46,12 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(Skipped{})
+	RegisterEvent(TimedOut{})
+	RegisterEvent(Progress{})
+	RegisterEvent(WaitingForWorker{})
+	RegisterEvent(QuotaExceeded{})
+	RegisterEvent(TaskResultCacheHit{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
@@ -47,4 +47,22 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// step skipped because its condition did not hold
+	EventTypeSkipped atc.EventType = "skipped"
+
+	// build exceeded its timeout and is being aborted
+	EventTypeTimedOut atc.EventType = "timed-out"
+
+	// estimated progress of a running build; only sent on the event stream
+	EventTypeProgress atc.EventType = "progress"
+
+	// step is waiting for a worker with enough capacity to run it
+	EventTypeWaitingForWorker atc.EventType = "waiting-for-worker"
+
+	// build or step is waiting for its team to drop below one of its quotas
+	EventTypeQuotaExceeded atc.EventType = "quota-exceeded"
+
+	// task step reused the cached result of an earlier build instead of running
+	EventTypeTaskResultCacheHit atc.EventType = "task-result-cache-hit"
 )
@@ -0,0 +1,115 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// AcquireStep acquires locks from the team's lock pools before running its
+// nested step. The locks are held until they are released by a ReleaseStep or
+// the build finishes.
+type AcquireStep struct {
+	step            Step
+	plan            atc.AcquirePlan
+	metadata        StepMetadata
+	delegate        BuildStepDelegate
+	lockPoolFactory db.LockPoolFactory
+	pollInterval    time.Duration
+}
+
+// Acquire constructs an AcquireStep.
+func Acquire(
+	step Step,
+	plan atc.AcquirePlan,
+	metadata StepMetadata,
+	delegate BuildStepDelegate,
+	lockPoolFactory db.LockPoolFactory,
+	pollInterval time.Duration,
+) *AcquireStep {
+	return &AcquireStep{
+		step:            step,
+		plan:            plan,
+		metadata:        metadata,
+		delegate:        delegate,
+		lockPoolFactory: lockPoolFactory,
+		pollInterval:    pollInterval,
+	}
+}
+
+// Run acquires each lock, waiting for it to become available, and then runs
+// the nested step.
+//
+// Locks are acquired in order of their name so that builds acquiring the same
+// set of locks cannot deadlock one another.
+//
+// If the context is canceled while waiting for a lock, the nested step is not
+// run and the context's error is returned.
+func (step *AcquireStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("acquire-step", lager.Data{
+		"build": step.metadata.BuildID,
+	})
+
+	locks := make([]atc.LockConfig, len(step.plan.Locks))
+	copy(locks, step.plan.Locks)
+
+	sort.SliceStable(locks, func(i, j int) bool {
+		return locks[i].Name < locks[j].Name
+	})
+
+	for _, lock := range locks {
+		err := step.acquire(ctx, logger, lock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return step.step.Run(ctx, state)
+}
+
+// Succeeded is true if the nested step completed successfully.
+func (step *AcquireStep) Succeeded() bool {
+	return step.step.Succeeded()
+}
+
+func (step *AcquireStep) acquire(ctx context.Context, logger lager.Logger, lock atc.LockConfig) error {
+	ticker := time.NewTicker(step.pollInterval)
+	defer ticker.Stop()
+
+	waiting := false
+	for {
+		acquired, err := step.lockPoolFactory.Acquire(step.metadata.TeamID, step.metadata.BuildID, lock)
+		if err != nil {
+			logger.Error("failed-to-acquire-lock", err, lager.Data{"lock": lock.Name})
+			return err
+		}
+
+		if acquired {
+			logger.Debug("acquired-lock", lager.Data{"lock": lock.Name})
+
+			if waiting {
+				fmt.Fprintf(step.delegate.Stdout(), "acquired lock '%s'\n", lock.Name)
+			}
+
+			return nil
+		}
+
+		if !waiting {
+			fmt.Fprintf(step.delegate.Stdout(), "waiting for lock '%s'...\n", lock.Name)
+			waiting = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("AcquireStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		step          *execfakes.FakeStep
+		delegate      *execfakes.FakeBuildStepDelegate
+		fakeLockPools *dbfakes.FakeLockPoolFactory
+		state         *execfakes.FakeRunState
+		stdout        *gbytes.Buffer
+		plan          atc.AcquirePlan
+		acquire       exec.Step
+		metadata      exec.StepMetadata
+
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		step = new(execfakes.FakeStep)
+		delegate = new(execfakes.FakeBuildStepDelegate)
+		fakeLockPools = new(dbfakes.FakeLockPoolFactory)
+		state = new(execfakes.FakeRunState)
+
+		stdout = gbytes.NewBuffer()
+		delegate.StdoutReturns(stdout)
+
+		metadata = exec.StepMetadata{TeamID: 1, BuildID: 42}
+
+		plan = atc.AcquirePlan{
+			Locks: atc.LockConfigs{
+				{Name: "some-pool", Size: 2},
+				{Name: "other-lock"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		acquire = exec.Acquire(step, plan, metadata, delegate, fakeLockPools, time.Millisecond)
+		stepErr = acquire.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the locks are available", func() {
+		BeforeEach(func() {
+			fakeLockPools.AcquireReturns(true, nil)
+		})
+
+		It("acquires each lock in order of their name", func() {
+			Expect(fakeLockPools.AcquireCallCount()).To(Equal(2))
+
+			teamID, buildID, lock := fakeLockPools.AcquireArgsForCall(0)
+			Expect(teamID).To(Equal(1))
+			Expect(buildID).To(Equal(42))
+			Expect(lock).To(Equal(atc.LockConfig{Name: "other-lock"}))
+
+			_, _, lock = fakeLockPools.AcquireArgsForCall(1)
+			Expect(lock).To(Equal(atc.LockConfig{Name: "some-pool", Size: 2}))
+		})
+
+		It("runs the nested step", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.RunCallCount()).To(Equal(1))
+		})
+
+		It("does not wait", func() {
+			Expect(stdout.Contents()).To(BeEmpty())
+		})
+	})
+
+	Context("when a lock is held by another build", func() {
+		BeforeEach(func() {
+			fakeLockPools.AcquireReturnsOnCall(0, false, nil)
+			fakeLockPools.AcquireReturnsOnCall(1, false, nil)
+			fakeLockPools.AcquireReturnsOnCall(2, true, nil)
+			fakeLockPools.AcquireReturnsOnCall(3, true, nil)
+		})
+
+		It("waits until it becomes available", func() {
+			Expect(fakeLockPools.AcquireCallCount()).To(Equal(4))
+			Expect(stdout).To(gbytes.Say("waiting for lock 'other-lock'..."))
+			Expect(stdout).To(gbytes.Say("acquired lock 'other-lock'"))
+			Expect(step.RunCallCount()).To(Equal(1))
+		})
+
+		Context("when the context is canceled while waiting", func() {
+			BeforeEach(func() {
+				fakeLockPools.AcquireStub = func(int, int, atc.LockConfig) (bool, error) {
+					cancel()
+					return false, nil
+				}
+			})
+
+			It("returns the context's error without running the nested step", func() {
+				Expect(stepErr).To(Equal(context.Canceled))
+				Expect(step.RunCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when acquiring a lock fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeLockPools.AcquireReturns(false, disaster)
+		})
+
+		It("returns the error without running the nested step", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(step.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Succeeded", func() {
+		BeforeEach(func() {
+			fakeLockPools.AcquireReturns(true, nil)
+		})
+
+		It("delegates to the nested step", func() {
+			step.SucceededReturns(true)
+			Expect(acquire.Succeeded()).To(BeTrue())
+
+			step.SucceededReturns(false)
+			Expect(acquire.Succeeded()).To(BeFalse())
+		})
+	})
+})
@@ -22,7 +22,11 @@ type BuildStepDelegate interface {
 	Variables() vars.CredVarsTracker
 
 	Initializing(lager.Logger)
+	WaitingForQuota(lager.Logger, atc.QuotaExceeded)
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
 	Errored(lager.Logger, string)
+	Skipped(lager.Logger, string)
+
+	SaveResult(lager.Logger, string, atc.StepResult)
 }
@@ -116,6 +116,8 @@ func (step *CheckStep) run(ctx context.Context, state RunState) error {
 		Tags:          step.plan.Tags,
 		ResourceTypes: resourceTypes,
 		TeamID:        step.metadata.TeamID,
+
+		WorkerSelector: step.plan.WorkerSelector,
 	}
 
 	expires := db.ContainerOwnerExpiries{
@@ -122,6 +122,24 @@ var _ = Describe("Ensure Step", func() {
 			hookCtx, _ := hook.RunArgsForCall(0)
 			Expect(hookCtx.Err()).ToNot(HaveOccurred())
 		})
+
+		Context("when a hook context is configured", func() {
+			var cancelHooks func()
+
+			BeforeEach(func() {
+				var hookCtx context.Context
+				hookCtx, cancelHooks = context.WithCancel(context.Background())
+				ctx = exec.WithHookContext(ctx, hookCtx)
+			})
+
+			It("runs the hook in the hook context", func() {
+				hookCtx, _ := hook.RunArgsForCall(0)
+				Expect(hookCtx.Err()).ToNot(HaveOccurred())
+
+				cancelHooks()
+				Expect(hookCtx.Err()).To(Equal(context.Canceled))
+			})
+		})
 	})
 
 	Context("when the context is canceled during the hook", func() {
@@ -54,6 +54,13 @@ type FakeCheckDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	SaveResultStub        func(lager.Logger, string, atc.StepResult)
+	saveResultMutex       sync.RWMutex
+	saveResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}
 	SaveVersionsStub        func(db.SpanContext, []atc.Version) error
 	saveVersionsMutex       sync.RWMutex
 	saveVersionsArgsForCall []struct {
@@ -66,6 +73,12 @@ type FakeCheckDelegate struct {
 	saveVersionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SkippedStub        func(lager.Logger, string)
+	skippedMutex       sync.RWMutex
+	skippedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -101,6 +114,12 @@ type FakeCheckDelegate struct {
 	variablesReturnsOnCall map[int]struct {
 		result1 vars.CredVarsTracker
 	}
+	WaitingForQuotaStub        func(lager.Logger, atc.QuotaExceeded)
+	waitingForQuotaMutex       sync.RWMutex
+	waitingForQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.QuotaExceeded
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -323,6 +342,39 @@ func (fake *FakeCheckDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeCheckDelegate) SaveResult(arg1 lager.Logger, arg2 string, arg3 atc.StepResult) {
+	fake.saveResultMutex.Lock()
+	fake.saveResultArgsForCall = append(fake.saveResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveResult", []interface{}{arg1, arg2, arg3})
+	fake.saveResultMutex.Unlock()
+	if fake.SaveResultStub != nil {
+		fake.SaveResultStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeCheckDelegate) SaveResultCallCount() int {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	return len(fake.saveResultArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) SaveResultCalls(stub func(lager.Logger, string, atc.StepResult)) {
+	fake.saveResultMutex.Lock()
+	defer fake.saveResultMutex.Unlock()
+	fake.SaveResultStub = stub
+}
+
+func (fake *FakeCheckDelegate) SaveResultArgsForCall(i int) (lager.Logger, string, atc.StepResult) {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	argsForCall := fake.saveResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeCheckDelegate) SaveVersions(arg1 db.SpanContext, arg2 []atc.Version) error {
 	var arg2Copy []atc.Version
 	if arg2 != nil {
@@ -389,6 +441,38 @@ func (fake *FakeCheckDelegate) SaveVersionsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCheckDelegate) Skipped(arg1 lager.Logger, arg2 string) {
+	fake.skippedMutex.Lock()
+	fake.skippedArgsForCall = append(fake.skippedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Skipped", []interface{}{arg1, arg2})
+	fake.skippedMutex.Unlock()
+	if fake.SkippedStub != nil {
+		fake.SkippedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeCheckDelegate) SkippedCallCount() int {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	return len(fake.skippedArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) SkippedCalls(stub func(lager.Logger, string)) {
+	fake.skippedMutex.Lock()
+	defer fake.skippedMutex.Unlock()
+	fake.SkippedStub = stub
+}
+
+func (fake *FakeCheckDelegate) SkippedArgsForCall(i int) (lager.Logger, string) {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	argsForCall := fake.skippedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeCheckDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -576,6 +660,38 @@ func (fake *FakeCheckDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVa
 	}{result1}
 }
 
+func (fake *FakeCheckDelegate) WaitingForQuota(arg1 lager.Logger, arg2 atc.QuotaExceeded) {
+	fake.waitingForQuotaMutex.Lock()
+	fake.waitingForQuotaArgsForCall = append(fake.waitingForQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.QuotaExceeded
+	}{arg1, arg2})
+	fake.recordInvocation("WaitingForQuota", []interface{}{arg1, arg2})
+	fake.waitingForQuotaMutex.Unlock()
+	if fake.WaitingForQuotaStub != nil {
+		fake.WaitingForQuotaStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeCheckDelegate) WaitingForQuotaCallCount() int {
+	fake.waitingForQuotaMutex.RLock()
+	defer fake.waitingForQuotaMutex.RUnlock()
+	return len(fake.waitingForQuotaArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) WaitingForQuotaCalls(stub func(lager.Logger, atc.QuotaExceeded)) {
+	fake.waitingForQuotaMutex.Lock()
+	defer fake.waitingForQuotaMutex.Unlock()
+	fake.WaitingForQuotaStub = stub
+}
+
+func (fake *FakeCheckDelegate) WaitingForQuotaArgsForCall(i int) (lager.Logger, atc.QuotaExceeded) {
+	fake.waitingForQuotaMutex.RLock()
+	defer fake.waitingForQuotaMutex.RUnlock()
+	argsForCall := fake.waitingForQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -589,8 +705,12 @@ func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
 	fake.saveVersionsMutex.RLock()
 	defer fake.saveVersionsMutex.RUnlock()
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
@@ -599,6 +719,8 @@ func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.waitingForQuotaMutex.RLock()
+	defer fake.waitingForQuotaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -56,6 +56,13 @@ type FakeGetDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	SaveResultStub        func(lager.Logger, string, atc.StepResult)
+	saveResultMutex       sync.RWMutex
+	saveResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -321,6 +328,39 @@ func (fake *FakeGetDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGetDelegate) SaveResult(arg1 lager.Logger, arg2 string, arg3 atc.StepResult) {
+	fake.saveResultMutex.Lock()
+	fake.saveResultArgsForCall = append(fake.saveResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveResult", []interface{}{arg1, arg2, arg3})
+	fake.saveResultMutex.Unlock()
+	if fake.SaveResultStub != nil {
+		fake.SaveResultStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeGetDelegate) SaveResultCallCount() int {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	return len(fake.saveResultArgsForCall)
+}
+
+func (fake *FakeGetDelegate) SaveResultCalls(stub func(lager.Logger, string, atc.StepResult)) {
+	fake.saveResultMutex.Lock()
+	defer fake.saveResultMutex.Unlock()
+	fake.SaveResultStub = stub
+}
+
+func (fake *FakeGetDelegate) SaveResultArgsForCall(i int) (lager.Logger, string, atc.StepResult) {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	argsForCall := fake.saveResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeGetDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -554,6 +594,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
@@ -65,6 +65,13 @@ type FakePutDelegate struct {
 		arg4 atc.VersionedResourceTypes
 		arg5 runtime.VersionResult
 	}
+	SaveResultStub        func(lager.Logger, string, atc.StepResult)
+	saveResultMutex       sync.RWMutex
+	saveResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -358,6 +365,39 @@ func (fake *FakePutDelegate) SaveOutputArgsForCall(i int) (lager.Logger, atc.Put
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
+func (fake *FakePutDelegate) SaveResult(arg1 lager.Logger, arg2 string, arg3 atc.StepResult) {
+	fake.saveResultMutex.Lock()
+	fake.saveResultArgsForCall = append(fake.saveResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveResult", []interface{}{arg1, arg2, arg3})
+	fake.saveResultMutex.Unlock()
+	if fake.SaveResultStub != nil {
+		fake.SaveResultStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakePutDelegate) SaveResultCallCount() int {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	return len(fake.saveResultArgsForCall)
+}
+
+func (fake *FakePutDelegate) SaveResultCalls(stub func(lager.Logger, string, atc.StepResult)) {
+	fake.saveResultMutex.Lock()
+	defer fake.saveResultMutex.Unlock()
+	fake.SaveResultStub = stub
+}
+
+func (fake *FakePutDelegate) SaveResultArgsForCall(i int) (lager.Logger, string, atc.StepResult) {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	argsForCall := fake.saveResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakePutDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -560,6 +600,8 @@ func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	defer fake.initializingMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
@@ -32,12 +32,31 @@ type FakeRunState struct {
 	resultReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	StepStatusStub        func(string) (string, bool)
+	stepStatusMutex       sync.RWMutex
+	stepStatusArgsForCall []struct {
+		arg1 string
+	}
+	stepStatusReturns struct {
+		result1 string
+		result2 bool
+	}
+	stepStatusReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	StoreResultStub        func(atc.PlanID, interface{})
 	storeResultMutex       sync.RWMutex
 	storeResultArgsForCall []struct {
 		arg1 atc.PlanID
 		arg2 interface{}
 	}
+	StoreStepStatusStub        func(string, string)
+	storeStepStatusMutex       sync.RWMutex
+	storeStepStatusArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -155,6 +174,69 @@ func (fake *FakeRunState) ResultReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeRunState) StepStatus(arg1 string) (string, bool) {
+	fake.stepStatusMutex.Lock()
+	ret, specificReturn := fake.stepStatusReturnsOnCall[len(fake.stepStatusArgsForCall)]
+	fake.stepStatusArgsForCall = append(fake.stepStatusArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("StepStatus", []interface{}{arg1})
+	fake.stepStatusMutex.Unlock()
+	if fake.StepStatusStub != nil {
+		return fake.StepStatusStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.stepStatusReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRunState) StepStatusCallCount() int {
+	fake.stepStatusMutex.RLock()
+	defer fake.stepStatusMutex.RUnlock()
+	return len(fake.stepStatusArgsForCall)
+}
+
+func (fake *FakeRunState) StepStatusCalls(stub func(string) (string, bool)) {
+	fake.stepStatusMutex.Lock()
+	defer fake.stepStatusMutex.Unlock()
+	fake.StepStatusStub = stub
+}
+
+func (fake *FakeRunState) StepStatusArgsForCall(i int) string {
+	fake.stepStatusMutex.RLock()
+	defer fake.stepStatusMutex.RUnlock()
+	argsForCall := fake.stepStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRunState) StepStatusReturns(result1 string, result2 bool) {
+	fake.stepStatusMutex.Lock()
+	defer fake.stepStatusMutex.Unlock()
+	fake.StepStatusStub = nil
+	fake.stepStatusReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeRunState) StepStatusReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.stepStatusMutex.Lock()
+	defer fake.stepStatusMutex.Unlock()
+	fake.StepStatusStub = nil
+	if fake.stepStatusReturnsOnCall == nil {
+		fake.stepStatusReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.stepStatusReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeRunState) StoreResult(arg1 atc.PlanID, arg2 interface{}) {
 	fake.storeResultMutex.Lock()
 	fake.storeResultArgsForCall = append(fake.storeResultArgsForCall, struct {
@@ -187,6 +269,38 @@ func (fake *FakeRunState) StoreResultArgsForCall(i int) (atc.PlanID, interface{}
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeRunState) StoreStepStatus(arg1 string, arg2 string) {
+	fake.storeStepStatusMutex.Lock()
+	fake.storeStepStatusArgsForCall = append(fake.storeStepStatusArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("StoreStepStatus", []interface{}{arg1, arg2})
+	fake.storeStepStatusMutex.Unlock()
+	if fake.StoreStepStatusStub != nil {
+		fake.StoreStepStatusStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeRunState) StoreStepStatusCallCount() int {
+	fake.storeStepStatusMutex.RLock()
+	defer fake.storeStepStatusMutex.RUnlock()
+	return len(fake.storeStepStatusArgsForCall)
+}
+
+func (fake *FakeRunState) StoreStepStatusCalls(stub func(string, string)) {
+	fake.storeStepStatusMutex.Lock()
+	defer fake.storeStepStatusMutex.Unlock()
+	fake.StoreStepStatusStub = stub
+}
+
+func (fake *FakeRunState) StoreStepStatusArgsForCall(i int) (string, string) {
+	fake.storeStepStatusMutex.RLock()
+	defer fake.storeStepStatusMutex.RUnlock()
+	argsForCall := fake.storeStepStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -194,8 +308,12 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.artifactRepositoryMutex.RUnlock()
 	fake.resultMutex.RLock()
 	defer fake.resultMutex.RUnlock()
+	fake.stepStatusMutex.RLock()
+	defer fake.stepStatusMutex.RUnlock()
 	fake.storeResultMutex.RLock()
 	defer fake.storeResultMutex.RUnlock()
+	fake.storeStepStatusMutex.RLock()
+	defer fake.storeStepStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
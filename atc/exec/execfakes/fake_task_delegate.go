@@ -25,19 +25,6 @@ type FakeTaskDelegate struct {
 		arg1 lager.Logger
 		arg2 exec.ExitStatus
 	}
-	ImageSourceRedactionStub        func(atc.Source) (atc.Source, error)
-	imageSourceRedactionMutex       sync.RWMutex
-	imageSourceRedactionArgsForCall []struct {
-		arg1 atc.Source
-	}
-	imageSourceRedactionReturns struct {
-		result1 atc.Source
-		result2 error
-	}
-	imageSourceRedactionReturnsOnCall map[int]struct {
-		result1 atc.Source
-		result2 error
-	}
 	ImageVersionDeterminedStub        func(db.UsedResourceCache) error
 	imageVersionDeterminedMutex       sync.RWMutex
 	imageVersionDeterminedArgsForCall []struct {
@@ -54,6 +41,55 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	RedactImageSourceStub        func(atc.Source) (atc.Source, error)
+	redactImageSourceMutex       sync.RWMutex
+	redactImageSourceArgsForCall []struct {
+		arg1 atc.Source
+	}
+	redactImageSourceReturns struct {
+		result1 atc.Source
+		result2 error
+	}
+	redactImageSourceReturnsOnCall map[int]struct {
+		result1 atc.Source
+		result2 error
+	}
+	ReusingCachedResultStub        func(lager.Logger, string, db.TaskResultCache)
+	reusingCachedResultMutex       sync.RWMutex
+	reusingCachedResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 db.TaskResultCache
+	}
+	SaveResultStub        func(lager.Logger, string, atc.StepResult)
+	saveResultMutex       sync.RWMutex
+	saveResultArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}
+	ServiceStderrStub        func(string) io.Writer
+	serviceStderrMutex       sync.RWMutex
+	serviceStderrArgsForCall []struct {
+		arg1 string
+	}
+	serviceStderrReturns struct {
+		result1 io.Writer
+	}
+	serviceStderrReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	ServiceStdoutStub        func(string) io.Writer
+	serviceStdoutMutex       sync.RWMutex
+	serviceStdoutArgsForCall []struct {
+		arg1 string
+	}
+	serviceStdoutReturns struct {
+		result1 io.Writer
+	}
+	serviceStdoutReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -94,6 +130,12 @@ type FakeTaskDelegate struct {
 	variablesReturnsOnCall map[int]struct {
 		result1 vars.CredVarsTracker
 	}
+	WaitingForWorkerStub        func(lager.Logger, string)
+	waitingForWorkerMutex       sync.RWMutex
+	waitingForWorkerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -162,69 +204,6 @@ func (fake *FakeTaskDelegate) FinishedArgsForCall(i int) (lager.Logger, exec.Exi
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeTaskDelegate) RedactImageSource(arg1 atc.Source) (atc.Source, error) {
-	fake.imageSourceRedactionMutex.Lock()
-	ret, specificReturn := fake.imageSourceRedactionReturnsOnCall[len(fake.imageSourceRedactionArgsForCall)]
-	fake.imageSourceRedactionArgsForCall = append(fake.imageSourceRedactionArgsForCall, struct {
-		arg1 atc.Source
-	}{arg1})
-	fake.recordInvocation("RedactImageSource", []interface{}{arg1})
-	fake.imageSourceRedactionMutex.Unlock()
-	if fake.ImageSourceRedactionStub != nil {
-		return fake.ImageSourceRedactionStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	fakeReturns := fake.imageSourceRedactionReturns
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeTaskDelegate) ImageSourceRedactionCallCount() int {
-	fake.imageSourceRedactionMutex.RLock()
-	defer fake.imageSourceRedactionMutex.RUnlock()
-	return len(fake.imageSourceRedactionArgsForCall)
-}
-
-func (fake *FakeTaskDelegate) ImageSourceRedactionCalls(stub func(atc.Source) (atc.Source, error)) {
-	fake.imageSourceRedactionMutex.Lock()
-	defer fake.imageSourceRedactionMutex.Unlock()
-	fake.ImageSourceRedactionStub = stub
-}
-
-func (fake *FakeTaskDelegate) ImageSourceRedactionArgsForCall(i int) atc.Source {
-	fake.imageSourceRedactionMutex.RLock()
-	defer fake.imageSourceRedactionMutex.RUnlock()
-	argsForCall := fake.imageSourceRedactionArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeTaskDelegate) ImageSourceRedactionReturns(result1 atc.Source, result2 error) {
-	fake.imageSourceRedactionMutex.Lock()
-	defer fake.imageSourceRedactionMutex.Unlock()
-	fake.ImageSourceRedactionStub = nil
-	fake.imageSourceRedactionReturns = struct {
-		result1 atc.Source
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeTaskDelegate) ImageSourceRedactionReturnsOnCall(i int, result1 atc.Source, result2 error) {
-	fake.imageSourceRedactionMutex.Lock()
-	defer fake.imageSourceRedactionMutex.Unlock()
-	fake.ImageSourceRedactionStub = nil
-	if fake.imageSourceRedactionReturnsOnCall == nil {
-		fake.imageSourceRedactionReturnsOnCall = make(map[int]struct {
-			result1 atc.Source
-			result2 error
-		})
-	}
-	fake.imageSourceRedactionReturnsOnCall[i] = struct {
-		result1 atc.Source
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakeTaskDelegate) ImageVersionDetermined(arg1 db.UsedResourceCache) error {
 	fake.imageVersionDeterminedMutex.Lock()
 	ret, specificReturn := fake.imageVersionDeterminedReturnsOnCall[len(fake.imageVersionDeterminedArgsForCall)]
@@ -316,6 +295,257 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) RedactImageSource(arg1 atc.Source) (atc.Source, error) {
+	fake.redactImageSourceMutex.Lock()
+	ret, specificReturn := fake.redactImageSourceReturnsOnCall[len(fake.redactImageSourceArgsForCall)]
+	fake.redactImageSourceArgsForCall = append(fake.redactImageSourceArgsForCall, struct {
+		arg1 atc.Source
+	}{arg1})
+	fake.recordInvocation("RedactImageSource", []interface{}{arg1})
+	fake.redactImageSourceMutex.Unlock()
+	if fake.RedactImageSourceStub != nil {
+		return fake.RedactImageSourceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.redactImageSourceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDelegate) RedactImageSourceCallCount() int {
+	fake.redactImageSourceMutex.RLock()
+	defer fake.redactImageSourceMutex.RUnlock()
+	return len(fake.redactImageSourceArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) RedactImageSourceCalls(stub func(atc.Source) (atc.Source, error)) {
+	fake.redactImageSourceMutex.Lock()
+	defer fake.redactImageSourceMutex.Unlock()
+	fake.RedactImageSourceStub = stub
+}
+
+func (fake *FakeTaskDelegate) RedactImageSourceArgsForCall(i int) atc.Source {
+	fake.redactImageSourceMutex.RLock()
+	defer fake.redactImageSourceMutex.RUnlock()
+	argsForCall := fake.redactImageSourceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) RedactImageSourceReturns(result1 atc.Source, result2 error) {
+	fake.redactImageSourceMutex.Lock()
+	defer fake.redactImageSourceMutex.Unlock()
+	fake.RedactImageSourceStub = nil
+	fake.redactImageSourceReturns = struct {
+		result1 atc.Source
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDelegate) RedactImageSourceReturnsOnCall(i int, result1 atc.Source, result2 error) {
+	fake.redactImageSourceMutex.Lock()
+	defer fake.redactImageSourceMutex.Unlock()
+	fake.RedactImageSourceStub = nil
+	if fake.redactImageSourceReturnsOnCall == nil {
+		fake.redactImageSourceReturnsOnCall = make(map[int]struct {
+			result1 atc.Source
+			result2 error
+		})
+	}
+	fake.redactImageSourceReturnsOnCall[i] = struct {
+		result1 atc.Source
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDelegate) ReusingCachedResult(arg1 lager.Logger, arg2 string, arg3 db.TaskResultCache) {
+	fake.reusingCachedResultMutex.Lock()
+	fake.reusingCachedResultArgsForCall = append(fake.reusingCachedResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 db.TaskResultCache
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ReusingCachedResult", []interface{}{arg1, arg2, arg3})
+	fake.reusingCachedResultMutex.Unlock()
+	if fake.ReusingCachedResultStub != nil {
+		fake.ReusingCachedResultStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) ReusingCachedResultCallCount() int {
+	fake.reusingCachedResultMutex.RLock()
+	defer fake.reusingCachedResultMutex.RUnlock()
+	return len(fake.reusingCachedResultArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ReusingCachedResultCalls(stub func(lager.Logger, string, db.TaskResultCache)) {
+	fake.reusingCachedResultMutex.Lock()
+	defer fake.reusingCachedResultMutex.Unlock()
+	fake.ReusingCachedResultStub = stub
+}
+
+func (fake *FakeTaskDelegate) ReusingCachedResultArgsForCall(i int) (lager.Logger, string, db.TaskResultCache) {
+	fake.reusingCachedResultMutex.RLock()
+	defer fake.reusingCachedResultMutex.RUnlock()
+	argsForCall := fake.reusingCachedResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) SaveResult(arg1 lager.Logger, arg2 string, arg3 atc.StepResult) {
+	fake.saveResultMutex.Lock()
+	fake.saveResultArgsForCall = append(fake.saveResultArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.StepResult
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveResult", []interface{}{arg1, arg2, arg3})
+	fake.saveResultMutex.Unlock()
+	if fake.SaveResultStub != nil {
+		fake.SaveResultStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) SaveResultCallCount() int {
+	fake.reusingCachedResultMutex.RLock()
+	defer fake.reusingCachedResultMutex.RUnlock()
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	return len(fake.saveResultArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveResultCalls(stub func(lager.Logger, string, atc.StepResult)) {
+	fake.saveResultMutex.Lock()
+	defer fake.saveResultMutex.Unlock()
+	fake.SaveResultStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveResultArgsForCall(i int) (lager.Logger, string, atc.StepResult) {
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	argsForCall := fake.saveResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) ServiceStderr(arg1 string) io.Writer {
+	fake.serviceStderrMutex.Lock()
+	ret, specificReturn := fake.serviceStderrReturnsOnCall[len(fake.serviceStderrArgsForCall)]
+	fake.serviceStderrArgsForCall = append(fake.serviceStderrArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ServiceStderr", []interface{}{arg1})
+	fake.serviceStderrMutex.Unlock()
+	if fake.ServiceStderrStub != nil {
+		return fake.ServiceStderrStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.serviceStderrReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrCallCount() int {
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	return len(fake.serviceStderrArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrCalls(stub func(string) io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = stub
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrArgsForCall(i int) string {
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	argsForCall := fake.serviceStderrArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrReturns(result1 io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = nil
+	fake.serviceStderrReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrReturnsOnCall(i int, result1 io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = nil
+	if fake.serviceStderrReturnsOnCall == nil {
+		fake.serviceStderrReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.serviceStderrReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStdout(arg1 string) io.Writer {
+	fake.serviceStdoutMutex.Lock()
+	ret, specificReturn := fake.serviceStdoutReturnsOnCall[len(fake.serviceStdoutArgsForCall)]
+	fake.serviceStdoutArgsForCall = append(fake.serviceStdoutArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ServiceStdout", []interface{}{arg1})
+	fake.serviceStdoutMutex.Unlock()
+	if fake.ServiceStdoutStub != nil {
+		return fake.ServiceStdoutStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.serviceStdoutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutCallCount() int {
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
+	return len(fake.serviceStdoutArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutCalls(stub func(string) io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = stub
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutArgsForCall(i int) string {
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
+	argsForCall := fake.serviceStdoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutReturns(result1 io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = nil
+	fake.serviceStdoutReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutReturnsOnCall(i int, result1 io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = nil
+	if fake.serviceStdoutReturnsOnCall == nil {
+		fake.serviceStdoutReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.serviceStdoutReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -534,6 +764,38 @@ func (fake *FakeTaskDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVar
 	}{result1}
 }
 
+func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
+	fake.waitingForWorkerMutex.Lock()
+	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
+	fake.waitingForWorkerMutex.Unlock()
+	if fake.WaitingForWorkerStub != nil {
+		fake.WaitingForWorkerStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerCallCount() int {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	return len(fake.waitingForWorkerArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
+	fake.waitingForWorkerMutex.Lock()
+	defer fake.waitingForWorkerMutex.Unlock()
+	fake.WaitingForWorkerStub = stub
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	argsForCall := fake.waitingForWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -541,12 +803,18 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
 	defer fake.finishedMutex.RUnlock()
-	fake.imageSourceRedactionMutex.RLock()
-	defer fake.imageSourceRedactionMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.redactImageSourceMutex.RLock()
+	defer fake.redactImageSourceMutex.RUnlock()
+	fake.saveResultMutex.RLock()
+	defer fake.saveResultMutex.RUnlock()
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startingMutex.RLock()
@@ -557,6 +825,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -51,6 +51,8 @@ type GetDelegate interface {
 	Errored(lager.Logger, string)
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
+
+	SaveResult(lager.Logger, string, atc.StepResult)
 }
 
 // GetStep will fetch a version of a resource on a worker that supports the
@@ -104,6 +106,8 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	state.StoreStepStatus(step.plan.Name, stepStatus(err, step.succeeded))
+
 	return err
 }
 
@@ -116,6 +120,28 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger)
 
+	if step.plan.Reuse != nil {
+		reused, err := reuseOutputs(logger, step.workerClient, step.metadata.TeamID, state.ArtifactRepository(), step.plan.Reuse, step.delegate.Stdout(), func(handle string) build.RegisterableArtifact {
+			return runtime.GetArtifact{VolumeHandle: handle}
+		})
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			step.delegate.SaveResult(logger, step.plan.ResultKey(), step.plan.Reuse.Result)
+
+			step.succeeded = true
+
+			step.delegate.Finished(logger, 0, runtime.VersionResult{
+				Version:  step.plan.Reuse.Result.Version,
+				Metadata: step.plan.Reuse.Result.Metadata,
+			})
+
+			return nil
+		}
+	}
+
 	variables := step.delegate.Variables()
 
 	source, err := creds.NewSource(variables, step.plan.Source).Evaluate()
@@ -152,6 +178,9 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+
+		WorkerSelector: step.plan.WorkerSelector,
+		BuildID:        step.metadata.BuildID,
 	}
 
 	imageSpec := worker.ImageFetcherSpec{
@@ -215,6 +244,12 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 			step.delegate.UpdateVersion(logger, step.plan, getResult.VersionResult)
 		}
 
+		step.delegate.SaveResult(logger, step.plan.ResultKey(), atc.StepResult{
+			Outputs:  map[string]string{step.plan.Name: getResult.GetArtifact.ID()},
+			Version:  getResult.VersionResult.Version,
+			Metadata: getResult.VersionResult.Metadata,
+		})
+
 		step.succeeded = true
 	}
 
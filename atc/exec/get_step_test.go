@@ -198,6 +198,7 @@ var _ = Describe("GetStep", func() {
 				Tags:          atc.Tags{"some", "tags"},
 				TeamID:        stepMetadata.TeamID,
 				ResourceTypes: interpolatedResourceTypes,
+				BuildID:       stepMetadata.BuildID,
 			},
 		))
 	})
@@ -350,11 +351,74 @@ var _ = Describe("GetStep", func() {
 			})
 		})
 
+		It("saves the result via the delegate", func() {
+			Expect(fakeDelegate.SaveResultCallCount()).To(Equal(1))
+			_, key, result := fakeDelegate.SaveResultArgsForCall(0)
+			Expect(key).To(Equal("get:some-name"))
+			Expect(result).To(Equal(atc.StepResult{
+				Outputs:  map[string]string{"some-name": "some-volume-handle"},
+				Version:  atc.Version{"some": "version"},
+				Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			}))
+		})
+
 		It("does not return an err", func() {
 			Expect(getStepErr).ToNot(HaveOccurred())
 		})
 	})
 
+	Context("when the plan reuses the result of an earlier build", func() {
+		BeforeEach(func() {
+			getPlan.Reuse = &atc.ReusedStep{
+				BuildID:   42,
+				BuildName: "7",
+				Result: atc.StepResult{
+					Outputs: map[string]string{"some-name": "reused-volume-handle"},
+					Version: atc.Version{"some": "reused-version"},
+				},
+			}
+		})
+
+		Context("when the volume still exists", func() {
+			BeforeEach(func() {
+				fakeClient.FindVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+			})
+
+			It("registers the reused artifact without running the step", func() {
+				Expect(fakeClient.RunGetStepCallCount()).To(BeZero())
+
+				artifact, found := artifactRepository.ArtifactFor(build.ArtifactName(getPlan.Name))
+				Expect(found).To(BeTrue())
+				Expect(artifact).To(Equal(runtime.GetArtifact{VolumeHandle: "reused-volume-handle"}))
+			})
+
+			It("finishes the step with the reused version", func() {
+				Expect(getStepErr).ToNot(HaveOccurred())
+				Expect(getStep.Succeeded()).To(BeTrue())
+
+				_, status, info := fakeDelegate.FinishedArgsForCall(0)
+				Expect(status).To(Equal(exec.ExitStatus(0)))
+				Expect(info.Version).To(Equal(atc.Version{"some": "reused-version"}))
+			})
+
+			It("saves the reused result for later reruns", func() {
+				_, key, result := fakeDelegate.SaveResultArgsForCall(0)
+				Expect(key).To(Equal("get:some-name"))
+				Expect(result).To(Equal(getPlan.Reuse.Result))
+			})
+		})
+
+		Context("when the volume is gone", func() {
+			BeforeEach(func() {
+				fakeClient.FindVolumeReturns(nil, false, nil)
+			})
+
+			It("runs the step", func() {
+				Expect(fakeClient.RunGetStepCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Context("when Client.RunGetStep returns a Failed GetResult", func() {
 		BeforeEach(func() {
 			fakeClient.RunGetStepReturns(
@@ -0,0 +1,25 @@
+package exec
+
+import "context"
+
+type hookContextKey struct{}
+
+// WithHookContext configures the context in which hooks are run once the
+// build has been aborted. Canceling the hook context interrupts any running
+// on_abort and ensure hooks, e.g. once a grace period has elapsed.
+//
+// Without a hook context, hooks run until they complete.
+func WithHookContext(ctx context.Context, hookCtx context.Context) context.Context {
+	return context.WithValue(ctx, hookContextKey{}, hookCtx)
+}
+
+// hookContext returns the context in which to run a hook after ctx has been
+// canceled. Hooks nested within the hook are run in the same context.
+func hookContext(ctx context.Context) context.Context {
+	hookCtx, ok := ctx.Value(hookContextKey{}).(context.Context)
+	if !ok {
+		return context.Background()
+	}
+
+	return WithHookContext(hookCtx, hookCtx)
+}
@@ -0,0 +1,112 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+// IfStep runs its nested step only if its condition holds. Otherwise the
+// nested step is skipped, which counts as success.
+type IfStep struct {
+	step     Step
+	plan     atc.IfPlan
+	build    atc.ConditionBuild
+	delegate BuildStepDelegate
+
+	skipped bool
+}
+
+// If constructs an IfStep.
+func If(
+	step Step,
+	plan atc.IfPlan,
+	build atc.ConditionBuild,
+	delegate BuildStepDelegate,
+) *IfStep {
+	return &IfStep{
+		step:     step,
+		plan:     plan,
+		build:    build,
+		delegate: delegate,
+	}
+}
+
+// Run evaluates the condition against the build's metadata, the local vars
+// set by load_var steps and the statuses of the steps which have already run.
+//
+// If the condition does not hold, a skipped event is emitted and each of the
+// steps nested within are marked as skipped, so that later conditions may
+// refer to them.
+func (step *IfStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("if-step", lager.Data{
+		"condition": step.plan.Condition,
+	})
+
+	condition, err := atc.ParseCondition(step.plan.Condition)
+	if err != nil {
+		return err
+	}
+
+	variables := step.delegate.Variables()
+
+	holds, err := condition.Evaluate(atc.ConditionContext{
+		Build: step.build,
+		LocalVar: func(name string) (interface{}, bool) {
+			value, found, err := variables.Get(vars.VariableDefinition{Name: ".:" + name})
+			if err != nil {
+				return nil, false
+			}
+
+			return value, found
+		},
+		StepStatus: state.StepStatus,
+	})
+	if err != nil {
+		return err
+	}
+
+	if holds {
+		return step.step.Run(ctx, state)
+	}
+
+	step.skipped = true
+
+	step.delegate.Skipped(logger, step.plan.Condition)
+
+	step.plan.Step.Each(func(plan *atc.Plan) {
+		if name, ok := namedStep(*plan); ok {
+			state.StoreStepStatus(name, StepStatusSkipped)
+		}
+	})
+
+	return nil
+}
+
+// Succeeded is true if the nested step was skipped or completed successfully.
+func (step *IfStep) Succeeded() bool {
+	return step.skipped || step.step.Succeeded()
+}
+
+// namedStep returns the name of the step run by a plan, if it is one of the
+// step types whose status can be referenced by a condition.
+func namedStep(plan atc.Plan) (string, bool) {
+	switch {
+	case plan.Get != nil:
+		return plan.Get.Name, true
+	case plan.Put != nil:
+		return plan.Put.Name, true
+	case plan.Task != nil:
+		return plan.Task.Name, true
+	case plan.SetPipeline != nil:
+		return plan.SetPipeline.Name, true
+	case plan.LoadVar != nil:
+		return plan.LoadVar.Name, true
+	default:
+		return "", false
+	}
+}
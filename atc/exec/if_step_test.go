@@ -0,0 +1,157 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IfStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		step            *execfakes.FakeStep
+		delegate        *execfakes.FakeBuildStepDelegate
+		credVarsTracker vars.CredVarsTracker
+		state           exec.RunState
+		plan            atc.IfPlan
+		build           atc.ConditionBuild
+		ifStep          exec.Step
+
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		step = new(execfakes.FakeStep)
+		delegate = new(execfakes.FakeBuildStepDelegate)
+
+		credVarsTracker = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+		credVarsTracker.AddLocalVar("branch", "release/6.5", false)
+		delegate.VariablesReturns(credVarsTracker)
+
+		state = exec.NewRunState()
+		state.StoreStepStatus("unit", exec.StepStatusSucceeded)
+
+		build = atc.ConditionBuild{
+			Team:     "some-team",
+			Pipeline: "some-pipeline",
+			Job:      "some-job",
+			Name:     "42",
+			Trigger:  "scheduled",
+		}
+
+		plan = atc.IfPlan{
+			Step: atc.Plan{
+				ID: "1",
+				OnSuccess: &atc.OnSuccessPlan{
+					Step: atc.Plan{ID: "2", Task: &atc.TaskPlan{Name: "deploy"}},
+					Next: atc.Plan{ID: "3", Put: &atc.PutPlan{Name: "release"}},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ifStep = exec.If(step, plan, build, delegate)
+		stepErr = ifStep.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the condition holds", func() {
+		BeforeEach(func() {
+			plan.Condition = `starts_with(vars.branch, "release/") && steps.unit == "succeeded" && build.trigger == "scheduled"`
+		})
+
+		It("runs the nested step", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.RunCallCount()).To(Equal(1))
+			Expect(delegate.SkippedCallCount()).To(BeZero())
+		})
+
+		Context("when the nested step succeeds", func() {
+			BeforeEach(func() {
+				step.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(ifStep.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the nested step fails", func() {
+			BeforeEach(func() {
+				step.SucceededReturns(false)
+			})
+
+			It("fails", func() {
+				Expect(ifStep.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the nested step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				step.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the condition does not hold", func() {
+		BeforeEach(func() {
+			plan.Condition = `vars.branch == "main" || steps.lint == "failed"`
+		})
+
+		It("does not run the nested step", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.RunCallCount()).To(BeZero())
+		})
+
+		It("emits a skipped event", func() {
+			Expect(delegate.SkippedCallCount()).To(Equal(1))
+			_, condition := delegate.SkippedArgsForCall(0)
+			Expect(condition).To(Equal(plan.Condition))
+		})
+
+		It("marks the nested steps as skipped", func() {
+			status, found := state.StepStatus("deploy")
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(exec.StepStatusSkipped))
+
+			status, found = state.StepStatus("release")
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(exec.StepStatusSkipped))
+		})
+
+		It("succeeds", func() {
+			Expect(ifStep.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the condition cannot be evaluated", func() {
+		BeforeEach(func() {
+			plan.Condition = `build.name > 10`
+		})
+
+		It("returns an error without running the nested step", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(atc.ConditionEvaluationError{}))
+			Expect(step.RunCallCount()).To(BeZero())
+		})
+	})
+})
@@ -78,6 +78,8 @@ func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	state.StoreStepStatus(step.plan.Name, stepStatus(err, step.succeeded))
+
 	return err
 }
 
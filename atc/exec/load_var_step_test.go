@@ -148,6 +148,13 @@ var _ = Describe("LoadVarStep", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(value)).To(Equal("pv\n"))
 			})
+
+			It("stores the status of the step", func() {
+				Expect(state.StoreStepStatusCallCount()).To(Equal(1))
+				name, status := state.StoreStepStatusArgsForCall(0)
+				Expect(name).To(Equal("some-var"))
+				Expect(status).To(Equal(exec.StepStatusSucceeded))
+			})
 		})
 
 		Context("when format is json", func() {
@@ -318,6 +325,12 @@ var _ = Describe("LoadVarStep", func() {
 				Expect(stepErr).To(HaveOccurred())
 				Expect(stepErr).To(MatchError(ContainSubstring("failed to parse some-resource/a.json in format json")))
 			})
+
+			It("stores the status of the step as errored", func() {
+				Expect(state.StoreStepStatusCallCount()).To(Equal(1))
+				_, status := state.StoreStepStatusArgsForCall(0)
+				Expect(status).To(Equal(exec.StepStatusErrored))
+			})
 		})
 
 		Context("when yaml file is bad", func() {
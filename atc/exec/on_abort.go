@@ -33,7 +33,7 @@ func (o OnAbortStep) Run(ctx context.Context, state RunState) error {
 
 	if stepRunErr == context.Canceled {
 		// run only on abort, not timeout
-		o.hook.Run(context.Background(), state)
+		o.hook.Run(hookContext(ctx), state)
 	}
 
 	return stepRunErr
@@ -2,6 +2,7 @@ package exec
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"code.cloudfoundry.org/lager"
@@ -33,6 +34,8 @@ type PutDelegate interface {
 	Errored(lager.Logger, string)
 
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
+
+	SaveResult(lager.Logger, string, atc.StepResult)
 }
 
 // PutStep produces a resource version using preconfigured params and any data
@@ -95,6 +98,8 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	state.StoreStepStatus(step.plan.Name, stepStatus(err, step.succeeded))
+
 	return err
 }
 
@@ -107,6 +112,25 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger)
 
+	if step.plan.Reuse != nil {
+		fmt.Fprintf(step.delegate.Stdout(), "reusing result of build #%s\n", step.plan.Reuse.BuildName)
+
+		versionResult := runtime.VersionResult{
+			Version:  step.plan.Reuse.Result.Version,
+			Metadata: step.plan.Reuse.Result.Metadata,
+		}
+
+		state.StoreResult(step.planID, versionResult)
+
+		step.delegate.SaveResult(logger, step.plan.ResultKey(), step.plan.Reuse.Result)
+
+		step.succeeded = true
+
+		step.delegate.Finished(logger, 0, versionResult)
+
+		return nil
+	}
+
 	variables := step.delegate.Variables()
 
 	source, err := creds.NewSource(variables, step.plan.Source).Evaluate()
@@ -164,6 +188,9 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+
+		WorkerSelector: step.plan.WorkerSelector,
+		BuildID:        step.metadata.BuildID,
 	}
 
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
@@ -218,6 +245,11 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 
 	state.StoreResult(step.planID, versionResult)
 
+	step.delegate.SaveResult(logger, step.plan.ResultKey(), atc.StepResult{
+		Version:  versionResult.Version,
+		Metadata: versionResult.Metadata,
+	})
+
 	step.succeeded = true
 
 	step.delegate.Finished(logger, 0, versionResult)
@@ -300,6 +300,7 @@ var _ = Describe("PutStep", func() {
 			Tags:          []string{"some", "tags"},
 			ResourceType:  "some-resource-type",
 			ResourceTypes: interpolatedResourceTypes,
+			BuildID:       42,
 		}))
 		Expect(actualStrategy).To(Equal(fakeStrategy))
 
@@ -0,0 +1,73 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ReleaseStep runs its nested step and then releases locks previously
+// acquired by the build, regardless of whether the nested step failed or
+// errored.
+type ReleaseStep struct {
+	step            Step
+	plan            atc.ReleasePlan
+	metadata        StepMetadata
+	lockPoolFactory db.LockPoolFactory
+}
+
+// Release constructs a ReleaseStep.
+func Release(
+	step Step,
+	plan atc.ReleasePlan,
+	metadata StepMetadata,
+	lockPoolFactory db.LockPoolFactory,
+) ReleaseStep {
+	return ReleaseStep{
+		step:            step,
+		plan:            plan,
+		metadata:        metadata,
+		lockPoolFactory: lockPoolFactory,
+	}
+}
+
+// Run will call Run on the nested step, wait for it to complete, and then
+// release each lock.
+//
+// If the nested step errors or any lock fails to be released, an aggregate of
+// the errors is returned.
+func (step ReleaseStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("release-step", lager.Data{
+		"build": step.metadata.BuildID,
+	})
+
+	var errors error
+
+	err := step.step.Run(ctx, state)
+	if err != nil {
+		errors = multierror.Append(errors, err)
+	}
+
+	for _, lock := range step.plan.Locks {
+		err := step.lockPoolFactory.Release(step.metadata.TeamID, step.metadata.BuildID, lock.Name)
+		if err != nil {
+			logger.Error("failed-to-release-lock", err, lager.Data{"lock": lock.Name})
+			errors = multierror.Append(errors, err)
+			continue
+		}
+
+		logger.Debug("released-lock", lager.Data{"lock": lock.Name})
+	}
+
+	return errors
+}
+
+// Succeeded is true if the nested step completed successfully.
+func (step ReleaseStep) Succeeded() bool {
+	return step.step.Succeeded()
+}
@@ -0,0 +1,81 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReleaseStep", func() {
+	var (
+		ctx context.Context
+
+		step          *execfakes.FakeStep
+		fakeLockPools *dbfakes.FakeLockPoolFactory
+		state         *execfakes.FakeRunState
+
+		release exec.Step
+
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		step = new(execfakes.FakeStep)
+		fakeLockPools = new(dbfakes.FakeLockPoolFactory)
+		state = new(execfakes.FakeRunState)
+
+		release = exec.Release(step, atc.ReleasePlan{
+			Locks: atc.LockConfigs{{Name: "some-lock"}, {Name: "other-lock"}},
+		}, exec.StepMetadata{TeamID: 1, BuildID: 42}, fakeLockPools)
+	})
+
+	JustBeforeEach(func() {
+		stepErr = release.Run(ctx, state)
+	})
+
+	It("runs the nested step and releases each lock", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(step.RunCallCount()).To(Equal(1))
+		Expect(fakeLockPools.ReleaseCallCount()).To(Equal(2))
+
+		teamID, buildID, name := fakeLockPools.ReleaseArgsForCall(0)
+		Expect(teamID).To(Equal(1))
+		Expect(buildID).To(Equal(42))
+		Expect(name).To(Equal("some-lock"))
+
+		_, _, name = fakeLockPools.ReleaseArgsForCall(1)
+		Expect(name).To(Equal("other-lock"))
+	})
+
+	Context("when the nested step errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			step.RunReturns(disaster)
+		})
+
+		It("still releases the locks", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+			Expect(fakeLockPools.ReleaseCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when releasing a lock fails", func() {
+		BeforeEach(func() {
+			fakeLockPools.ReleaseReturnsOnCall(0, errors.New("release failed"))
+		})
+
+		It("releases the remaining locks and returns the error", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("release failed")))
+			Expect(fakeLockPools.ReleaseCallCount()).To(Equal(2))
+		})
+	})
+})
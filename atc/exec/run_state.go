@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"context"
 	"reflect"
 	"sync"
 
@@ -11,12 +12,14 @@ import (
 type runState struct {
 	artifacts *build.Repository
 	results   *sync.Map
+	statuses  *sync.Map
 }
 
 func NewRunState() RunState {
 	return &runState{
 		artifacts: build.NewRepository(),
 		results:   &sync.Map{},
+		statuses:  &sync.Map{},
 	}
 }
 
@@ -41,3 +44,40 @@ func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 func (state *runState) StoreResult(id atc.PlanID, val interface{}) {
 	state.results.Store(id, val)
 }
+
+// StepStatus returns the status of the most recent run of the named step.
+func (state *runState) StepStatus(name string) (string, bool) {
+	val, ok := state.statuses.Load(name)
+	if !ok {
+		return "", false
+	}
+
+	return val.(string), true
+}
+
+func (state *runState) StoreStepStatus(name string, status string) {
+	state.statuses.Store(name, status)
+}
+
+const (
+	StepStatusSucceeded = "succeeded"
+	StepStatusFailed    = "failed"
+	StepStatusErrored   = "errored"
+	StepStatusAborted   = "aborted"
+	StepStatusSkipped   = "skipped"
+)
+
+// stepStatus determines the status of a step which has finished running, to
+// be referenced by the conditions of later steps.
+func stepStatus(err error, succeeded bool) string {
+	switch {
+	case err == context.Canceled:
+		return StepStatusAborted
+	case err != nil:
+		return StepStatusErrored
+	case succeeded:
+		return StepStatusSucceeded
+	default:
+		return StepStatusFailed
+	}
+}
@@ -93,4 +93,20 @@ var _ = Describe("RunState", func() {
 			})
 		})
 	})
+
+	Describe("StepStatus", func() {
+		It("returns false for steps which have not run", func() {
+			_, found := state.StepStatus("some-step")
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the most recently stored status", func() {
+			state.StoreStepStatus("some-step", exec.StepStatusFailed)
+			state.StoreStepStatus("some-step", exec.StepStatusSucceeded)
+
+			status, found := state.StepStatus("some-step")
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(exec.StepStatusSucceeded))
+		})
+	})
 })
@@ -33,6 +33,7 @@ type SetPipelineStep struct {
 	delegate    BuildStepDelegate
 	teamFactory db.TeamFactory
 	client      worker.Client
+	lintRules   atc.LintRules
 	succeeded   bool
 }
 
@@ -43,6 +44,7 @@ func NewSetPipelineStep(
 	delegate BuildStepDelegate,
 	teamFactory db.TeamFactory,
 	client worker.Client,
+	lintRules atc.LintRules,
 ) Step {
 	return &SetPipelineStep{
 		planID:      planID,
@@ -51,6 +53,7 @@ func NewSetPipelineStep(
 		delegate:    delegate,
 		teamFactory: teamFactory,
 		client:      client,
+		lintRules:   lintRules,
 	}
 }
 
@@ -67,6 +70,8 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	state.StoreStepStatus(step.plan.Name, stepStatus(err, step.succeeded))
+
 	return err
 }
 
@@ -172,6 +177,29 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 		team = targetTeam
 	}
 
+	// lint the config as if it were set through the API, applying the
+	// cluster's rules overridden by the team's
+	teamLintRules, err := team.LintRules()
+	if err != nil {
+		return err
+	}
+
+	lintWarnings, lintErrors := configvalidate.LintWarnings(configvalidate.Lint(atcConfig, step.lintRules.Merge(teamLintRules)))
+	for _, warning := range lintWarnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(lintErrors) > 0 {
+		fmt.Fprintln(stderr, "pipeline failed lint rules:")
+
+		for _, e := range lintErrors {
+			fmt.Fprintf(stderr, "- %s\n", e)
+		}
+
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
 	fromVersion := db.ConfigVersion(0)
 	pipeline, found, err := team.Pipeline(step.plan.Name)
 	if err != nil {
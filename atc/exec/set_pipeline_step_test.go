@@ -100,6 +100,8 @@ jobs:
 
 		stdout, stderr *gbytes.Buffer
 
+		lintRules atc.LintRules
+
 		planID = 56
 	)
 
@@ -147,6 +149,8 @@ jobs:
 
 		fakeWorkerClient = new(workerfakes.FakeClient)
 
+		lintRules = nil
+
 		spPlan = &atc.SetPipelinePlan{
 			Name: "some-pipeline",
 			File: "some-resource/pipeline.yml",
@@ -170,6 +174,7 @@ jobs:
 			fakeDelegate,
 			fakeTeamFactory,
 			fakeWorkerClient,
+			lintRules,
 		)
 
 		stepErr = spStep.Run(ctx, state)
@@ -226,6 +231,63 @@ jobs:
 				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: pipelineContent}, nil)
 			})
 
+			Context("when the config violates a lint rule", func() {
+				BeforeEach(func() {
+					fakeTeam.PipelineReturns(nil, false, nil)
+					fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+				})
+
+				It("warns about it by default", func() {
+					Expect(stderr).To(gbytes.Say("WARNING: .*image_resource"))
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+				})
+
+				Context("when the rule is configured to be an error", func() {
+					BeforeEach(func() {
+						lintRules = atc.LintRules{"unpinned-image-resource": atc.LintSeverityError}
+					})
+
+					It("should not return error", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+					})
+
+					It("should stderr have the lint error", func() {
+						Expect(stderr).To(gbytes.Say("pipeline failed lint rules:"))
+						Expect(stderr).To(gbytes.Say(`\(unpinned-image-resource\)`))
+					})
+
+					It("should not save the pipeline", func() {
+						Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+					})
+
+					It("should finish unsuccessfully", func() {
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+						_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+						Expect(succeeded).To(BeFalse())
+					})
+
+					Context("when the team turns the rule off", func() {
+						BeforeEach(func() {
+							fakeTeam.LintRulesReturns(atc.LintRules{"unpinned-image-resource": atc.LintSeverityOff}, nil)
+						})
+
+						It("should save the pipeline", func() {
+							Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+						})
+					})
+				})
+
+				Context("when getting the team's lint rules fails", func() {
+					BeforeEach(func() {
+						fakeTeam.LintRulesReturns(nil, errors.New("nope"))
+					})
+
+					It("should return error", func() {
+						Expect(stepErr).To(MatchError("nope"))
+					})
+				})
+			})
+
 			Context("when get pipeline fails", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelineReturns(nil, false, errors.New("fail to get pipeline"))
@@ -40,6 +40,9 @@ type RunState interface {
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
+
+	StepStatus(string) (string, bool)
+	StoreStepStatus(string, string)
 }
 
 // ExitStatus is the resulting exit code from the process that the step ran.
@@ -0,0 +1,45 @@
+package exec
+
+import (
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// reuseOutputs registers the outputs of a step's result from an earlier
+// build, if all of their volumes still exist. Otherwise, the step has to be
+// run again.
+func reuseOutputs(
+	logger lager.Logger,
+	workerClient worker.Client,
+	teamID int,
+	repository *build.Repository,
+	reuse *atc.ReusedStep,
+	stdout io.Writer,
+	newArtifact func(handle string) build.RegisterableArtifact,
+) (bool, error) {
+	for name, handle := range reuse.Result.Outputs {
+		_, found, err := workerClient.FindVolume(logger, teamID, handle)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			logger.Info("reused-output-volume-not-found", lager.Data{"output": name, "volume": handle})
+			fmt.Fprintf(stdout, "not reusing result of build #%s as the volume of '%s' is gone\n", reuse.BuildName, name)
+			return false, nil
+		}
+	}
+
+	for name, handle := range reuse.Result.Outputs {
+		repository.RegisterArtifact(build.ArtifactName(name), newArtifact(handle))
+	}
+
+	fmt.Fprintf(stdout, "reusing result of build #%s\n", reuse.BuildName)
+
+	return true, nil
+}
@@ -0,0 +1,211 @@
+package exec
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// taskResultCacheKey is everything that determines the result of a task run
+// with `cache_result: true`. Its hash is used to look up cached results.
+type taskResultCacheKey struct {
+	Config     atc.TaskConfig    `json:"config"`
+	Privileged bool              `json:"privileged"`
+	Image      string            `json:"image,omitempty"`
+	Inputs     map[string]string `json:"inputs"`
+}
+
+// resultCacheKey computes the key identifying the task's result. An empty key
+// is returned if the result cannot be cached, in which case the reason is
+// printed to the task's stderr.
+func (step *TaskStep) resultCacheKey(logger lager.Logger, repository *build.Repository, config atc.TaskConfig) (string, error) {
+	key := taskResultCacheKey{
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Inputs:     map[string]string{},
+	}
+
+	if step.plan.ImageArtifactName != "" {
+		art, found := repository.ArtifactFor(build.ArtifactName(step.plan.ImageArtifactName))
+		if !found {
+			return "", MissingTaskImageSourceError{step.plan.ImageArtifactName}
+		}
+
+		artifactKey, found, err := step.artifactKey(logger, art)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			step.resultNotCacheable(fmt.Sprintf("the contents of image '%s' are not known", step.plan.ImageArtifactName))
+			return "", nil
+		}
+
+		key.Image = artifactKey
+	} else if config.ImageResource != nil && config.ImageResource.Version == nil {
+		step.resultNotCacheable("its image_resource does not specify a version")
+		return "", nil
+	}
+
+	for _, input := range config.Inputs {
+		inputName := input.Name
+		if sourceName, ok := step.plan.InputMapping[inputName]; ok {
+			inputName = sourceName
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(inputName))
+		if !found {
+			// a missing optional input is part of the key by its absence
+			continue
+		}
+
+		artifactKey, found, err := step.artifactKey(logger, art)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			step.resultNotCacheable(fmt.Sprintf("the contents of input '%s' are not known", input.Name))
+			return "", nil
+		}
+
+		key.Inputs[input.Name] = artifactKey
+	}
+
+	payload, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+// artifactKey identifies the contents of an artifact. Only the volumes of
+// resource caches and of cached task outputs have known contents.
+func (step *TaskStep) artifactKey(logger lager.Logger, art runtime.Artifact) (string, bool, error) {
+	volume, found, err := step.workerClient.FindVolume(logger, step.metadata.TeamID, art.ID())
+	if err != nil {
+		return "", false, err
+	}
+
+	if !found {
+		return "", false, nil
+	}
+
+	if resourceCacheID := volume.GetResourceCacheID(); resourceCacheID != 0 {
+		return fmt.Sprintf("resource-cache:%d", resourceCacheID), true, nil
+	}
+
+	outputKey, found, err := step.taskResultCacheFactory.OutputKey(volume.Handle())
+	if err != nil {
+		return "", false, err
+	}
+
+	if !found {
+		return "", false, nil
+	}
+
+	return fmt.Sprintf("task-result:%s", outputKey), true, nil
+}
+
+func (step *TaskStep) resultNotCacheable(reason string) {
+	fmt.Fprintf(step.delegate.Stderr(), "not using the task result cache because %s\n", reason)
+}
+
+// reuseCachedResult registers the outputs of the cached result for the key,
+// if there is one and all of its output volumes still exist.
+func (step *TaskStep) reuseCachedResult(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, key string) (db.TaskResultCache, bool, error) {
+	cache, found, err := step.taskResultCacheFactory.Find(step.metadata.JobID, step.plan.Name, key)
+	if err != nil {
+		return db.TaskResultCache{}, false, err
+	}
+
+	if !found {
+		return db.TaskResultCache{}, false, nil
+	}
+
+	handles := map[string]string{}
+	for _, output := range cache.Outputs {
+		_, found, err := step.workerClient.FindVolume(logger, step.metadata.TeamID, output.VolumeHandle)
+		if err != nil {
+			return db.TaskResultCache{}, false, err
+		}
+
+		if !found {
+			logger.Info("cached-output-volume-not-found", lager.Data{"output": output.Name, "volume": output.VolumeHandle})
+			return db.TaskResultCache{}, false, nil
+		}
+
+		handles[output.Name] = output.VolumeHandle
+	}
+
+	for _, output := range config.Outputs {
+		handle, found := handles[output.Name]
+		if !found {
+			logger.Info("cached-output-not-found", lager.Data{"output": output.Name})
+			return db.TaskResultCache{}, false, nil
+		}
+
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		repository.RegisterArtifact(build.ArtifactName(outputName), &runtime.TaskArtifact{
+			VolumeHandle: handle,
+		})
+	}
+
+	return cache, true, nil
+}
+
+// saveResult stores the task's exit status and outputs under the key. The
+// output volumes are kept around by initializing them as artifacts.
+func (step *TaskStep) saveResult(logger lager.Logger, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata, key string, exitStatus int) error {
+	var outputs []db.TaskResultCacheOutput
+
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, metadata.WorkingDirectory)
+
+		var volume worker.Volume
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				volume = mount.Volume
+			}
+		}
+
+		if volume == nil {
+			logger.Info("output-volume-not-found", lager.Data{"output": output.Name})
+			return nil
+		}
+
+		artifact, err := volume.InitializeArtifact(output.Name, step.metadata.BuildID)
+		if err != nil {
+			return err
+		}
+
+		outputs = append(outputs, db.TaskResultCacheOutput{
+			Name:             output.Name,
+			Key:              fmt.Sprintf("%x", sha256.Sum256([]byte(key+"/"+output.Name))),
+			VolumeHandle:     volume.Handle(),
+			WorkerArtifactID: artifact.ID(),
+		})
+	}
+
+	return step.taskResultCacheFactory.Save(
+		step.metadata.JobID,
+		step.plan.Name,
+		key,
+		step.metadata.BuildID,
+		exitStatus,
+		outputs,
+	)
+}
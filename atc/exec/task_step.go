@@ -59,14 +59,21 @@ type TaskDelegate interface {
 	Stdout() io.Writer
 	Stderr() io.Writer
 
+	ServiceStdout(name string) io.Writer
+	ServiceStderr(name string) io.Writer
+
 	Variables() vars.CredVarsTracker
 
 	SetTaskConfig(config atc.TaskConfig)
 
 	Initializing(lager.Logger)
+	WaitingForWorker(lager.Logger, string)
+	ReusingCachedResult(lager.Logger, string, db.TaskResultCache)
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus)
 	Errored(lager.Logger, string)
+
+	SaveResult(lager.Logger, string, atc.StepResult)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -82,6 +89,8 @@ type TaskStep struct {
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
 	succeeded         bool
+
+	taskResultCacheFactory db.TaskResultCacheFactory
 }
 
 func NewTaskStep(
@@ -94,6 +103,7 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	taskResultCacheFactory db.TaskResultCacheFactory,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -105,6 +115,8 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+
+		taskResultCacheFactory: taskResultCacheFactory,
 	}
 }
 
@@ -123,6 +135,10 @@ func NewTaskStep(
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an StreamableArtifactSource under the
 // name of the task.
+//
+// If the plan sets CacheResult, the exit status and outputs of a previous run
+// with the same config, image and inputs are reused instead of running the
+// script, and the result of running it is cached otherwise.
 func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
 		"team":     step.metadata.TeamName,
@@ -135,6 +151,8 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	state.StoreStepStatus(step.plan.Name, stepStatus(err, step.succeeded))
+
 	return err
 }
 
@@ -215,6 +233,23 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger)
 
+	if step.plan.Reuse != nil {
+		reused, err := reuseOutputs(logger, step.workerClient, step.metadata.TeamID, repository, step.plan.Reuse, step.delegate.Stdout(), func(handle string) build.RegisterableArtifact {
+			return &runtime.TaskArtifact{VolumeHandle: handle}
+		})
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			step.delegate.SaveResult(logger, step.plan.ResultKey(), step.plan.Reuse.Result)
+
+			step.succeeded = true
+			step.delegate.Finished(logger, 0)
+			return nil
+		}
+	}
+
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
 	if err != nil {
 		return err
@@ -226,6 +261,36 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	}
 	tracing.Inject(ctx, &containerSpec)
 
+	containerSpec.Services = step.serviceSpecs(config)
+
+	// Do not cache results of one-off builds
+	var resultCacheKey string
+	if step.plan.CacheResult && step.metadata.JobID != 0 {
+		resultCacheKey, err = step.resultCacheKey(logger, repository, config)
+		if err != nil {
+			return err
+		}
+	}
+
+	if resultCacheKey != "" {
+		cache, found, err := step.reuseCachedResult(logger, repository, config, resultCacheKey)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			step.delegate.ReusingCachedResult(logger, resultCacheKey, cache)
+
+			step.succeeded = cache.ExitStatus == 0
+			if step.succeeded {
+				step.saveOutputs(logger, repository, config)
+			}
+
+			step.delegate.Finished(logger, ExitStatus(cache.ExitStatus))
+			return nil
+		}
+	}
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -267,6 +332,17 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 
 	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
 
+	if step.succeeded {
+		step.saveOutputs(logger, repository, config)
+	}
+
+	if resultCacheKey != "" {
+		err = step.saveResult(logger, config, result.VolumeMounts, step.containerMetadata, resultCacheKey, result.ExitStatus)
+		if err != nil {
+			logger.Error("failed-to-save-result", err)
+		}
+	}
+
 	// Do not initialize caches for one-off builds
 	if step.metadata.JobID != 0 {
 		err = step.registerCaches(logger, repository, config, result.VolumeMounts, step.containerMetadata)
@@ -388,6 +464,10 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		Outputs: worker.OutputPaths{},
 	}
 
+	if config.Resources != nil && config.Resources.Requests != nil {
+		containerSpec.ResourceRequests = *config.Resources.Requests
+	}
+
 	containerSpec.ArtifactByPath, err = step.containerInputs(logger, repository, config, metadata)
 	if err != nil {
 		return worker.ContainerSpec{}, err
@@ -401,12 +481,77 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 	return containerSpec, nil
 }
 
+func (step *TaskStep) serviceSpecs(config atc.TaskConfig) []worker.ServiceSpec {
+	var services []worker.ServiceSpec
+
+	for _, service := range config.Services {
+		metadata := step.containerMetadata
+		metadata.StepName = fmt.Sprintf("%s/%s", metadata.StepName, service.Name)
+		metadata.WorkingDirectory = ""
+
+		spec := worker.ServiceSpec{
+			Name:    service.Name,
+			EnvName: service.EnvName(),
+			Owner: db.NewBuildStepContainerOwner(
+				step.metadata.BuildID,
+				atc.PlanID(fmt.Sprintf("%s/services/%s", step.planID, service.Name)),
+				step.metadata.TeamID,
+			),
+			Metadata: metadata,
+			ContainerSpec: worker.ContainerSpec{
+				Platform: config.Platform,
+				Tags:     step.plan.Tags,
+				TeamID:   step.metadata.TeamID,
+				ImageSpec: worker.ImageSpec{
+					ImageResource: &worker.ImageResource{
+						Type:    service.ImageResource.Type,
+						Source:  service.ImageResource.Source,
+						Params:  service.ImageResource.Params,
+						Version: service.ImageResource.Version,
+					},
+				},
+				User: service.Run.User,
+				Env:  service.Env.Env(),
+				Type: step.containerMetadata.Type,
+			},
+			Ports: service.Ports,
+			Process: runtime.ProcessSpec{
+				Path:         service.Run.Path,
+				Args:         service.Run.Args,
+				Dir:          service.Run.Dir,
+				StdoutWriter: step.delegate.ServiceStdout(service.Name),
+				StderrWriter: step.delegate.ServiceStderr(service.Name),
+			},
+		}
+
+		if service.Readiness != nil {
+			// the durations have already been validated with the config
+			interval, _ := service.Readiness.IntervalDuration()
+			timeout, _ := service.Readiness.TimeoutDuration()
+
+			spec.Readiness = &worker.ServiceReadiness{
+				Path:     service.Readiness.Run.Path,
+				Args:     service.Readiness.Run.Args,
+				Interval: interval,
+				Timeout:  timeout,
+			}
+		}
+
+		services = append(services, spec)
+	}
+
+	return services
+}
+
 func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.VersionedResourceTypes, repository *build.Repository, config atc.TaskConfig) (worker.WorkerSpec, error) {
 	workerSpec := worker.WorkerSpec{
 		Platform:      config.Platform,
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+
+		WorkerSelector: step.plan.WorkerSelector,
+		BuildID:        step.metadata.BuildID,
 	}
 
 	imageSpec, err := step.imageSpec(logger, repository, config)
@@ -443,6 +588,27 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
+// saveOutputs records the volumes of the registered outputs as the result of
+// the task, for partial reruns of the build to reuse.
+func (step *TaskStep) saveOutputs(logger lager.Logger, repository *build.Repository, config atc.TaskConfig) {
+	outputs := map[string]string{}
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(outputName))
+		if found {
+			outputs[outputName] = art.ID()
+		}
+	}
+
+	step.delegate.SaveResult(logger, step.plan.ResultKey(), atc.StepResult{
+		Outputs: outputs,
+	})
+}
+
 func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
 	logger.Debug("initializing-caches", lager.Data{"caches": config.Caches})
 
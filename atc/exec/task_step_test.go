@@ -3,10 +3,12 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
@@ -38,6 +40,8 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory *lockfakes.FakeLockFactory
 
+		fakeTaskResultCacheFactory *dbfakes.FakeTaskResultCacheFactory
+
 		fakeDelegate *execfakes.FakeTaskDelegate
 		taskPlan     *atc.TaskPlan
 
@@ -77,6 +81,8 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
 
+		fakeTaskResultCacheFactory = new(dbfakes.FakeTaskResultCacheFactory)
+
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
@@ -138,6 +144,7 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegate,
 			fakeLockFactory,
+			fakeTaskResultCacheFactory,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -277,6 +284,20 @@ var _ = Describe("TaskStep", func() {
 			Expect(actualTaskConfig).To(Equal(*taskPlan.Config))
 		})
 
+		Context("when the config has resource requests", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Resources = &atc.TaskResources{
+					Requests: &atc.ResourceRequests{CPU: 500, Memory: 1024},
+				}
+			})
+
+			It("sets the requests on the container spec", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.ResourceRequests).To(Equal(atc.ResourceRequests{CPU: 500, Memory: 1024}))
+			})
+		})
+
 		Context("when privileged", func() {
 			BeforeEach(func() {
 				taskPlan.Privileged = true
@@ -725,6 +746,7 @@ var _ = Describe("TaskStep", func() {
 					ResourceTypes: interpolatedResourceTypes,
 					Tags:          []string{"step", "tags"},
 					ResourceType:  "docker",
+					BuildID:       1234,
 				}))
 			})
 		})
@@ -751,6 +773,7 @@ var _ = Describe("TaskStep", func() {
 					Platform:      "some-platform",
 					ResourceTypes: interpolatedResourceTypes,
 					Tags:          []string{"step", "tags"},
+					BuildID:       1234,
 				}))
 			})
 		})
@@ -784,6 +807,72 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when services are specified", func() {
+			var serviceStdout, serviceStderr *gbytes.Buffer
+
+			BeforeEach(func() {
+				serviceStdout = gbytes.NewBuffer()
+				serviceStderr = gbytes.NewBuffer()
+				fakeDelegate.ServiceStdoutReturns(serviceStdout)
+				fakeDelegate.ServiceStderrReturns(serviceStderr)
+
+				taskPlan.Config.Services = []atc.TaskServiceConfig{
+					{
+						Name: "my-db",
+						ImageResource: &atc.ImageResource{
+							Type:   "registry-image",
+							Source: atc.Source{"repository": "postgres"},
+						},
+						Env:   atc.TaskEnv{"POSTGRES_PASSWORD": "password"},
+						Ports: []uint16{5432},
+						Run: atc.TaskRunConfig{
+							Path: "postgres",
+							Args: []string{"-D", "/data"},
+							User: "postgres",
+						},
+						Readiness: &atc.TaskServiceReadiness{
+							Run:     atc.TaskRunConfig{Path: "pg_isready"},
+							Timeout: "30s",
+						},
+					},
+				}
+			})
+
+			It("adds the services to the container spec", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Services).To(HaveLen(1))
+
+				service := containerSpec.Services[0]
+				Expect(service.Name).To(Equal("my-db"))
+				Expect(service.EnvName).To(Equal("MY_DB"))
+				Expect(service.Owner).To(Equal(db.NewBuildStepContainerOwner(1234, planID+"/services/my-db", 123)))
+				Expect(service.Ports).To(Equal([]uint16{5432}))
+				Expect(service.ContainerSpec.Platform).To(Equal("some-platform"))
+				Expect(service.ContainerSpec.Tags).To(Equal([]string{"step", "tags"}))
+				Expect(service.ContainerSpec.TeamID).To(Equal(123))
+				Expect(service.ContainerSpec.User).To(Equal("postgres"))
+				Expect(service.ContainerSpec.Env).To(Equal([]string{"POSTGRES_PASSWORD=password"}))
+				Expect(service.ContainerSpec.ImageSpec.ImageResource).To(Equal(&worker.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "postgres"},
+				}))
+				Expect(service.Process.Path).To(Equal("postgres"))
+				Expect(service.Process.Args).To(Equal([]string{"-D", "/data"}))
+				Expect(service.Process.StdoutWriter).To(Equal(serviceStdout))
+				Expect(service.Process.StderrWriter).To(Equal(serviceStderr))
+				Expect(service.Readiness).To(Equal(&worker.ServiceReadiness{
+					Path:     "pg_isready",
+					Interval: atc.DefaultServiceReadinessInterval,
+					Timeout:  30 * time.Second,
+				}))
+			})
+
+			It("writes service output under the service's name", func() {
+				Expect(fakeDelegate.ServiceStdoutArgsForCall(0)).To(Equal("my-db"))
+				Expect(fakeDelegate.ServiceStderrArgsForCall(0)).To(Equal("my-db"))
+			})
+		})
+
 		Context("when running the task succeeds", func() {
 			var taskStepStatus int
 			BeforeEach(func() {
@@ -1042,6 +1131,19 @@ var _ = Describe("TaskStep", func() {
 					fakeClient.RunTaskStepReturns(taskResult, runTaskStepError)
 				})
 				outputsAreRegistered()
+
+				It("saves the output volumes as the result via the delegate", func() {
+					Expect(fakeDelegate.SaveResultCallCount()).To(Equal(1))
+					_, key, result := fakeDelegate.SaveResultArgsForCall(0)
+					Expect(key).To(Equal("task:some-task"))
+					Expect(result).To(Equal(atc.StepResult{
+						Outputs: map[string]string{
+							"some-output":                "some-handle-1",
+							"some-other-output":          "some-handle-2",
+							"some-trailing-slash-output": "some-handle-3",
+						},
+					}))
+				})
 			})
 
 			Context("when RunTaskStep returns a context Canceled error", func() {
@@ -1117,5 +1219,246 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the plan reuses the result of an earlier build", func() {
+			BeforeEach(func() {
+				taskPlan.Reuse = &atc.ReusedStep{
+					BuildID:   42,
+					BuildName: "7",
+					Result: atc.StepResult{
+						Outputs: map[string]string{"some-output": "reused-handle"},
+					},
+				}
+			})
+
+			Context("when the output volumes still exist", func() {
+				BeforeEach(func() {
+					fakeClient.FindVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+				})
+
+				It("registers the reused outputs without running the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+					Expect(taskStep.Succeeded()).To(BeTrue())
+
+					artifact, found := repo.ArtifactFor("some-output")
+					Expect(found).To(BeTrue())
+					Expect(artifact.ID()).To(Equal("reused-handle"))
+				})
+			})
+
+			Context("when an output volume is gone", func() {
+				BeforeEach(func() {
+					fakeClient.FindVolumeReturns(nil, false, nil)
+				})
+
+				It("runs the task", func() {
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the result is cached", func() {
+			var (
+				inputArtifact *runtimefakes.FakeArtifact
+				inputVolume   *workerfakes.FakeVolume
+				outputVolume  *workerfakes.FakeVolume
+			)
+
+			BeforeEach(func() {
+				stepMetadata.JobID = 12345
+
+				taskPlan.CacheResult = true
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					ImageResource: &atc.ImageResource{
+						Type:    "docker",
+						Source:  atc.Source{"some": "source"},
+						Version: atc.Version{"some": "version"},
+					},
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Inputs: []atc.TaskInputConfig{
+						{Name: "some-input"},
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "some-output"},
+					},
+				}
+
+				inputArtifact = new(runtimefakes.FakeArtifact)
+				inputArtifact.IDReturns("some-input-handle")
+				repo.RegisterArtifact("some-input", inputArtifact)
+
+				inputVolume = new(workerfakes.FakeVolume)
+				inputVolume.HandleReturns("some-input-handle")
+				inputVolume.GetResourceCacheIDReturns(42)
+
+				outputVolume = new(workerfakes.FakeVolume)
+				outputVolume.HandleReturns("some-output-handle")
+
+				fakeClient.FindVolumeStub = func(_ lager.Logger, _ int, handle string) (worker.Volume, bool, error) {
+					switch handle {
+					case "some-input-handle":
+						return inputVolume, true, nil
+					case "some-cached-output-handle":
+						return new(workerfakes.FakeVolume), true, nil
+					default:
+						return nil, false, nil
+					}
+				}
+
+				workerArtifact := new(dbfakes.FakeWorkerArtifact)
+				workerArtifact.IDReturns(7)
+				outputVolume.InitializeArtifactReturns(workerArtifact, nil)
+
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 1,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    outputVolume,
+							MountPath: "some-artifact-root/some-output",
+						},
+					},
+				}, nil)
+			})
+
+			Context("when there is no cached result", func() {
+				It("runs the task", func() {
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				})
+
+				It("saves the result", func() {
+					Expect(fakeTaskResultCacheFactory.SaveCallCount()).To(Equal(1))
+					jobID, stepName, key, buildID, exitStatus, outputs := fakeTaskResultCacheFactory.SaveArgsForCall(0)
+					Expect(jobID).To(Equal(12345))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(key).ToNot(BeEmpty())
+					Expect(buildID).To(Equal(1234))
+					Expect(exitStatus).To(Equal(1))
+					Expect(outputs).To(HaveLen(1))
+					Expect(outputs[0].Name).To(Equal("some-output"))
+					Expect(outputs[0].Key).ToNot(BeEmpty())
+					Expect(outputs[0].VolumeHandle).To(Equal("some-output-handle"))
+					Expect(outputs[0].WorkerArtifactID).To(Equal(7))
+
+					name, buildID := outputVolume.InitializeArtifactArgsForCall(0)
+					Expect(name).To(Equal("some-output"))
+					Expect(buildID).To(Equal(1234))
+				})
+
+				It("looks up the result by the same key it is saved under", func() {
+					_, _, findKey := fakeTaskResultCacheFactory.FindArgsForCall(0)
+					_, _, saveKey, _, _, _ := fakeTaskResultCacheFactory.SaveArgsForCall(0)
+					Expect(findKey).To(Equal(saveKey))
+				})
+			})
+
+			Context("when there is a cached result", func() {
+				BeforeEach(func() {
+					fakeTaskResultCacheFactory.FindReturns(db.TaskResultCache{
+						BuildID:    99,
+						BuildName:  "7",
+						ExitStatus: 0,
+						Outputs: []db.TaskResultCacheOutput{
+							{Name: "some-output", VolumeHandle: "some-cached-output-handle"},
+						},
+					}, true, nil)
+				})
+
+				It("does not run the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+
+				It("registers the cached outputs", func() {
+					artifact, found := repo.ArtifactFor("some-output")
+					Expect(found).To(BeTrue())
+					Expect(artifact.ID()).To(Equal("some-cached-output-handle"))
+				})
+
+				It("finishes with the cached exit status", func() {
+					Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					_, status := fakeDelegate.FinishedArgsForCall(0)
+					Expect(status).To(Equal(exec.ExitStatus(0)))
+					Expect(taskStep.Succeeded()).To(BeTrue())
+				})
+
+				It("reports the cache hit with the source build and key", func() {
+					Expect(fakeDelegate.ReusingCachedResultCallCount()).To(Equal(1))
+					_, key, cache := fakeDelegate.ReusingCachedResultArgsForCall(0)
+					_, _, findKey := fakeTaskResultCacheFactory.FindArgsForCall(0)
+					Expect(key).To(Equal(findKey))
+					Expect(cache.BuildID).To(Equal(99))
+					Expect(cache.BuildName).To(Equal("7"))
+				})
+
+				Context("when a cached output volume no longer exists", func() {
+					BeforeEach(func() {
+						fakeTaskResultCacheFactory.FindReturns(db.TaskResultCache{
+							Outputs: []db.TaskResultCacheOutput{
+								{Name: "some-output", VolumeHandle: "some-missing-handle"},
+							},
+						}, true, nil)
+					})
+
+					It("runs the task", func() {
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					})
+
+					It("does not report a cache hit", func() {
+						Expect(fakeDelegate.ReusingCachedResultCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when an input's contents are not known", func() {
+				BeforeEach(func() {
+					inputVolume.GetResourceCacheIDReturns(0)
+				})
+
+				It("explains why the result is not cached", func() {
+					Expect(stderrBuf).To(gbytes.Say("not using the task result cache because the contents of input 'some-input' are not known"))
+				})
+
+				It("runs the task without caching its result", func() {
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeTaskResultCacheFactory.FindCallCount()).To(BeZero())
+					Expect(fakeTaskResultCacheFactory.SaveCallCount()).To(BeZero())
+				})
+
+				Context("when the input was produced by a cached task", func() {
+					BeforeEach(func() {
+						fakeTaskResultCacheFactory.OutputKeyReturns("some-output-key", true, nil)
+					})
+
+					It("caches the result", func() {
+						Expect(fakeTaskResultCacheFactory.SaveCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the image_resource does not specify a version", func() {
+				BeforeEach(func() {
+					taskPlan.Config.ImageResource.Version = nil
+				})
+
+				It("does not cache the result", func() {
+					Expect(stderrBuf).To(gbytes.Say("its image_resource does not specify a version"))
+					Expect(fakeTaskResultCacheFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when running a one-off build", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				It("does not cache the result", func() {
+					Expect(fakeTaskResultCacheFactory.FindCallCount()).To(BeZero())
+					Expect(fakeTaskResultCacheFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 })
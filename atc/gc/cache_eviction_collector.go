@@ -0,0 +1,77 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type cacheEvictionCollector struct {
+	workerCacheLifecycle db.WorkerCacheLifecycle
+	highWaterMark        float64
+	batchSize            int
+}
+
+// NewCacheEvictionCollector returns a collector which evicts up to batchSize
+// of the least recently used caches from each worker whose volume store usage
+// is at or above the high-water mark, given as a fraction of its capacity.
+func NewCacheEvictionCollector(workerCacheLifecycle db.WorkerCacheLifecycle, highWaterMark float64, batchSize int) *cacheEvictionCollector {
+	return &cacheEvictionCollector{
+		workerCacheLifecycle: workerCacheLifecycle,
+		highWaterMark:        highWaterMark,
+		batchSize:            batchSize,
+	}
+}
+
+func (c *cacheEvictionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("cache-eviction-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.highWaterMark <= 0 || c.batchSize <= 0 {
+		return nil
+	}
+
+	workerNames, err := c.workerCacheLifecycle.WorkersAboveVolumeStoreUsage(c.highWaterMark)
+	if err != nil {
+		logger.Error("failed-to-find-workers-under-disk-pressure", err)
+		return err
+	}
+
+	for _, workerName := range workerNames {
+		evicted, err := c.workerCacheLifecycle.EvictLeastRecentlyUsedCaches(workerName, c.batchSize)
+		if err != nil {
+			logger.Error("failed-to-evict-caches", err, lager.Data{"worker": workerName})
+			continue
+		}
+
+		if evicted.ResourceCaches == 0 && evicted.TaskCaches == 0 {
+			logger.Info("no-caches-to-evict", lager.Data{"worker": workerName})
+			continue
+		}
+
+		logger.Info("evicted-caches", lager.Data{
+			"worker":          workerName,
+			"resource-caches": evicted.ResourceCaches,
+			"task-caches":     evicted.TaskCaches,
+		})
+
+		metric.CachesEvicted{
+			WorkerName: workerName,
+			CacheType:  "resource",
+			Caches:     evicted.ResourceCaches,
+		}.Emit(logger)
+
+		metric.CachesEvicted{
+			WorkerName: workerName,
+			CacheType:  "task",
+			Caches:     evicted.TaskCaches,
+		}.Emit(logger)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheEvictionCollector", func() {
+	var collector GcCollector
+	var fakeWorkerCacheLifecycle *dbfakes.FakeWorkerCacheLifecycle
+
+	BeforeEach(func() {
+		fakeWorkerCacheLifecycle = new(dbfakes.FakeWorkerCacheLifecycle)
+
+		collector = gc.NewCacheEvictionCollector(fakeWorkerCacheLifecycle, 0.9, 10)
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			fakeWorkerCacheLifecycle.WorkersAboveVolumeStoreUsageReturns([]string{"worker-1", "worker-2"}, nil)
+			fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesReturns(db.EvictedCaches{ResourceCaches: 3, TaskCaches: 1}, nil)
+		})
+
+		It("evicts caches from each worker above the high-water mark", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerCacheLifecycle.WorkersAboveVolumeStoreUsageCallCount()).To(Equal(1))
+			Expect(fakeWorkerCacheLifecycle.WorkersAboveVolumeStoreUsageArgsForCall(0)).To(Equal(0.9))
+
+			Expect(fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesCallCount()).To(Equal(2))
+
+			workerName, limit := fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesArgsForCall(0)
+			Expect(workerName).To(Equal("worker-1"))
+			Expect(limit).To(Equal(10))
+
+			workerName, limit = fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesArgsForCall(1)
+			Expect(workerName).To(Equal("worker-2"))
+			Expect(limit).To(Equal(10))
+		})
+
+		Context("when evicting from one worker fails", func() {
+			BeforeEach(func() {
+				fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesReturnsOnCall(0, db.EvictedCaches{}, errors.New("disaster"))
+			})
+
+			It("still evicts from the other workers", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when finding the workers fails", func() {
+			BeforeEach(func() {
+				fakeWorkerCacheLifecycle.WorkersAboveVolumeStoreUsageReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+
+		Context("when eviction is disabled", func() {
+			BeforeEach(func() {
+				collector = gc.NewCacheEvictionCollector(fakeWorkerCacheLifecycle, 0, 10)
+			})
+
+			It("does nothing", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeWorkerCacheLifecycle.WorkersAboveVolumeStoreUsageCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -51,10 +51,11 @@ var (
 	defaultJob      db.Job
 	defaultBuild    db.Build
 
-	usedResource     db.Resource
-	usedResourceType db.ResourceType
-	logger           *lagertest.TestLogger
-	fakeLogFunc      = func(logger lager.Logger, id lock.LockID) {}
+	usedResource       db.Resource
+	usedResourceType   db.ResourceType
+	logger             *lagertest.TestLogger
+	fakeLogFunc        = func(logger lager.Logger, id lock.LockID) {}
+	fakeReleaseLogFunc = func(logger lager.Logger, id lock.LockID, held time.Duration) {}
 )
 
 var _ = BeforeSuite(func() {
@@ -72,7 +73,7 @@ var _ = BeforeEach(func() {
 
 	dbConn = postgresRunner.OpenConn()
 
-	lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeLogFunc)
+	lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeReleaseLogFunc)
 
 	teamFactory = db.NewTeamFactory(dbConn, lockFactory)
 	buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0, time.Hour)
@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type hijackSessionCollector struct {
+	hijackSessionFactory db.HijackSessionFactory
+	retention            time.Duration
+}
+
+func NewHijackSessionCollector(hijackSessionFactory db.HijackSessionFactory, retention time.Duration) *hijackSessionCollector {
+	return &hijackSessionCollector{
+		hijackSessionFactory: hijackSessionFactory,
+		retention:            retention,
+	}
+}
+
+func (c *hijackSessionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("hijack-session-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.hijackSessionFactory.RemoveExpired(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-hijack-sessions", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-hijack-sessions", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HijackSessionCollector", func() {
+	var collector GcCollector
+	var fakeHijackSessionFactory *dbfakes.FakeHijackSessionFactory
+
+	BeforeEach(func() {
+		fakeHijackSessionFactory = new(dbfakes.FakeHijackSessionFactory)
+
+		collector = gc.NewHijackSessionCollector(fakeHijackSessionFactory, time.Hour*24)
+	})
+
+	Describe("Run", func() {
+		It("removes hijack sessions which ended before the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeHijackSessionFactory.RemoveExpiredCallCount()).To(Equal(1))
+			retention := fakeHijackSessionFactory.RemoveExpiredArgsForCall(0)
+			Expect(retention).To(Equal(time.Hour * 24))
+		})
+
+		Context("when removing them fails", func() {
+			BeforeEach(func() {
+				fakeHijackSessionFactory.RemoveExpiredReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
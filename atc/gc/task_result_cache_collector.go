@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type taskResultCacheCollector struct {
+	taskResultCacheFactory db.TaskResultCacheFactory
+	retention              time.Duration
+}
+
+func NewTaskResultCacheCollector(taskResultCacheFactory db.TaskResultCacheFactory, retention time.Duration) *taskResultCacheCollector {
+	return &taskResultCacheCollector{
+		taskResultCacheFactory: taskResultCacheFactory,
+		retention:              retention,
+	}
+}
+
+func (c *taskResultCacheCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("task-result-cache-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.taskResultCacheFactory.RemoveExpired(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-task-result-caches", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-task-result-caches", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskResultCacheCollector", func() {
+	var collector GcCollector
+	var fakeTaskResultCacheFactory *dbfakes.FakeTaskResultCacheFactory
+
+	BeforeEach(func() {
+		fakeTaskResultCacheFactory = new(dbfakes.FakeTaskResultCacheFactory)
+
+		collector = gc.NewTaskResultCacheCollector(fakeTaskResultCacheFactory, time.Hour*24)
+	})
+
+	Describe("Run", func() {
+		It("removes task result caches which have not been used within the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeTaskResultCacheFactory.RemoveExpiredCallCount()).To(Equal(1))
+			retention := fakeTaskResultCacheFactory.RemoveExpiredArgsForCall(0)
+			Expect(retention).To(Equal(time.Hour * 24))
+		})
+
+		Context("when removing them fails", func() {
+			BeforeEach(func() {
+				fakeTaskResultCacheFactory.RemoveExpiredReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package atc
+
+import "time"
+
+// HijackSession is a recorded `fly hijack` or `fly intercept` session. Its
+// recording is an asciicast (v2) of the session's input and output, available
+// separately.
+type HijackSession struct {
+	ID       int    `json:"id"`
+	TeamName string `json:"team_name"`
+	User     string `json:"user"`
+
+	Container HijackSessionContainer `json:"container"`
+
+	Path string   `json:"path"`
+	Args []string `json:"args,omitempty"`
+
+	StartTime  int64 `json:"start_time"`
+	EndTime    int64 `json:"end_time,omitempty"`
+	ExitStatus *int  `json:"exit_status,omitempty"`
+}
+
+// HijackSessionContainer describes the container a session was run in at the
+// time of the session, as the container itself may be gone by the time the
+// session is looked at.
+type HijackSessionContainer struct {
+	Handle     string `json:"handle"`
+	WorkerName string `json:"worker_name,omitempty"`
+	Type       string `json:"type,omitempty"`
+
+	PipelineName string `json:"pipeline_name,omitempty"`
+	JobName      string `json:"job_name,omitempty"`
+	BuildID      int    `json:"build_id,omitempty"`
+	BuildName    string `json:"build_name,omitempty"`
+	StepName     string `json:"step_name,omitempty"`
+	Attempt      string `json:"attempt,omitempty"`
+}
+
+// Duration returns how long the session lasted, or how long it has been
+// running if it has not ended yet.
+func (session HijackSession) Duration(now time.Time) time.Duration {
+	end := now
+	if session.EndTime != 0 {
+		end = time.Unix(session.EndTime, 0)
+	}
+
+	return end.Sub(time.Unix(session.StartTime, 0))
+}
@@ -26,6 +26,7 @@ type JobInput struct {
 	Trigger  bool           `json:"trigger"`
 	Passed   []string       `json:"passed,omitempty"`
 	Version  *VersionConfig `json:"version,omitempty"`
+	Filter   *VersionFilter `json:"filter,omitempty"`
 }
 
 type JobInputParams struct {
@@ -14,6 +14,10 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	// BuildTimeout limits the duration of each build of the job, including
+	// the time spent waiting for inputs and workers.
+	BuildTimeout string `json:"build_timeout,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -95,6 +99,7 @@ func (config JobConfig) Inputs() []JobInputParams {
 					Resource: step.ResourceName(),
 					Passed:   step.Passed,
 					Version:  step.Version,
+					Filter:   step.Filter,
 					Trigger:  step.Trigger,
 				},
 				Params: step.Params,
@@ -0,0 +1,34 @@
+package atc
+
+// JobStats summarizes the builds of a job which finished within a period of
+// time. Durations are in seconds.
+type JobStats struct {
+	JobName string `json:"job_name"`
+
+	Builds    int `json:"builds"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Errored   int `json:"errored"`
+	Aborted   int `json:"aborted"`
+
+	// SuccessRate is the ratio of succeeded builds to builds which did not
+	// abort, or 0 if there are none.
+	SuccessRate float64 `json:"success_rate"`
+
+	DurationP50 float64 `json:"duration_p50"`
+	DurationP95 float64 `json:"duration_p95"`
+
+	// MeanTimeToRecovery is the mean time from the first build to fail after
+	// a success to the next build to succeed, over Recoveries recoveries.
+	MeanTimeToRecovery float64 `json:"mean_time_to_recovery"`
+	Recoveries         int     `json:"recoveries"`
+
+	Triggers JobTriggerStats `json:"triggers"`
+}
+
+// JobTriggerStats counts how builds of a job were triggered.
+type JobTriggerStats struct {
+	Scheduled int `json:"scheduled"`
+	Manual    int `json:"manual"`
+	Rerun     int `json:"rerun"`
+}
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/metric"
@@ -24,6 +25,7 @@ func NewChecker(
 	checkFactory db.CheckFactory,
 	engine engine.Engine,
 	checkRateCalculator RateCalculator,
+	teamQuotaFactory db.TeamQuotaFactory,
 ) *checker {
 	return &checker{
 		logger:              logger,
@@ -31,6 +33,7 @@ func NewChecker(
 		engine:              engine,
 		running:             &sync.Map{},
 		checkRateCalculator: checkRateCalculator,
+		teamQuotaFactory:    teamQuotaFactory,
 	}
 }
 
@@ -40,6 +43,7 @@ type checker struct {
 	checkFactory        db.CheckFactory
 	engine              engine.Engine
 	checkRateCalculator RateCalculator
+	teamQuotaFactory    db.TeamQuotaFactory
 
 	running *sync.Map
 }
@@ -65,10 +69,26 @@ func (c *checker) Run(ctx context.Context) error {
 		return err
 	}
 
+	teamQuotas := map[int]atc.TeamQuotas{}
+
 	for _, ck := range checks {
 		if _, exists := c.running.LoadOrStore(ck.ID(), true); !exists {
 			if !ck.ManuallyTriggered() {
-				err := limiter.Wait(ctx)
+				allowed, err := c.allowedByTeamQuota(ck, teamQuotas)
+				if err != nil {
+					c.logger.Error("failed-to-check-team-quota", err)
+					c.running.Delete(ck.ID())
+					continue
+				}
+
+				if !allowed {
+					// leave the check pending; it will be run by a later Run once
+					// the team is back within its quota
+					c.running.Delete(ck.ID())
+					continue
+				}
+
+				err = limiter.Wait(ctx)
 				if err != nil {
 					c.logger.Error("failed-to-wait-for-limiter", err)
 					continue
@@ -104,3 +124,41 @@ func (c *checker) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// allowedByTeamQuota returns whether the check's team may run another check
+// without exceeding its checks per minute quota, marking the check as started
+// if so. Checks are counted in the database, so that the quota holds across
+// every ATC. Quotas are cached in teamQuotas for the duration of a Run.
+func (c *checker) allowedByTeamQuota(check db.Check, teamQuotas map[int]atc.TeamQuotas) (bool, error) {
+	quotas, found := teamQuotas[check.TeamID()]
+	if !found {
+		var err error
+		quotas, err = c.teamQuotaFactory.Quotas(check.TeamID())
+		if err != nil {
+			return false, err
+		}
+
+		teamQuotas[check.TeamID()] = quotas
+	}
+
+	if quotas.MaxChecksPerMinute == 0 {
+		return true, nil
+	}
+
+	started, err := c.teamQuotaFactory.TryStartCheck(check.TeamID(), check.ID())
+	if err != nil {
+		return false, err
+	}
+
+	if !started {
+		c.logger.Debug("team-quota-exceeded", lager.Data{
+			"team":  check.TeamName(),
+			"quota": atc.QuotaChecksPerMinute,
+			"limit": quotas.MaxChecksPerMinute,
+		})
+
+		return false, nil
+	}
+
+	return true, nil
+}
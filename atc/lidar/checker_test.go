@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
@@ -33,6 +34,8 @@ var _ = Describe("Checker", func() {
 		fakeRateCalculator *lidarfakes.FakeRateCalculator
 		fakeLimiter        *lidarfakes.FakeLimiter
 
+		fakeTeamQuotaFactory *dbfakes.FakeTeamQuotaFactory
+
 		checker Checker
 		logger  *lagertest.TestLogger
 	)
@@ -42,6 +45,7 @@ var _ = Describe("Checker", func() {
 		fakeEngine = new(enginefakes.FakeEngine)
 		fakeRateCalculator = new(lidarfakes.FakeRateCalculator)
 		fakeLimiter = new(lidarfakes.FakeLimiter)
+		fakeTeamQuotaFactory = new(dbfakes.FakeTeamQuotaFactory)
 
 		logger = lagertest.NewTestLogger("test")
 	})
@@ -52,6 +56,7 @@ var _ = Describe("Checker", func() {
 			fakeCheckFactory,
 			fakeEngine,
 			fakeRateCalculator,
+			fakeTeamQuotaFactory,
 		)
 
 		err = checker.Run(context.TODO())
@@ -161,6 +166,89 @@ var _ = Describe("Checker", func() {
 				})
 			})
 
+			Context("when the team has a checks per minute quota", func() {
+				BeforeEach(func() {
+					fakeLimiter.WaitReturns(nil)
+					fakeRateCalculator.RateLimiterReturns(fakeLimiter, nil)
+
+					fakeCheck1.TeamIDReturns(4)
+					fakeCheck2.TeamIDReturns(4)
+					fakeCheck3.TeamIDReturns(4)
+
+					fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{MaxChecksPerMinute: 2}, nil)
+					fakeTeamQuotaFactory.TryStartCheckReturnsOnCall(0, true, nil)
+					fakeTeamQuotaFactory.TryStartCheckReturnsOnCall(1, true, nil)
+					fakeTeamQuotaFactory.TryStartCheckReturnsOnCall(2, false, nil)
+				})
+
+				It("only runs the checks which the quota allows to start", func() {
+					Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+					Consistently(fakeEngine.NewCheckCallCount).Should(Equal(2))
+				})
+
+				It("starts the checks against the team's quota", func() {
+					Expect(fakeTeamQuotaFactory.TryStartCheckCallCount()).To(Equal(3))
+
+					teamID, checkID := fakeTeamQuotaFactory.TryStartCheckArgsForCall(0)
+					Expect(teamID).To(Equal(4))
+					Expect(checkID).To(Equal(fakeCheck1.ID()))
+				})
+
+				It("looks up the team's quotas once", func() {
+					Expect(fakeTeamQuotaFactory.QuotasCallCount()).To(Equal(1))
+					Expect(fakeTeamQuotaFactory.QuotasArgsForCall(0)).To(Equal(4))
+				})
+
+				Context("when a check is manually triggered", func() {
+					BeforeEach(func() {
+						fakeCheck3.ManuallyTriggeredReturns(true)
+						fakeTeamQuotaFactory.TryStartCheckReturnsOnCall(1, false, nil)
+					})
+
+					It("runs it regardless of the quota", func() {
+						Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+						Expect(fakeTeamQuotaFactory.TryStartCheckCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("when starting a check against the quota fails", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.TryStartCheckReturnsOnCall(0, false, errors.New("nope"))
+					})
+
+					It("does not run that check", func() {
+						Eventually(fakeEngine.NewCheckCallCount).Should(Equal(1))
+						Consistently(fakeEngine.NewCheckCallCount).Should(Equal(1))
+					})
+				})
+			})
+
+			Context("when the team has no checks per minute quota", func() {
+				BeforeEach(func() {
+					fakeLimiter.WaitReturns(nil)
+					fakeRateCalculator.RateLimiterReturns(fakeLimiter, nil)
+				})
+
+				It("does not count the checks against a quota", func() {
+					Eventually(fakeEngine.NewCheckCallCount).Should(Equal(3))
+					Expect(fakeTeamQuotaFactory.TryStartCheckCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when looking up the team's quotas fails", func() {
+				BeforeEach(func() {
+					fakeLimiter.WaitReturns(nil)
+					fakeRateCalculator.RateLimiterReturns(fakeLimiter, nil)
+
+					fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{}, errors.New("nope"))
+				})
+
+				It("does not run the checks", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Consistently(fakeEngine.NewCheckCallCount).Should(BeZero())
+				})
+			})
+
 			Context("when calculating the rate limit fails", func() {
 				BeforeEach(func() {
 					fakeRateCalculator.RateLimiterReturns(nil, errors.New("disaster"))
@@ -0,0 +1,96 @@
+package atc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type LintSeverity string
+
+const (
+	LintSeverityError   LintSeverity = "error"
+	LintSeverityWarning LintSeverity = "warning"
+	LintSeverityInfo    LintSeverity = "info"
+	LintSeverityOff     LintSeverity = "off"
+)
+
+func (severity LintSeverity) Validate() error {
+	switch severity {
+	case LintSeverityError, LintSeverityWarning, LintSeverityInfo, LintSeverityOff:
+		return nil
+	default:
+		return fmt.Errorf("unknown lint severity '%s' (must be one of error, warning, info, off)", severity)
+	}
+}
+
+// LintRules configures the severity of pipeline lint rules by rule ID,
+// overriding their default severity.
+type LintRules map[string]LintSeverity
+
+// Merge returns the rules with the overrides applied on top.
+func (rules LintRules) Merge(overrides LintRules) LintRules {
+	merged := LintRules{}
+	for id, severity := range rules {
+		merged[id] = severity
+	}
+
+	for id, severity := range overrides {
+		merged[id] = severity
+	}
+
+	return merged
+}
+
+// ParseLintRules parses a list of RULE=SEVERITY pairs.
+func ParseLintRules(pairs []string) (LintRules, error) {
+	rules := LintRules{}
+	for _, pair := range pairs {
+		segs := strings.SplitN(pair, "=", 2)
+		if len(segs) != 2 {
+			return nil, fmt.Errorf("invalid lint rule '%s' (must be RULE=SEVERITY)", pair)
+		}
+
+		rules[segs[0]] = LintSeverity(segs[1])
+	}
+
+	return rules, nil
+}
+
+// UnmarshalFlag parses a single RULE=SEVERITY pair, adding it to the rules,
+// so that the flag can be specified multiple times.
+func (rules *LintRules) UnmarshalFlag(value string) error {
+	parsed, err := ParseLintRules([]string{value})
+	if err != nil {
+		return err
+	}
+
+	*rules = rules.Merge(parsed)
+
+	return nil
+}
+
+func (rules LintRules) String() string {
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	pairs := make([]string, len(ids))
+	for i, id := range ids {
+		pairs[i] = fmt.Sprintf("%s=%s", id, rules[id])
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+// LintFinding is a problem found in a pipeline config by a lint rule.
+type LintFinding struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Location string       `json:"location"`
+	Message  string       `json:"message"`
+	Hint     string       `json:"hint,omitempty"`
+}
@@ -0,0 +1,110 @@
+package atc
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// LockPool is a team-scoped pool of named locks which builds acquire and
+// release via the `acquire:` and `release:` step modifiers. A pool with a size
+// of 1 acts as a mutex.
+type LockPool struct {
+	Name     string           `json:"name"`
+	TeamName string           `json:"team_name"`
+	Size     int              `json:"size"`
+	Holders  []LockPoolHolder `json:"holders"`
+}
+
+// LockPoolHolder is a build currently holding a lock in a pool.
+type LockPoolHolder struct {
+	BuildID      int    `json:"build_id"`
+	BuildName    string `json:"build_name"`
+	JobName      string `json:"job_name,omitempty"`
+	PipelineName string `json:"pipeline_name,omitempty"`
+	TeamName     string `json:"team_name"`
+	AcquiredAt   int64  `json:"acquired_at"`
+}
+
+// Duration returns how long the holder has held the lock.
+func (holder LockPoolHolder) Duration(now time.Time) time.Duration {
+	return now.Sub(time.Unix(holder.AcquiredAt, 0))
+}
+
+// LockConfig names a lock pool to acquire or release. It may be configured
+// either as a plain name, which refers to a mutex, or as an object specifying
+// the size of the pool.
+type LockConfig struct {
+	Name string `json:"name"`
+	Size int    `json:"size,omitempty"`
+}
+
+// PoolSize returns the configured size of the pool, defaulting to 1.
+func (config LockConfig) PoolSize() int {
+	if config.Size == 0 {
+		return 1
+	}
+
+	return config.Size
+}
+
+func (config *LockConfig) UnmarshalJSON(payload []byte) error {
+	var name string
+	if err := json.Unmarshal(payload, &name); err == nil {
+		config.Name = name
+		return nil
+	}
+
+	// Used to avoid infinite recursion when unmarshalling this variant.
+	type target LockConfig
+
+	var t target
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return errors.New("lock must be a name or an object with a name and size")
+	}
+
+	*config = LockConfig(t)
+
+	return nil
+}
+
+func (config LockConfig) MarshalJSON() ([]byte, error) {
+	if config.Size == 0 {
+		return json.Marshal(config.Name)
+	}
+
+	type target LockConfig
+
+	return json.Marshal(target(config))
+}
+
+// LockConfigs is a list of locks, which may also be configured as a single
+// lock.
+type LockConfigs []LockConfig
+
+func (configs *LockConfigs) UnmarshalJSON(payload []byte) error {
+	var list []LockConfig
+	if err := json.Unmarshal(payload, &list); err == nil {
+		*configs = list
+		return nil
+	}
+
+	var single LockConfig
+	if err := json.Unmarshal(payload, &single); err != nil {
+		return err
+	}
+
+	*configs = LockConfigs{single}
+
+	return nil
+}
+
+// Names returns the names of the configured locks.
+func (configs LockConfigs) Names() []string {
+	names := make([]string, len(configs))
+	for i, config := range configs {
+		names[i] = config.Name
+	}
+
+	return names
+}
@@ -0,0 +1,19 @@
+package atc
+
+// Maintenance describes the cluster's maintenance mode. While it is enabled no
+// new builds are scheduled or started and no resources are checked; builds
+// which are already running are left to finish.
+type Maintenance struct {
+	Enabled   bool   `json:"enabled"`
+	Message   string `json:"message,omitempty"`
+	StartTime int64  `json:"start_time,omitempty"`
+
+	RunningBuilds int `json:"running_builds"`
+	PendingBuilds int `json:"pending_builds"`
+}
+
+// Drained reports whether every build that was running when maintenance
+// began has finished.
+func (maintenance Maintenance) Drained() bool {
+	return maintenance.RunningBuilds == 0
+}
@@ -37,6 +37,16 @@ type PrometheusEmitter struct {
 	buildsFinishedVec *prometheus.CounterVec
 	buildsSucceeded   prometheus.Counter
 
+	jobSuccessRate        *prometheus.GaugeVec
+	jobDurationP50        *prometheus.GaugeVec
+	jobDurationP95        *prometheus.GaugeVec
+	jobMeanTimeToRecovery *prometheus.GaugeVec
+
+	teamRunningBuilds *prometheus.GaugeVec
+	teamContainers    *prometheus.GaugeVec
+	teamVolumes       *prometheus.GaugeVec
+	teamQuota         *prometheus.GaugeVec
+
 	dbConnections  *prometheus.GaugeVec
 	dbQueriesTotal prometheus.Counter
 
@@ -44,7 +54,8 @@ type PrometheusEmitter struct {
 
 	httpRequestsDuration *prometheus.HistogramVec
 
-	locksHeld *prometheus.GaugeVec
+	locksHeld         *prometheus.GaugeVec
+	lockHoldDurations *prometheus.HistogramVec
 
 	checksFinished  *prometheus.CounterVec
 	checksQueueSize prometheus.Gauge
@@ -58,6 +69,8 @@ type PrometheusEmitter struct {
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
+	cachesEvicted *prometheus.CounterVec
+
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
@@ -122,6 +135,15 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	}, []string{"type"})
 	prometheus.MustRegister(locksHeld)
 
+	lockHoldDurations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "concourse",
+		Subsystem: "locks",
+		Name:      "hold_duration_seconds",
+		Help:      "How long database locks were held before being released",
+		Buckets:   []float64{0.01, 0.1, 1, 10, 60, 300, 900, 3600},
+	}, []string{"type"})
+	prometheus.MustRegister(lockHoldDurations)
+
 	// job metrics
 	jobsScheduled := prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "concourse",
@@ -242,6 +264,96 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	// job stats metrics
+	jobSuccessRate := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "success_rate",
+			Help:      "Ratio of succeeded builds to builds which did not abort, over the job stats period.",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobSuccessRate)
+
+	jobDurationP50 := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "duration_p50_seconds",
+			Help:      "Median build duration in seconds, over the job stats period.",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobDurationP50)
+
+	jobDurationP95 := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "duration_p95_seconds",
+			Help:      "95th percentile build duration in seconds, over the job stats period.",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobDurationP95)
+
+	jobMeanTimeToRecovery := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "mean_time_to_recovery_seconds",
+			Help:      "Mean time in seconds from a failing build to the next succeeded build, over the job stats period.",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobMeanTimeToRecovery)
+
+	// team usage metrics
+	teamRunningBuilds := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "running_builds",
+			Help:      "Number of builds the team has scheduled or running.",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamRunningBuilds)
+
+	teamContainers := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "containers",
+			Help:      "Number of containers the team has across all workers.",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamContainers)
+
+	teamVolumes := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "volumes",
+			Help:      "Number of volumes the team has across all workers.",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamVolumes)
+
+	teamQuota := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "quota",
+			Help:      "Limit of each quota set on the team.",
+		},
+		[]string{"team", "quota"},
+	)
+	prometheus.MustRegister(teamQuota)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -351,6 +463,17 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checksFinished)
 
+	cachesEvicted := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "caches_evicted_total",
+			Help:      "Total number of caches evicted from workers under disk pressure",
+		},
+		[]string{"worker", "type"},
+	)
+	prometheus.MustRegister(cachesEvicted)
+
 	checksQueueSize := prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -408,6 +531,16 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsFinishedVec: buildsFinishedVec,
 		buildsSucceeded:   buildsSucceeded,
 
+		jobSuccessRate:        jobSuccessRate,
+		jobDurationP50:        jobDurationP50,
+		jobDurationP95:        jobDurationP95,
+		jobMeanTimeToRecovery: jobMeanTimeToRecovery,
+
+		teamRunningBuilds: teamRunningBuilds,
+		teamContainers:    teamContainers,
+		teamVolumes:       teamVolumes,
+		teamQuota:         teamQuota,
+
 		dbConnections:  dbConnections,
 		dbQueriesTotal: dbQueriesTotal,
 
@@ -415,7 +548,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		httpRequestsDuration: httpRequestsDuration,
 
-		locksHeld: locksHeld,
+		locksHeld:         locksHeld,
+		lockHoldDurations: lockHoldDurations,
 
 		checksFinished:  checksFinished,
 		checksQueueSize: checksQueueSize,
@@ -432,6 +566,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		workerTasks:             workerTasks,
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
+
+		cachesEvicted: cachesEvicted,
 	}
 	go emitter.periodicMetricGC()
 
@@ -452,6 +588,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.errorLogsMetric(logger, event)
 	case "lock held":
 		emitter.lock(logger, event)
+	case "lock hold duration":
+		emitter.lockHoldDuration(logger, event)
 	case "jobs scheduled":
 		emitter.jobsScheduled.Add(event.Value)
 	case "jobs scheduling":
@@ -468,6 +606,22 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.tasksWaiting.Set(event.Value)
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
+	case "job success rate":
+		emitter.jobStatsMetric(logger, emitter.jobSuccessRate, event)
+	case "job duration p50":
+		emitter.jobStatsMetric(logger, emitter.jobDurationP50, event)
+	case "job duration p95":
+		emitter.jobStatsMetric(logger, emitter.jobDurationP95, event)
+	case "job mean time to recovery":
+		emitter.jobStatsMetric(logger, emitter.jobMeanTimeToRecovery, event)
+	case "team running builds":
+		emitter.teamUsageMetric(logger, emitter.teamRunningBuilds, event)
+	case "team containers":
+		emitter.teamUsageMetric(logger, emitter.teamContainers, event)
+	case "team volumes":
+		emitter.teamUsageMetric(logger, emitter.teamVolumes, event)
+	case "team quota":
+		emitter.teamQuotaMetric(logger, event)
 	case "worker containers":
 		emitter.workerContainersMetric(logger, event)
 	case "worker volumes":
@@ -494,6 +648,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksEnqueued.Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "caches evicted":
+		emitter.cachesEvicted.WithLabelValues(event.Attributes["worker"], event.Attributes["type"]).Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -513,6 +669,16 @@ func (emitter *PrometheusEmitter) lock(logger lager.Logger, event metric.Event)
 	}
 }
 
+func (emitter *PrometheusEmitter) lockHoldDuration(logger lager.Logger, event metric.Event) {
+	lockType, exists := event.Attributes["type"]
+	if !exists {
+		logger.Error("failed-to-find-type-in-event", fmt.Errorf("expected type to exist in event.Attributes"))
+		return
+	}
+
+	emitter.lockHoldDurations.WithLabelValues(lockType).Observe(event.Value / 1000)
+}
+
 func (emitter *PrometheusEmitter) errorLogsMetric(logger lager.Logger, event metric.Event) {
 	message, exists := event.Attributes["message"]
 	if !exists {
@@ -575,6 +741,54 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline, job).Observe(duration)
 }
 
+func (emitter *PrometheusEmitter) jobStatsMetric(logger lager.Logger, gauge *prometheus.GaugeVec, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	gauge.WithLabelValues(team, pipeline, job).Set(event.Value)
+}
+
+func (emitter *PrometheusEmitter) teamUsageMetric(logger lager.Logger, gauge *prometheus.GaugeVec, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	gauge.WithLabelValues(team).Set(event.Value)
+}
+
+func (emitter *PrometheusEmitter) teamQuotaMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	quota, exists := event.Attributes["quota"]
+	if !exists {
+		logger.Error("failed-to-find-quota-in-event", fmt.Errorf("expected quota to exist in event.Attributes"))
+		return
+	}
+
+	emitter.teamQuota.WithLabelValues(team, quota).Set(event.Value)
+}
+
 func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, event metric.Event) {
 	worker, exists := event.Attributes["worker"]
 	if !exists {
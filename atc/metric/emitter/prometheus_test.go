@@ -192,18 +192,58 @@ var _ = Describe("PrometheusEmitter", func() {
 		prometheusEmitter, err = prometheusConfig.NewEmitter()
 	})
 
-	It("emits task waiting metric", func() {
+	It("emits task waiting, job stats, team usage and lock hold duration metrics", func() {
 		prometheusEmitter.Emit(logger, metric.Event{
 			Name:  "tasks waiting",
 			Value: 4,
 		})
 
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "job success rate",
+			Value: 0.75,
+			Attributes: map[string]string{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+			},
+		})
+
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "team containers",
+			Value: 12,
+			Attributes: map[string]string{
+				"team_name": "some-team",
+			},
+		})
+
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "team quota",
+			Value: 20,
+			Attributes: map[string]string{
+				"team_name": "some-team",
+				"quota":     "containers",
+			},
+		})
+
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "lock hold duration",
+			Value: 2500,
+			Attributes: map[string]string{
+				"type": "JobScheduling",
+			},
+		})
+
 		res, _ := http.Get(fmt.Sprintf("http://%s:%s/metrics", prometheusConfig.BindIP, prometheusConfig.BindPort))
 		defer res.Body.Close()
 		body, _ := ioutil.ReadAll(res.Body)
 
 		Expect(res.StatusCode).To(Equal(http.StatusOK))
 		Expect(string(body)).To(ContainSubstring("concourse_tasks_waiting 4"))
+		Expect(string(body)).To(ContainSubstring(`concourse_jobs_success_rate{job="some-job",pipeline="some-pipeline",team="some-team"} 0.75`))
+		Expect(string(body)).To(ContainSubstring(`concourse_teams_containers{team="some-team"} 12`))
+		Expect(string(body)).To(ContainSubstring(`concourse_teams_quota{quota="containers",team="some-team"} 20`))
+		Expect(string(body)).To(ContainSubstring(`concourse_locks_hold_duration_seconds_bucket{type="JobScheduling",le="10"} 1`))
+		Expect(string(body)).To(ContainSubstring(`concourse_locks_hold_duration_seconds_sum{type="JobScheduling"} 2.5`))
 		Expect(err).To(BeNil())
 	})
 })
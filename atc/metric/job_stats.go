@@ -0,0 +1,63 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// JobStatsCollector periodically emits the stats of every job, summarizing
+// the builds which finished within the given period.
+type JobStatsCollector struct {
+	pipelineFactory db.PipelineFactory
+	period          time.Duration
+}
+
+func NewJobStatsCollector(pipelineFactory db.PipelineFactory, period time.Duration) *JobStatsCollector {
+	return &JobStatsCollector{
+		pipelineFactory: pipelineFactory,
+		period:          period,
+	}
+}
+
+func (collector *JobStatsCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("job-stats")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := collector.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	since := time.Now().Add(-collector.period)
+
+	for _, pipeline := range pipelines {
+		if pipeline.Archived() {
+			continue
+		}
+
+		stats, err := pipeline.JobStats(since)
+		if err != nil {
+			logger.Error("failed-to-get-job-stats", err, lager.Data{
+				"pipeline": pipeline.Name(),
+			})
+			continue
+		}
+
+		for _, jobStats := range stats {
+			JobStats{
+				TeamName:     pipeline.TeamName(),
+				PipelineName: pipeline.Name(),
+				Stats:        jobStats,
+			}.Emit(logger)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package metric_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobStatsCollector", func() {
+	var (
+		emitter         *smartFakeEmitter
+		pipelineFactory *dbfakes.FakePipelineFactory
+		pipeline        *dbfakes.FakePipeline
+		archived        *dbfakes.FakePipeline
+		collector       *metric.JobStatsCollector
+		runErr          error
+	)
+
+	BeforeEach(func() {
+		emitter = registerFakeEmitterInUnsafeGlobalMap()
+
+		pipeline = new(dbfakes.FakePipeline)
+		pipeline.NameReturns("some-pipeline")
+		pipeline.TeamNameReturns("some-team")
+		pipeline.JobStatsReturns([]atc.JobStats{
+			{
+				JobName:            "some-job",
+				SuccessRate:        0.75,
+				DurationP50:        60,
+				DurationP95:        90,
+				MeanTimeToRecovery: 300,
+			},
+		}, nil)
+
+		archived = new(dbfakes.FakePipeline)
+		archived.ArchivedReturns(true)
+
+		pipelineFactory = new(dbfakes.FakePipelineFactory)
+		pipelineFactory.AllPipelinesReturns([]db.Pipeline{pipeline, archived}, nil)
+
+		collector = metric.NewJobStatsCollector(pipelineFactory, 24*time.Hour)
+	})
+
+	AfterEach(func() {
+		metric.Deinitialize(testLogger)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(lagerctx.NewContext(context.Background(), testLogger))
+	})
+
+	It("summarizes the builds within the period", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(pipeline.JobStatsCallCount()).To(Equal(1))
+		Expect(pipeline.JobStatsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+
+	It("skips archived pipelines", func() {
+		Expect(archived.JobStatsCallCount()).To(BeZero())
+	})
+
+	It("emits the stats of each job", func() {
+		Eventually(emitter.EmitCallCount).Should(Equal(4))
+
+		values := map[string]float64{}
+		for i := 0; i < emitter.EmitCallCount(); i++ {
+			_, event := emitter.EmitArgsForCall(i)
+			Expect(event.Attributes).To(Equal(map[string]string{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+			}))
+			values[event.Name] = event.Value
+		}
+
+		Expect(values).To(Equal(map[string]float64{
+			"job success rate":          0.75,
+			"job duration p50":          60,
+			"job duration p95":          90,
+			"job mean time to recovery": 300,
+		}))
+	})
+
+	Context("when getting the pipelines fails", func() {
+		BeforeEach(func() {
+			pipelineFactory.AllPipelinesReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
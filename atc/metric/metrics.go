@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -293,6 +294,26 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type CachesEvicted struct {
+	WorkerName string
+	CacheType  string
+	Caches     int
+}
+
+func (event CachesEvicted) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("caches-evicted"),
+		Event{
+			Name:  "caches evicted",
+			Value: float64(event.Caches),
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+				"type":   event.CacheType,
+			},
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
@@ -529,18 +550,6 @@ func (event HTTPResponseTime) Emit(logger lager.Logger) {
 	)
 }
 
-var lockTypeNames = map[int]string{
-	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
-	lock.LockTypeBuildTracking:          "BuildTracking",
-	lock.LockTypeJobScheduling:          "JobScheduling",
-	lock.LockTypeBatch:                  "Batch",
-	lock.LockTypeVolumeCreating:         "VolumeCreating",
-	lock.LockTypeContainerCreating:      "ContainerCreating",
-	lock.LockTypeDatabaseMigration:      "DatabaseMigration",
-	lock.LockTypeActiveTasks:            "ActiveTasks",
-	lock.LockTypeResourceScanning:       "ResourceScanning",
-}
-
 type LockAcquired struct {
 	LockType string
 }
@@ -575,6 +584,24 @@ func (event LockReleased) Emit(logger lager.Logger) {
 	)
 }
 
+type LockHoldDuration struct {
+	LockType string
+	Duration time.Duration
+}
+
+func (event LockHoldDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("lock-hold-duration"),
+		Event{
+			Name:  "lock hold duration",
+			Value: ms(event.Duration),
+			Attributes: map[string]string{
+				"type": event.LockType,
+			},
+		},
+	)
+}
+
 func LogLockAcquired(logger lager.Logger, lockID lock.LockID) {
 	logger.Debug("acquired")
 
@@ -582,20 +609,21 @@ func LogLockAcquired(logger lager.Logger, lockID lock.LockID) {
 		return
 	}
 
-	if lockType, ok := lockTypeNames[lockID[0]]; ok {
+	if lockType, ok := lock.LockTypeNames[lockID[0]]; ok {
 		LockAcquired{LockType: lockType}.Emit(logger)
 	}
 }
 
-func LogLockReleased(logger lager.Logger, lockID lock.LockID) {
-	logger.Debug("released")
+func LogLockReleased(logger lager.Logger, lockID lock.LockID, held time.Duration) {
+	logger.Debug("released", lager.Data{"held": held.String()})
 
 	if len(lockID) == 0 {
 		return
 	}
 
-	if lockType, ok := lockTypeNames[lockID[0]]; ok {
+	if lockType, ok := lock.LockTypeNames[lockID[0]]; ok {
 		LockReleased{LockType: lockType}.Emit(logger)
+		LockHoldDuration{LockType: lockType, Duration: held}.Emit(logger)
 	}
 }
 
@@ -624,3 +652,119 @@ func (event WorkersState) Emit(logger lager.Logger) {
 		)
 	}
 }
+
+type JobStats struct {
+	TeamName     string
+	PipelineName string
+	Stats        atc.JobStats
+}
+
+func (event JobStats) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"team_name": event.TeamName,
+		"pipeline":  event.PipelineName,
+		"job":       event.Stats.JobName,
+	}
+
+	emit(
+		logger.Session("job-success-rate"),
+		Event{
+			Name:       "job success rate",
+			Value:      event.Stats.SuccessRate,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("job-duration-p50"),
+		Event{
+			Name:       "job duration p50",
+			Value:      event.Stats.DurationP50,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("job-duration-p95"),
+		Event{
+			Name:       "job duration p95",
+			Value:      event.Stats.DurationP95,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("job-mean-time-to-recovery"),
+		Event{
+			Name:       "job mean time to recovery",
+			Value:      event.Stats.MeanTimeToRecovery,
+			Attributes: attributes,
+		},
+	)
+}
+
+// TeamUsage is how much of the resources limited by team quotas a team is
+// using, along with its quotas.
+type TeamUsage struct {
+	TeamName string
+	Quotas   atc.TeamQuotas
+	Usage    atc.TeamUsage
+}
+
+func (event TeamUsage) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"team_name": event.TeamName,
+	}
+
+	emit(
+		logger.Session("team-running-builds"),
+		Event{
+			Name:       "team running builds",
+			Value:      float64(event.Usage.RunningBuilds),
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("team-containers"),
+		Event{
+			Name:       "team containers",
+			Value:      float64(event.Usage.Containers),
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("team-volumes"),
+		Event{
+			Name:       "team volumes",
+			Value:      float64(event.Usage.Volumes),
+			Attributes: attributes,
+		},
+	)
+
+	quotas := map[string]int{
+		atc.QuotaConcurrentBuilds: event.Quotas.MaxConcurrentBuilds,
+		atc.QuotaContainers:       event.Quotas.MaxContainers,
+		atc.QuotaVolumes:          event.Quotas.MaxVolumes,
+		atc.QuotaChecksPerMinute:  event.Quotas.MaxChecksPerMinute,
+	}
+
+	for quota, limit := range quotas {
+		if limit == 0 {
+			continue
+		}
+
+		emit(
+			logger.Session("team-quota"),
+			Event{
+				Name:  "team quota",
+				Value: float64(limit),
+				Attributes: map[string]string{
+					"team_name": event.TeamName,
+					"quota":     quota,
+				},
+			},
+		)
+	}
+}
@@ -0,0 +1,43 @@
+package metric
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// TeamUsageCollector periodically emits how much of its quotas every team is
+// using.
+type TeamUsageCollector struct {
+	teamQuotaFactory db.TeamQuotaFactory
+}
+
+func NewTeamUsageCollector(teamQuotaFactory db.TeamQuotaFactory) *TeamUsageCollector {
+	return &TeamUsageCollector{
+		teamQuotaFactory: teamQuotaFactory,
+	}
+}
+
+func (collector *TeamUsageCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("team-usage")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	teams, err := collector.teamQuotaFactory.AllUsage()
+	if err != nil {
+		logger.Error("failed-to-get-team-usage", err)
+		return err
+	}
+
+	for teamName, usage := range teams {
+		TeamUsage{
+			TeamName: teamName,
+			Quotas:   usage.Quotas,
+			Usage:    usage.Usage,
+		}.Emit(logger)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package metric_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamUsageCollector", func() {
+	var (
+		emitter          *smartFakeEmitter
+		teamQuotaFactory *dbfakes.FakeTeamQuotaFactory
+		collector        *metric.TeamUsageCollector
+		runErr           error
+	)
+
+	BeforeEach(func() {
+		emitter = registerFakeEmitterInUnsafeGlobalMap()
+
+		teamQuotaFactory = new(dbfakes.FakeTeamQuotaFactory)
+		teamQuotaFactory.AllUsageReturns(map[string]db.TeamQuotaUsage{
+			"some-team": {
+				Quotas: atc.TeamQuotas{MaxContainers: 20},
+				Usage: atc.TeamUsage{
+					RunningBuilds: 2,
+					Containers:    12,
+					Volumes:       30,
+				},
+			},
+		}, nil)
+
+		collector = metric.NewTeamUsageCollector(teamQuotaFactory)
+	})
+
+	AfterEach(func() {
+		metric.Deinitialize(testLogger)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(lagerctx.NewContext(context.Background(), testLogger))
+	})
+
+	It("emits the usage and quotas of each team", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Eventually(emitter.EmitCallCount).Should(Equal(4))
+
+		values := map[string]float64{}
+		for i := 0; i < emitter.EmitCallCount(); i++ {
+			_, event := emitter.EmitArgsForCall(i)
+			Expect(event.Attributes).To(HaveKeyWithValue("team_name", "some-team"))
+			values[event.Name] = event.Value
+		}
+
+		Expect(values).To(Equal(map[string]float64{
+			"team running builds": 2,
+			"team containers":     12,
+			"team volumes":        30,
+			"team quota":          20,
+		}))
+	})
+
+	Context("when getting the usage fails", func() {
+		BeforeEach(func() {
+			teamQuotaFactory.AllUsageReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(HaveOccurred())
+		})
+	})
+})
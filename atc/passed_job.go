@@ -0,0 +1,58 @@
+package atc
+
+import "strings"
+
+// PassedJob is a reference to a job named by a `passed:` constraint on a get
+// step.
+//
+// Jobs in the same pipeline are referenced by name alone. Jobs in another
+// pipeline of the same team are referenced as `pipeline/job`, and jobs in an
+// exposed pipeline of another team are referenced as `team/pipeline/job`.
+type PassedJob struct {
+	Team     string
+	Pipeline string
+	Job      string
+}
+
+// ParsePassedJob splits a `passed:` entry into its team, pipeline, and job
+// components. It returns false if the reference is malformed, i.e. it has
+// more than three components or any of them are empty.
+func ParsePassedJob(ref string) (PassedJob, bool) {
+	parts := strings.Split(ref, "/")
+	for _, part := range parts {
+		if part == "" {
+			return PassedJob{}, false
+		}
+	}
+
+	switch len(parts) {
+	case 1:
+		return PassedJob{Job: parts[0]}, true
+	case 2:
+		return PassedJob{Pipeline: parts[0], Job: parts[1]}, true
+	case 3:
+		return PassedJob{Team: parts[0], Pipeline: parts[1], Job: parts[2]}, true
+	default:
+		return PassedJob{}, false
+	}
+}
+
+// IsLocal returns true if the job is in the same pipeline as the step
+// referencing it.
+func (job PassedJob) IsLocal() bool {
+	return job.Pipeline == ""
+}
+
+func (job PassedJob) String() string {
+	parts := []string{}
+
+	if job.Team != "" {
+		parts = append(parts, job.Team)
+	}
+
+	if job.Pipeline != "" {
+		parts = append(parts, job.Pipeline)
+	}
+
+	return strings.Join(append(parts, job.Job), "/")
+}
@@ -0,0 +1,36 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PassedJob", func() {
+	DescribeTable("ParsePassedJob",
+		func(ref string, expected atc.PassedJob, valid bool) {
+			job, ok := atc.ParsePassedJob(ref)
+			Expect(ok).To(Equal(valid))
+			Expect(job).To(Equal(expected))
+
+			if valid {
+				Expect(job.String()).To(Equal(ref))
+			}
+		},
+		Entry("a job in the same pipeline", "some-job", atc.PassedJob{Job: "some-job"}, true),
+		Entry("a job in another pipeline", "some-pipeline/some-job", atc.PassedJob{Pipeline: "some-pipeline", Job: "some-job"}, true),
+		Entry("a job in another team", "some-team/some-pipeline/some-job", atc.PassedJob{Team: "some-team", Pipeline: "some-pipeline", Job: "some-job"}, true),
+		Entry("too many components", "a/b/c/d", atc.PassedJob{}, false),
+		Entry("an empty component", "some-pipeline/", atc.PassedJob{}, false),
+		Entry("an empty reference", "", atc.PassedJob{}, false),
+	)
+
+	Describe("IsLocal", func() {
+		It("is true only for jobs without a pipeline", func() {
+			Expect(atc.PassedJob{Job: "some-job"}.IsLocal()).To(BeTrue())
+			Expect(atc.PassedJob{Pipeline: "some-pipeline", Job: "some-job"}.IsLocal()).To(BeFalse())
+		})
+	})
+})
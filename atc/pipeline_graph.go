@@ -0,0 +1,289 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PipelineGraphFormatJSON    = "json"
+	PipelineGraphFormatDOT     = "dot"
+	PipelineGraphFormatMermaid = "mermaid"
+)
+
+type GraphNodeType string
+
+const (
+	GraphNodeJob          GraphNodeType = "job"
+	GraphNodeResource     GraphNodeType = "resource"
+	GraphNodeResourceType GraphNodeType = "resource_type"
+)
+
+type GraphEdgeType string
+
+const (
+	// GraphEdgeGet goes from a resource to a job which gets it.
+	GraphEdgeGet GraphEdgeType = "get"
+
+	// GraphEdgePut goes from a job to a resource which it puts to.
+	GraphEdgePut GraphEdgeType = "put"
+
+	// GraphEdgePassed goes from a job to a job whose input has a passed
+	// constraint on it.
+	GraphEdgePassed GraphEdgeType = "passed"
+
+	// GraphEdgeResourceType goes from a resource type to the resources and
+	// resource types using it.
+	GraphEdgeResourceType GraphEdgeType = "type"
+)
+
+// PipelineGraph is the DAG of a pipeline's jobs, resources and resource
+// types.
+type PipelineGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+type GraphNode struct {
+	ID     string        `json:"id"`
+	Type   GraphNodeType `json:"type"`
+	Name   string        `json:"name"`
+	Groups []string      `json:"groups,omitempty"`
+}
+
+type GraphEdge struct {
+	From    string        `json:"from"`
+	To      string        `json:"to"`
+	Type    GraphEdgeType `json:"type"`
+	Trigger bool          `json:"trigger,omitempty"`
+
+	// Resource is the resource a passed constraint is on.
+	Resource string `json:"resource,omitempty"`
+}
+
+func GraphNodeID(nodeType GraphNodeType, name string) string {
+	return string(nodeType) + ":" + name
+}
+
+// NewPipelineGraph derives the graph from the config. Edges to jobs and
+// resources which are not in the config are left out.
+func NewPipelineGraph(config Config) PipelineGraph {
+	graph := PipelineGraph{
+		Nodes: []GraphNode{},
+		Edges: []GraphEdge{},
+	}
+
+	jobGroups := map[string][]string{}
+	resourceGroups := map[string][]string{}
+	for _, group := range config.Groups {
+		for _, job := range group.Jobs {
+			jobGroups[job] = append(jobGroups[job], group.Name)
+		}
+
+		for _, resource := range group.Resources {
+			resourceGroups[resource] = append(resourceGroups[resource], group.Name)
+		}
+	}
+
+	nodes := map[string]bool{}
+	addNode := func(nodeType GraphNodeType, name string, groups []string) {
+		id := GraphNodeID(nodeType, name)
+		nodes[id] = true
+
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:     id,
+			Type:   nodeType,
+			Name:   name,
+			Groups: groups,
+		})
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		addNode(GraphNodeResourceType, resourceType.Name, nil)
+	}
+
+	for _, resource := range config.Resources {
+		addNode(GraphNodeResource, resource.Name, resourceGroups[resource.Name])
+	}
+
+	for _, job := range config.Jobs {
+		addNode(GraphNodeJob, job.Name, jobGroups[job.Name])
+	}
+
+	edges := map[GraphEdge]bool{}
+	addEdge := func(edge GraphEdge) {
+		if !nodes[edge.From] || !nodes[edge.To] || edges[edge] {
+			return
+		}
+
+		edges[edge] = true
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		addEdge(GraphEdge{
+			From: GraphNodeID(GraphNodeResourceType, resourceType.Type),
+			To:   GraphNodeID(GraphNodeResourceType, resourceType.Name),
+			Type: GraphEdgeResourceType,
+		})
+	}
+
+	for _, resource := range config.Resources {
+		addEdge(GraphEdge{
+			From: GraphNodeID(GraphNodeResourceType, resource.Type),
+			To:   GraphNodeID(GraphNodeResource, resource.Name),
+			Type: GraphEdgeResourceType,
+		})
+	}
+
+	for _, job := range config.Jobs {
+		jobID := GraphNodeID(GraphNodeJob, job.Name)
+
+		for _, input := range job.Inputs() {
+			addEdge(GraphEdge{
+				From:    GraphNodeID(GraphNodeResource, input.Resource),
+				To:      jobID,
+				Type:    GraphEdgeGet,
+				Trigger: input.Trigger,
+			})
+
+			for _, passed := range input.Passed {
+				addEdge(GraphEdge{
+					From:     GraphNodeID(GraphNodeJob, passed),
+					To:       jobID,
+					Type:     GraphEdgePassed,
+					Trigger:  input.Trigger,
+					Resource: input.Resource,
+				})
+			}
+		}
+
+		for _, output := range job.Outputs() {
+			addEdge(GraphEdge{
+				From: jobID,
+				To:   GraphNodeID(GraphNodeResource, output.Resource),
+				Type: GraphEdgePut,
+			})
+		}
+	}
+
+	return graph
+}
+
+// Group returns the part of the graph in the given group. Resource types are
+// kept if anything in the group uses them.
+func (graph PipelineGraph) Group(name string) PipelineGraph {
+	kept := map[string]bool{}
+	for _, node := range graph.Nodes {
+		for _, group := range node.Groups {
+			if group == name {
+				kept[node.ID] = true
+			}
+		}
+	}
+
+	// resource types can be nested, so keep going until nothing changes
+	for changed := true; changed; {
+		changed = false
+
+		for _, edge := range graph.Edges {
+			if edge.Type == GraphEdgeResourceType && kept[edge.To] && !kept[edge.From] {
+				kept[edge.From] = true
+				changed = true
+			}
+		}
+	}
+
+	group := PipelineGraph{
+		Nodes: []GraphNode{},
+		Edges: []GraphEdge{},
+	}
+
+	for _, node := range graph.Nodes {
+		if kept[node.ID] {
+			group.Nodes = append(group.Nodes, node)
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if kept[edge.From] && kept[edge.To] {
+			group.Edges = append(group.Edges, edge)
+		}
+	}
+
+	return group
+}
+
+// DOT renders the graph in the Graphviz DOT language.
+func (graph PipelineGraph) DOT() string {
+	buf := new(strings.Builder)
+
+	fmt.Fprintln(buf, "digraph pipeline {")
+	fmt.Fprintln(buf, "  rankdir=LR;")
+
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(buf, "  %q [label=%q, shape=%s];\n", node.ID, node.Name, dotShapes[node.Type])
+	}
+
+	for _, edge := range graph.Edges {
+		attrs := []string{fmt.Sprintf("label=%q", edgeLabel(edge))}
+		if !edge.Trigger && (edge.Type == GraphEdgeGet || edge.Type == GraphEdgePassed) {
+			attrs = append(attrs, "style=dashed")
+		}
+
+		fmt.Fprintf(buf, "  %q -> %q [%s];\n", edge.From, edge.To, strings.Join(attrs, ", "))
+	}
+
+	fmt.Fprintln(buf, "}")
+
+	return buf.String()
+}
+
+var dotShapes = map[GraphNodeType]string{
+	GraphNodeJob:          "box",
+	GraphNodeResource:     "ellipse",
+	GraphNodeResourceType: "hexagon",
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. Node IDs are replaced with
+// generated ones, as Mermaid does not allow most punctuation in them.
+func (graph PipelineGraph) Mermaid() string {
+	buf := new(strings.Builder)
+
+	fmt.Fprintln(buf, "flowchart LR")
+
+	ids := map[string]string{}
+	for i, node := range graph.Nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+
+		label := strings.Replace(node.Name, `"`, "#quot;", -1)
+
+		switch node.Type {
+		case GraphNodeJob:
+			fmt.Fprintf(buf, "  %s[\"%s\"]\n", ids[node.ID], label)
+		case GraphNodeResource:
+			fmt.Fprintf(buf, "  %s([\"%s\"])\n", ids[node.ID], label)
+		case GraphNodeResourceType:
+			fmt.Fprintf(buf, "  %s{{\"%s\"}}\n", ids[node.ID], label)
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if !edge.Trigger && (edge.Type == GraphEdgeGet || edge.Type == GraphEdgePassed) {
+			arrow = "-.->"
+		}
+
+		fmt.Fprintf(buf, "  %s %s|%s| %s\n", ids[edge.From], arrow, edgeLabel(edge), ids[edge.To])
+	}
+
+	return buf.String()
+}
+
+func edgeLabel(edge GraphEdge) string {
+	if edge.Type == GraphEdgePassed {
+		return "passed " + edge.Resource
+	}
+
+	return string(edge.Type)
+}
@@ -0,0 +1,147 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineGraph", func() {
+	var (
+		config atc.Config
+		graph  atc.PipelineGraph
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Groups: atc.GroupConfigs{
+				{
+					Name:      "build",
+					Jobs:      []string{"unit"},
+					Resources: []string{"repo"},
+				},
+				{
+					Name:      "deploy",
+					Jobs:      []string{"deploy"},
+					Resources: []string{"repo", "app"},
+				},
+			},
+
+			ResourceTypes: atc.ResourceTypes{
+				{Name: "cf", Type: "registry-image"},
+			},
+
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+				{Name: "app", Type: "cf"},
+			},
+
+			Jobs: atc.JobConfigs{
+				{
+					Name: "unit",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+						{Config: &atc.GetStep{Name: "repo"}},
+					},
+				},
+				{
+					Name: "deploy",
+					PlanSequence: []atc.Step{
+						{
+							Config: &atc.InParallelStep{
+								Config: atc.InParallelConfig{
+									Steps: []atc.Step{
+										{Config: &atc.GetStep{Name: "repo", Passed: []string{"unit"}}},
+									},
+								},
+							},
+						},
+						{Config: &atc.PutStep{Name: "app"}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		graph = atc.NewPipelineGraph(config)
+	})
+
+	It("has a node for each job, resource and resource type", func() {
+		Expect(graph.Nodes).To(Equal([]atc.GraphNode{
+			{ID: "resource_type:cf", Type: atc.GraphNodeResourceType, Name: "cf"},
+			{ID: "resource:repo", Type: atc.GraphNodeResource, Name: "repo", Groups: []string{"build", "deploy"}},
+			{ID: "resource:app", Type: atc.GraphNodeResource, Name: "app", Groups: []string{"deploy"}},
+			{ID: "job:unit", Type: atc.GraphNodeJob, Name: "unit", Groups: []string{"build"}},
+			{ID: "job:deploy", Type: atc.GraphNodeJob, Name: "deploy", Groups: []string{"deploy"}},
+		}))
+	})
+
+	It("has an edge for each get, put, passed constraint and custom type", func() {
+		Expect(graph.Edges).To(Equal([]atc.GraphEdge{
+			{From: "resource_type:cf", To: "resource:app", Type: atc.GraphEdgeResourceType},
+			{From: "resource:repo", To: "job:unit", Type: atc.GraphEdgeGet, Trigger: true},
+			{From: "resource:repo", To: "job:unit", Type: atc.GraphEdgeGet},
+			{From: "resource:repo", To: "job:deploy", Type: atc.GraphEdgeGet},
+			{From: "job:unit", To: "job:deploy", Type: atc.GraphEdgePassed, Resource: "repo"},
+			{From: "job:deploy", To: "resource:app", Type: atc.GraphEdgePut},
+		}))
+	})
+
+	Describe("Group", func() {
+		It("keeps the group's nodes and the resource types they use", func() {
+			group := graph.Group("deploy")
+
+			var ids []string
+			for _, node := range group.Nodes {
+				ids = append(ids, node.ID)
+			}
+
+			Expect(ids).To(Equal([]string{"resource_type:cf", "resource:repo", "resource:app", "job:deploy"}))
+			Expect(group.Edges).To(Equal([]atc.GraphEdge{
+				{From: "resource_type:cf", To: "resource:app", Type: atc.GraphEdgeResourceType},
+				{From: "resource:repo", To: "job:deploy", Type: atc.GraphEdgeGet},
+				{From: "job:deploy", To: "resource:app", Type: atc.GraphEdgePut},
+			}))
+		})
+	})
+
+	Describe("DOT", func() {
+		It("renders the graph", func() {
+			Expect(graph.DOT()).To(Equal(`digraph pipeline {
+  rankdir=LR;
+  "resource_type:cf" [label="cf", shape=hexagon];
+  "resource:repo" [label="repo", shape=ellipse];
+  "resource:app" [label="app", shape=ellipse];
+  "job:unit" [label="unit", shape=box];
+  "job:deploy" [label="deploy", shape=box];
+  "resource_type:cf" -> "resource:app" [label="type"];
+  "resource:repo" -> "job:unit" [label="get"];
+  "resource:repo" -> "job:unit" [label="get", style=dashed];
+  "resource:repo" -> "job:deploy" [label="get", style=dashed];
+  "job:unit" -> "job:deploy" [label="passed repo", style=dashed];
+  "job:deploy" -> "resource:app" [label="put"];
+}
+`))
+		})
+	})
+
+	Describe("Mermaid", func() {
+		It("renders the graph", func() {
+			Expect(graph.Mermaid()).To(Equal(`flowchart LR
+  n0{{"cf"}}
+  n1(["repo"])
+  n2(["app"])
+  n3["unit"]
+  n4["deploy"]
+  n0 -->|type| n2
+  n1 -->|get| n3
+  n1 -.->|get| n3
+  n1 -.->|get| n4
+  n3 -.->|passed repo| n4
+  n4 -->|put| n2
+`))
+		})
+	})
+})
@@ -25,6 +25,11 @@ type Plan struct {
 	Timeout *TimeoutPlan `json:"timeout,omitempty"`
 	Retry   *RetryPlan   `json:"retry,omitempty"`
 
+	Acquire *AcquirePlan `json:"acquire,omitempty"`
+	Release *ReleasePlan `json:"release,omitempty"`
+
+	If *IfPlan `json:"if,omitempty"`
+
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
 	ArtifactOutput *ArtifactOutputPlan `json:"artifact_output,omitempty"`
@@ -96,6 +101,18 @@ func (plan *Plan) Each(f func(*Plan)) {
 			(*plan.Retry)[i] = p
 		}
 	}
+
+	if plan.Acquire != nil {
+		plan.Acquire.Step.Each(f)
+	}
+
+	if plan.Release != nil {
+		plan.Release.Step.Each(f)
+	}
+
+	if plan.If != nil {
+		plan.If.Step.Each(f)
+	}
 }
 
 type PlanID string
@@ -139,6 +156,21 @@ type TimeoutPlan struct {
 	Duration string `json:"duration"`
 }
 
+type AcquirePlan struct {
+	Step  Plan        `json:"step"`
+	Locks LockConfigs `json:"locks"`
+}
+
+type ReleasePlan struct {
+	Step  Plan        `json:"step"`
+	Locks LockConfigs `json:"locks"`
+}
+
+type IfPlan struct {
+	Step      Plan   `json:"step"`
+	Condition string `json:"condition"`
+}
+
 type TryPlan struct {
 	Step Plan `json:"step"`
 }
@@ -156,36 +188,43 @@ type DoPlan []Plan
 type GetPlan struct {
 	Name string `json:"name,omitempty"`
 
-	Type        string   `json:"type"`
-	Resource    string   `json:"resource"`
-	Source      Source   `json:"source"`
-	Params      Params   `json:"params,omitempty"`
-	Version     *Version `json:"version,omitempty"`
-	VersionFrom *PlanID  `json:"version_from,omitempty"`
-	Tags        Tags     `json:"tags,omitempty"`
+	Type           string          `json:"type"`
+	Resource       string          `json:"resource"`
+	Source         Source          `json:"source"`
+	Params         Params          `json:"params,omitempty"`
+	Version        *Version        `json:"version,omitempty"`
+	VersionFrom    *PlanID         `json:"version_from,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
+
+	Reuse *ReusedStep `json:"reuse,omitempty"`
 }
 
 type PutPlan struct {
-	Type     string        `json:"type"`
-	Name     string        `json:"name,omitempty"`
-	Resource string        `json:"resource"`
-	Source   Source        `json:"source"`
-	Params   Params        `json:"params,omitempty"`
-	Tags     Tags          `json:"tags,omitempty"`
-	Inputs   *InputsConfig `json:"inputs,omitempty"`
+	Type           string          `json:"type"`
+	Name           string          `json:"name,omitempty"`
+	Resource       string          `json:"resource"`
+	Source         Source          `json:"source"`
+	Params         Params          `json:"params,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+	Inputs         *InputsConfig   `json:"inputs,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
+
+	Reuse *ReusedStep `json:"reuse,omitempty"`
 }
 
 type CheckPlan struct {
-	Type        string  `json:"type"`
-	Name        string  `json:"name,omitempty"`
-	Source      Source  `json:"source"`
-	Tags        Tags    `json:"tags,omitempty"`
-	Timeout     string  `json:"timeout,omitempty"`
-	FromVersion Version `json:"from_version,omitempty"`
+	Type           string          `json:"type"`
+	Name           string          `json:"name,omitempty"`
+	Source         Source          `json:"source"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+	Timeout        string          `json:"timeout,omitempty"`
+	FromVersion    Version         `json:"from_version,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
@@ -193,8 +232,9 @@ type CheckPlan struct {
 type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
-	Privileged bool `json:"privileged"`
-	Tags       Tags `json:"tags,omitempty"`
+	Privileged     bool            `json:"privileged"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
 
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
@@ -204,8 +244,11 @@ type TaskPlan struct {
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
+	CacheResult       bool              `json:"cache_result,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
+
+	Reuse *ReusedStep `json:"reuse,omitempty"`
 }
 
 type SetPipelinePlan struct {
@@ -59,6 +59,12 @@ func (factory PlanFactory) NewPlan(step PlanConfig) Plan {
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case AcquirePlan:
+		plan.Acquire = &t
+	case ReleasePlan:
+		plan.Release = &t
+	case IfPlan:
+		plan.If = &t
 	case ArtifactInputPlan:
 		plan.ArtifactInput = &t
 	case ArtifactOutputPlan:
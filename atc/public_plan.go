@@ -24,6 +24,9 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		Acquire        *json.RawMessage `json:"acquire,omitempty"`
+		Release        *json.RawMessage `json:"release,omitempty"`
+		If             *json.RawMessage `json:"if,omitempty"`
 		ArtifactInput  *json.RawMessage `json:"artifact_input,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -98,6 +101,18 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Acquire != nil {
+		public.Acquire = plan.Acquire.Public()
+	}
+
+	if plan.Release != nil {
+		public.Release = plan.Release.Public()
+	}
+
+	if plan.If != nil {
+		public.If = plan.If.Public()
+	}
+
 	if plan.ArtifactInput != nil {
 		public.ArtifactInput = plan.ArtifactInput.Public()
 	}
@@ -163,15 +178,17 @@ func (plan EnsurePlan) Public() *json.RawMessage {
 
 func (plan GetPlan) Public() *json.RawMessage {
 	return enc(struct {
-		Type     string   `json:"type"`
-		Name     string   `json:"name,omitempty"`
-		Resource string   `json:"resource"`
-		Version  *Version `json:"version,omitempty"`
+		Type     string           `json:"type"`
+		Name     string           `json:"name,omitempty"`
+		Resource string           `json:"resource"`
+		Version  *Version         `json:"version,omitempty"`
+		Reused   *json.RawMessage `json:"reused,omitempty"`
 	}{
 		Type:     plan.Type,
 		Name:     plan.Name,
 		Resource: plan.Resource,
 		Version:  plan.Version,
+		Reused:   plan.Reuse.Public(),
 	})
 }
 
@@ -229,13 +246,15 @@ func (plan OnSuccessPlan) Public() *json.RawMessage {
 
 func (plan PutPlan) Public() *json.RawMessage {
 	return enc(struct {
-		Type     string `json:"type"`
-		Name     string `json:"name,omitempty"`
-		Resource string `json:"resource"`
+		Type     string           `json:"type"`
+		Name     string           `json:"name,omitempty"`
+		Resource string           `json:"resource"`
+		Reused   *json.RawMessage `json:"reused,omitempty"`
 	}{
 		Type:     plan.Type,
 		Name:     plan.Name,
 		Resource: plan.Resource,
+		Reused:   plan.Reuse.Public(),
 	})
 }
 
@@ -251,11 +270,13 @@ func (plan CheckPlan) Public() *json.RawMessage {
 
 func (plan TaskPlan) Public() *json.RawMessage {
 	return enc(struct {
-		Name       string `json:"name"`
-		Privileged bool   `json:"privileged"`
+		Name       string           `json:"name"`
+		Privileged bool             `json:"privileged"`
+		Reused     *json.RawMessage `json:"reused,omitempty"`
 	}{
 		Name:       plan.Name,
 		Privileged: plan.Privileged,
+		Reused:     plan.Reuse.Public(),
 	})
 }
 
@@ -287,6 +308,36 @@ func (plan TimeoutPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan AcquirePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step  *json.RawMessage `json:"step"`
+		Locks []string         `json:"locks"`
+	}{
+		Step:  plan.Step.Public(),
+		Locks: plan.Locks.Names(),
+	})
+}
+
+func (plan ReleasePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step  *json.RawMessage `json:"step"`
+		Locks []string         `json:"locks"`
+	}{
+		Step:  plan.Step.Public(),
+		Locks: plan.Locks.Names(),
+	})
+}
+
+func (plan IfPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step      *json.RawMessage `json:"step"`
+		Condition string           `json:"condition"`
+	}{
+		Step:      plan.Step.Public(),
+		Condition: plan.Condition,
+	})
+}
+
 func (plan TryPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
@@ -8,6 +8,66 @@ import (
 
 var _ = Describe("Plan", func() {
 	Describe("Public", func() {
+		It("marks reused steps without including their results", func() {
+			reuse := &atc.ReusedStep{
+				BuildID:   42,
+				BuildName: "7",
+				Result: atc.StepResult{
+					Outputs: map[string]string{"some-output": "some-handle"},
+					Version: atc.Version{"some": "version"},
+				},
+			}
+
+			plan := atc.Plan{
+				ID: "0",
+				Do: &atc.DoPlan{
+					{
+						ID:  "1",
+						Get: &atc.GetPlan{Type: "type", Name: "name", Resource: "resource", Reuse: reuse},
+					},
+					{
+						ID:   "2",
+						Task: &atc.TaskPlan{Name: "name", Reuse: reuse},
+					},
+					{
+						ID:  "3",
+						Put: &atc.PutPlan{Type: "type", Name: "name", Resource: "resource"},
+					},
+				},
+			}
+
+			Expect(plan.Public()).To(MatchJSON(`{
+				"id": "0",
+				"do": [
+					{
+						"id": "1",
+						"get": {
+							"type": "type",
+							"name": "name",
+							"resource": "resource",
+							"reused": {"build_id": 42, "build_name": "7"}
+						}
+					},
+					{
+						"id": "2",
+						"task": {
+							"name": "name",
+							"privileged": false,
+							"reused": {"build_id": 42, "build_name": "7"}
+						}
+					},
+					{
+						"id": "3",
+						"put": {
+							"type": "type",
+							"name": "name",
+							"resource": "resource"
+						}
+					}
+				]
+			}`))
+		})
+
 		It("returns a sanitized form of the plan", func() {
 			plan := atc.Plan{
 				ID: "0",
@@ -360,6 +420,41 @@ var _ = Describe("Plan", func() {
 							Vars:     map[string]interface{}{"k1": "v1"},
 						},
 					},
+					atc.Plan{
+						ID: "38",
+						Acquire: &atc.AcquirePlan{
+							Locks: atc.LockConfigs{{Name: "some-lock"}, {Name: "some-pool", Size: 3}},
+							Step: atc.Plan{
+								ID: "39",
+								Release: &atc.ReleasePlan{
+									Locks: atc.LockConfigs{{Name: "some-lock"}},
+									Step: atc.Plan{
+										ID: "40",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: atc.TaskEnv{"some": "secret"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					atc.Plan{
+						ID: "41",
+						If: &atc.IfPlan{
+							Condition: `vars.branch == "main"`,
+							Step: atc.Plan{
+								ID: "42",
+								Task: &atc.TaskPlan{
+									Name:       "name",
+									ConfigPath: "some/config/path.yml",
+								},
+							},
+						},
+					},
 				},
 			}
 
@@ -625,6 +720,38 @@ var _ = Describe("Plan", func() {
 		"name": "some-pipeline",
 		"team": "some-team"
 	  }
+	},
+	{
+	  "id": "38",
+	  "acquire": {
+		"locks": ["some-lock", "some-pool"],
+		"step": {
+		  "id": "39",
+		  "release": {
+			"locks": ["some-lock"],
+			"step": {
+			  "id": "40",
+			  "task": {
+				"name": "name",
+				"privileged": false
+			  }
+			}
+		  }
+		}
+	  }
+	},
+	{
+	  "id": "41",
+	  "if": {
+		"condition": "vars.branch == \"main\"",
+		"step": {
+		  "id": "42",
+		  "task": {
+			"name": "name",
+			"privileged": false
+		  }
+		}
+	  }
 	}
   ]
 }
@@ -0,0 +1,162 @@
+package atc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResourceRequests are the CPU and memory reserved for a task on its worker,
+// or the capacity a worker has available for them.
+type ResourceRequests struct {
+	CPU    CPUQuantity    `json:"cpu,omitempty"`
+	Memory MemoryQuantity `json:"memory,omitempty"`
+}
+
+func (requests ResourceRequests) IsZero() bool {
+	return requests.CPU == 0 && requests.Memory == 0
+}
+
+func (requests ResourceRequests) Add(other ResourceRequests) ResourceRequests {
+	return ResourceRequests{
+		CPU:    requests.CPU + other.CPU,
+		Memory: requests.Memory + other.Memory,
+	}
+}
+
+// Fits returns true if the requests fit within the capacity once the already
+// committed requests are taken into account. A zero capacity is unlimited.
+func (requests ResourceRequests) Fits(capacity ResourceRequests, committed ResourceRequests) bool {
+	total := committed.Add(requests)
+
+	if capacity.CPU != 0 && total.CPU > capacity.CPU {
+		return false
+	}
+
+	if capacity.Memory != 0 && total.Memory > capacity.Memory {
+		return false
+	}
+
+	return true
+}
+
+// CPUQuantity is an amount of CPU in millicores. It is written either as a
+// number of cores, e.g. 2 or 0.5, or in millicores, e.g. "500m".
+type CPUQuantity uint64
+
+func ParseCPUQuantity(raw string) (CPUQuantity, error) {
+	raw = strings.TrimSpace(raw)
+
+	if strings.HasSuffix(raw, "m") {
+		millicores, err := strconv.ParseUint(strings.TrimSuffix(raw, "m"), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid cpu quantity '%s'", raw)
+		}
+
+		return CPUQuantity(millicores), nil
+	}
+
+	cores, err := strconv.ParseFloat(raw, 64)
+	if err != nil || cores < 0 {
+		return 0, fmt.Errorf("invalid cpu quantity '%s'", raw)
+	}
+
+	return CPUQuantity(cores * 1000), nil
+}
+
+func (quantity CPUQuantity) String() string {
+	return fmt.Sprintf("%dm", uint64(quantity))
+}
+
+func (quantity CPUQuantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(quantity.String())
+}
+
+func (quantity *CPUQuantity) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	switch value := raw.(type) {
+	case float64:
+		if value < 0 {
+			return errors.New("cpu quantity must not be negative")
+		}
+
+		*quantity = CPUQuantity(value * 1000)
+	case string:
+		*quantity, err = ParseCPUQuantity(value)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("cpu quantity must be a number of cores or a string")
+	}
+
+	return nil
+}
+
+func (quantity *CPUQuantity) UnmarshalFlag(value string) error {
+	parsed, err := ParseCPUQuantity(value)
+	if err != nil {
+		return err
+	}
+
+	*quantity = parsed
+
+	return nil
+}
+
+// MemoryQuantity is an amount of memory in bytes. It is written either as a
+// number of bytes or with a unit, e.g. "512MB".
+type MemoryQuantity uint64
+
+func ParseMemoryQuantity(raw string) (MemoryQuantity, error) {
+	bytes, err := parseMemoryLimit(strings.TrimSpace(raw))
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory quantity '%s'", raw)
+	}
+
+	return MemoryQuantity(bytes), nil
+}
+
+func (quantity *MemoryQuantity) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	switch value := raw.(type) {
+	case float64:
+		if value < 0 {
+			return errors.New("memory quantity must not be negative")
+		}
+
+		*quantity = MemoryQuantity(value)
+	case string:
+		*quantity, err = ParseMemoryQuantity(value)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("memory quantity must be a number of bytes or a string")
+	}
+
+	return nil
+}
+
+func (quantity *MemoryQuantity) UnmarshalFlag(value string) error {
+	parsed, err := ParseMemoryQuantity(value)
+	if err != nil {
+		return err
+	}
+
+	*quantity = parsed
+
+	return nil
+}
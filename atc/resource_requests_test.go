@@ -0,0 +1,79 @@
+package atc_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceRequests", func() {
+	DescribeTable("unmarshaling",
+		func(payload string, expected atc.ResourceRequests) {
+			var requests atc.ResourceRequests
+			err := json.Unmarshal([]byte(payload), &requests)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(Equal(expected))
+		},
+		Entry("whole cores", `{"cpu": 2}`, atc.ResourceRequests{CPU: 2000}),
+		Entry("fractional cores", `{"cpu": 0.5}`, atc.ResourceRequests{CPU: 500}),
+		Entry("cores as a string", `{"cpu": "1.5"}`, atc.ResourceRequests{CPU: 1500}),
+		Entry("millicores", `{"cpu": "250m"}`, atc.ResourceRequests{CPU: 250}),
+		Entry("bytes of memory", `{"memory": 1024}`, atc.ResourceRequests{Memory: 1024}),
+		Entry("memory with a unit", `{"memory": "512MB"}`, atc.ResourceRequests{Memory: 512 * 1024 * 1024}),
+	)
+
+	DescribeTable("invalid requests",
+		func(payload string) {
+			var requests atc.ResourceRequests
+			err := json.Unmarshal([]byte(payload), &requests)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("negative cores", `{"cpu": -1}`),
+		Entry("malformed millicores", `{"cpu": "lots"}`),
+		Entry("malformed memory", `{"memory": "512 parsecs"}`),
+		Entry("memory as a list", `{"memory": [1]}`),
+	)
+
+	It("round-trips through JSON", func() {
+		requests := atc.ResourceRequests{CPU: 1500, Memory: 2048}
+
+		payload, err := json.Marshal(requests)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(MatchJSON(`{"cpu": "1500m", "memory": 2048}`))
+
+		var unmarshaled atc.ResourceRequests
+		err = json.Unmarshal(payload, &unmarshaled)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unmarshaled).To(Equal(requests))
+	})
+
+	DescribeTable("Fits",
+		func(requests, capacity, committed atc.ResourceRequests, expected bool) {
+			Expect(requests.Fits(capacity, committed)).To(Equal(expected))
+		},
+		Entry("within capacity",
+			atc.ResourceRequests{CPU: 1000, Memory: 1024},
+			atc.ResourceRequests{CPU: 4000, Memory: 4096},
+			atc.ResourceRequests{CPU: 3000, Memory: 3072},
+			true),
+		Entry("over the cpu capacity",
+			atc.ResourceRequests{CPU: 1001},
+			atc.ResourceRequests{CPU: 4000, Memory: 4096},
+			atc.ResourceRequests{CPU: 3000},
+			false),
+		Entry("over the memory capacity",
+			atc.ResourceRequests{Memory: 2048},
+			atc.ResourceRequests{CPU: 4000, Memory: 4096},
+			atc.ResourceRequests{Memory: 3072},
+			false),
+		Entry("unlimited capacity",
+			atc.ResourceRequests{CPU: 64000, Memory: 1 << 40},
+			atc.ResourceRequests{},
+			atc.ResourceRequests{CPU: 64000},
+			true),
+	)
+})
@@ -4,6 +4,10 @@ type ClearTaskCacheResponse struct {
 	CachesRemoved int64 `json:"caches_removed"`
 }
 
+type ClearTaskResultCacheResponse struct {
+	CachesRemoved int64 `json:"caches_removed"`
+}
+
 type SaveConfigResponse struct {
 	Errors   []string        `json:"errors,omitempty"`
 	Warnings []ConfigWarning `json:"warnings,omitempty"`
@@ -11,6 +11,8 @@ const (
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
 	BuildEvents         = "BuildEvents"
+	GetBuildStepLog     = "GetBuildStepLog"
+	DownloadBuildLog    = "DownloadBuildLog"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
@@ -32,7 +34,8 @@ const (
 	JobBadge       = "JobBadge"
 	MainJobBadge   = "MainJobBadge"
 
-	ClearTaskCache = "ClearTaskCache"
+	ClearTaskCache       = "ClearTaskCache"
+	ClearTaskResultCache = "ClearTaskResultCache"
 
 	ListAllResources     = "ListAllResources"
 	ListResources        = "ListResources"
@@ -69,6 +72,8 @@ const (
 	ListPipelineBuilds  = "ListPipelineBuilds"
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
+	GetPipelineGraph    = "GetPipelineGraph"
+	GetPipelineStats    = "GetPipelineStats"
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
@@ -91,6 +96,12 @@ const (
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
 
+	ListHijackSessions        = "ListHijackSessions"
+	GetHijackSessionRecording = "GetHijackSessionRecording"
+
+	ListLockPools   = "ListLockPools"
+	ReleaseLockPool = "ReleaseLockPool"
+
 	ListVolumes           = "ListVolumes"
 	ListDestroyingVolumes = "ListDestroyingVolumes"
 	ReportWorkerVolumes   = "ReportWorkerVolumes"
@@ -98,6 +109,7 @@ const (
 	ListTeams      = "ListTeams"
 	GetTeam        = "GetTeam"
 	SetTeam        = "SetTeam"
+	ApplyTeam      = "ApplyTeam"
 	RenameTeam     = "RenameTeam"
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
@@ -112,6 +124,18 @@ const (
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	GetMaintenance     = "GetMaintenance"
+	EnableMaintenance  = "EnableMaintenance"
+	DisableMaintenance = "DisableMaintenance"
+
+	ListComponents   = "ListComponents"
+	PauseComponent   = "PauseComponent"
+	UnpauseComponent = "UnpauseComponent"
+	RunComponent     = "RunComponent"
+
+	ListSystemLocks   = "ListSystemLocks"
+	ReleaseSystemLock = "ReleaseSystemLock"
 )
 
 const (
@@ -129,6 +153,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
+	{Path: "/api/v1/builds/:build_id/steps/:origin/log", Method: "GET", Name: GetBuildStepLog},
+	{Path: "/api/v1/builds/:build_id/log", Method: "GET", Name: DownloadBuildLog},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
@@ -151,6 +177,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: MainJobBadge},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", Method: "DELETE", Name: ClearTaskCache},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/result-cache", Method: "DELETE", Name: ClearTaskResultCache},
 
 	{Path: "/api/v1/pipelines", Method: "GET", Name: ListAllPipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines", Method: "GET", Name: ListPipelines},
@@ -163,6 +190,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/graph", Method: "GET", Name: GetPipelineGraph},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/stats", Method: "GET", Name: GetPipelineStats},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/rename", Method: "PUT", Name: RenamePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
@@ -213,6 +242,12 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
 
+	{Path: "/api/v1/teams/:team_name/hijack-sessions", Method: "GET", Name: ListHijackSessions},
+	{Path: "/api/v1/teams/:team_name/hijack-sessions/:hijack_session_id/recording", Method: "GET", Name: GetHijackSessionRecording},
+
+	{Path: "/api/v1/teams/:team_name/locks", Method: "GET", Name: ListLockPools},
+	{Path: "/api/v1/teams/:team_name/locks/:lock_name/release", Method: "PUT", Name: ReleaseLockPool},
+
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
 	{Path: "/api/v1/volumes/report", Method: "PUT", Name: ReportWorkerVolumes},
@@ -220,6 +255,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams", Method: "GET", Name: ListTeams},
 	{Path: "/api/v1/teams/:team_name", Method: "GET", Name: GetTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "PUT", Name: SetTeam},
+	{Path: "/api/v1/teams/:team_name/apply", Method: "PUT", Name: ApplyTeam},
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
@@ -230,4 +266,16 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
+
+	{Path: "/api/v1/maintenance", Method: "GET", Name: GetMaintenance},
+	{Path: "/api/v1/maintenance", Method: "PUT", Name: EnableMaintenance},
+	{Path: "/api/v1/maintenance", Method: "DELETE", Name: DisableMaintenance},
+
+	{Path: "/api/v1/components", Method: "GET", Name: ListComponents},
+	{Path: "/api/v1/components/:component_name/pause", Method: "PUT", Name: PauseComponent},
+	{Path: "/api/v1/components/:component_name/unpause", Method: "PUT", Name: UnpauseComponent},
+	{Path: "/api/v1/components/:component_name/run", Method: "PUT", Name: RunComponent},
+
+	{Path: "/api/v1/locks", Method: "GET", Name: ListSystemLocks},
+	{Path: "/api/v1/locks/sessions/:session_id/release", Method: "PUT", Name: ReleaseSystemLock},
 })
@@ -19,6 +19,22 @@ type StartingEventDelegate interface {
 	Starting(lager.Logger)
 }
 
+//go:generate counterfeiter . WaitingEventDelegate
+
+// WaitingEventDelegate is implemented by event delegates which report when a
+// step is waiting for a worker with enough capacity to run it.
+type WaitingEventDelegate interface {
+	WaitingForWorker(logger lager.Logger, reason string)
+}
+
+//go:generate counterfeiter . QuotaEventDelegate
+
+// QuotaEventDelegate is implemented by event delegates which report when a
+// step is waiting for its team to drop below one of its quotas.
+type QuotaEventDelegate interface {
+	WaitingForQuota(logger lager.Logger, exceeded atc.QuotaExceeded)
+}
+
 type VersionResult struct {
 	Version  atc.Version         `json:"version"`
 	Metadata []atc.MetadataField `json:"metadata,omitempty"`
@@ -1,6 +1,8 @@
 package algorithm_test
 
 import (
+	"github.com/concourse/concourse/atc"
+
 	. "github.com/onsi/ginkgo/extensions/table"
 )
 
@@ -3241,4 +3243,209 @@ var _ = DescribeTable("Input resolving",
 			},
 		},
 	}),
+
+	Entry("finds the latest version matching a semver filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "1.2.0", CheckOrder: 1},
+				{Resource: "resource-x", Version: "1.3.0", CheckOrder: 2},
+				{Resource: "resource-x", Version: "2.0.0", CheckOrder: 3},
+				{Resource: "resource-x", Version: "not-a-version", CheckOrder: 4},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Latest: true},
+				Filter:   &atc.VersionFilter{Semver: &atc.SemverFilter{Field: "ver", Range: ">=1.2 <2"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "1.3.0",
+			},
+		},
+	}),
+
+	Entry("does not resolve a resource when no version matches its filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Latest: true},
+				Filter:   &atc.VersionFilter{Version: map[string]string{"ver": "^release-"}},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Errors: map[string]string{"resource-x": "latest version of resource not found"},
+		},
+	}),
+
+	Entry("finds the next version matching a filter for inputs that use every version", Example{
+		DB: DB{
+			BuildInputs: []DBRow{
+				{Job: CurrentJobName, BuildID: 4, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2-wip", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+				{Resource: "resource-x", Version: "rxv4", CheckOrder: 4},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Every: true},
+				Filter:   &atc.VersionFilter{Version: map[string]string{"ver": "^rxv[0-9]+$"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv3",
+			},
+		},
+	}),
+
+	Entry("finds the latest version that passed constraints and matches a filter", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "1.0.0", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "2.0.0-rc.1", CheckOrder: 2},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "1.0.0", CheckOrder: 1},
+				{Resource: "resource-x", Version: "2.0.0-rc.1", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Filter:   &atc.VersionFilter{Semver: &atc.SemverFilter{Field: "ver", Range: "<2"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "1.0.0",
+			},
+		},
+	}),
+
+	Entry("resolves versions that passed a job in another pipeline through its resource sharing the same scope", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+
+			BuildOutputs: []DBRow{
+				{Job: "upstream-a", BuildID: 1, Resource: "upstream-resource-x", SharesScopeWith: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:            "resource-x",
+				Resource:        "resource-x",
+				Passed:          []string{"upstream-a"},
+				PassedResources: map[string]string{"upstream-a": "upstream-resource-x"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			PassedBuildIDs: map[string][]int{
+				"resource-x": []int{1},
+			},
+		},
+	}),
+
+	Entry("requires versions to pass both local jobs and jobs in other pipelines", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "upstream-a", BuildID: 2, Resource: "upstream-resource-x", SharesScopeWith: "resource-x", Version: "rxv1", CheckOrder: 1},
+
+				// pass simple-a but not upstream-a
+				{Job: "simple-a", BuildID: 3, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:            "resource-x",
+				Resource:        "resource-x",
+				Passed:          []string{"simple-a", "upstream-a"},
+				PassedResources: map[string]string{"upstream-a": "upstream-resource-x"},
+			},
+		},
+
+		// no v2 as it hasn't passed upstream-a
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("does not resolve versions that a job in another pipeline output for an unrelated resource", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			BuildOutputs: []DBRow{
+				{Job: "upstream-a", BuildID: 1, Resource: "upstream-resource-x", SharesScopeWith: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "upstream-a", BuildID: 2, Resource: "upstream-resource-y", Version: "ryv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:            "resource-x",
+				Resource:        "resource-x",
+				Passed:          []string{"upstream-a"},
+				PassedResources: map[string]string{"upstream-a": "upstream-resource-y"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no satisfiable builds from passed jobs found for set of inputs",
+			},
+		},
+	}),
 )
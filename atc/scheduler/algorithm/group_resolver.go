@@ -257,7 +257,7 @@ outputs:
 			}
 
 			if candidate == nil {
-				exists, err := r.vdb.VersionExists(ctx, output.ResourceID, output.Version)
+				exists, err := r.vdb.VersionExists(ctx, r.inputConfigs[c].ResourceID, output.Version)
 				if err != nil {
 					tracing.End(span, err)
 					return false, err
@@ -415,7 +415,7 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	constrainingCandidates := map[string][]string{}
 	for passedIndex, passedInput := range r.inputConfigs {
 		if passedInput.Passed[passedJobID] && r.candidates[passedIndex] != nil {
-			resID := strconv.Itoa(passedInput.ResourceID)
+			resID := strconv.Itoa(passedInput.ResourceIDForPassedJob(passedJobID))
 			constrainingCandidates[resID] = append(constrainingCandidates[resID], string(r.candidates[passedIndex].Version))
 		}
 	}
@@ -427,7 +427,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
-	if inputConfig.ResourceID != output.ResourceID {
+	if inputConfig.ResourceIDForPassedJob(passedJobID) != output.ResourceID {
 		// unrelated; different resource
 		return false, false, nil
 	}
@@ -443,7 +443,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, true, nil
 	}
 
-	disabled, err := r.vdb.VersionIsDisabled(ctx, output.ResourceID, output.Version)
+	disabled, err := r.vdb.VersionIsDisabled(ctx, inputConfig.ResourceID, output.Version)
 	if err != nil {
 		return false, false, err
 	}
@@ -459,6 +459,22 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, false, nil
 	}
 
+	matches, err := r.vdb.VersionMatchesFilter(ctx, inputConfig.ResourceID, output.Version, inputConfig.Filter)
+	if err != nil {
+		return false, false, err
+	}
+
+	if !matches {
+		// this version is excluded by the input's filter
+		span.AddEvent(
+			ctx,
+			"version filtered",
+			key.New("resourceID").Int(output.ResourceID),
+			key.New("version").String(string(output.Version)),
+		)
+		return false, false, nil
+	}
+
 	if inputConfig.PinnedVersion != nil && r.pins[candidateIdx] != output.Version {
 		// input is both pinned and assigned a 'passed' constraint, but the pinned
 		// version doesn't match the job's output version
@@ -38,7 +38,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 	if r.inputConfig.UseEveryVersion {
 		var found bool
 		var err error
-		version, hasNext, found, err = r.vdb.NextEveryVersion(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID)
+		version, hasNext, found, err = r.vdb.NextEveryVersion(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID, r.inputConfig.Filter)
 		if err != nil {
 			tracing.End(span, err)
 			return nil, "", err
@@ -55,7 +55,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		// there are no passed constraints, so just take the latest version
 		var err error
 		var found bool
-		version, found, err = r.vdb.LatestVersionOfResource(ctx, r.inputConfig.ResourceID)
+		version, found, err = r.vdb.LatestVersionOfResource(ctx, r.inputConfig.ResourceID, r.inputConfig.Filter)
 		if err != nil {
 			tracing.End(span, err)
 			return nil, "", err
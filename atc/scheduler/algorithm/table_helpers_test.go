@@ -45,6 +45,10 @@ type DBRow struct {
 	BuildStatus           string
 	NoResourceConfigScope bool
 	DoNotInsertVersion    bool
+
+	// SharesScopeWith names another resource whose config scope this row's
+	// resource shares, e.g. the same resource in an upstream pipeline.
+	SharesScopeWith string
 }
 
 type Example struct {
@@ -63,7 +67,12 @@ type Input struct {
 	Resource              string
 	Passed                []string
 	Version               Version
+	Filter                *atc.VersionFilter
 	NoResourceConfigScope bool
+
+	// PassedResources maps passed jobs to the resource their outputs are
+	// recorded against, for jobs in other pipelines.
+	PassedResources map[string]string
 }
 
 type Version struct {
@@ -166,9 +175,18 @@ func (example Example) Run() {
 			Passed:          passed,
 			ResourceID:      setup.resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
+			Filter:          input.Filter,
 			JobID:           setup.jobIDs.ID(CurrentJobName),
 		}
 
+		for jobName, resourceName := range input.PassedResources {
+			if inputConfigs[i].PassedResourceIDs == nil {
+				inputConfigs[i].PassedResourceIDs = map[int]int{}
+			}
+
+			inputConfigs[i].PassedResourceIDs[setup.jobIDs.ID(jobName)] = setup.resourceIDs.ID(resourceName)
+		}
+
 		if len(input.Version.Pinned) != 0 {
 			inputConfigs[i].PinnedVersion = atc.Version{"ver": input.Version.Pinned}
 
@@ -749,9 +767,14 @@ func (s setupDB) insertRowVersion(resources map[string]atc.ResourceConfig, row D
 	resourceID := s.resourceIDs.ID(row.Resource)
 	versionID := s.versionIDs.ID(row.Version)
 
+	scopeID := resourceID
+	if row.SharesScopeWith != "" {
+		scopeID = s.resourceIDs.ID(row.SharesScopeWith)
+	}
+
 	var scope *int
 	if !row.NoResourceConfigScope {
-		scope = &resourceID
+		scope = &scopeID
 	}
 
 	s.insertResource(row.Resource, scope)
@@ -773,7 +796,7 @@ func (s setupDB) insertRowVersion(resources map[string]atc.ResourceConfig, row D
 
 	_, err = s.psql.Insert("resource_config_versions").
 		Columns("id", "resource_config_scope_id", "version", "version_md5", "check_order").
-		Values(versionID, resourceID, versionJSON, sq.Expr("md5(?)", versionJSON), row.CheckOrder).
+		Values(versionID, scopeID, versionJSON, sq.Expr("md5(?)", versionJSON), row.CheckOrder).
 		Suffix("ON CONFLICT DO NOTHING").
 		Exec()
 	Expect(err).ToNot(HaveOccurred())
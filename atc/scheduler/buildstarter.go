@@ -3,10 +3,14 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/metric"
 )
 
@@ -36,16 +40,25 @@ type Build interface {
 func NewBuildStarter(
 	planner BuildPlanner,
 	algorithm Algorithm,
+	teamQuotaFactory db.TeamQuotaFactory,
 ) BuildStarter {
 	return &buildStarter{
-		planner:   planner,
-		algorithm: algorithm,
+		planner:          planner,
+		algorithm:        algorithm,
+		teamQuotaFactory: teamQuotaFactory,
+		waitingOnQuota:   map[int]bool{},
 	}
 }
 
 type buildStarter struct {
-	planner   BuildPlanner
-	algorithm Algorithm
+	planner          BuildPlanner
+	algorithm        Algorithm
+	teamQuotaFactory db.TeamQuotaFactory
+
+	// waitingOnQuota tracks the builds which have already been told that
+	// their team's quota is exceeded, so that the event is only sent once.
+	waitingOnQuota     map[int]bool
+	waitingOnQuotaLock sync.Mutex
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -142,6 +155,8 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	if nextPendingBuild.IsAborted() {
 		logger.Debug("cancel-aborted-pending-build")
 
+		s.stopWaitingOnQuota(nextPendingBuild)
+
 		err := nextPendingBuild.Finish(db.BuildStatusAborted)
 		if err != nil {
 			return startResults{}, fmt.Errorf("finish aborted build: %w", err)
@@ -152,11 +167,24 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	exceeded, err := s.teamQuotaExceeded(logger, job, nextPendingBuild)
+	if err != nil {
+		return startResults{}, fmt.Errorf("check team quota: %w", err)
+	}
+
+	if exceeded {
+		return startResults{}, nil
+	}
+
 	scheduled, err := job.ScheduleBuild(nextPendingBuild)
 	if err != nil {
 		return startResults{}, fmt.Errorf("schedule build: %w", err)
 	}
 
+	if scheduled {
+		s.stopWaitingOnQuota(nextPendingBuild)
+	}
+
 	if !scheduled {
 		logger.Debug("build-not-scheduled")
 		return startResults{
@@ -213,6 +241,13 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	if nextPendingBuild.PartialRerunOf() != 0 {
+		err = s.reuseStepResults(job, nextPendingBuild, &plan)
+		if err != nil {
+			return startResults{}, fmt.Errorf("reuse step results: %w", err)
+		}
+	}
+
 	started, err := nextPendingBuild.Start(plan)
 	if err != nil {
 		logger.Error("failed-to-mark-build-as-started", err)
@@ -236,3 +271,90 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		finished: true,
 	}, nil
 }
+
+// teamQuotaExceeded returns true if the job's team is already running as many
+// builds as its quota allows, or is at its container or volume quota so the
+// build could not create any containers. The build is told it is waiting the
+// first time this happens.
+//
+// This only saves scheduling builds which are bound to wait. The concurrent
+// builds quota is enforced when the build is scheduled, which counts the
+// team's builds with the team locked so that builds scheduled at the same
+// time by different ATCs cannot exceed it together.
+func (s *buildStarter) teamQuotaExceeded(logger lager.Logger, job db.SchedulerJob, build Build) (bool, error) {
+	// a scheduled build is already counted towards the team's usage, so it
+	// must not be held back by itself while e.g. waiting for its inputs
+	if build.IsScheduled() {
+		return false, nil
+	}
+
+	quotas, err := s.teamQuotaFactory.Quotas(job.TeamID())
+	if err != nil {
+		return false, err
+	}
+
+	if quotas.MaxConcurrentBuilds == 0 && quotas.MaxContainers == 0 && quotas.MaxVolumes == 0 {
+		return false, nil
+	}
+
+	usage, err := s.teamQuotaFactory.Usage(job.TeamID())
+	if err != nil {
+		return false, err
+	}
+
+	exceeded, ok := quotas.ExceededBuilds(usage)
+	if !ok {
+		exceeded, ok = quotas.ExceededContainers(usage)
+	}
+
+	if !ok {
+		return false, nil
+	}
+
+	logger.Debug("team-quota-exceeded", lager.Data{"quota": exceeded.Quota, "limit": exceeded.Limit})
+
+	s.waitingOnQuotaLock.Lock()
+	alreadyWaiting := s.waitingOnQuota[build.ID()]
+	s.waitingOnQuota[build.ID()] = true
+	s.waitingOnQuotaLock.Unlock()
+
+	if !alreadyWaiting {
+		err = build.SaveEvent(event.QuotaExceeded{
+			Time:  time.Now().Unix(),
+			Quota: exceeded.Quota,
+			Limit: exceeded.Limit,
+			Usage: exceeded.Usage,
+		})
+		if err != nil {
+			logger.Error("failed-to-save-quota-exceeded-event", err)
+		}
+	}
+
+	return true, nil
+}
+
+func (s *buildStarter) stopWaitingOnQuota(build Build) {
+	s.waitingOnQuotaLock.Lock()
+	delete(s.waitingOnQuota, build.ID())
+	s.waitingOnQuotaLock.Unlock()
+}
+
+func (s *buildStarter) reuseStepResults(job db.SchedulerJob, build Build, plan *atc.Plan) error {
+	buildToRerun, found, err := job.Build(build.PartialRerunOfName())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	results, err := buildToRerun.StepResults()
+	if err != nil {
+		return err
+	}
+
+	builds.ReuseStepResults(plan, buildToRerun.ID(), buildToRerun.Name(), results, build.RerunFromStep())
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
 
@@ -22,6 +23,8 @@ var _ = Describe("BuildStarter", func() {
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
 
+		fakeTeamQuotaFactory *dbfakes.FakeTeamQuotaFactory
+
 		buildStarter scheduler.BuildStarter
 
 		jobInputs db.InputConfigs
@@ -34,7 +37,9 @@ var _ = Describe("BuildStarter", func() {
 		fakePlanner = new(schedulerfakes.FakeBuildPlanner)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 
-		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm)
+		fakeTeamQuotaFactory = new(dbfakes.FakeTeamQuotaFactory)
+
+		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm, fakeTeamQuotaFactory)
 
 		disaster = errors.New("bad thing")
 	})
@@ -166,6 +171,126 @@ var _ = Describe("BuildStarter", func() {
 				})
 			})
 
+			Context("when the team has a concurrent builds quota", func() {
+				var logger *lagertest.TestLogger
+
+				BeforeEach(func() {
+					logger = lagertest.NewTestLogger("test")
+
+					job.TeamIDReturns(3)
+					fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{MaxConcurrentBuilds: 2}, nil)
+				})
+
+				JustBeforeEach(func() {
+					needsReschedule, tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+						logger,
+						db.SchedulerJob{
+							Job:           job,
+							Resources:     resources,
+							ResourceTypes: versionedResourceTypes,
+						},
+						jobInputs,
+					)
+				})
+
+				It("looks up the job's team", func() {
+					Expect(fakeTeamQuotaFactory.QuotasArgsForCall(0)).To(Equal(3))
+					Expect(fakeTeamQuotaFactory.UsageArgsForCall(0)).To(Equal(3))
+				})
+
+				Context("when the team is below the quota", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{RunningBuilds: 1}, nil)
+					})
+
+					It("schedules the build", func() {
+						Expect(job.ScheduleBuildCallCount()).To(Equal(1))
+						Expect(createdBuild.SaveEventCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the team has reached the quota", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{RunningBuilds: 2}, nil)
+					})
+
+					It("does not schedule the build and needs to be rescheduled", func() {
+						Expect(tryStartErr).ToNot(HaveOccurred())
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+						Expect(needsReschedule).To(BeTrue())
+					})
+
+					It("tells the build that it is waiting on the quota once", func() {
+						Expect(createdBuild.SaveEventCallCount()).To(Equal(1))
+						savedEvent := createdBuild.SaveEventArgsForCall(0).(event.QuotaExceeded)
+						Expect(savedEvent.Quota).To(Equal(atc.QuotaConcurrentBuilds))
+						Expect(savedEvent.Limit).To(Equal(2))
+						Expect(savedEvent.Usage).To(Equal(2))
+
+						_, err := buildStarter.TryStartPendingBuildsForJob(
+							logger,
+							db.SchedulerJob{Job: job},
+							jobInputs,
+						)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(createdBuild.SaveEventCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the team has reached the quota with the build already scheduled", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{RunningBuilds: 2}, nil)
+						createdBuild.IsScheduledReturns(true)
+					})
+
+					It("does not count the build against itself", func() {
+						Expect(tryStartErr).ToNot(HaveOccurred())
+						Expect(job.ScheduleBuildCallCount()).To(Equal(1))
+						Expect(createdBuild.SaveEventCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the team is at its container quota", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{MaxConcurrentBuilds: 2, MaxContainers: 5}, nil)
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{RunningBuilds: 1, Containers: 5}, nil)
+					})
+
+					It("does not admit the build", func() {
+						Expect(tryStartErr).ToNot(HaveOccurred())
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+						Expect(needsReschedule).To(BeTrue())
+
+						savedEvent := createdBuild.SaveEventArgsForCall(0).(event.QuotaExceeded)
+						Expect(savedEvent.Quota).To(Equal(atc.QuotaContainers))
+						Expect(savedEvent.Limit).To(Equal(5))
+					})
+				})
+
+				Context("when the team only has a volume quota and is at it", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{MaxVolumes: 5}, nil)
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{Volumes: 5}, nil)
+					})
+
+					It("does not admit the build", func() {
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+						Expect(createdBuild.SaveEventArgsForCall(0).(event.QuotaExceeded).Quota).To(Equal(atc.QuotaVolumes))
+					})
+				})
+
+				Context("when fetching the usage fails", func() {
+					BeforeEach(func() {
+						fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{}, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(tryStartErr).To(Equal(fmt.Errorf("check team quota: %w", disaster)))
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when manually triggered", func() {
 				BeforeEach(func() {
 					createdBuild.IsManuallyTriggeredReturns(true)
@@ -775,6 +900,61 @@ var _ = Describe("BuildStarter", func() {
 											Expect(rerunBuild.StartCallCount()).To(Equal(1))
 											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
 										})
+
+										Context("when the rerun build is a partial rerun", func() {
+											var buildToRerun *dbfakes.FakeBuild
+
+											BeforeEach(func() {
+												fakePlanner.CreateStub = func(atc.StepConfig, db.SchedulerResources, atc.VersionedResourceTypes, []db.BuildInput) (atc.Plan, error) {
+													return atc.Plan{
+														Get: &atc.GetPlan{
+															Name:     "some-input",
+															Resource: "some-input",
+														},
+													}, nil
+												}
+
+												rerunBuild.PartialRerunOfReturns(pendingBuild1.ID())
+												rerunBuild.PartialRerunOfNameReturns("1")
+
+												buildToRerun = new(dbfakes.FakeBuild)
+												buildToRerun.IDReturns(pendingBuild1.ID())
+												buildToRerun.NameReturns("1")
+												buildToRerun.StepResultsReturns(map[string]atc.StepResult{
+													"get:some-input": {Version: atc.Version{"some": "version"}},
+												}, nil)
+												job.BuildReturns(buildToRerun, true, nil)
+											})
+
+											It("starts the build reusing the results of the build to rerun", func() {
+												Expect(job.BuildCallCount()).To(Equal(1))
+												Expect(job.BuildArgsForCall(0)).To(Equal("1"))
+
+												Expect(rerunBuild.StartArgsForCall(0)).To(Equal(atc.Plan{
+													Get: &atc.GetPlan{
+														Name:     "some-input",
+														Resource: "some-input",
+														Reuse: &atc.ReusedStep{
+															BuildID:   pendingBuild1.ID(),
+															BuildName: "1",
+															Result:    atc.StepResult{Version: atc.Version{"some": "version"}},
+														},
+													},
+												}))
+
+												Expect(pendingBuild1.StartArgsForCall(0).Get.Reuse).To(BeNil())
+											})
+
+											Context("when getting the step results fails", func() {
+												BeforeEach(func() {
+													buildToRerun.StepResultsReturns(nil, disaster)
+												})
+
+												It("returns the error", func() {
+													Expect(tryStartErr).To(Equal(fmt.Errorf("reuse step results: %w", disaster)))
+												})
+											})
+										})
 									})
 								})
 							})
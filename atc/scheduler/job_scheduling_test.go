@@ -260,7 +260,7 @@ func (example Example) Run() {
 	fakeAlgorithm := new(schedulerfakes.FakeAlgorithm)
 	fakeAlgorithm.ComputeReturns(nil, true, false, nil)
 
-	buildStarter := scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm)
+	buildStarter := scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm, new(dbfakes.FakeTeamQuotaFactory))
 
 	fakeJob := new(dbfakes.FakeJob)
 	fakeJob.ConfigReturns(atc.JobConfig{}, nil)
@@ -0,0 +1,51 @@
+package atc
+
+import "encoding/json"
+
+// stepKeyTypes are the types of public plans which run a named step.
+var stepKeyTypes = []string{"get", "put", "task", "check", "set_pipeline", "load_var"}
+
+// PublicPlanSteps maps the ID of each named step within a public plan to a
+// key identifying the step across builds of a job, such as "task:unit".
+//
+// Plan IDs differ between builds, so these keys are used to compare the
+// events of a step with those of the same step in earlier builds.
+func PublicPlanSteps(plan json.RawMessage) (map[PlanID]string, error) {
+	var tree interface{}
+	err := json.Unmarshal(plan, &tree)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := map[PlanID]string{}
+	collectStepKeys(tree, steps)
+
+	return steps, nil
+}
+
+func collectStepKeys(node interface{}, steps map[PlanID]string) {
+	switch node := node.(type) {
+	case []interface{}:
+		for _, child := range node {
+			collectStepKeys(child, steps)
+		}
+
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, stepType := range stepKeyTypes {
+				step, ok := node[stepType].(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if name, ok := step["name"].(string); ok {
+					steps[PlanID(id)] = stepType + ":" + name
+				}
+			}
+		}
+
+		for _, child := range node {
+			collectStepKeys(child, steps)
+		}
+	}
+}
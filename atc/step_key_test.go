@@ -0,0 +1,43 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PublicPlanSteps", func() {
+	It("maps the ID of each named step to its key", func() {
+		plan := atc.Plan{
+			ID: "1",
+			Do: &atc.DoPlan{
+				{
+					ID: "2",
+					InParallel: &atc.InParallelPlan{
+						Steps: []atc.Plan{
+							{ID: "3", Get: &atc.GetPlan{Name: "repo", Resource: "some-repo"}},
+							{ID: "4", LoadVar: &atc.LoadVarPlan{Name: "version"}},
+						},
+					},
+				},
+				{
+					ID: "5",
+					OnFailure: &atc.OnFailurePlan{
+						Step: atc.Plan{ID: "6", Task: &atc.TaskPlan{Name: "unit"}},
+						Next: atc.Plan{ID: "7", Put: &atc.PutPlan{Name: "notify", Resource: "slack"}},
+					},
+				},
+			},
+		}
+
+		steps, err := atc.PublicPlanSteps(*plan.Public())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(Equal(map[atc.PlanID]string{
+			"3": "get:repo",
+			"4": "load_var:version",
+			"6": "task:unit",
+			"7": "put:notify",
+		}))
+	})
+})
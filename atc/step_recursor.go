@@ -169,3 +169,18 @@ func (recursor StepRecursor) VisitEnsure(step *EnsureStep) error {
 
 	return step.Hook.Config.Visit(recursor)
 }
+
+// VisitAcquire recurses through to the wrapped step.
+func (recursor StepRecursor) VisitAcquire(step *AcquireStep) error {
+	return step.Step.Visit(recursor)
+}
+
+// VisitRelease recurses through to the wrapped step.
+func (recursor StepRecursor) VisitRelease(step *ReleaseStep) error {
+	return step.Step.Visit(recursor)
+}
+
+// VisitIf recurses through to the wrapped step.
+func (recursor StepRecursor) VisitIf(step *IfStep) error {
+	return step.Step.Visit(recursor)
+}
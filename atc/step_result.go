@@ -0,0 +1,53 @@
+package atc
+
+import "encoding/json"
+
+// StepResult is what a successful get, put or task step produced. It is
+// recorded so that a partial rerun of the build can reuse it instead of
+// running the step again.
+type StepResult struct {
+	// Outputs maps the names of the artifacts registered by the step to the
+	// handles of their volumes.
+	Outputs map[string]string `json:"outputs,omitempty"`
+
+	Version  Version         `json:"version,omitempty"`
+	Metadata []MetadataField `json:"metadata,omitempty"`
+}
+
+// ReusedStep marks a step of a partial rerun whose result is taken from an
+// earlier build of the job.
+type ReusedStep struct {
+	BuildID   int        `json:"build_id"`
+	BuildName string     `json:"build_name"`
+	Result    StepResult `json:"result"`
+}
+
+// Public leaves out the result, as it refers to volumes.
+func (reused *ReusedStep) Public() *json.RawMessage {
+	if reused == nil {
+		return nil
+	}
+
+	return enc(struct {
+		BuildID   int    `json:"build_id"`
+		BuildName string `json:"build_name"`
+	}{
+		BuildID:   reused.BuildID,
+		BuildName: reused.BuildName,
+	})
+}
+
+// Plan IDs differ between builds, so step results are recorded under keys
+// made of the step's type and name instead.
+
+func (plan GetPlan) ResultKey() string {
+	if plan.VersionFrom != nil {
+		// the implicit get after a put shares its name
+		return "put:" + plan.Name + ":get"
+	}
+
+	return "get:" + plan.Name
+}
+
+func (plan PutPlan) ResultKey() string  { return "put:" + plan.Name }
+func (plan TaskPlan) ResultKey() string { return "task:" + plan.Name }
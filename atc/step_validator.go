@@ -98,12 +98,33 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 		validator.recordError("unknown resource '%s'", resourceName)
 	}
 
+	if step.Filter != nil {
+		validator.pushContext(".filter")
+
+		for _, err := range step.Filter.Validate() {
+			validator.recordError("%s", err)
+		}
+
+		if step.Version != nil && step.Version.Pinned != nil {
+			validator.recordError("cannot be combined with a pinned version")
+		}
+
+		validator.popContext()
+	}
+
 	validator.pushContext(".passed")
 
 	for _, job := range step.Passed {
 		jobConfig, found := validator.config.Jobs.Lookup(job)
 		if !found {
-			validator.recordError("unknown job '%s'", job)
+			passedJob, valid := ParsePassedJob(job)
+			if !valid {
+				validator.recordError("invalid job reference '%s'", job)
+			} else if passedJob.IsLocal() {
+				validator.recordError("unknown job '%s'", job)
+			}
+
+			// jobs in other pipelines are resolved when the pipeline is saved
 			continue
 		}
 
@@ -331,6 +352,87 @@ func (validator *StepValidator) VisitEnsure(step *EnsureStep) error {
 	return step.Hook.Config.Visit(validator)
 }
 
+func (validator *StepValidator) VisitAcquire(step *AcquireStep) error {
+	err := step.Step.Visit(validator)
+	if err != nil {
+		return err
+	}
+
+	validator.pushContext(".acquire")
+	defer validator.popContext()
+
+	if len(step.Locks) == 0 {
+		validator.recordError("no locks specified")
+	}
+
+	for i, lock := range step.Locks {
+		validator.pushContext("[%d]", i)
+
+		if lock.Name == "" {
+			validator.recordError("no name specified")
+		}
+
+		if lock.Size < 0 {
+			validator.recordError("size cannot be negative")
+		}
+
+		validator.popContext()
+	}
+
+	return nil
+}
+
+func (validator *StepValidator) VisitRelease(step *ReleaseStep) error {
+	err := step.Step.Visit(validator)
+	if err != nil {
+		return err
+	}
+
+	validator.pushContext(".release")
+	defer validator.popContext()
+
+	if len(step.Locks) == 0 {
+		validator.recordError("no locks specified")
+	}
+
+	for i, lock := range step.Locks {
+		validator.pushContext("[%d]", i)
+
+		if lock.Name == "" {
+			validator.recordError("no name specified")
+		}
+
+		if lock.Size != 0 {
+			validator.recordError("size can only be specified when acquiring")
+		}
+
+		validator.popContext()
+	}
+
+	return nil
+}
+
+func (validator *StepValidator) VisitIf(step *IfStep) error {
+	err := step.Step.Visit(validator)
+	if err != nil {
+		return err
+	}
+
+	validator.pushContext(".if")
+	defer validator.popContext()
+
+	_, err = ParseCondition(step.Condition)
+	if err != nil {
+		if syntaxErr, ok := err.(ConditionSyntaxError); ok {
+			validator.recordError("%s (at position %d)", syntaxErr.Message, syntaxErr.Position)
+		} else {
+			validator.recordError(err.Error())
+		}
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) recordWarning(message string, args ...interface{}) {
 	validator.Warnings = append(validator.Warnings, validator.annotate(fmt.Sprintf(message, args...)))
 }
@@ -168,6 +168,9 @@ type StepVisitor interface {
 	VisitOnAbort(*OnAbortStep) error
 	VisitOnError(*OnErrorStep) error
 	VisitEnsure(*EnsureStep) error
+	VisitAcquire(*AcquireStep) error
+	VisitRelease(*ReleaseStep) error
+	VisitIf(*IfStep) error
 }
 
 // StepDetector is a simple structure used to detect whether a step type is
@@ -185,6 +188,18 @@ type StepDetector struct {
 // some important inter-modifier precedence - while core step types are parsed
 // last.
 var StepPrecedence = []StepDetector{
+	{
+		Key: "if",
+		New: func() StepConfig { return &IfStep{} },
+	},
+	{
+		Key: "release",
+		New: func() StepConfig { return &ReleaseStep{} },
+	},
+	{
+		Key: "acquire",
+		New: func() StepConfig { return &AcquireStep{} },
+	},
 	{
 		Key: "ensure",
 		New: func() StepConfig { return &EnsureStep{} },
@@ -252,13 +267,15 @@ var StepPrecedence = []StepDetector{
 }
 
 type GetStep struct {
-	Name     string         `json:"get"`
-	Resource string         `json:"resource,omitempty"`
-	Version  *VersionConfig `json:"version,omitempty"`
-	Params   Params         `json:"params,omitempty"`
-	Passed   []string       `json:"passed,omitempty"`
-	Trigger  bool           `json:"trigger,omitempty"`
-	Tags     Tags           `json:"tags,omitempty"`
+	Name           string          `json:"get"`
+	Resource       string          `json:"resource,omitempty"`
+	Version        *VersionConfig  `json:"version,omitempty"`
+	Filter         *VersionFilter  `json:"filter,omitempty"`
+	Params         Params          `json:"params,omitempty"`
+	Passed         []string        `json:"passed,omitempty"`
+	Trigger        bool            `json:"trigger,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
 }
 
 func (step *GetStep) ResourceName() string {
@@ -281,12 +298,13 @@ func (step *GetStep) Visit(v StepVisitor) error {
 }
 
 type PutStep struct {
-	Name      string        `json:"put"`
-	Resource  string        `json:"resource,omitempty"`
-	Params    Params        `json:"params,omitempty"`
-	Inputs    *InputsConfig `json:"inputs,omitempty"`
-	Tags      Tags          `json:"tags,omitempty"`
-	GetParams Params        `json:"get_params,omitempty"`
+	Name           string          `json:"put"`
+	Resource       string          `json:"resource,omitempty"`
+	Params         Params          `json:"params,omitempty"`
+	Inputs         *InputsConfig   `json:"inputs,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+	GetParams      Params          `json:"get_params,omitempty"`
 }
 
 func (step *PutStep) ResourceName() string {
@@ -316,9 +334,11 @@ type TaskStep struct {
 	Params            Params            `json:"params,omitempty"`
 	Vars              Params            `json:"vars,omitempty"`
 	Tags              Tags              `json:"tags,omitempty"`
+	WorkerSelector    *WorkerSelector   `json:"worker_selector,omitempty"`
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
+	CacheResult       bool              `json:"cache_result,omitempty"`
 }
 
 func (step *TaskStep) ParseJSON(data []byte) error {
@@ -642,6 +662,88 @@ func (step *EnsureStep) Visit(v StepVisitor) error {
 	return v.VisitEnsure(step)
 }
 
+// AcquireStep acquires locks from team-scoped lock pools before running the
+// wrapped step. The locks are held by the build until they are released by a
+// ReleaseStep or the build completes.
+type AcquireStep struct {
+	Step  StepConfig  `json:"-"`
+	Locks LockConfigs `json:"acquire"`
+}
+
+func (step *AcquireStep) ParseJSON(data []byte) error {
+	return json.Unmarshal(data, step)
+}
+
+func (step *AcquireStep) Wrap(sub StepConfig) {
+	if step.Step != nil {
+		step.Step.Wrap(sub)
+	} else {
+		step.Step = sub
+	}
+}
+
+func (step *AcquireStep) Unwrap() StepConfig {
+	return step.Step
+}
+
+func (step *AcquireStep) Visit(v StepVisitor) error {
+	return v.VisitAcquire(step)
+}
+
+// ReleaseStep releases locks held by the build once the wrapped step has
+// finished, regardless of its result.
+type ReleaseStep struct {
+	Step  StepConfig  `json:"-"`
+	Locks LockConfigs `json:"release"`
+}
+
+func (step *ReleaseStep) ParseJSON(data []byte) error {
+	return json.Unmarshal(data, step)
+}
+
+func (step *ReleaseStep) Wrap(sub StepConfig) {
+	if step.Step != nil {
+		step.Step.Wrap(sub)
+	} else {
+		step.Step = sub
+	}
+}
+
+func (step *ReleaseStep) Unwrap() StepConfig {
+	return step.Step
+}
+
+func (step *ReleaseStep) Visit(v StepVisitor) error {
+	return v.VisitRelease(step)
+}
+
+// IfStep runs the wrapped step only if its condition holds. Otherwise the
+// step, along with any hooks configured on it, is skipped.
+type IfStep struct {
+	Step      StepConfig `json:"-"`
+	Condition string     `json:"if"`
+}
+
+func (step *IfStep) ParseJSON(data []byte) error {
+	return json.Unmarshal(data, step)
+}
+
+func (step *IfStep) Wrap(sub StepConfig) {
+	if step.Step != nil {
+		step.Step.Wrap(sub)
+	} else {
+		step.Step = sub
+	}
+}
+
+func (step *IfStep) Unwrap() StepConfig {
+	return step.Step
+}
+
+func (step *IfStep) Visit(v StepVisitor) error {
+	return v.VisitIf(step)
+}
+
 // A VersionConfig represents the choice to include every version of a
 // resource, the latest version of a resource, or a pinned (specific) one.
 type VersionConfig struct {
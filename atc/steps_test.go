@@ -42,6 +42,62 @@ var factoryTests = []StepTest{
 			Tags:     []string{"tag-1", "tag-2"},
 		},
 	},
+	{
+		Title: "get step with filter",
+		ConfigYAML: `
+			get: some-name
+			version: every
+			filter:
+			  metadata: {branch: master}
+			  version: {ref: "^[0-9a-f]+$"}
+			  semver: {field: tag, range: ">=1.2 <2"}
+		`,
+		StepConfig: &atc.GetStep{
+			Name:    "some-name",
+			Version: &atc.VersionConfig{Every: true},
+			Filter: &atc.VersionFilter{
+				Metadata: map[string]string{"branch": "master"},
+				Version:  map[string]string{"ref": "^[0-9a-f]+$"},
+				Semver:   &atc.SemverFilter{Field: "tag", Range: ">=1.2 <2"},
+			},
+		},
+	},
+	{
+		Title: "get step with worker selector",
+		ConfigYAML: `
+			get: some-name
+			worker_selector:
+			  required: ["zone in (us-east, us-west)"]
+			  preferred: [disk=ssd, gpu]
+		`,
+		StepConfig: &atc.GetStep{
+			Name: "some-name",
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorIn, Values: []string{"us-east", "us-west"}},
+				},
+				Preferred: []atc.LabelExpression{
+					{Key: "disk", Operator: atc.LabelOperatorEquals, Values: []string{"ssd"}},
+					{Key: "gpu", Operator: atc.LabelOperatorExists},
+				},
+			},
+		},
+	},
+	{
+		Title: "task step with worker selector list",
+		ConfigYAML: `
+			task: some-task
+			worker_selector: [zone!=us-east]
+		`,
+		StepConfig: &atc.TaskStep{
+			Name: "some-task",
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorNotEquals, Values: []string{"us-east"}},
+				},
+			},
+		},
+	},
 	{
 		Title: "put step",
 
@@ -78,6 +134,7 @@ var factoryTests = []StepTest{
 			input_mapping: {generic: specific}
 			output_mapping: {specific: generic}
 			image: some-image
+			cache_result: true
 		`,
 
 		StepConfig: &atc.TaskStep{
@@ -94,6 +151,7 @@ var factoryTests = []StepTest{
 			InputMapping:      map[string]string{"generic": "specific"},
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
+			CacheResult:       true,
 		},
 	},
 	{
@@ -303,6 +361,68 @@ var factoryTests = []StepTest{
 			Attempts: 3,
 		},
 	},
+	{
+		Title: "acquire modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			acquire: [some-lock, {name: some-pool, size: 3}]
+		`,
+
+		StepConfig: &atc.AcquireStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Locks: atc.LockConfigs{
+				{Name: "some-lock"},
+				{Name: "some-pool", Size: 3},
+			},
+		},
+	},
+	{
+		Title: "release modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			acquire: some-lock
+			release: some-lock
+		`,
+
+		StepConfig: &atc.ReleaseStep{
+			Step: &atc.AcquireStep{
+				Step: &atc.LoadVarStep{
+					Name: "some-var",
+					File: "some-file",
+				},
+				Locks: atc.LockConfigs{{Name: "some-lock"}},
+			},
+			Locks: atc.LockConfigs{{Name: "some-lock"}},
+		},
+	},
+	{
+		Title: "if modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			if: build.trigger == "manual"
+			acquire: some-lock
+		`,
+
+		StepConfig: &atc.IfStep{
+			Step: &atc.AcquireStep{
+				Step: &atc.LoadVarStep{
+					Name: "some-var",
+					File: "some-file",
+				},
+				Locks: atc.LockConfigs{{Name: "some-lock"}},
+			},
+			Condition: `build.trigger == "manual"`,
+		},
+	},
 	{
 		Title: "precedence of all hooks and modifiers",
 
@@ -387,6 +507,14 @@ var factoryTests = []StepTest{
 		`,
 		Err: `error unmarshaling JSON: while decoding JSON: malformed get step: json: unknown field "bogus"`,
 	},
+	{
+		Title: "invalid worker selector with put step",
+		ConfigYAML: `
+			put: some-name
+			worker_selector: ["zone like us-east"]
+		`,
+		Err: `error unmarshaling JSON: while decoding JSON: malformed put step: invalid label expression 'zone like us-east': unknown operator`,
+	},
 	{
 		Title: "multiple steps defined",
 		ConfigYAML: `
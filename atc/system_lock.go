@@ -0,0 +1,37 @@
+package atc
+
+import "time"
+
+// SystemLockReleaseChannel is notified when an ATC is requested to release
+// one of its locks.
+const SystemLockReleaseChannel = "system_lock_release"
+
+// SystemLock is a database advisory lock held by one of the ATCs, e.g. while
+// tracking a build or scheduling a job.
+type SystemLock struct {
+	Type string `json:"type"`
+	ID   []int  `json:"id"`
+
+	// Object describes what the lock guards, e.g. a build or a job, if it could
+	// be determined.
+	Object string `json:"object,omitempty"`
+
+	// ATC is the name of the ATC holding the lock, and SessionID identifies
+	// its database session.
+	ATC        string `json:"atc,omitempty"`
+	ClientAddr string `json:"client_addr,omitempty"`
+	SessionID  int    `json:"session_id"`
+
+	// HoldDuration is how long the lock has been held, in seconds. It is only
+	// known to the ATC holding the lock, and is otherwise omitted.
+	HoldDuration float64 `json:"hold_duration,omitempty"`
+}
+
+// Held returns how long the lock has been held, if known.
+func (lock SystemLock) Held() (time.Duration, bool) {
+	if lock.HoldDuration == 0 {
+		return 0, false
+	}
+
+	return time.Duration(lock.HoldDuration * float64(time.Second)), true
+}
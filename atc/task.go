@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 )
@@ -22,6 +23,9 @@ type TaskConfig struct {
 	// Limits to set on the Task Container
 	Limits *ContainerLimits `json:"container_limits,omitempty"`
 
+	// Resources to reserve for the task on its worker.
+	Resources *TaskResources `json:"resources,omitempty"`
+
 	// Parameters to pass to the task via environment variables.
 	Params TaskEnv `json:"params,omitempty"`
 
@@ -36,6 +40,9 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Sidecar containers started alongside the task and torn down with it.
+	Services []TaskServiceConfig `json:"services,omitempty"`
 }
 
 type ContainerLimits struct {
@@ -43,6 +50,12 @@ type ContainerLimits struct {
 	Memory *uint64 `json:"memory,omitempty"`
 }
 
+type TaskResources struct {
+	// Requests are used to place the task on a worker with enough capacity
+	// left for them.
+	Requests *ResourceRequests `json:"requests,omitempty"`
+}
+
 type ImageResource struct {
 	Type   string `json:"type"`
 	Source Source `json:"source"`
@@ -87,6 +100,7 @@ func (config TaskConfig) Validate() error {
 
 	errors = append(errors, config.validateInputContainsNames()...)
 	errors = append(errors, config.validateOutputContainsNames()...)
+	errors = append(errors, config.validateServices()...)
 
 	if len(errors) > 0 {
 		return TaskValidationError{
@@ -121,6 +135,54 @@ func (config TaskConfig) validateInputContainsNames() []string {
 	return messages
 }
 
+func (config TaskConfig) validateServices() []string {
+	var messages []string
+
+	names := map[string]bool{}
+	envNames := map[string]string{}
+	for i, service := range config.Services {
+		identifier := fmt.Sprintf("service in position %d", i)
+		if service.Name == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing a name", identifier))
+		} else {
+			identifier = fmt.Sprintf("service '%s'", service.Name)
+
+			if names[service.Name] {
+				messages = append(messages, fmt.Sprintf("  %s is declared more than once", identifier))
+			} else if other, found := envNames[service.EnvName()]; found {
+				messages = append(messages, fmt.Sprintf("  %s has the same environment variable name as service '%s' (%s)", identifier, other, service.EnvName()))
+			}
+
+			names[service.Name] = true
+			envNames[service.EnvName()] = service.Name
+		}
+
+		if service.ImageResource == nil {
+			messages = append(messages, fmt.Sprintf("  %s is missing an image_resource", identifier))
+		}
+
+		if service.Run.Path == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing path to executable to run", identifier))
+		}
+
+		if service.Readiness != nil {
+			if service.Readiness.Run.Path == "" {
+				messages = append(messages, fmt.Sprintf("  %s readiness probe is missing path to executable to run", identifier))
+			}
+
+			if _, err := service.Readiness.IntervalDuration(); err != nil {
+				messages = append(messages, fmt.Sprintf("  %s readiness probe has invalid interval: %s", identifier, err))
+			}
+
+			if _, err := service.Readiness.TimeoutDuration(); err != nil {
+				messages = append(messages, fmt.Sprintf("  %s readiness probe has invalid timeout: %s", identifier, err))
+			}
+		}
+	}
+
+	return messages
+}
+
 type TaskRunConfig struct {
 	Path string   `json:"path"`
 	Args []string `json:"args,omitempty"`
@@ -141,6 +203,73 @@ type TaskOutputConfig struct {
 	Path string `json:"path,omitempty"`
 }
 
+// TaskServiceConfig describes a sidecar container that runs for the duration
+// of the task, e.g. a database used by integration tests. The task reaches it
+// through the SERVICE_<NAME>_HOST and SERVICE_<NAME>_PORT environment
+// variables.
+type TaskServiceConfig struct {
+	Name string `json:"name"`
+
+	ImageResource *ImageResource `json:"image_resource"`
+
+	// Environment variables to set in the service container.
+	Env TaskEnv `json:"env,omitempty"`
+
+	// Ports the service listens on. The first port is exposed to the task as
+	// SERVICE_<NAME>_PORT.
+	Ports []uint16 `json:"ports,omitempty"`
+
+	// Command that starts the service.
+	Run TaskRunConfig `json:"run"`
+
+	// Command run in the service container to determine whether the service is
+	// ready. The task does not start until it exits 0.
+	Readiness *TaskServiceReadiness `json:"readiness,omitempty"`
+}
+
+const (
+	DefaultServiceReadinessInterval = time.Second
+	DefaultServiceReadinessTimeout  = time.Minute
+)
+
+type TaskServiceReadiness struct {
+	Run TaskRunConfig `json:"run"`
+
+	// How long to wait between readiness checks (defaults to 1s).
+	Interval string `json:"interval,omitempty"`
+
+	// How long to wait for the service to become ready (defaults to 1m).
+	Timeout string `json:"timeout,omitempty"`
+}
+
+func (readiness TaskServiceReadiness) IntervalDuration() (time.Duration, error) {
+	if readiness.Interval == "" {
+		return DefaultServiceReadinessInterval, nil
+	}
+
+	return time.ParseDuration(readiness.Interval)
+}
+
+func (readiness TaskServiceReadiness) TimeoutDuration() (time.Duration, error) {
+	if readiness.Timeout == "" {
+		return DefaultServiceReadinessTimeout, nil
+	}
+
+	return time.ParseDuration(readiness.Timeout)
+}
+
+// EnvName returns the service name in the form used for the environment
+// variables exposed to the task, e.g. "my-db" becomes "MY_DB".
+func (service TaskServiceConfig) EnvName() string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, service.Name))
+}
+
 type TaskCacheConfig struct {
 	Path string `json:"path,omitempty"`
 }
@@ -213,6 +213,38 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when resource requests are specified", func() {
+			It("parses the requests", func() {
+				data := []byte(`
+platform: beos
+resources:
+  requests: { cpu: 500m, memory: 2GB }
+
+run: {path: a/file}
+`)
+				task, err := NewTaskConfig(data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Resources).To(Equal(&TaskResources{
+					Requests: &ResourceRequests{
+						CPU:    500,
+						Memory: 2 * 1024 * 1024 * 1024,
+					},
+				}))
+			})
+
+			It("rejects invalid requests", func() {
+				data := []byte(`
+platform: beos
+resources:
+  requests: { cpu: lots }
+
+run: {path: a/file}
+`)
+				_, err := NewTaskConfig(data)
+				Expect(err).To(MatchError(ContainSubstring("invalid cpu quantity 'lots'")))
+			})
+		})
+
 		Context("when container limits are specified", func() {
 			Context("when memory and cpu limits are correctly specified", func() {
 				It("successfully parses the limits with memory units", func() {
@@ -386,6 +418,94 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has services", func() {
+			BeforeEach(func() {
+				validConfig.Services = []TaskServiceConfig{
+					{
+						Name:          "db",
+						ImageResource: &ImageResource{Type: "registry-image", Source: Source{"repository": "postgres"}},
+						Ports:         []uint16{5432},
+						Run:           TaskRunConfig{Path: "postgres"},
+						Readiness: &TaskServiceReadiness{
+							Run:      TaskRunConfig{Path: "pg_isready"},
+							Interval: "2s",
+						},
+					},
+				}
+
+				invalidConfig.Services = append([]TaskServiceConfig{}, validConfig.Services...)
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when service.name is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Services[0].Name = ""
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service in position 0 is missing a name")))
+				})
+			})
+
+			Context("when a service is declared twice", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(invalidConfig.Services, invalidConfig.Services[0])
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'db' is declared more than once")))
+				})
+			})
+
+			Context("when services have names which map to the same environment variables", func() {
+				BeforeEach(func() {
+					other := invalidConfig.Services[0]
+					other.Name = "DB"
+					invalidConfig.Services = append(invalidConfig.Services, other)
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'DB' has the same environment variable name as service 'db' (DB)")))
+				})
+			})
+
+			Context("when service.image_resource is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Services[0].ImageResource = nil
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'db' is missing an image_resource")))
+				})
+			})
+
+			Context("when service.run is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Services[0].Run.Path = ""
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'db' is missing path to executable to run")))
+				})
+			})
+
+			Context("when the readiness timeout is invalid", func() {
+				BeforeEach(func() {
+					invalidConfig.Services[0].Readiness = &TaskServiceReadiness{
+						Run:     TaskRunConfig{Path: "pg_isready"},
+						Timeout: "forever",
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'db' readiness probe has invalid timeout")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
@@ -398,4 +518,21 @@ run: {path: a/file}
 
 	})
 
+	Describe("TaskServiceConfig", func() {
+		It("converts the name into an environment variable friendly form", func() {
+			Expect(TaskServiceConfig{Name: "my-db.1"}.EnvName()).To(Equal("MY_DB_1"))
+		})
+	})
+
+	Describe("TaskServiceReadiness", func() {
+		It("defaults the interval and timeout", func() {
+			interval, err := TaskServiceReadiness{}.IntervalDuration()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(interval).To(Equal(DefaultServiceReadinessInterval))
+
+			timeout, err := TaskServiceReadiness{}.TimeoutDuration()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timeout).To(Equal(DefaultServiceReadinessTimeout))
+		})
+	})
 })
@@ -13,10 +13,25 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	LintRules LintRules `json:"lint_rules,omitempty"`
+
+	// Quotas replaces the team's quotas when set, and leaves them unchanged
+	// otherwise.
+	Quotas *TeamQuotas `json:"quotas,omitempty"`
 }
 
 func (team Team) Validate() error {
-	return team.Auth.Validate()
+	err := team.Auth.Validate()
+	if err != nil {
+		return err
+	}
+
+	if team.Quotas != nil {
+		return team.Quotas.Validate()
+	}
+
+	return nil
 }
 
 type TeamAuth map[string]map[string][]string
@@ -0,0 +1,90 @@
+package atc
+
+import "fmt"
+
+const (
+	QuotaConcurrentBuilds = "concurrent builds"
+	QuotaContainers       = "containers"
+	QuotaVolumes          = "volumes"
+	QuotaChecksPerMinute  = "checks per minute"
+)
+
+// TeamQuotas limits the resources a team may use at once. A quota of 0 means
+// unlimited.
+type TeamQuotas struct {
+	MaxConcurrentBuilds int `json:"max_concurrent_builds,omitempty"`
+	MaxContainers       int `json:"max_containers,omitempty"`
+	MaxVolumes          int `json:"max_volumes,omitempty"`
+	MaxChecksPerMinute  int `json:"max_checks_per_minute,omitempty"`
+}
+
+func (quotas TeamQuotas) Validate() error {
+	if quotas.MaxConcurrentBuilds < 0 ||
+		quotas.MaxContainers < 0 ||
+		quotas.MaxVolumes < 0 ||
+		quotas.MaxChecksPerMinute < 0 {
+		return fmt.Errorf("quotas must not be negative")
+	}
+
+	return nil
+}
+
+// IsZero returns true if none of the quotas are set.
+func (quotas TeamQuotas) IsZero() bool {
+	return quotas == TeamQuotas{}
+}
+
+// TeamUsage is how much of the resources limited by its quotas a team is
+// currently using.
+type TeamUsage struct {
+	RunningBuilds int `json:"running_builds"`
+	Containers    int `json:"containers"`
+	Volumes       int `json:"volumes"`
+}
+
+// ExceededBuilds returns the exceeded quota if the team may not start another
+// build.
+func (quotas TeamQuotas) ExceededBuilds(usage TeamUsage) (QuotaExceeded, bool) {
+	if quotas.MaxConcurrentBuilds > 0 && usage.RunningBuilds >= quotas.MaxConcurrentBuilds {
+		return QuotaExceeded{
+			Quota: QuotaConcurrentBuilds,
+			Limit: quotas.MaxConcurrentBuilds,
+			Usage: usage.RunningBuilds,
+		}, true
+	}
+
+	return QuotaExceeded{}, false
+}
+
+// ExceededContainers returns the exceeded quota if the team may not create
+// another container, along with its volumes.
+func (quotas TeamQuotas) ExceededContainers(usage TeamUsage) (QuotaExceeded, bool) {
+	if quotas.MaxContainers > 0 && usage.Containers >= quotas.MaxContainers {
+		return QuotaExceeded{
+			Quota: QuotaContainers,
+			Limit: quotas.MaxContainers,
+			Usage: usage.Containers,
+		}, true
+	}
+
+	if quotas.MaxVolumes > 0 && usage.Volumes >= quotas.MaxVolumes {
+		return QuotaExceeded{
+			Quota: QuotaVolumes,
+			Limit: quotas.MaxVolumes,
+			Usage: usage.Volumes,
+		}, true
+	}
+
+	return QuotaExceeded{}, false
+}
+
+// QuotaExceeded describes a quota which a team has reached.
+type QuotaExceeded struct {
+	Quota string `json:"quota"`
+	Limit int    `json:"limit"`
+	Usage int    `json:"usage"`
+}
+
+func (exceeded QuotaExceeded) String() string {
+	return fmt.Sprintf("team is using %d of its %d %s", exceeded.Usage, exceeded.Limit, exceeded.Quota)
+}
@@ -0,0 +1,399 @@
+package atc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VersionFilter narrows down the versions of a resource which a get step may
+// use. Every configured criterion must match.
+type VersionFilter struct {
+	// Metadata requires the version's metadata to contain each name/value
+	// pair exactly.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Version requires each named version field to match a regular
+	// expression.
+	Version map[string]string `json:"version,omitempty"`
+
+	// Semver requires a version field to be a semantic version within a
+	// range.
+	Semver *SemverFilter `json:"semver,omitempty"`
+}
+
+type SemverFilter struct {
+	Field string `json:"field"`
+	Range string `json:"range"`
+}
+
+// IsEmpty returns true if the filter has no criteria, and so matches every
+// version.
+func (filter *VersionFilter) IsEmpty() bool {
+	return filter == nil ||
+		len(filter.Metadata) == 0 && len(filter.Version) == 0 && filter.Semver == nil
+}
+
+// Validate returns an error for each regular expression or semver range in
+// the filter which does not parse.
+func (filter *VersionFilter) Validate() []error {
+	if filter == nil {
+		return nil
+	}
+
+	var errs []error
+	for field, expr := range filter.Version {
+		_, err := regexp.Compile(expr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid regular expression for version field '%s': %s", field, err))
+		}
+	}
+
+	if filter.Semver != nil {
+		if filter.Semver.Field == "" {
+			errs = append(errs, fmt.Errorf("semver filter must specify a version field"))
+		}
+
+		_, err := ParseSemverRange(filter.Semver.Range)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// SemverKey orders semantic versions: major, minor, patch, 1 for a release
+// or 0 for a prerelease, and then each prerelease identifier. A numeric
+// identifier is encoded as 0 and its value, and any other identifier as 1, its
+// characters, and a terminating 0, so that keys compare by semver precedence.
+//
+// The semver_key database function computes the same key, so that ranges can
+// be matched in queries.
+type SemverKey []int64
+
+func (key SemverKey) Compare(other SemverKey) int {
+	for i := 0; i < len(key) && i < len(other); i++ {
+		if key[i] < other[i] {
+			return -1
+		}
+
+		if key[i] > other[i] {
+			return 1
+		}
+	}
+
+	switch {
+	case len(key) < len(other):
+		return -1
+	case len(key) > len(other):
+		return 1
+	default:
+		return 0
+	}
+}
+
+var semverPattern = regexp.MustCompile(`^v?(\d{1,18})(?:\.(\d{1,18}))?(?:\.(\d{1,18}))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// ParseSemverKey returns the key of a version string, which may omit its minor
+// and patch numbers. It returns false if the string is not a version.
+func ParseSemverKey(version string) (SemverKey, bool) {
+	match := semverPattern.FindStringSubmatch(version)
+	if match == nil {
+		return SemverKey{}, false
+	}
+
+	key := make(SemverKey, 4)
+	for i := 0; i < 3; i++ {
+		if match[i+1] != "" {
+			key[i], _ = strconv.ParseInt(match[i+1], 10, 64)
+		}
+	}
+
+	if match[4] == "" {
+		key[3] = 1
+	}
+
+	return appendPrerelease(key, match[4]), true
+}
+
+var numericIdentifierPattern = regexp.MustCompile(`^\d{1,18}$`)
+
+// appendPrerelease encodes the identifiers of a prerelease such as "-rc.1"
+// onto a key. Numeric identifiers too long for an int64 are compared as text.
+func appendPrerelease(key SemverKey, prerelease string) SemverKey {
+	if prerelease == "" {
+		return key
+	}
+
+	for _, identifier := range strings.Split(prerelease[1:], ".") {
+		if numericIdentifierPattern.MatchString(identifier) {
+			n, _ := strconv.ParseInt(identifier, 10, 64)
+			key = append(key, 0, n)
+			continue
+		}
+
+		key = append(key, 1)
+		for _, c := range []byte(identifier) {
+			key = append(key, int64(c))
+		}
+
+		key = append(key, 0)
+	}
+
+	return key
+}
+
+// SemverComparator compares a version's key against a bound with one of =,
+// <, <=, > or >=.
+type SemverComparator struct {
+	Operator string
+	Key      SemverKey
+}
+
+func (comparator SemverComparator) Matches(key SemverKey) bool {
+	cmp := key.Compare(comparator.Key)
+
+	switch comparator.Operator {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// SemverRange is a parsed range such as ">=1.2 <2" or "^1.4 || ~2.0.1". A
+// version matches if it matches every comparator of any alternative.
+type SemverRange [][]SemverComparator
+
+// ParseSemverRange parses a range in the syntax used by npm. Alternatives are
+// separated by ||, and each is a space-separated list of comparators:
+//
+//	1.2.3, =1.2.3  exactly 1.2.3
+//	1.2, 1.2.x     any 1.2 release
+//	>1.2 <=2.0.1   bounds, with partial versions filled in
+//	~1.2.3         at least 1.2.3, below 1.3.0
+//	^1.2.3         at least 1.2.3, below 2.0.0
+//	*              any release
+//
+// A version's prereleases sort below its release and are ordered by semver
+// precedence, so >=1.2 excludes 1.2.0-rc.1, <2 excludes 2.0.0-rc.1, and
+// >1.2.3-rc.2 matches 1.2.3-rc.10.
+func ParseSemverRange(raw string) (SemverRange, error) {
+	var semverRange SemverRange
+
+	for _, alternative := range strings.Split(raw, "||") {
+		tokens := strings.Fields(alternative)
+
+		var comparators []SemverComparator
+		for i := 0; i < len(tokens); i++ {
+			token := tokens[i]
+
+			// allow whitespace between an operator and its version
+			if strings.Trim(token, "<>=~^") == "" && i+1 < len(tokens) {
+				i++
+				token += tokens[i]
+			}
+
+			parsed, err := parseSemverComparator(token)
+			if err != nil {
+				return nil, SemverRangeError{Range: raw, Message: err.Error()}
+			}
+
+			comparators = append(comparators, parsed...)
+		}
+
+		if len(comparators) == 0 {
+			return nil, SemverRangeError{Range: raw, Message: "empty range"}
+		}
+
+		semverRange = append(semverRange, comparators)
+	}
+
+	return semverRange, nil
+}
+
+// Matches returns true if the version string is a semantic version within the
+// range.
+func (semverRange SemverRange) Matches(version string) bool {
+	key, ok := ParseSemverKey(version)
+	if !ok {
+		return false
+	}
+
+	for _, comparators := range semverRange {
+		matched := true
+		for _, comparator := range comparators {
+			if !comparator.Matches(key) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+type SemverRangeError struct {
+	Range   string
+	Message string
+}
+
+func (err SemverRangeError) Error() string {
+	return fmt.Sprintf("invalid semver range '%s': %s", err.Range, err.Message)
+}
+
+var semverBoundPattern = regexp.MustCompile(`^v?(\d{1,18}|[xX*])(?:\.(\d{1,18}|[xX*]))?(?:\.(\d{1,18}|[xX*]))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+func parseSemverComparator(token string) ([]SemverComparator, error) {
+	operator := ""
+	for _, op := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(token, op) {
+			operator = op
+			break
+		}
+	}
+
+	bound := strings.TrimPrefix(token, operator)
+
+	match := semverBoundPattern.FindStringSubmatch(bound)
+	if match == nil {
+		return nil, fmt.Errorf("invalid version '%s'", bound)
+	}
+
+	// parts holds the numbers given before the first wildcard or omitted
+	// number
+	var parts []int64
+	for i := 1; i <= 3; i++ {
+		part := match[i]
+		if part == "" || part == "x" || part == "X" || part == "*" {
+			break
+		}
+
+		n, _ := strconv.ParseInt(part, 10, 64)
+		parts = append(parts, n)
+	}
+
+	prerelease := match[4]
+	if prerelease != "" && len(parts) < 3 {
+		return nil, fmt.Errorf("prerelease of partial version '%s'", bound)
+	}
+
+	lower := semverFloor(parts, prerelease)
+
+	switch operator {
+	case "", "=":
+		if len(parts) == 3 {
+			return []SemverComparator{{"=", lower}}, nil
+		}
+
+		return semverBetween(lower, semverCeiling(parts, len(parts))), nil
+
+	case ">=":
+		return []SemverComparator{{">=", lower}}, nil
+
+	case ">":
+		if len(parts) == 3 {
+			return []SemverComparator{{">", lower}}, nil
+		}
+
+		if len(parts) == 0 {
+			return nil, fmt.Errorf("no version is greater than '%s'", bound)
+		}
+
+		above := semverCeiling(parts, len(parts))
+		above[3] = 1
+
+		return []SemverComparator{{">=", above}}, nil
+
+	case "<":
+		if prerelease != "" {
+			return []SemverComparator{{"<", lower}}, nil
+		}
+
+		return []SemverComparator{{"<", semverKey(parts, 0)}}, nil
+
+	case "<=":
+		if len(parts) == 3 {
+			return []SemverComparator{{"<=", lower}}, nil
+		}
+
+		if len(parts) == 0 {
+			return []SemverComparator{{">=", lower}}, nil
+		}
+
+		return []SemverComparator{{"<", semverCeiling(parts, len(parts))}}, nil
+
+	case "~":
+		precision := len(parts)
+		if precision > 2 {
+			precision = 2
+		}
+
+		return semverBetween(lower, semverCeiling(parts, precision)), nil
+
+	default: // "^"
+		precision := 1
+		for precision < len(parts) && parts[precision-1] == 0 {
+			precision++
+		}
+
+		if len(parts) == 0 {
+			precision = 0
+		}
+
+		return semverBetween(lower, semverCeiling(parts, precision)), nil
+	}
+}
+
+// semverFloor is the lowest release matching the given parts, or the given
+// prerelease.
+func semverFloor(parts []int64, prerelease string) SemverKey {
+	if prerelease != "" {
+		return appendPrerelease(semverKey(parts, 0), prerelease)
+	}
+
+	return semverKey(parts, 1)
+}
+
+// semverCeiling is the lowest version, including prereleases, which is above
+// every version matching the first precision parts. A precision of zero has
+// no ceiling.
+func semverCeiling(parts []int64, precision int) SemverKey {
+	if precision == 0 {
+		return nil
+	}
+
+	ceiling := make([]int64, precision)
+	copy(ceiling, parts)
+	ceiling[precision-1]++
+
+	return semverKey(ceiling, 0)
+}
+
+func semverBetween(lower SemverKey, upper SemverKey) []SemverComparator {
+	comparators := []SemverComparator{{">=", lower}}
+	if upper != nil {
+		comparators = append(comparators, SemverComparator{"<", upper})
+	}
+
+	return comparators
+}
+
+func semverKey(parts []int64, release int64) SemverKey {
+	key := make(SemverKey, 4)
+	copy(key, parts)
+	key[3] = release
+	return key
+}
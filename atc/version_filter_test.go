@@ -0,0 +1,92 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionFilter", func() {
+	DescribeTable("semver ranges",
+		func(semverRange string, version string, expected bool) {
+			parsed, err := atc.ParseSemverRange(semverRange)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Matches(version)).To(Equal(expected))
+		},
+		Entry("exact", "1.2.3", "1.2.3", true),
+		Entry("exact with operator", "=1.2.3", "1.2.4", false),
+		Entry("leading v", "1.2.3", "v1.2.3", true),
+		Entry("build metadata is ignored", "1.2.3", "1.2.3+abc", true),
+		Entry("partial version", "1.2", "1.2.9", true),
+		Entry("partial version excludes the next minor", "1.2", "1.3.0", false),
+		Entry("wildcard", "1.x", "1.9.0", true),
+		Entry("star", "*", "0.0.1", true),
+		Entry("not a version", "*", "latest", false),
+		Entry("lower bound", ">=1.2", "1.2.0", true),
+		Entry("lower bound excludes prereleases", ">=1.2", "1.2.0-rc.1", false),
+		Entry("exclusive lower bound", ">1.2.3", "1.2.3", false),
+		Entry("exclusive partial lower bound", ">1.2", "1.2.9", false),
+		Entry("exclusive partial lower bound above", ">1.2", "1.3.0", true),
+		Entry("exclusive partial lower bound excludes prereleases", ">1.2", "1.3.0-rc.1", false),
+		Entry("upper bound", "<2", "1.99.99", true),
+		Entry("upper bound excludes its prereleases", "<2", "2.0.0-rc.1", false),
+		Entry("inclusive partial upper bound", "<=1.2", "1.2.7", true),
+		Entry("bounded range", ">=1.2 <2", "1.5.0", true),
+		Entry("bounded range with spaces", ">= 1.2 < 2", "2.0.0", false),
+		Entry("tilde", "~1.2.3", "1.2.9", true),
+		Entry("tilde below", "~1.2.3", "1.2.2", false),
+		Entry("tilde above", "~1.2.3", "1.3.0", false),
+		Entry("caret", "^1.2.3", "1.9.0", true),
+		Entry("caret above", "^1.2.3", "2.0.0", false),
+		Entry("caret on zero major", "^0.2.3", "0.3.0", false),
+		Entry("caret on zero minor", "^0.0.3", "0.0.4", false),
+		Entry("prerelease lower bound", ">=1.2.3-rc.1", "1.2.3-rc.2", true),
+		Entry("prerelease lower bound release", ">=1.2.3-rc.1", "1.2.3", true),
+		Entry("prerelease lower bound excludes earlier prereleases", ">=1.2.3-rc.2", "1.2.3-rc.1", false),
+		Entry("exact prerelease", "1.2.3-rc.1", "1.2.3-rc.2", false),
+		Entry("exclusive prerelease lower bound", ">1.2.3-rc.1", "1.2.3-rc.1", false),
+		Entry("numeric prerelease identifiers", ">1.2.3-rc.9", "1.2.3-rc.10", true),
+		Entry("numeric below alphanumeric identifiers", "<1.2.3-rc.beta", "1.2.3-rc.1", true),
+		Entry("alphanumeric identifiers", "<1.2.3-beta", "1.2.3-alpha", true),
+		Entry("fewer prerelease identifiers", "<1.2.3-alpha.1", "1.2.3-alpha", true),
+		Entry("prerelease upper bound excludes its release", "<=1.2.3-rc.1", "1.2.3", false),
+		Entry("alternatives", "^1.2 || ^3.0", "3.1.0", true),
+		Entry("alternatives without a match", "^1.2 || ^3.0", "2.1.0", false),
+	)
+
+	DescribeTable("invalid semver ranges",
+		func(semverRange string) {
+			_, err := atc.ParseSemverRange(semverRange)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("empty alternative", "1.2 ||"),
+		Entry("not a version", ">=latest"),
+		Entry("dangling operator", ">="),
+		Entry("prerelease of a partial version", "1.2-rc.1"),
+		Entry("hyphen range", "1.2 - 2.0"),
+	)
+
+	Describe("Validate", func() {
+		It("returns errors for invalid regular expressions and ranges", func() {
+			filter := &atc.VersionFilter{
+				Version: map[string]string{"ref": "("},
+				Semver:  &atc.SemverFilter{Range: ">=x.y"},
+			}
+
+			Expect(filter.Validate()).To(HaveLen(3))
+		})
+
+		It("returns nothing for a valid filter", func() {
+			filter := &atc.VersionFilter{
+				Metadata: map[string]string{"branch": "master"},
+				Version:  map[string]string{"ref": "^[0-9a-f]+$"},
+				Semver:   &atc.SemverFilter{Field: "tag", Range: ">=1.2 <2"},
+			}
+
+			Expect(filter.Validate()).To(BeEmpty())
+		})
+	})
+})
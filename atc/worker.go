@@ -10,6 +10,10 @@ type Worker struct {
 	GardenAddr      string `json:"addr"`
 	BaggageclaimURL string `json:"baggageclaim_url"`
 
+	// URL at which other workers can reach this worker's P2P streaming
+	// server. Empty if the worker does not support P2P volume streaming.
+	P2PURL string `json:"p2p_url,omitempty"`
+
 	CertsPath *string `json:"certs_path,omitempty"`
 
 	HTTPProxyURL  string `json:"http_proxy_url,omitempty"`
@@ -20,16 +24,27 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	// Size and usage, in bytes, of the filesystem holding the worker's
+	// volumes. Zero if the worker does not report them.
+	VolumeStoreCapacity int64 `json:"volume_store_capacity,omitempty"`
+	VolumeStoreUsed     int64 `json:"volume_store_used,omitempty"`
+
+	// Capacity available for task resource requests, and the requests
+	// currently committed against it. A zero capacity is unlimited.
+	Allocatable *ResourceRequests `json:"allocatable,omitempty"`
+	Committed   *ResourceRequests `json:"committed,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
-	Platform  string   `json:"platform"`
-	Tags      []string `json:"tags"`
-	Team      string   `json:"team"`
-	Name      string   `json:"name"`
-	Version   string   `json:"version"`
-	StartTime int64    `json:"start_time"`
-	Ephemeral bool     `json:"ephemeral"`
-	State     string   `json:"state"`
+	Platform  string            `json:"platform"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Team      string            `json:"team"`
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	StartTime int64             `json:"start_time"`
+	Ephemeral bool              `json:"ephemeral"`
+	State     string            `json:"state"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
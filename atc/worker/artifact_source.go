@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/compression"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker/p2p"
 	"github.com/concourse/concourse/tracing"
 	"github.com/hashicorp/go-multierror"
 )
@@ -29,7 +30,8 @@ type StreamableArtifactSource interface {
 	ArtifactSource
 	// StreamTo copies the data from the source to the destination. Note that
 	// this potentially uses a lot of network transfer, for larger artifacts, as
-	// the ATC will effectively act as a middleman.
+	// the ATC will effectively act as a middleman, unless P2P streaming is
+	// enabled and both workers support it.
 	StreamTo(context.Context, lager.Logger, ArtifactDestination) error
 
 	// StreamFile returns the contents of a single file in the artifact source.
@@ -41,17 +43,23 @@ type artifactSource struct {
 	artifact    runtime.Artifact
 	volume      Volume
 	compression compression.Compression
+	p2pStreamer p2p.Streamer
 }
 
+// NewStreamableArtifactSource returns a source for the artifact's volume. If
+// p2pStreamer is non-nil, the volume will be streamed directly between
+// workers where possible.
 func NewStreamableArtifactSource(
 	artifact runtime.Artifact,
 	volume Volume,
 	compression compression.Compression,
+	p2pStreamer p2p.Streamer,
 ) StreamableArtifactSource {
 	return &artifactSource{
 		artifact:    artifact,
 		volume:      volume,
 		compression: compression,
+		p2pStreamer: p2pStreamer,
 	}
 }
 
@@ -64,6 +72,21 @@ func (source *artifactSource) StreamTo(
 	ctx, span := tracing.StartSpan(ctx, "artifactSource.StreamTo", nil)
 	defer span.End()
 
+	if destVolume, ok := destination.(Volume); ok && source.canStreamP2P(destVolume) {
+		err := source.streamP2P(ctx, destVolume)
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// the workers may not be able to reach each other; fall back to
+		// streaming through the ATC
+		logger.Info("failed-to-stream-p2p", lager.Data{"error": err.Error()})
+	}
+
 	_, outSpan := tracing.StartSpan(ctx, "volume.StreamOut", tracing.Attrs{
 		"origin-volume": source.volume.Handle(),
 		"origin-worker": source.volume.WorkerName(),
@@ -83,6 +106,39 @@ func (source *artifactSource) StreamTo(
 	return err
 }
 
+func (source *artifactSource) canStreamP2P(destination Volume) bool {
+	return source.p2pStreamer != nil &&
+		source.volume.P2PURL() != "" &&
+		destination.P2PURL() != ""
+}
+
+func (source *artifactSource) streamP2P(ctx context.Context, destination Volume) error {
+	ctx, span := tracing.StartSpan(ctx, "artifactSource.streamP2P", tracing.Attrs{
+		"origin-volume":      source.volume.Handle(),
+		"origin-worker":      source.volume.WorkerName(),
+		"destination-volume": destination.Handle(),
+		"destination-worker": destination.WorkerName(),
+	})
+
+	err := source.p2pStreamer.Stream(
+		ctx,
+		p2p.VolumeRef{
+			URL:    source.volume.P2PURL(),
+			Handle: source.volume.Handle(),
+			Path:   ".",
+		},
+		p2p.VolumeRef{
+			URL:    destination.P2PURL(),
+			Handle: destination.Handle(),
+			Path:   ".",
+		},
+		source.compression.Encoding(),
+	)
+	tracing.End(span, err)
+
+	return err
+}
+
 // TODO: figure out if we want logging before and after streams, I remove logger from private methods
 func (source *artifactSource) StreamFile(
 	ctx context.Context,
@@ -16,6 +16,8 @@ import (
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/p2p"
+	"github.com/concourse/concourse/atc/worker/p2p/p2pfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/onsi/gomega/gbytes"
 
@@ -28,6 +30,7 @@ var _ = Describe("StreamableArtifactSource", func() {
 		fakeDestination *workerfakes.FakeArtifactDestination
 		fakeVolume      *workerfakes.FakeVolume
 		fakeArtifact    *runtimefakes.FakeArtifact
+		fakeStreamer    *p2pfakes.FakeStreamer
 
 		artifactSource worker.StreamableArtifactSource
 		comp           compression.Compression
@@ -40,9 +43,10 @@ var _ = Describe("StreamableArtifactSource", func() {
 		fakeArtifact = new(runtimefakes.FakeArtifact)
 		fakeVolume = new(workerfakes.FakeVolume)
 		fakeDestination = new(workerfakes.FakeArtifactDestination)
+		fakeStreamer = new(p2pfakes.FakeStreamer)
 		comp = compression.NewGzipCompression()
 
-		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp)
+		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, fakeStreamer)
 		testLogger = lager.NewLogger("test")
 		disaster = errors.New("disaster")
 	})
@@ -104,6 +108,83 @@ var _ = Describe("StreamableArtifactSource", func() {
 		})
 	})
 
+	Context("StreamTo a volume", func() {
+		var (
+			fakeDestVolume *workerfakes.FakeVolume
+			outStream      *gbytes.Buffer
+			streamToErr    error
+		)
+
+		BeforeEach(func() {
+			outStream = gbytes.NewBuffer()
+			fakeVolume.StreamOutReturns(outStream, nil)
+			fakeVolume.HandleReturns("source-handle")
+			fakeVolume.P2PURLReturns("http://source-worker:7788")
+
+			fakeDestVolume = new(workerfakes.FakeVolume)
+			fakeDestVolume.HandleReturns("dest-handle")
+			fakeDestVolume.P2PURLReturns("http://dest-worker:7788")
+		})
+
+		JustBeforeEach(func() {
+			streamToErr = artifactSource.StreamTo(context.TODO(), testLogger, fakeDestVolume)
+		})
+
+		Context("when both workers support P2P streaming", func() {
+			It("streams the volume directly between the workers", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeStreamer.StreamCallCount()).To(Equal(1))
+
+				_, source, dest, encoding := fakeStreamer.StreamArgsForCall(0)
+				Expect(source).To(Equal(p2p.VolumeRef{URL: "http://source-worker:7788", Handle: "source-handle", Path: "."}))
+				Expect(dest).To(Equal(p2p.VolumeRef{URL: "http://dest-worker:7788", Handle: "dest-handle", Path: "."}))
+				Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+			})
+
+			It("does not stream through the ATC", func() {
+				Expect(fakeVolume.StreamOutCallCount()).To(BeZero())
+				Expect(fakeDestVolume.StreamInCallCount()).To(BeZero())
+			})
+
+			Context("when P2P streaming fails", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamReturns(disaster)
+				})
+
+				It("falls back to streaming through the ATC", func() {
+					Expect(streamToErr).ToNot(HaveOccurred())
+					Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+
+					_, _, _, streamedIn := fakeDestVolume.StreamInArgsForCall(0)
+					Expect(streamedIn).To(Equal(outStream))
+				})
+			})
+		})
+
+		Context("when the destination worker does not support P2P streaming", func() {
+			BeforeEach(func() {
+				fakeDestVolume.P2PURLReturns("")
+			})
+
+			It("streams through the ATC", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeStreamer.StreamCallCount()).To(BeZero())
+				Expect(fakeDestVolume.StreamInCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when P2P streaming is disabled", func() {
+			BeforeEach(func() {
+				artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, nil)
+			})
+
+			It("streams through the ATC", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeDestVolume.StreamInCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Context("StreamFile", func() {
 		var (
 			streamFileErr    error
@@ -81,6 +81,7 @@ var _ = Describe("RunTaskStep", func() {
 				fakePool,
 				fakeProvider,
 				fakeCompression,
+				nil,
 				workerInterval,
 				workerStatusInterval)
 		})
@@ -3,10 +3,12 @@ package worker
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
@@ -19,6 +21,7 @@ import (
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker/p2p"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -99,12 +102,14 @@ type Client interface {
 func NewClient(pool Pool,
 	provider WorkerProvider,
 	compression compression.Compression,
+	p2pStreamer p2p.Streamer,
 	workerPollingInterval time.Duration,
 	WorkerStatusPublishInterval time.Duration) *client {
 	return &client{
 		pool:                        pool,
 		provider:                    provider,
 		compression:                 compression,
+		p2pStreamer:                 p2pStreamer,
 		workerPollingInterval:       workerPollingInterval,
 		workerStatusPublishInterval: WorkerStatusPublishInterval,
 	}
@@ -114,6 +119,7 @@ type client struct {
 	pool                        Pool
 	provider                    WorkerProvider
 	compression                 compression.Compression
+	p2pStreamer                 p2p.Streamer
 	workerPollingInterval       time.Duration
 	workerStatusPublishInterval time.Duration
 }
@@ -281,6 +287,7 @@ func (client *client) RunTaskStep(
 		containerSpec,
 		workerSpec,
 		processSpec.StdoutWriter,
+		eventDelegate,
 	)
 	if err != nil {
 		return TaskResult{}, err
@@ -290,6 +297,26 @@ func (client *client) RunTaskStep(
 		defer decreaseActiveTasks(logger.Session("decrease-active-tasks"), chosenWorker)
 	}
 
+	if strategy.ModifiesCommittedRequests() && !containerSpec.ResourceRequests.IsZero() {
+		defer releaseRequests(logger.Session("release-requests"), chosenWorker, owner)
+	}
+
+	if len(containerSpec.Services) > 0 {
+		serviceEnv, serviceContainers, err := client.startServices(
+			ctx,
+			logger,
+			chosenWorker,
+			imageFetcherSpec,
+			containerSpec.Services,
+		)
+		defer stopServices(logger.Session("stop-services"), serviceContainers)
+		if err != nil {
+			return TaskResult{}, err
+		}
+
+		containerSpec.Env = append(containerSpec.Env, serviceEnv...)
+	}
+
 	container, err := chosenWorker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -415,13 +442,15 @@ func (client *client) RunGetStep(
 	resource resource.Resource,
 ) (GetResult, error) {
 
-	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+	chosenWorker, err := client.findOrChooseWorkerWithinQuota(
 		ctx,
 		logger,
 		owner,
 		containerSpec,
 		workerSpec,
 		strategy,
+		processSpec.StdoutWriter,
+		eventDelegate,
 	)
 	if err != nil {
 		return GetResult{}, err
@@ -473,13 +502,15 @@ func (client *client) RunPutStep(
 		return PutResult{}, err
 	}
 
-	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+	chosenWorker, err := client.findOrChooseWorkerWithinQuota(
 		ctx,
 		logger,
 		owner,
 		containerSpec,
 		workerSpec,
 		strategy,
+		spec.StdoutWriter,
+		eventDelegate,
 	)
 	if err != nil {
 		return PutResult{}, err
@@ -564,6 +595,7 @@ func (client *client) chooseTaskWorker(
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
 	outputWriter io.Writer,
+	eventDelegate runtime.StartingEventDelegate,
 ) (Worker, error) {
 	var (
 		chosenWorker    Worker
@@ -580,17 +612,61 @@ func (client *client) chooseTaskWorker(
 	defer workerStatusPublishTicker.Stop()
 
 	for {
-		if chosenWorker, err = client.pool.FindOrChooseWorkerForContainer(
+		if chosenWorker, err = client.findOrChooseWorkerWithinQuota(
 			ctx,
 			logger,
 			owner,
 			containerSpec,
 			workerSpec,
 			strategy,
+			outputWriter,
+			eventDelegate,
 		); err != nil {
 			return nil, err
 		}
 
+		if strategy.ModifiesCommittedRequests() {
+			if chosenWorker != nil {
+				committed, err := commitRequests(logger, client.pool, chosenWorker, owner, containerSpec, workerSpec)
+				if err != nil {
+					return nil, err
+				}
+
+				if committed {
+					if elapsed > 0 {
+						message := fmt.Sprintf("Found a worker with enough capacity after waiting %s.\n", elapsed.Round(1*time.Second))
+						writeOutputMessage(logger, outputWriter, message)
+					}
+
+					return chosenWorker, nil
+				}
+			}
+
+			if elapsed == 0 {
+				metric.TasksWaiting.Inc()
+				defer metric.TasksWaiting.Dec()
+
+				if delegate, ok := eventDelegate.(runtime.WaitingEventDelegate); ok {
+					delegate.WaitingForWorker(logger, insufficientCapacityReason(containerSpec.ResourceRequests))
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				logger.Info("aborted-waiting-worker")
+				return nil, ctx.Err()
+			default:
+			}
+
+			elapsed = waitForWorker(logger,
+				workerPollingTicker,
+				workerStatusPublishTicker,
+				outputWriter,
+				started)
+
+			continue
+		}
+
 		if !strategy.ModifiesActiveTasks() {
 			return chosenWorker, nil
 		}
@@ -668,7 +744,7 @@ func (client *client) wireInputsAndCaches(logger lager.Logger, spec *ContainerSp
 				return fmt.Errorf("volume not found for artifact id %v type %T", artifact.ID(), artifact)
 			}
 
-			source := NewStreamableArtifactSource(artifact, artifactVolume, client.compression)
+			source := NewStreamableArtifactSource(artifact, artifactVolume, client.compression, client.p2pStreamer)
 			inputs = append(inputs, inputSource{source, path})
 		}
 	}
@@ -689,7 +765,7 @@ func (client *client) wireImageVolume(logger lager.Logger, spec *ImageSpec) erro
 		return fmt.Errorf("volume not found for artifact id %v type %T", imageArtifact.ID(), imageArtifact)
 	}
 
-	spec.ImageArtifactSource = NewStreamableArtifactSource(imageArtifact, artifactVolume, client.compression)
+	spec.ImageArtifactSource = NewStreamableArtifactSource(imageArtifact, artifactVolume, client.compression, client.p2pStreamer)
 
 	return nil
 }
@@ -707,6 +783,56 @@ func lockName(resourceJSON []byte, workerName string) string {
 	return fmt.Sprintf("%x", sha256.Sum256(jsonRes))
 }
 
+// findOrChooseWorkerWithinQuota chooses a worker like the pool does, but
+// waits for as long as the team is at its container or volume quota. The wait
+// is bounded by ctx, i.e. the step's timeout. A build which is at the quota on
+// its own, or whose team's other running builds are all waiting for the quota
+// too, fails instead as it would otherwise wait forever.
+func (client *client) findOrChooseWorkerWithinQuota(
+	ctx context.Context,
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	strategy ContainerPlacementStrategy,
+	outputWriter io.Writer,
+	eventDelegate runtime.StartingEventDelegate,
+) (Worker, error) {
+	var waiting bool
+	for {
+		chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+			ctx,
+			logger,
+			owner,
+			containerSpec,
+			workerSpec,
+			strategy,
+		)
+
+		var quotaErr TeamQuotaExceededError
+		if !errors.As(err, &quotaErr) {
+			return chosenWorker, err
+		}
+
+		if !waiting {
+			waiting = true
+
+			if delegate, ok := eventDelegate.(runtime.QuotaEventDelegate); ok {
+				delegate.WaitingForQuota(logger, quotaErr.QuotaExceeded)
+			}
+
+			writeOutputMessage(logger, outputWriter, fmt.Sprintf("%s, waiting...\n", quotaErr))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("aborted-waiting-for-quota")
+			return nil, ctx.Err()
+		case <-time.After(client.workerPollingInterval):
+		}
+	}
+}
+
 func waitForWorker(
 	logger lager.Logger,
 	waitForWorkerTicker, workerStatusTicker *time.Ticker,
@@ -759,6 +885,64 @@ func increaseActiveTasks(
 	return err
 }
 
+// commitRequests commits the container's resource requests against the
+// worker, returning false if the worker no longer has room for them. Requests
+// are not committed again for a container which already exists on the worker.
+func commitRequests(
+	logger lager.Logger,
+	pool Pool,
+	chosenWorker Worker,
+	owner db.ContainerOwner,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+) (bool, error) {
+	if containerSpec.ResourceRequests.IsZero() {
+		return true, nil
+	}
+
+	existingContainer, err := pool.ContainerInWorker(logger, owner, workerSpec)
+	if err != nil {
+		return false, err
+	}
+
+	if existingContainer {
+		return true, nil
+	}
+
+	committed, err := chosenWorker.CommitRequests(owner, containerSpec.ResourceRequests)
+	if err != nil {
+		logger.Error("failed-to-commit-requests", err)
+		return false, err
+	}
+
+	return committed, nil
+}
+
+func releaseRequests(logger lager.Logger, w Worker, owner db.ContainerOwner) {
+	err := w.ReleaseRequests(owner)
+	if err != nil {
+		logger.Error("failed-to-release-requests", err)
+		return
+	}
+}
+
+func insufficientCapacityReason(requests atc.ResourceRequests) string {
+	var wanted []string
+	if requests.CPU != 0 {
+		wanted = append(wanted, fmt.Sprintf("%s cpu", requests.CPU))
+	}
+
+	if requests.Memory != 0 {
+		wanted = append(wanted, fmt.Sprintf("%d bytes of memory", requests.Memory))
+	}
+
+	if len(wanted) == 0 {
+		return "no worker is available"
+	}
+
+	return fmt.Sprintf("no worker has %s free", strings.Join(wanted, " and "))
+}
+
 func release(activeTasksLock lock.Lock, err error) {
 	releaseErr := activeTasksLock.Release()
 	if releaseErr != nil {
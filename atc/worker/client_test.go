@@ -49,7 +49,7 @@ var _ = Describe("Client", func() {
 		workerPolling := 1 * time.Second
 		workerStatus := 2 * time.Second
 
-		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus)
+		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, nil, workerPolling, workerStatus)
 	})
 
 	Describe("FindContainer", func() {
@@ -365,6 +365,7 @@ var _ = Describe("Client", func() {
 			fakeStrategy          *workerfakes.FakeContainerPlacementStrategy
 			fakeDelegate          *workerfakes.FakeImageFetchingDelegate
 			fakeEventDelegate     *runtimefakes.FakeStartingEventDelegate
+			eventDelegate         runtime.StartingEventDelegate
 			fakeResourceTypes     atc.VersionedResourceTypes
 			fakeContainer         *workerfakes.FakeContainer
 			fakeProcessSpec       runtime.ProcessSpec
@@ -387,6 +388,7 @@ var _ = Describe("Client", func() {
 			fakeChosenWorker = new(workerfakes.FakeWorker)
 			fakeDelegate = new(workerfakes.FakeImageFetchingDelegate)
 			fakeEventDelegate = new(runtimefakes.FakeStartingEventDelegate)
+			eventDelegate = fakeEventDelegate
 			fakeResourceTypes = atc.VersionedResourceTypes{}
 			imageSpec = worker.ImageFetcherSpec{
 				Delegate:      fakeDelegate,
@@ -424,7 +426,7 @@ var _ = Describe("Client", func() {
 				metadata,
 				imageSpec,
 				fakeProcessSpec,
-				fakeEventDelegate,
+				eventDelegate,
 				fakeUsedResourceCache,
 				fakeResource,
 			)
@@ -481,6 +483,101 @@ var _ = Describe("Client", func() {
 			})
 		})
 
+		Context("when the team is at its container quota", func() {
+			var (
+				fakeQuotaDelegate *runtimefakes.FakeQuotaEventDelegate
+				exceeded          atc.QuotaExceeded
+			)
+
+			BeforeEach(func() {
+				fakeQuotaDelegate = new(runtimefakes.FakeQuotaEventDelegate)
+				eventDelegate = quotaEventDelegate{fakeEventDelegate, fakeQuotaDelegate}
+				fakeProcessSpec.StdoutWriter = gbytes.NewBuffer()
+
+				exceeded = atc.QuotaExceeded{Quota: atc.QuotaContainers, Limit: 10, Usage: 10}
+				fakePool.FindOrChooseWorkerForContainerReturnsOnCall(0, nil, worker.TeamQuotaExceededError{QuotaExceeded: exceeded})
+				fakePool.FindOrChooseWorkerForContainerReturnsOnCall(1, nil, worker.TeamQuotaExceededError{QuotaExceeded: exceeded})
+			})
+
+			It("waits until the team is back within its quota", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(3))
+				Expect(fakeChosenWorker.FetchCallCount()).To(Equal(1))
+			})
+
+			It("reports that it is waiting once", func() {
+				Expect(fakeQuotaDelegate.WaitingForQuotaCallCount()).To(Equal(1))
+				_, actualExceeded := fakeQuotaDelegate.WaitingForQuotaArgsForCall(0)
+				Expect(actualExceeded).To(Equal(exceeded))
+
+				Expect(fakeProcessSpec.StdoutWriter).To(gbytes.Say("team quota exceeded: team is using 10 of its 10 containers, waiting..."))
+			})
+
+			Context("when the step is aborted while waiting", func() {
+				BeforeEach(func() {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithCancel(context.Background())
+					cancel()
+
+					fakePool.FindOrChooseWorkerForContainerReturns(nil, worker.TeamQuotaExceededError{QuotaExceeded: exceeded})
+				})
+
+				It("returns the context's error", func() {
+					Expect(err).To(Equal(context.Canceled))
+					Expect(fakeChosenWorker.FetchCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the step times out while waiting", func() {
+				var cancel context.CancelFunc
+
+				BeforeEach(func() {
+					ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+
+					fakePool.FindOrChooseWorkerForContainerReturns(nil, worker.TeamQuotaExceededError{QuotaExceeded: exceeded})
+				})
+
+				AfterEach(func() {
+					cancel()
+				})
+
+				It("returns the context's error", func() {
+					Expect(err).To(Equal(context.DeadlineExceeded))
+					Expect(fakeChosenWorker.FetchCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the build alone is at the quota", func() {
+				var buildErr worker.BuildQuotaExceededError
+
+				BeforeEach(func() {
+					buildErr = worker.BuildQuotaExceededError{QuotaExceeded: exceeded}
+					fakePool.FindOrChooseWorkerForContainerReturnsOnCall(0, nil, buildErr)
+				})
+
+				It("fails without waiting", func() {
+					Expect(err).To(Equal(buildErr))
+					Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(1))
+					Expect(fakeQuotaDelegate.WaitingForQuotaCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the team's other builds are all waiting for the quota", func() {
+				var deadlockErr worker.TeamQuotaDeadlockError
+
+				BeforeEach(func() {
+					deadlockErr = worker.TeamQuotaDeadlockError{QuotaExceeded: exceeded}
+					fakePool.FindOrChooseWorkerForContainerReturnsOnCall(0, nil, worker.TeamQuotaExceededError{QuotaExceeded: exceeded})
+					fakePool.FindOrChooseWorkerForContainerReturnsOnCall(1, nil, deadlockErr)
+				})
+
+				It("stops waiting and fails", func() {
+					Expect(err).To(Equal(deadlockErr))
+					Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(2))
+				})
+			})
+		})
+
 		Context("Calling chosenWorker.Fetch", func() {
 			var (
 				someError     error
@@ -525,6 +622,7 @@ var _ = Describe("Client", func() {
 			fakeTaskProcessSpec  runtime.ProcessSpec
 			fakeContainer        *workerfakes.FakeContainer
 			fakeEventDelegate    *runtimefakes.FakeStartingEventDelegate
+			fakeWaitingDelegate  *runtimefakes.FakeWaitingEventDelegate
 
 			ctx    context.Context
 			cancel func()
@@ -603,6 +701,7 @@ var _ = Describe("Client", func() {
 			}
 
 			fakeEventDelegate = new(runtimefakes.FakeStartingEventDelegate)
+			fakeWaitingDelegate = new(runtimefakes.FakeWaitingEventDelegate)
 
 			fakeLockFactory = new(lockfakes.FakeLockFactory)
 			fakeLock = new(lockfakes.FakeLock)
@@ -623,7 +722,10 @@ var _ = Describe("Client", func() {
 				fakeMetadata,
 				fakeImageFetcherSpec,
 				fakeTaskProcessSpec,
-				fakeEventDelegate,
+				struct {
+					*runtimefakes.FakeStartingEventDelegate
+					*runtimefakes.FakeWaitingEventDelegate
+				}{fakeEventDelegate, fakeWaitingDelegate},
 				fakeLockFactory,
 			)
 			status = taskResult.ExitStatus
@@ -688,6 +790,96 @@ var _ = Describe("Client", func() {
 				})
 			})
 
+			Context("when a resource requests strategy is chosen", func() {
+				requests := atc.ResourceRequests{CPU: 500, Memory: 1024}
+
+				BeforeEach(func() {
+					fakeStrategy.ModifiesCommittedRequestsReturns(true)
+					fakeContainerSpec.ResourceRequests = requests
+					fakeWorker.CommitRequestsReturns(true, nil)
+				})
+
+				It("commits the requests of the task's container against the worker", func() {
+					Expect(fakeWorker.CommitRequestsCallCount()).To(Equal(1))
+
+					actualOwner, actualRequests := fakeWorker.CommitRequestsArgsForCall(0)
+					Expect(actualOwner).To(Equal(fakeContainerOwner))
+					Expect(actualRequests).To(Equal(requests))
+				})
+
+				It("releases the requests once the task has finished", func() {
+					Expect(fakeWorker.ReleaseRequestsCallCount()).To(Equal(1))
+					Expect(fakeWorker.ReleaseRequestsArgsForCall(0)).To(Equal(fakeContainerOwner))
+				})
+
+				It("does not wait for a worker", func() {
+					Expect(fakeWaitingDelegate.WaitingForWorkerCallCount()).To(Equal(0))
+				})
+
+				Context("when the container is already present on the worker", func() {
+					BeforeEach(func() {
+						fakePool.ContainerInWorkerReturns(true, nil)
+					})
+
+					It("does not commit the requests again", func() {
+						Expect(fakeWorker.CommitRequestsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the task has no requests", func() {
+					BeforeEach(func() {
+						fakeContainerSpec.ResourceRequests = atc.ResourceRequests{}
+					})
+
+					It("neither commits nor releases anything", func() {
+						Expect(fakeWorker.CommitRequestsCallCount()).To(Equal(0))
+						Expect(fakeWorker.ReleaseRequestsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when no worker has room for the requests at first", func() {
+					BeforeEach(func() {
+						fakeWorker.CommitRequestsReturnsOnCall(0, false, nil)
+						fakeWorker.CommitRequestsReturnsOnCall(1, true, nil)
+					})
+
+					It("waits until the requests can be committed", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(fakeWorker.CommitRequestsCallCount()).To(Equal(2))
+					})
+
+					It("reports that it is waiting for a worker once", func() {
+						Expect(fakeWaitingDelegate.WaitingForWorkerCallCount()).To(Equal(1))
+						_, reason := fakeWaitingDelegate.WaitingForWorkerArgsForCall(0)
+						Expect(reason).To(Equal("no worker has 500m cpu and 1024 bytes of memory free"))
+					})
+				})
+
+				Context("when the task is aborted waiting for a worker with room", func() {
+					BeforeEach(func() {
+						fakeWorker.CommitRequestsReturns(false, nil)
+						cancel()
+					})
+
+					It("returns the context's error without releasing anything", func() {
+						Expect(err).To(Equal(context.Canceled))
+						Expect(fakeWorker.ReleaseRequestsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when committing the requests fails", func() {
+					disaster := errors.New("nope")
+
+					BeforeEach(func() {
+						fakeWorker.CommitRequestsReturns(false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(err).To(Equal(disaster))
+					})
+				})
+			})
+
 			Context("when finding or choosing the worker errors", func() {
 				workerDisaster := errors.New("worker selection errored")
 
@@ -717,6 +909,140 @@ var _ = Describe("Client", func() {
 			}))
 		})
 
+		Context("when the task has services", func() {
+			var (
+				fakeServiceContainer *workerfakes.FakeContainer
+				fakeServiceProcess   *gardenfakes.FakeProcess
+				fakeProbeProcess     *gardenfakes.FakeProcess
+				serviceOwner         db.ContainerOwner
+			)
+
+			BeforeEach(func() {
+				serviceOwner = db.NewBuildStepContainerOwner(1234, atc.PlanID("42/services/db"), 123)
+
+				fakeContainerSpec.Services = []worker.ServiceSpec{
+					{
+						Name:    "db",
+						EnvName: "DB",
+						Owner:   serviceOwner,
+						Ports:   []uint16{5432, 5433},
+						Process: runtime.ProcessSpec{
+							Path: "postgres",
+							Args: []string{"-D", "/data"},
+						},
+						Readiness: &worker.ServiceReadiness{
+							Path:     "pg_isready",
+							Interval: time.Millisecond,
+							Timeout:  time.Second,
+						},
+					},
+				}
+
+				fakeServiceProcess = new(gardenfakes.FakeProcess)
+				fakeServiceProcess.WaitStub = func() (int, error) {
+					select {}
+				}
+
+				fakeProbeProcess = new(gardenfakes.FakeProcess)
+				fakeProbeProcess.WaitReturnsOnCall(0, 1, nil)
+				fakeProbeProcess.WaitReturnsOnCall(1, 0, nil)
+
+				fakeServiceContainer = new(workerfakes.FakeContainer)
+				fakeServiceContainer.HandleReturns("service-handle")
+				fakeServiceContainer.AttachReturns(nil, errors.New("not running"))
+				fakeServiceContainer.RunStub = func(_ context.Context, spec garden.ProcessSpec, _ garden.ProcessIO) (garden.Process, error) {
+					if spec.ID == "service" {
+						return fakeServiceProcess, nil
+					}
+
+					return fakeProbeProcess, nil
+				}
+				fakeServiceContainer.InfoReturns(garden.ContainerInfo{ContainerIP: "10.0.0.2"}, nil)
+
+				fakeWorker.FindOrCreateContainerReturnsOnCall(0, fakeServiceContainer, nil)
+				fakeWorker.FindOrCreateContainerReturnsOnCall(1, fakeContainer, nil)
+			})
+
+			It("creates the service container on the chosen worker before the task container", func() {
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+				_, _, _, owner, _, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(owner).To(Equal(serviceOwner))
+			})
+
+			It("runs the service process", func() {
+				_, spec, _ := fakeServiceContainer.RunArgsForCall(0)
+				Expect(spec.ID).To(Equal("service"))
+				Expect(spec.Path).To(Equal("postgres"))
+				Expect(spec.Args).To(Equal([]string{"-D", "/data"}))
+			})
+
+			It("probes the service until it is ready", func() {
+				Expect(fakeProbeProcess.WaitCallCount()).To(Equal(2))
+				_, spec, _ := fakeServiceContainer.RunArgsForCall(1)
+				Expect(spec.Path).To(Equal("pg_isready"))
+			})
+
+			It("exposes the service address to the task", func() {
+				_, _, _, _, _, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+				Expect(containerSpec.Env).To(ContainElement("SERVICE_DB_HOST=10.0.0.2"))
+				Expect(containerSpec.Env).To(ContainElement("SERVICE_DB_PORT=5432"))
+			})
+
+			It("stops the service container once the task is done", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+				Expect(fakeServiceContainer.StopArgsForCall(0)).To(BeTrue())
+			})
+
+			Context("when the service exits before becoming ready", func() {
+				BeforeEach(func() {
+					fakeServiceProcess.WaitStub = nil
+					fakeServiceProcess.WaitReturns(2, nil)
+					fakeProbeProcess.WaitReturnsOnCall(1, 1, nil)
+					fakeContainerSpec.Services[0].Readiness.Interval = time.Hour
+				})
+
+				It("returns an error without creating the task container", func() {
+					Expect(err).To(Equal(worker.ServiceExitedError{Name: "db", ExitStatus: 2}))
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+
+				It("stops the service container", func() {
+					Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the service does not become ready in time", func() {
+				BeforeEach(func() {
+					fakeProbeProcess.WaitReturnsOnCall(1, 1, nil)
+					fakeProbeProcess.WaitReturns(1, nil)
+					fakeContainerSpec.Services[0].Readiness.Timeout = 10 * time.Millisecond
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(Equal(worker.ServiceNotReadyError{Name: "db", Timeout: 10 * time.Millisecond}))
+				})
+			})
+
+			Context("when the readiness probe hangs", func() {
+				BeforeEach(func() {
+					fakeProbeProcess.WaitStub = func() (int, error) {
+						select {}
+					}
+					fakeContainerSpec.Services[0].Readiness.Timeout = 10 * time.Millisecond
+				})
+
+				It("returns an error once the timeout elapses", func() {
+					Expect(err).To(Equal(worker.ServiceNotReadyError{Name: "db", Timeout: 10 * time.Millisecond}))
+				})
+
+				It("kills the probe", func() {
+					Expect(fakeProbeProcess.SignalCallCount()).To(Equal(1))
+					Expect(fakeProbeProcess.SignalArgsForCall(0)).To(Equal(garden.SignalKill))
+				})
+			})
+		})
+
 		Context("found a container that has already exited", func() {
 			BeforeEach(func() {
 				fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "8"}, nil)
@@ -1492,3 +1818,8 @@ var _ = Describe("Client", func() {
 		})
 	})
 })
+
+type quotaEventDelegate struct {
+	*runtimefakes.FakeStartingEventDelegate
+	*runtimefakes.FakeQuotaEventDelegate
+}
@@ -3,6 +3,7 @@ package worker
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
@@ -16,6 +17,13 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes atc.VersionedResourceTypes
+
+	WorkerSelector *atc.WorkerSelector
+
+	// BuildID is the build the container is for, if any. It lets team quota
+	// checks tell a build that can never fit in its quota from one that is
+	// waiting on other work.
+	BuildID int
 }
 
 type ContainerSpec struct {
@@ -44,11 +52,48 @@ type ContainerSpec struct {
 	// Resource limits to be set on the container when creating in garden.
 	Limits ContainerLimits
 
+	// Resources reserved on the worker while the container's process runs.
+	// Only used for task steps.
+	ResourceRequests atc.ResourceRequests
+
 	// Local volumes to bind mount directly to the container when creating in garden.
 	BindMounts []BindMountSource
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Sidecar services to start on the same worker before the container's
+	// process is run. Only used for task steps.
+	Services []ServiceSpec
+}
+
+// ServiceSpec describes a sidecar container started alongside a task. Its
+// address is exposed to the task via SERVICE_<NAME>_HOST and
+// SERVICE_<NAME>_PORT.
+type ServiceSpec struct {
+	Name string
+
+	// The name of the service as used in environment variables.
+	EnvName string
+
+	Owner         db.ContainerOwner
+	Metadata      db.ContainerMetadata
+	ContainerSpec ContainerSpec
+
+	Ports []uint16
+
+	Process runtime.ProcessSpec
+
+	// Optional probe that must succeed before the task is started.
+	Readiness *ServiceReadiness
+}
+
+type ServiceReadiness struct {
+	Path string
+	Args []string
+
+	Interval time.Duration
+	Timeout  time.Duration
 }
 
 // The below methods cause ContainerSpec to fulfill the
@@ -158,5 +203,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if !spec.WorkerSelector.IsEmpty() {
+		attrs = append(attrs, fmt.Sprintf("worker selector '%s'", spec.WorkerSelector))
+	}
+
 	return strings.Join(attrs, ", ")
 }
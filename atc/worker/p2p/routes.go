@@ -0,0 +1,16 @@
+package p2p
+
+import "github.com/tedsuo/rata"
+
+const (
+	StreamOut = "StreamOut"
+	StreamIn  = "StreamIn"
+)
+
+// Routes served by a worker's P2P streaming server. StreamOut streams a
+// volume's contents to a peer, and StreamIn pulls a volume's contents from a
+// peer's StreamOut route.
+var Routes = rata.Routes{
+	{Path: "/volumes/:handle/stream-out", Method: "PUT", Name: StreamOut},
+	{Path: "/volumes/:handle/stream-in", Method: "PUT", Name: StreamIn},
+}
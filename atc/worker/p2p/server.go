@@ -0,0 +1,180 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/tedsuo/rata"
+)
+
+// NewHandler returns the handler for a worker's P2P streaming server, which
+// streams volumes to and from peers through the worker's local baggageclaim
+// server so that the web node does not have to proxy the data.
+//
+// The given HTTP client is used for pulling volumes from peers. Every request
+// must carry a token signed by the web node with the given secret, which also
+// covers the peer to pull from.
+func NewHandler(
+	logger lager.Logger,
+	baggageclaimClient baggageclaim.Client,
+	httpClient *http.Client,
+	secret []byte,
+) (http.Handler, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("p2p secret must not be empty")
+	}
+
+	server := &server{
+		logger:             logger,
+		baggageclaimClient: baggageclaimClient,
+		httpClient:         httpClient,
+		secret:             secret,
+	}
+
+	return rata.NewRouter(Routes, rata.Handlers{
+		StreamOut: server.authenticated(server.StreamOut),
+		StreamIn:  server.authenticated(server.StreamIn),
+	})
+}
+
+type server struct {
+	logger             lager.Logger
+	baggageclaimClient baggageclaim.Client
+	httpClient         *http.Client
+	secret             []byte
+}
+
+func (s *server) authenticated(handler http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := verifyToken(s.secret, r, requestToken(r), time.Now())
+		if err != nil {
+			s.logger.Info("unauthorized", lager.Data{"path": r.URL.Path, "error": err.Error()})
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		handler(w, r)
+	})
+}
+
+func (s *server) StreamOut(w http.ResponseWriter, r *http.Request) {
+	handle := rata.Param(r, "handle")
+	path := r.URL.Query().Get("path")
+	encoding := baggageclaim.Encoding(r.URL.Query().Get("encoding"))
+
+	logger := s.logger.Session("stream-out", lager.Data{
+		"volume": handle,
+		"path":   path,
+	})
+
+	volume, found, err := s.baggageclaimClient.LookupVolume(logger, handle)
+	if err != nil {
+		logger.Error("failed-to-lookup-volume", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("volume-not-found")
+		http.Error(w, baggageclaim.ErrVolumeNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	out, err := volume.StreamOut(r.Context(), path, encoding)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		logger.Error("failed-to-stream-out", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	defer out.Close()
+
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, out)
+	if err != nil {
+		logger.Error("failed-to-write-stream", err)
+	}
+}
+
+func (s *server) StreamIn(w http.ResponseWriter, r *http.Request) {
+	handle := rata.Param(r, "handle")
+	query := r.URL.Query()
+
+	path := query.Get("path")
+	encoding := baggageclaim.Encoding(query.Get("encoding"))
+
+	source := VolumeRef{
+		URL:    query.Get("source_url"),
+		Handle: query.Get("source_handle"),
+		Path:   query.Get("source_path"),
+	}
+
+	logger := s.logger.Session("stream-in", lager.Data{
+		"volume":        handle,
+		"path":          path,
+		"source-url":    source.URL,
+		"source-volume": source.Handle,
+	})
+
+	if source.URL == "" || source.Handle == "" {
+		http.Error(w, "missing source_url or source_handle", http.StatusBadRequest)
+		return
+	}
+
+	volume, found, err := s.baggageclaimClient.LookupVolume(logger, handle)
+	if err != nil {
+		logger.Error("failed-to-lookup-volume", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("volume-not-found")
+		http.Error(w, baggageclaim.ErrVolumeNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	request, err := streamOutRequest(source, encoding)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	setToken(request, query.Get("source_token"))
+
+	response, err := s.httpClient.Do(request.WithContext(r.Context()))
+	if err != nil {
+		logger.Error("failed-to-reach-peer", err)
+		http.Error(w, fmt.Sprintf("failed to reach peer: %s", err), http.StatusBadGateway)
+		return
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := responseError(response)
+		logger.Error("failed-to-stream-out-from-peer", err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	err = volume.StreamIn(r.Context(), path, encoding, response.Body)
+	if err != nil {
+		logger.Error("failed-to-stream-in", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
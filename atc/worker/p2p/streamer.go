@@ -0,0 +1,130 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/tedsuo/rata"
+)
+
+// VolumeRef identifies a volume (or a path within it) on a worker's P2P
+// streaming server.
+type VolumeRef struct {
+	URL    string
+	Handle string
+	Path   string
+}
+
+// StreamError is returned when a worker's P2P streaming server responds with
+// an error.
+type StreamError struct {
+	StatusCode int
+	Message    string
+}
+
+func (err StreamError) Error() string {
+	return fmt.Sprintf("p2p streaming failed (%d): %s", err.StatusCode, err.Message)
+}
+
+//go:generate counterfeiter . Streamer
+
+// Streamer coordinates streaming a volume directly from one worker to
+// another.
+type Streamer interface {
+	// Stream instructs the destination worker to pull the source volume's
+	// contents from the source worker.
+	Stream(ctx context.Context, source VolumeRef, destination VolumeRef, encoding baggageclaim.Encoding) error
+}
+
+type streamer struct {
+	httpClient *http.Client
+	secret     []byte
+}
+
+// NewStreamer returns a Streamer which signs its requests with the secret
+// shared with the workers' P2P streaming servers.
+func NewStreamer(httpClient *http.Client, secret []byte) Streamer {
+	return &streamer{
+		httpClient: httpClient,
+		secret:     secret,
+	}
+}
+
+func (s *streamer) Stream(ctx context.Context, source VolumeRef, destination VolumeRef, encoding baggageclaim.Encoding) error {
+	expires := time.Now().Add(TokenTTL)
+
+	// the destination passes this on to the source, so that the source only
+	// streams out the volume we asked for
+	sourceRequest, err := streamOutRequest(source, encoding)
+	if err != nil {
+		return err
+	}
+
+	request, err := rata.NewRequestGenerator(destination.URL, Routes).CreateRequest(
+		StreamIn,
+		rata.Params{"handle": destination.Handle},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	query := streamQuery(destination.Path, encoding)
+	query.Set("source_url", source.URL)
+	query.Set("source_handle", source.Handle)
+	query.Set("source_path", source.Path)
+	query.Set("source_token", signToken(s.secret, sourceRequest, expires))
+	request.URL.RawQuery = query.Encode()
+
+	setToken(request, signToken(s.secret, request, expires))
+
+	response, err := s.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		return responseError(response)
+	}
+
+	return nil
+}
+
+func streamOutRequest(source VolumeRef, encoding baggageclaim.Encoding) (*http.Request, error) {
+	request, err := rata.NewRequestGenerator(source.URL, Routes).CreateRequest(
+		StreamOut,
+		rata.Params{"handle": source.Handle},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	request.URL.RawQuery = streamQuery(source.Path, encoding).Encode()
+
+	return request, nil
+}
+
+func streamQuery(path string, encoding baggageclaim.Encoding) url.Values {
+	query := url.Values{}
+	query.Set("path", path)
+	query.Set("encoding", string(encoding))
+	return query
+}
+
+func responseError(response *http.Response) error {
+	body, _ := ioutil.ReadAll(response.Body)
+
+	return StreamError{
+		StatusCode: response.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+	}
+}
@@ -0,0 +1,221 @@
+package p2p_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/atc/worker/p2p"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Streamer", func() {
+	var (
+		sourceBaggageclaim *baggageclaimfakes.FakeClient
+		sourceVolume       *baggageclaimfakes.FakeVolume
+		sourceServer       *httptest.Server
+
+		destBaggageclaim *baggageclaimfakes.FakeClient
+		destVolume       *baggageclaimfakes.FakeVolume
+		destServer       *httptest.Server
+
+		secret     []byte
+		streamedIn string
+		streamErr  error
+	)
+
+	BeforeEach(func() {
+		secret = []byte("some-secret")
+
+		logger := lagertest.NewTestLogger("p2p")
+
+		sourceVolume = new(baggageclaimfakes.FakeVolume)
+		sourceVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-tar-stream")), nil)
+
+		sourceBaggageclaim = new(baggageclaimfakes.FakeClient)
+		sourceBaggageclaim.LookupVolumeReturns(sourceVolume, true, nil)
+
+		sourceHandler, err := p2p.NewHandler(logger, sourceBaggageclaim, http.DefaultClient, []byte("some-secret"))
+		Expect(err).ToNot(HaveOccurred())
+		sourceServer = httptest.NewServer(sourceHandler)
+
+		streamedIn = ""
+		destVolume = new(baggageclaimfakes.FakeVolume)
+		destVolume.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, tarStream io.Reader) error {
+			contents, err := ioutil.ReadAll(tarStream)
+			streamedIn = string(contents)
+			return err
+		}
+
+		destBaggageclaim = new(baggageclaimfakes.FakeClient)
+		destBaggageclaim.LookupVolumeReturns(destVolume, true, nil)
+
+		destHandler, err := p2p.NewHandler(logger, destBaggageclaim, http.DefaultClient, []byte("some-secret"))
+		Expect(err).ToNot(HaveOccurred())
+		destServer = httptest.NewServer(destHandler)
+	})
+
+	AfterEach(func() {
+		sourceServer.Close()
+		destServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		streamErr = p2p.NewStreamer(http.DefaultClient, secret).Stream(
+			context.Background(),
+			p2p.VolumeRef{URL: sourceServer.URL, Handle: "source-handle", Path: "."},
+			p2p.VolumeRef{URL: destServer.URL, Handle: "dest-handle", Path: "some/path"},
+			baggageclaim.GzipEncoding,
+		)
+	})
+
+	It("streams the source volume into the destination volume", func() {
+		Expect(streamErr).ToNot(HaveOccurred())
+		Expect(streamedIn).To(Equal("some-tar-stream"))
+	})
+
+	It("looks up the volumes on each worker", func() {
+		_, handle := sourceBaggageclaim.LookupVolumeArgsForCall(0)
+		Expect(handle).To(Equal("source-handle"))
+
+		_, handle = destBaggageclaim.LookupVolumeArgsForCall(0)
+		Expect(handle).To(Equal("dest-handle"))
+	})
+
+	It("streams the requested paths with the requested encoding", func() {
+		_, path, encoding := sourceVolume.StreamOutArgsForCall(0)
+		Expect(path).To(Equal("."))
+		Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+
+		_, path, encoding, _ = destVolume.StreamInArgsForCall(0)
+		Expect(path).To(Equal("some/path"))
+		Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+	})
+
+	Context("when the source volume does not exist", func() {
+		BeforeEach(func() {
+			sourceBaggageclaim.LookupVolumeReturns(nil, false, nil)
+		})
+
+		It("returns a bad gateway error without streaming in", func() {
+			Expect(streamErr).To(BeAssignableToTypeOf(p2p.StreamError{}))
+			Expect(streamErr.(p2p.StreamError).StatusCode).To(Equal(http.StatusBadGateway))
+			Expect(destVolume.StreamInCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the source worker cannot be reached", func() {
+		BeforeEach(func() {
+			sourceServer.Close()
+		})
+
+		It("returns a bad gateway error", func() {
+			Expect(streamErr).To(BeAssignableToTypeOf(p2p.StreamError{}))
+			Expect(streamErr.(p2p.StreamError).StatusCode).To(Equal(http.StatusBadGateway))
+			Expect(streamErr.Error()).To(ContainSubstring("failed to reach peer"))
+		})
+	})
+
+	Context("when the destination volume does not exist", func() {
+		BeforeEach(func() {
+			destBaggageclaim.LookupVolumeReturns(nil, false, nil)
+		})
+
+		It("returns a not found error", func() {
+			Expect(streamErr).To(Equal(p2p.StreamError{
+				StatusCode: http.StatusNotFound,
+				Message:    "volume not found",
+			}))
+		})
+	})
+
+	Context("when streaming in fails", func() {
+		BeforeEach(func() {
+			destVolume.StreamInStub = nil
+			destVolume.StreamInReturns(errors.New("disk full"))
+		})
+
+		It("returns the error", func() {
+			Expect(streamErr).To(Equal(p2p.StreamError{
+				StatusCode: http.StatusInternalServerError,
+				Message:    "disk full",
+			}))
+		})
+	})
+
+	Context("when the streamer signs with a different secret", func() {
+		BeforeEach(func() {
+			secret = []byte("some-other-secret")
+		})
+
+		It("is unauthorized", func() {
+			Expect(streamErr).To(Equal(p2p.StreamError{
+				StatusCode: http.StatusUnauthorized,
+				Message:    p2p.ErrInvalidToken.Error(),
+			}))
+			Expect(destBaggageclaim.LookupVolumeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the token has expired", func() {
+		var originalTTL time.Duration
+
+		BeforeEach(func() {
+			originalTTL = p2p.TokenTTL
+			p2p.TokenTTL = -time.Minute
+		})
+
+		AfterEach(func() {
+			p2p.TokenTTL = originalTTL
+		})
+
+		It("is unauthorized", func() {
+			Expect(streamErr).To(Equal(p2p.StreamError{
+				StatusCode: http.StatusUnauthorized,
+				Message:    p2p.ErrExpiredToken.Error(),
+			}))
+		})
+	})
+
+	Describe("requests which are not signed by the web node", func() {
+		It("rejects them", func() {
+			request, err := http.NewRequest("PUT", destServer.URL+"/volumes/dest-handle/stream-in?source_url=http://169.254.169.254&source_handle=x", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+			request, err = http.NewRequest("PUT", sourceServer.URL+"/volumes/source-handle/stream-out", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err = http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+			Expect(destBaggageclaim.LookupVolumeCallCount()).To(Equal(1))
+			Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the destination worker cannot be reached", func() {
+		BeforeEach(func() {
+			destServer.Close()
+		})
+
+		It("returns the error", func() {
+			Expect(streamErr).To(HaveOccurred())
+			Expect(streamErr).ToNot(BeAssignableToTypeOf(p2p.StreamError{}))
+		})
+	})
+})
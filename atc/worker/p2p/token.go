@@ -0,0 +1,88 @@
+package p2p
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TokenTTL is how long a token authorizes a request for. Streams which are
+// already running are not interrupted when their token expires.
+var TokenTTL = 5 * time.Minute
+
+var (
+	ErrMissingToken = errors.New("missing p2p token")
+	ErrInvalidToken = errors.New("invalid p2p token")
+	ErrExpiredToken = errors.New("expired p2p token")
+)
+
+// signToken returns a token authorizing exactly the given request until it
+// expires. The token is an HMAC of the request's method, path and query,
+// signed with a secret shared between the web nodes and the workers, so a
+// worker only streams volumes and contacts peers as instructed by the web
+// node.
+func signToken(secret []byte, request *http.Request, expires time.Time) string {
+	expiry := strconv.FormatInt(expires.Unix(), 10)
+	return expiry + "." + base64.RawURLEncoding.EncodeToString(tokenMAC(secret, request, expiry))
+}
+
+func verifyToken(secret []byte, request *http.Request, token string, now time.Time) error {
+	if token == "" {
+		return ErrMissingToken
+	}
+
+	segments := strings.SplitN(token, ".", 2)
+	if len(segments) != 2 {
+		return ErrInvalidToken
+	}
+
+	expiry, encodedMAC := segments[0], segments[1]
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if !hmac.Equal(mac, tokenMAC(secret, request, expiry)) {
+		return ErrInvalidToken
+	}
+
+	expires, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if now.After(time.Unix(expires, 0)) {
+		return ErrExpiredToken
+	}
+
+	return nil
+}
+
+func tokenMAC(secret []byte, request *http.Request, expiry string) []byte {
+	query := request.URL.Query()
+	for param := range query {
+		// the router adds the route's params to the query, e.g. ':handle'
+		if strings.HasPrefix(param, ":") {
+			query.Del(param)
+		}
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s", request.Method, request.URL.Path, query.Encode(), expiry)
+	return mac.Sum(nil)
+}
+
+func setToken(request *http.Request, token string) {
+	request.Header.Set("Authorization", "Bearer "+token)
+}
+
+func requestToken(request *http.Request) string {
+	return strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+}
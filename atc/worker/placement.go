@@ -1,10 +1,12 @@
 package worker
 
 import (
+	"math"
 	"math/rand"
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -13,6 +15,7 @@ type ContainerPlacementStrategy interface {
 	// Change this after check containers stop being reused
 	Choose(lager.Logger, []Worker, ContainerSpec) (Worker, error)
 	ModifiesActiveTasks() bool
+	ModifiesCommittedRequests() bool
 }
 
 type VolumeLocalityPlacementStrategy struct {
@@ -58,6 +61,10 @@ func (strategy *VolumeLocalityPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
 
+func (strategy *VolumeLocalityPlacementStrategy) ModifiesCommittedRequests() bool {
+	return false
+}
+
 type FewestBuildContainersPlacementStrategy struct {
 	rand *rand.Rand
 }
@@ -88,6 +95,10 @@ func (strategy *FewestBuildContainersPlacementStrategy) ModifiesActiveTasks() bo
 	return false
 }
 
+func (strategy *FewestBuildContainersPlacementStrategy) ModifiesCommittedRequests() bool {
+	return false
+}
+
 type LimitActiveTasksPlacementStrategy struct {
 	rand     *rand.Rand
 	maxTasks int
@@ -134,6 +145,10 @@ func (strategy *LimitActiveTasksPlacementStrategy) ModifiesActiveTasks() bool {
 	return true
 }
 
+func (strategy *LimitActiveTasksPlacementStrategy) ModifiesCommittedRequests() bool {
+	return false
+}
+
 type RandomPlacementStrategy struct {
 	rand *rand.Rand
 }
@@ -151,3 +166,84 @@ func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []W
 func (strategy *RandomPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
+
+func (strategy *RandomPlacementStrategy) ModifiesCommittedRequests() bool {
+	return false
+}
+
+// ResourceRequestsPlacementStrategy places containers on workers with enough
+// allocatable capacity left for their resource requests. Workers are either
+// filled up one at a time (bin-packing) or kept evenly loaded (spreading).
+type ResourceRequestsPlacementStrategy struct {
+	rand   *rand.Rand
+	spread bool
+}
+
+func NewResourceRequestsPlacementStrategy(spread bool) ContainerPlacementStrategy {
+	return &ResourceRequestsPlacementStrategy{
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		spread: spread,
+	}
+}
+
+func (strategy *ResourceRequestsPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	var candidates []Worker
+	var bestLoad float64
+
+	for _, w := range workers {
+		committed, err := w.CommittedRequests()
+		if err != nil {
+			logger.Error("failed-to-get-committed-requests", err, lager.Data{"worker": w.Name()})
+			continue
+		}
+
+		allocatable := w.AllocatableRequests()
+		if !spec.ResourceRequests.Fits(allocatable, committed) {
+			logger.Debug("worker-lacks-capacity", lager.Data{"worker": w.Name()})
+			continue
+		}
+
+		load := requestsLoad(allocatable, committed.Add(spec.ResourceRequests))
+
+		better := len(candidates) == 0 ||
+			(strategy.spread && load < bestLoad) ||
+			(!strategy.spread && load > bestLoad)
+
+		if better {
+			candidates = []Worker{w}
+			bestLoad = load
+		} else if load == bestLoad {
+			candidates = append(candidates, w)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	return candidates[strategy.rand.Intn(len(candidates))], nil
+}
+
+func (strategy *ResourceRequestsPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
+func (strategy *ResourceRequestsPlacementStrategy) ModifiesCommittedRequests() bool {
+	return true
+}
+
+// requestsLoad returns the largest fraction of the worker's allocatable CPU or
+// memory which the requests take up. Unlimited capacity is never loaded.
+func requestsLoad(allocatable atc.ResourceRequests, requests atc.ResourceRequests) float64 {
+	var load float64
+
+	if allocatable.CPU != 0 {
+		load = math.Max(load, float64(requests.CPU)/float64(allocatable.CPU))
+	}
+
+	if allocatable.Memory != 0 {
+		load = math.Max(load, float64(requests.Memory)/float64(allocatable.Memory))
+	}
+
+	return load
+}
@@ -1,8 +1,11 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -450,3 +453,110 @@ var _ = Describe("LimitActiveTasksPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("ResourceRequestsPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("resource-requests-placement-test")
+			strategy = NewResourceRequestsPlacementStrategy(false)
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				Type: "task",
+
+				TeamID: 4567,
+
+				ResourceRequests: atc.ResourceRequests{
+					CPU:    1000,
+					Memory: 1024,
+				},
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			for _, w := range []*workerfakes.FakeWorker{compatibleWorker1, compatibleWorker2, compatibleWorker3} {
+				w.AllocatableRequestsReturns(atc.ResourceRequests{CPU: 4000, Memory: 4096})
+			}
+
+			compatibleWorker1.CommittedRequestsReturns(atc.ResourceRequests{CPU: 1000, Memory: 1024}, nil)
+			compatibleWorker2.CommittedRequestsReturns(atc.ResourceRequests{CPU: 2000, Memory: 1024}, nil)
+			compatibleWorker3.CommittedRequestsReturns(atc.ResourceRequests{CPU: 3500, Memory: 1024}, nil)
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		It("picks the most loaded worker with room for the requests", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(compatibleWorker2))
+		})
+
+		Context("when spreading", func() {
+			BeforeEach(func() {
+				strategy = NewResourceRequestsPlacementStrategy(true)
+			})
+
+			It("picks the least loaded worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+			})
+		})
+
+		Context("when a worker's memory would be overcommitted", func() {
+			BeforeEach(func() {
+				compatibleWorker2.CommittedRequestsReturns(atc.ResourceRequests{CPU: 2000, Memory: 3584}, nil)
+			})
+
+			It("skips the worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+			})
+		})
+
+		Context("when a worker has unlimited capacity", func() {
+			BeforeEach(func() {
+				compatibleWorker3.AllocatableRequestsReturns(atc.ResourceRequests{})
+			})
+
+			It("treats it as unloaded", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker2))
+			})
+		})
+
+		Context("when the committed requests of a worker cannot be determined", func() {
+			BeforeEach(func() {
+				compatibleWorker2.CommittedRequestsReturns(atc.ResourceRequests{}, errors.New("disaster"))
+			})
+
+			It("skips the worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+			})
+		})
+
+		Context("when no worker has room for the requests", func() {
+			BeforeEach(func() {
+				spec.ResourceRequests.CPU = 3500
+			})
+
+			It("picks no worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+	})
+})
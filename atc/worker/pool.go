@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -46,6 +48,11 @@ var (
 	ErrFailedAcquirePoolLock = errors.New("failed to acquire pool lock")
 )
 
+// teamQuotaWaitExpiry is how long a build counts as waiting for its team's
+// quota after last being refused a container. Builds retry much more often,
+// so it only expires once a build has stopped waiting without saying so.
+const teamQuotaWaitExpiry = time.Minute
+
 type NoCompatibleWorkersError struct {
 	Spec WorkerSpec
 }
@@ -54,6 +61,60 @@ func (err NoCompatibleWorkersError) Error() string {
 	return fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
 }
 
+// TeamQuotaExceededError is returned when a new container would exceed its
+// team's container or volume quota.
+type TeamQuotaExceededError struct {
+	atc.QuotaExceeded
+}
+
+func (err TeamQuotaExceededError) Error() string {
+	return "team quota exceeded: " + err.QuotaExceeded.String()
+}
+
+// BuildQuotaExceededError is returned when a build's own containers or volumes
+// already use up its team's quota, so waiting for other work to finish would
+// never free enough for a new container.
+type BuildQuotaExceededError struct {
+	atc.QuotaExceeded
+}
+
+func (err BuildQuotaExceededError) Error() string {
+	return fmt.Sprintf(
+		"team quota exceeded: build alone is using %d of its team's %d %s",
+		err.Usage,
+		err.Limit,
+		err.Quota,
+	)
+}
+
+// TeamQuotaDeadlockError is returned when a build would wait for room in its
+// team's container or volume quota while every other running build of the
+// team is waiting for it too, so none of them would ever free any up.
+type TeamQuotaDeadlockError struct {
+	atc.QuotaExceeded
+}
+
+func (err TeamQuotaDeadlockError) Error() string {
+	return fmt.Sprintf(
+		"team quota exceeded: every running build of the team is waiting for room in its %d %s",
+		err.Limit,
+		err.Quota,
+	)
+}
+
+type WorkersUnderDiskPressureError struct {
+	Spec    WorkerSpec
+	Workers []string
+}
+
+func (err WorkersUnderDiskPressureError) Error() string {
+	return fmt.Sprintf(
+		"all workers satisfying %s are low on disk space: %s",
+		err.Spec.Description(),
+		strings.Join(err.Workers, ", "),
+	)
+}
+
 //go:generate counterfeiter . Pool
 
 type Pool interface {
@@ -79,16 +140,30 @@ type Pool interface {
 }
 
 type pool struct {
-	provider WorkerProvider
-	rand     *rand.Rand
+	provider          WorkerProvider
+	diskHighWaterMark float64
+	teamQuotaFactory  db.TeamQuotaFactory
+	rand              *rand.Rand
 }
 
+// NewPool returns a pool which chooses among the provider's running workers,
+// skipping those whose volume store usage is at or above diskHighWaterMark, a
+// fraction of its capacity. A diskHighWaterMark of 0 skips none. Choosing a
+// worker for a new container fails with a TeamQuotaExceededError when the
+// team is at its container or volume quota, or a BuildQuotaExceededError when
+// the spec's build is at the quota by itself. It fails with a
+// TeamQuotaDeadlockError instead when every other running build of the team
+// is waiting for the quota as well.
 func NewPool(
 	provider WorkerProvider,
+	diskHighWaterMark float64,
+	teamQuotaFactory db.TeamQuotaFactory,
 ) Pool {
 	return &pool{
-		provider: provider,
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		provider:          provider,
+		diskHighWaterMark: diskHighWaterMark,
+		teamQuotaFactory:  teamQuotaFactory,
+		rand:              rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
@@ -104,9 +179,20 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 
 	compatibleTeamWorkers := []Worker{}
 	compatibleGeneralWorkers := []Worker{}
+	pressuredWorkers := []string{}
 	for _, worker := range workers {
 		compatible := worker.Satisfies(logger, spec)
 		if compatible {
+			if pool.underDiskPressure(worker) {
+				logger.Info("skipping-worker-under-disk-pressure", lager.Data{
+					"worker":             worker.Name(),
+					"volume-store-usage": worker.VolumeStoreUsage(),
+				})
+
+				pressuredWorkers = append(pressuredWorkers, worker.Name())
+				continue
+			}
+
 			if worker.IsOwnedByTeam() {
 				compatibleTeamWorkers = append(compatibleTeamWorkers, worker)
 			} else {
@@ -116,11 +202,18 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	}
 
 	if len(compatibleTeamWorkers) != 0 {
-		return compatibleTeamWorkers, nil
+		return mostPreferred(compatibleTeamWorkers, spec.WorkerSelector), nil
 	}
 
 	if len(compatibleGeneralWorkers) != 0 {
-		return compatibleGeneralWorkers, nil
+		return mostPreferred(compatibleGeneralWorkers, spec.WorkerSelector), nil
+	}
+
+	if len(pressuredWorkers) != 0 {
+		return nil, WorkersUnderDiskPressureError{
+			Spec:    spec,
+			Workers: pressuredWorkers,
+		}
 	}
 
 	return nil, NoCompatibleWorkersError{
@@ -128,6 +221,39 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	}
 }
 
+// mostPreferred narrows the workers down to those matching the most of the
+// selector's preferred expressions. If none match any, all of the workers are
+// returned, as preferences never prevent a step from running.
+func mostPreferred(workers []Worker, selector *atc.WorkerSelector) []Worker {
+	if selector == nil || len(selector.Preferred) == 0 {
+		return workers
+	}
+
+	best := 0
+	preferred := []Worker{}
+	for _, worker := range workers {
+		score := selector.Preference(worker.Labels())
+		if score > best {
+			best = score
+			preferred = []Worker{}
+		}
+
+		if score == best {
+			preferred = append(preferred, worker)
+		}
+	}
+
+	if best == 0 {
+		return workers
+	}
+
+	return preferred
+}
+
+func (pool *pool) underDiskPressure(worker Worker) bool {
+	return pool.diskHighWaterMark > 0 && worker.VolumeStoreUsage() >= pool.diskHighWaterMark
+}
+
 func (pool *pool) ContainerInWorker(logger lager.Logger, owner db.ContainerOwner, workerSpec WorkerSpec) (bool, error) {
 	workersWithContainer, err := pool.provider.FindWorkersForContainerByOwner(
 		logger.Session("find-worker"),
@@ -186,6 +312,11 @@ dance:
 	}
 
 	if worker == nil {
+		err = pool.checkTeamQuota(workerSpec.TeamID, workerSpec.BuildID)
+		if err != nil {
+			return nil, err
+		}
+
 		worker, err = strategy.Choose(logger, compatibleWorkers, containerSpec)
 		if err != nil {
 			return nil, err
@@ -195,6 +326,62 @@ dance:
 	return worker, nil
 }
 
+func (pool *pool) checkTeamQuota(teamID int, buildID int) error {
+	if teamID == 0 {
+		return nil
+	}
+
+	quotas, err := pool.teamQuotaFactory.Quotas(teamID)
+	if err != nil {
+		return err
+	}
+
+	if quotas.MaxContainers == 0 && quotas.MaxVolumes == 0 {
+		return nil
+	}
+
+	usage, err := pool.teamQuotaFactory.Usage(teamID)
+	if err != nil {
+		return err
+	}
+
+	exceeded, ok := quotas.ExceededContainers(usage)
+	if !ok {
+		if buildID != 0 {
+			return pool.teamQuotaFactory.StopWaitingForQuota(buildID)
+		}
+
+		return nil
+	}
+
+	if buildID != 0 {
+		buildUsage, err := pool.teamQuotaFactory.BuildUsage(buildID)
+		if err != nil {
+			return err
+		}
+
+		if buildExceeded, ok := quotas.ExceededContainers(buildUsage); ok {
+			return BuildQuotaExceededError{buildExceeded}
+		}
+
+		deadlocked, err := pool.teamQuotaFactory.WaitingForQuota(teamID, buildID, teamQuotaWaitExpiry)
+		if err != nil {
+			return err
+		}
+
+		if deadlocked {
+			err = pool.teamQuotaFactory.StopWaitingForQuota(buildID)
+			if err != nil {
+				return err
+			}
+
+			return TeamQuotaDeadlockError{exceeded}
+		}
+	}
+
+	return TeamQuotaExceededError{exceeded}
+}
+
 func (pool *pool) FindOrChooseWorker(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
@@ -20,13 +20,16 @@ var _ = Describe("Pool", func() {
 		logger       *lagertest.TestLogger
 		pool         Pool
 		fakeProvider *workerfakes.FakeWorkerProvider
+
+		fakeTeamQuotaFactory *dbfakes.FakeTeamQuotaFactory
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeTeamQuotaFactory = new(dbfakes.FakeTeamQuotaFactory)
 
-		pool = NewPool(fakeProvider)
+		pool = NewPool(fakeProvider, 0.9, fakeTeamQuotaFactory)
 	})
 
 	Describe("FindOrChooseWorkerForContainer", func() {
@@ -234,6 +237,157 @@ var _ = Describe("Pool", func() {
 			})
 		})
 
+		Context("when the team has a container quota", func() {
+			BeforeEach(func() {
+				compatibleWorker.NameReturns("some-worker")
+				fakeProvider.RunningWorkersReturns([]Worker{compatibleWorker}, nil)
+				fakeStrategy.ChooseReturns(compatibleWorker, nil)
+
+				fakeTeamQuotaFactory.QuotasReturns(atc.TeamQuotas{MaxContainers: 10, MaxVolumes: 50}, nil)
+			})
+
+			Context("when the team is below its quotas", func() {
+				BeforeEach(func() {
+					fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{Containers: 9, Volumes: 49}, nil)
+				})
+
+				It("chooses a worker", func() {
+					Expect(chooseErr).NotTo(HaveOccurred())
+					Expect(chosenWorker).To(Equal(compatibleWorker))
+
+					Expect(fakeTeamQuotaFactory.QuotasArgsForCall(0)).To(Equal(4567))
+					Expect(fakeTeamQuotaFactory.UsageArgsForCall(0)).To(Equal(4567))
+				})
+
+				Context("when the spec is for a build", func() {
+					BeforeEach(func() {
+						workerSpec.BuildID = 42
+					})
+
+					It("stops any wait for the quota", func() {
+						Expect(fakeTeamQuotaFactory.StopWaitingForQuotaCallCount()).To(Equal(1))
+						Expect(fakeTeamQuotaFactory.StopWaitingForQuotaArgsForCall(0)).To(Equal(42))
+					})
+				})
+			})
+
+			Context("when the team is at its container quota", func() {
+				BeforeEach(func() {
+					fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{Containers: 10}, nil)
+				})
+
+				It("returns a TeamQuotaExceededError", func() {
+					Expect(chooseErr).To(Equal(TeamQuotaExceededError{
+						QuotaExceeded: atc.QuotaExceeded{
+							Quota: atc.QuotaContainers,
+							Limit: 10,
+							Usage: 10,
+						},
+					}))
+					Expect(fakeStrategy.ChooseCallCount()).To(BeZero())
+				})
+
+				Context("when the spec is for a build", func() {
+					BeforeEach(func() {
+						workerSpec.BuildID = 42
+					})
+
+					Context("when other work is using the quota", func() {
+						BeforeEach(func() {
+							fakeTeamQuotaFactory.BuildUsageReturns(atc.TeamUsage{Containers: 3}, nil)
+						})
+
+						It("returns a TeamQuotaExceededError", func() {
+							Expect(fakeTeamQuotaFactory.BuildUsageArgsForCall(0)).To(Equal(42))
+							Expect(chooseErr).To(BeAssignableToTypeOf(TeamQuotaExceededError{}))
+						})
+
+						It("records that the build is waiting for the quota", func() {
+							Expect(fakeTeamQuotaFactory.WaitingForQuotaCallCount()).To(Equal(1))
+							teamID, buildID, expires := fakeTeamQuotaFactory.WaitingForQuotaArgsForCall(0)
+							Expect(teamID).To(Equal(4567))
+							Expect(buildID).To(Equal(42))
+							Expect(expires).To(BeNumerically(">", 0))
+						})
+
+						Context("when every other running build is waiting for the quota", func() {
+							BeforeEach(func() {
+								fakeTeamQuotaFactory.WaitingForQuotaReturns(true, nil)
+							})
+
+							It("returns a TeamQuotaDeadlockError", func() {
+								Expect(chooseErr).To(Equal(TeamQuotaDeadlockError{
+									QuotaExceeded: atc.QuotaExceeded{
+										Quota: atc.QuotaContainers,
+										Limit: 10,
+										Usage: 10,
+									},
+								}))
+							})
+
+							It("stops waiting", func() {
+								Expect(fakeTeamQuotaFactory.StopWaitingForQuotaCallCount()).To(Equal(1))
+								Expect(fakeTeamQuotaFactory.StopWaitingForQuotaArgsForCall(0)).To(Equal(42))
+							})
+						})
+					})
+
+					Context("when the build alone is using the quota", func() {
+						BeforeEach(func() {
+							fakeTeamQuotaFactory.BuildUsageReturns(atc.TeamUsage{Containers: 10}, nil)
+						})
+
+						It("returns a BuildQuotaExceededError", func() {
+							Expect(chooseErr).To(Equal(BuildQuotaExceededError{
+								QuotaExceeded: atc.QuotaExceeded{
+									Quota: atc.QuotaContainers,
+									Limit: 10,
+									Usage: 10,
+								},
+							}))
+						})
+					})
+				})
+
+				Context("when the container already exists", func() {
+					BeforeEach(func() {
+						fakeProvider.FindWorkersForContainerByOwnerReturns([]Worker{compatibleWorker}, nil)
+					})
+
+					It("returns the worker with the container", func() {
+						Expect(chooseErr).NotTo(HaveOccurred())
+						Expect(chosenWorker).To(Equal(compatibleWorker))
+					})
+				})
+			})
+
+			Context("when the team is at its volume quota", func() {
+				BeforeEach(func() {
+					fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{Containers: 1, Volumes: 50}, nil)
+				})
+
+				It("returns a TeamQuotaExceededError", func() {
+					Expect(chooseErr).To(Equal(TeamQuotaExceededError{
+						QuotaExceeded: atc.QuotaExceeded{
+							Quota: atc.QuotaVolumes,
+							Limit: 50,
+							Usage: 50,
+						},
+					}))
+				})
+			})
+
+			Context("when fetching the usage fails", func() {
+				BeforeEach(func() {
+					fakeTeamQuotaFactory.UsageReturns(atc.TeamUsage{}, errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(chooseErr).To(MatchError("nope"))
+				})
+			})
+		})
+
 		Context("when no worker is found with the container", func() {
 			BeforeEach(func() {
 				fakeProvider.FindWorkersForContainerByOwnerReturns(nil, nil)
@@ -279,6 +433,68 @@ var _ = Describe("Pool", func() {
 					Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
 				})
 
+				Context("when a worker's volume store is above the high-water mark", func() {
+					BeforeEach(func() {
+						workerA.VolumeStoreUsageReturns(0.5)
+						workerB.VolumeStoreUsageReturns(0.95)
+					})
+
+					It("skips the worker", func() {
+						_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+						Expect(satisfyingWorkers).To(ConsistOf(workerA))
+					})
+				})
+
+				Context("when every satisfying worker is above the high-water mark", func() {
+					BeforeEach(func() {
+						workerB.NameReturns("workerB")
+						workerA.VolumeStoreUsageReturns(0.9)
+						workerB.VolumeStoreUsageReturns(0.95)
+					})
+
+					It("returns a WorkersUnderDiskPressureError", func() {
+						Expect(chooseErr).To(Equal(WorkersUnderDiskPressureError{
+							Spec:    workerSpec,
+							Workers: []string{"workerA", "workerB"},
+						}))
+					})
+				})
+
+				Context("when the spec has preferred selector expressions", func() {
+					BeforeEach(func() {
+						workerSpec.WorkerSelector = &atc.WorkerSelector{
+							Preferred: []atc.LabelExpression{
+								{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+								{Key: "disk", Operator: atc.LabelOperatorEquals, Values: []string{"ssd"}},
+							},
+						}
+					})
+
+					Context("when some workers match more of them than others", func() {
+						BeforeEach(func() {
+							workerA.LabelsReturns(map[string]string{"zone": "us-east"})
+							workerB.LabelsReturns(map[string]string{"zone": "us-east", "disk": "ssd"})
+							workerC.LabelsReturns(map[string]string{"zone": "us-east", "disk": "ssd"})
+						})
+
+						It("returns only the satisfying workers matching the most", func() {
+							_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(satisfyingWorkers).To(ConsistOf(workerB))
+						})
+					})
+
+					Context("when no workers match them", func() {
+						BeforeEach(func() {
+							workerA.LabelsReturns(map[string]string{"zone": "us-west"})
+						})
+
+						It("returns all workers satisfying the spec", func() {
+							_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
+						})
+					})
+				})
+
 				Context("when no workers satisfy the spec", func() {
 					BeforeEach(func() {
 						workerA.SatisfiesReturns(false)
@@ -0,0 +1,209 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+)
+
+const serviceProcessID = "service"
+
+// ServiceNotReadyError is returned when a service's readiness probe does not
+// succeed before its timeout elapses.
+type ServiceNotReadyError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (err ServiceNotReadyError) Error() string {
+	return fmt.Sprintf("service '%s' did not become ready within %s", err.Name, err.Timeout)
+}
+
+// ServiceExitedError is returned when a service's process exits before it
+// becomes ready.
+type ServiceExitedError struct {
+	Name       string
+	ExitStatus int
+}
+
+func (err ServiceExitedError) Error() string {
+	return fmt.Sprintf("service '%s' exited with status %d before becoming ready", err.Name, err.ExitStatus)
+}
+
+// startServices starts the services on the given worker and waits for them to
+// become ready. It returns the environment variables describing their
+// addresses, along with the containers that were started so that they can be
+// stopped once the task is done, even if an error is returned.
+func (client *client) startServices(
+	ctx context.Context,
+	logger lager.Logger,
+	chosenWorker Worker,
+	imageFetcherSpec ImageFetcherSpec,
+	services []ServiceSpec,
+) ([]string, []Container, error) {
+	var env []string
+	var containers []Container
+
+	for _, service := range services {
+		logger := logger.Session("start-service", lager.Data{"service": service.Name})
+
+		container, err := chosenWorker.FindOrCreateContainer(
+			ctx,
+			logger,
+			imageFetcherSpec.Delegate,
+			service.Owner,
+			service.Metadata,
+			service.ContainerSpec,
+			imageFetcherSpec.ResourceTypes,
+		)
+		if err != nil {
+			return nil, containers, err
+		}
+
+		containers = append(containers, container)
+
+		processIO := garden.ProcessIO{
+			Stdout: service.Process.StdoutWriter,
+			Stderr: service.Process.StderrWriter,
+		}
+
+		process, err := container.Attach(context.Background(), serviceProcessID, processIO)
+		if err == nil {
+			logger.Info("already-running")
+		} else {
+			logger.Info("spawning")
+
+			process, err = container.Run(
+				context.Background(),
+				garden.ProcessSpec{
+					ID:   serviceProcessID,
+					Path: service.Process.Path,
+					Args: service.Process.Args,
+					Dir:  service.Process.Dir,
+				},
+				processIO,
+			)
+			if err != nil {
+				return nil, containers, err
+			}
+		}
+
+		info, err := container.Info()
+		if err != nil {
+			return nil, containers, err
+		}
+
+		env = append(env, fmt.Sprintf("SERVICE_%s_HOST=%s", service.EnvName, info.ContainerIP))
+		if len(service.Ports) > 0 {
+			env = append(env, fmt.Sprintf("SERVICE_%s_PORT=%d", service.EnvName, service.Ports[0]))
+		}
+
+		if service.Readiness != nil {
+			err = waitForService(ctx, logger, container, process, service)
+			if err != nil {
+				return nil, containers, err
+			}
+		}
+
+		logger.Info("ready")
+	}
+
+	return env, containers, nil
+}
+
+func waitForService(
+	ctx context.Context,
+	logger lager.Logger,
+	container Container,
+	process garden.Process,
+	service ServiceSpec,
+) error {
+	readiness := service.Readiness
+
+	exited := make(chan processStatus, 1)
+	go func() {
+		status := processStatus{}
+		status.processStatus, status.processErr = process.Wait()
+		exited <- status
+	}()
+
+	timeout := time.NewTimer(readiness.Timeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(readiness.Interval)
+	defer ticker.Stop()
+
+	// stopped returns why we should stop waiting for the service, if at all
+	stopped := func(next <-chan time.Time) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case status := <-exited:
+			if status.processErr != nil {
+				return status.processErr
+			}
+
+			return ServiceExitedError{Name: service.Name, ExitStatus: status.processStatus}
+		case <-timeout.C:
+			return ServiceNotReadyError{Name: service.Name, Timeout: readiness.Timeout}
+		case <-next:
+			return nil
+		}
+	}
+
+	for {
+		probe, err := container.Run(
+			ctx,
+			garden.ProcessSpec{
+				Path: readiness.Path,
+				Args: readiness.Args,
+			},
+			garden.ProcessIO{
+				Stdout: ioutil.Discard,
+				Stderr: ioutil.Discard,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// a hanging probe must not outlive the readiness timeout
+		probed := make(chan time.Time, 1)
+		var probeStatus int
+		var probeErr error
+		go func() {
+			probeStatus, probeErr = probe.Wait()
+			probed <- time.Now()
+		}()
+
+		err = stopped(probed)
+		if err != nil {
+			_ = probe.Signal(garden.SignalKill)
+			return err
+		}
+
+		if probeErr == nil && probeStatus == 0 {
+			return nil
+		}
+
+		logger.Debug("not-ready", lager.Data{"status": probeStatus})
+
+		err = stopped(ticker.C)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func stopServices(logger lager.Logger, containers []Container) {
+	for _, container := range containers {
+		err := container.Stop(true)
+		if err != nil {
+			logger.Error("failed-to-stop-service", err, lager.Data{"handle": container.Handle()})
+		}
+	}
+}
@@ -34,6 +34,12 @@ type Volume interface {
 	CreateChildForContainer(db.CreatingContainer, string) (db.CreatingVolume, error)
 
 	WorkerName() string
+
+	// P2PURL returns the URL of the P2P streaming server of the worker the
+	// volume lives on, or an empty string if the worker does not support P2P
+	// streaming.
+	P2PURL() string
+
 	Destroy() error
 }
 
@@ -110,6 +116,10 @@ func (v *volume) WorkerName() string {
 	return v.dbVolume.WorkerName()
 }
 
+func (v *volume) P2PURL() string {
+	return v.volumeClient.P2PURL()
+}
+
 func (v *volume) Destroy() error {
 	return v.bcVolume.Destroy()
 }
@@ -70,6 +70,8 @@ type VolumeClient interface {
 	) (volume Volume, found bool, err error)
 
 	LookupVolume(lager.Logger, string) (Volume, bool, error)
+
+	P2PURL() string
 }
 
 type VolumeSpec struct {
@@ -134,6 +136,12 @@ func NewVolumeClient(
 	}
 }
 
+// P2PURL returns the URL of the worker's P2P streaming server, as advertised
+// when it registered.
+func (c *volumeClient) P2PURL() string {
+	return c.dbWorker.P2PURL()
+}
+
 func (c *volumeClient) FindOrCreateVolumeForContainer(
 	logger lager.Logger,
 	volumeSpec VolumeSpec,
@@ -42,6 +42,7 @@ type Worker interface {
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() map[string]string
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 	Ephemeral() bool
@@ -81,9 +82,15 @@ type Worker interface {
 	CreateVolume(logger lager.Logger, spec VolumeSpec, teamID int, volumeType db.VolumeType) (Volume, error)
 
 	GardenClient() gclient.Client
+	VolumeStoreUsage() float64
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
+
+	AllocatableRequests() atc.ResourceRequests
+	CommittedRequests() (atc.ResourceRequests, error)
+	CommitRequests(db.ContainerOwner, atc.ResourceRequests) (bool, error)
+	ReleaseRequests(db.ContainerOwner) error
 }
 
 type gardenWorker struct {
@@ -770,6 +777,21 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.dbWorker.Tags()
 }
 
+// Labels returns the worker's labels, along with each of its tags as a label
+// without a value.
+func (worker *gardenWorker) Labels() map[string]string {
+	labels := map[string]string{}
+	for _, tag := range worker.dbWorker.Tags() {
+		labels[tag] = ""
+	}
+
+	for key, value := range worker.dbWorker.Labels() {
+		labels[key] = value
+	}
+
+	return labels
+}
+
 func (worker *gardenWorker) Ephemeral() bool {
 	return worker.dbWorker.Ephemeral()
 }
@@ -808,7 +830,11 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		}
 	}
 
-	if !worker.tagsMatch(spec.Tags) {
+	if !worker.tagsMatch(spec.Tags, spec.WorkerSelector) {
+		return false
+	}
+
+	if !spec.WorkerSelector.Matches(worker.Labels()) {
 		return false
 	}
 
@@ -839,6 +865,19 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	labels := worker.dbWorker.Labels()
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("label '%s=%s'", key, labels[key]))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -850,9 +889,12 @@ func (worker *gardenWorker) Uptime() time.Duration {
 	return time.Since(worker.dbWorker.StartTime())
 }
 
-func (worker *gardenWorker) tagsMatch(tags []string) bool {
+// tagsMatch returns true if the worker has every tag. Tagged workers only
+// run steps which ask for them, either by tag or with a required selector
+// expression which targets one of their labels or tags.
+func (worker *gardenWorker) tagsMatch(tags []string, selector *atc.WorkerSelector) bool {
 	workerTags := worker.dbWorker.Tags()
-	if len(workerTags) > 0 && len(tags) == 0 {
+	if len(workerTags) > 0 && len(tags) == 0 && !selector.Targets(worker.Labels()) {
 		return false
 	}
 
@@ -870,6 +912,17 @@ insert_coin:
 	return true
 }
 
+// VolumeStoreUsage returns the fraction of the worker's volume store which is
+// in use, or 0 if the worker does not report it.
+func (worker *gardenWorker) VolumeStoreUsage() float64 {
+	capacity := worker.dbWorker.VolumeStoreCapacity()
+	if capacity <= 0 {
+		return 0
+	}
+
+	return float64(worker.dbWorker.VolumeStoreUsed()) / float64(capacity)
+}
+
 func (worker *gardenWorker) ActiveTasks() (int, error) {
 	return worker.dbWorker.ActiveTasks()
 }
@@ -879,3 +932,19 @@ func (worker *gardenWorker) IncreaseActiveTasks() error {
 func (worker *gardenWorker) DecreaseActiveTasks() error {
 	return worker.dbWorker.DecreaseActiveTasks()
 }
+
+func (worker *gardenWorker) AllocatableRequests() atc.ResourceRequests {
+	return worker.dbWorker.Allocatable()
+}
+
+func (worker *gardenWorker) CommittedRequests() (atc.ResourceRequests, error) {
+	return worker.dbWorker.CommittedRequests()
+}
+
+func (worker *gardenWorker) CommitRequests(owner db.ContainerOwner, requests atc.ResourceRequests) (bool, error) {
+	return worker.dbWorker.CommitRequests(owner, requests)
+}
+
+func (worker *gardenWorker) ReleaseRequests(owner db.ContainerOwner) error {
+	return worker.dbWorker.ReleaseRequests(owner)
+}
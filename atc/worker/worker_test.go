@@ -712,6 +712,115 @@ var _ = Describe("Worker", func() {
 			})
 		})
 
+		Context("when the spec has a worker selector", func() {
+			BeforeEach(func() {
+				fakeDBWorker.LabelsReturns(map[string]string{"zone": "us-east", "disk": "ssd"})
+			})
+
+			Context("when the worker's labels match the required expressions", func() {
+				BeforeEach(func() {
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Required: []atc.LabelExpression{
+							{Key: "zone", Operator: atc.LabelOperatorIn, Values: []string{"us-east", "us-west"}},
+							{Key: "disk", Operator: atc.LabelOperatorNotEquals, Values: []string{"hdd"}},
+						},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker's labels do not match a required expression", func() {
+				BeforeEach(func() {
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Required: []atc.LabelExpression{
+							{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-west"}},
+						},
+					}
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+
+			Context("when the worker's labels do not match a preferred expression", func() {
+				BeforeEach(func() {
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Preferred: []atc.LabelExpression{
+							{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-west"}},
+						},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the required expressions match the worker's tags", func() {
+				BeforeEach(func() {
+					spec.Tags = nil
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Required: []atc.LabelExpression{
+							{Key: "some", Operator: atc.LabelOperatorExists},
+						},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker has tags and the required expressions only exclude other workers", func() {
+				BeforeEach(func() {
+					spec.Tags = nil
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Required: []atc.LabelExpression{
+							{Key: "zone", Operator: atc.LabelOperatorNotEquals, Values: []string{"us-west"}},
+						},
+					}
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+
+			Context("when the worker has tags and a required expression targets its labels", func() {
+				BeforeEach(func() {
+					spec.Tags = nil
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Required: []atc.LabelExpression{
+							{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+						},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker has tags and the selector is only preferred", func() {
+				BeforeEach(func() {
+					spec.Tags = nil
+					spec.WorkerSelector = &atc.WorkerSelector{
+						Preferred: []atc.LabelExpression{
+							{Key: "some", Operator: atc.LabelOperatorExists},
+						},
+					}
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when the resource type is supported by the worker", func() {
 			BeforeEach(func() {
 				spec.ResourceType = "some-resource"
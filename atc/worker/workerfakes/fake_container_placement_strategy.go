@@ -34,6 +34,16 @@ type FakeContainerPlacementStrategy struct {
 	modifiesActiveTasksReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ModifiesCommittedRequestsStub        func() bool
+	modifiesCommittedRequestsMutex       sync.RWMutex
+	modifiesCommittedRequestsArgsForCall []struct {
+	}
+	modifiesCommittedRequestsReturns struct {
+		result1 bool
+	}
+	modifiesCommittedRequestsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -160,6 +170,58 @@ func (fake *FakeContainerPlacementStrategy) ModifiesActiveTasksReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeContainerPlacementStrategy) ModifiesCommittedRequests() bool {
+	fake.modifiesCommittedRequestsMutex.Lock()
+	ret, specificReturn := fake.modifiesCommittedRequestsReturnsOnCall[len(fake.modifiesCommittedRequestsArgsForCall)]
+	fake.modifiesCommittedRequestsArgsForCall = append(fake.modifiesCommittedRequestsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ModifiesCommittedRequests", []interface{}{})
+	fake.modifiesCommittedRequestsMutex.Unlock()
+	if fake.ModifiesCommittedRequestsStub != nil {
+		return fake.ModifiesCommittedRequestsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.modifiesCommittedRequestsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerPlacementStrategy) ModifiesCommittedRequestsCallCount() int {
+	fake.modifiesCommittedRequestsMutex.RLock()
+	defer fake.modifiesCommittedRequestsMutex.RUnlock()
+	return len(fake.modifiesCommittedRequestsArgsForCall)
+}
+
+func (fake *FakeContainerPlacementStrategy) ModifiesCommittedRequestsCalls(stub func() bool) {
+	fake.modifiesCommittedRequestsMutex.Lock()
+	defer fake.modifiesCommittedRequestsMutex.Unlock()
+	fake.ModifiesCommittedRequestsStub = stub
+}
+
+func (fake *FakeContainerPlacementStrategy) ModifiesCommittedRequestsReturns(result1 bool) {
+	fake.modifiesCommittedRequestsMutex.Lock()
+	defer fake.modifiesCommittedRequestsMutex.Unlock()
+	fake.ModifiesCommittedRequestsStub = nil
+	fake.modifiesCommittedRequestsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeContainerPlacementStrategy) ModifiesCommittedRequestsReturnsOnCall(i int, result1 bool) {
+	fake.modifiesCommittedRequestsMutex.Lock()
+	defer fake.modifiesCommittedRequestsMutex.Unlock()
+	fake.ModifiesCommittedRequestsStub = nil
+	if fake.modifiesCommittedRequestsReturnsOnCall == nil {
+		fake.modifiesCommittedRequestsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.modifiesCommittedRequestsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeContainerPlacementStrategy) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -167,6 +229,8 @@ func (fake *FakeContainerPlacementStrategy) Invocations() map[string][][]interfa
 	defer fake.chooseMutex.RUnlock()
 	fake.modifiesActiveTasksMutex.RLock()
 	defer fake.modifiesActiveTasksMutex.RUnlock()
+	fake.modifiesCommittedRequestsMutex.RLock()
+	defer fake.modifiesCommittedRequestsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
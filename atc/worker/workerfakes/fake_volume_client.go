@@ -162,6 +162,16 @@ type FakeVolumeClient struct {
 		result2 bool
 		result3 error
 	}
+	P2PURLStub        func() string
+	p2PURLMutex       sync.RWMutex
+	p2PURLArgsForCall []struct {
+	}
+	p2PURLReturns struct {
+		result1 string
+	}
+	p2PURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -772,6 +782,58 @@ func (fake *FakeVolumeClient) LookupVolumeReturnsOnCall(i int, result1 worker.Vo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeClient) P2PURL() string {
+	fake.p2PURLMutex.Lock()
+	ret, specificReturn := fake.p2PURLReturnsOnCall[len(fake.p2PURLArgsForCall)]
+	fake.p2PURLArgsForCall = append(fake.p2PURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("P2PURL", []interface{}{})
+	fake.p2PURLMutex.Unlock()
+	if fake.P2PURLStub != nil {
+		return fake.P2PURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.p2PURLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolumeClient) P2PURLCallCount() int {
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
+	return len(fake.p2PURLArgsForCall)
+}
+
+func (fake *FakeVolumeClient) P2PURLCalls(stub func() string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = stub
+}
+
+func (fake *FakeVolumeClient) P2PURLReturns(result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	fake.p2PURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeVolumeClient) P2PURLReturnsOnCall(i int, result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	if fake.p2PURLReturnsOnCall == nil {
+		fake.p2PURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.p2PURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -793,6 +855,8 @@ func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	defer fake.findVolumeForTaskCacheMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
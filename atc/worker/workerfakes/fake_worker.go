@@ -29,6 +29,16 @@ type FakeWorker struct {
 		result1 int
 		result2 error
 	}
+	AllocatableRequestsStub        func() atc.ResourceRequests
+	allocatableRequestsMutex       sync.RWMutex
+	allocatableRequestsArgsForCall []struct {
+	}
+	allocatableRequestsReturns struct {
+		result1 atc.ResourceRequests
+	}
+	allocatableRequestsReturnsOnCall map[int]struct {
+		result1 atc.ResourceRequests
+	}
 	BuildContainersStub        func() int
 	buildContainersMutex       sync.RWMutex
 	buildContainersArgsForCall []struct {
@@ -54,6 +64,32 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	CommitRequestsStub        func(db.ContainerOwner, atc.ResourceRequests) (bool, error)
+	commitRequestsMutex       sync.RWMutex
+	commitRequestsArgsForCall []struct {
+		arg1 db.ContainerOwner
+		arg2 atc.ResourceRequests
+	}
+	commitRequestsReturns struct {
+		result1 bool
+		result2 error
+	}
+	commitRequestsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CommittedRequestsStub        func() (atc.ResourceRequests, error)
+	committedRequestsMutex       sync.RWMutex
+	committedRequestsArgsForCall []struct {
+	}
+	committedRequestsReturns struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}
+	committedRequestsReturnsOnCall map[int]struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, int, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -253,6 +289,16 @@ type FakeWorker struct {
 	isVersionCompatibleReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LookupVolumeStub        func(lager.Logger, string) (worker.Volume, bool, error)
 	lookupVolumeMutex       sync.RWMutex
 	lookupVolumeArgsForCall []struct {
@@ -279,6 +325,17 @@ type FakeWorker struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ReleaseRequestsStub        func(db.ContainerOwner) error
+	releaseRequestsMutex       sync.RWMutex
+	releaseRequestsArgsForCall []struct {
+		arg1 db.ContainerOwner
+	}
+	releaseRequestsReturns struct {
+		result1 error
+	}
+	releaseRequestsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResourceTypesStub        func() []atc.WorkerResourceType
 	resourceTypesMutex       sync.RWMutex
 	resourceTypesArgsForCall []struct {
@@ -321,6 +378,16 @@ type FakeWorker struct {
 	uptimeReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	VolumeStoreUsageStub        func() float64
+	volumeStoreUsageMutex       sync.RWMutex
+	volumeStoreUsageArgsForCall []struct {
+	}
+	volumeStoreUsageReturns struct {
+		result1 float64
+	}
+	volumeStoreUsageReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -380,6 +447,58 @@ func (fake *FakeWorker) ActiveTasksReturnsOnCall(i int, result1 int, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) AllocatableRequests() atc.ResourceRequests {
+	fake.allocatableRequestsMutex.Lock()
+	ret, specificReturn := fake.allocatableRequestsReturnsOnCall[len(fake.allocatableRequestsArgsForCall)]
+	fake.allocatableRequestsArgsForCall = append(fake.allocatableRequestsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatableRequests", []interface{}{})
+	fake.allocatableRequestsMutex.Unlock()
+	if fake.AllocatableRequestsStub != nil {
+		return fake.AllocatableRequestsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableRequestsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableRequestsCallCount() int {
+	fake.allocatableRequestsMutex.RLock()
+	defer fake.allocatableRequestsMutex.RUnlock()
+	return len(fake.allocatableRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableRequestsCalls(stub func() atc.ResourceRequests) {
+	fake.allocatableRequestsMutex.Lock()
+	defer fake.allocatableRequestsMutex.Unlock()
+	fake.AllocatableRequestsStub = stub
+}
+
+func (fake *FakeWorker) AllocatableRequestsReturns(result1 atc.ResourceRequests) {
+	fake.allocatableRequestsMutex.Lock()
+	defer fake.allocatableRequestsMutex.Unlock()
+	fake.AllocatableRequestsStub = nil
+	fake.allocatableRequestsReturns = struct {
+		result1 atc.ResourceRequests
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableRequestsReturnsOnCall(i int, result1 atc.ResourceRequests) {
+	fake.allocatableRequestsMutex.Lock()
+	defer fake.allocatableRequestsMutex.Unlock()
+	fake.AllocatableRequestsStub = nil
+	if fake.allocatableRequestsReturnsOnCall == nil {
+		fake.allocatableRequestsReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceRequests
+		})
+	}
+	fake.allocatableRequestsReturnsOnCall[i] = struct {
+		result1 atc.ResourceRequests
+	}{result1}
+}
+
 func (fake *FakeWorker) BuildContainers() int {
 	fake.buildContainersMutex.Lock()
 	ret, specificReturn := fake.buildContainersReturnsOnCall[len(fake.buildContainersArgsForCall)]
@@ -498,6 +617,127 @@ func (fake *FakeWorker) CertsVolumeReturnsOnCall(i int, result1 worker.Volume, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) CommitRequests(arg1 db.ContainerOwner, arg2 atc.ResourceRequests) (bool, error) {
+	fake.commitRequestsMutex.Lock()
+	ret, specificReturn := fake.commitRequestsReturnsOnCall[len(fake.commitRequestsArgsForCall)]
+	fake.commitRequestsArgsForCall = append(fake.commitRequestsArgsForCall, struct {
+		arg1 db.ContainerOwner
+		arg2 atc.ResourceRequests
+	}{arg1, arg2})
+	fake.recordInvocation("CommitRequests", []interface{}{arg1, arg2})
+	fake.commitRequestsMutex.Unlock()
+	if fake.CommitRequestsStub != nil {
+		return fake.CommitRequestsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.commitRequestsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CommitRequestsCallCount() int {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	return len(fake.commitRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) CommitRequestsCalls(stub func(db.ContainerOwner, atc.ResourceRequests) (bool, error)) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = stub
+}
+
+func (fake *FakeWorker) CommitRequestsArgsForCall(i int) (db.ContainerOwner, atc.ResourceRequests) {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	argsForCall := fake.commitRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) CommitRequestsReturns(result1 bool, result2 error) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = nil
+	fake.commitRequestsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommitRequestsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.commitRequestsMutex.Lock()
+	defer fake.commitRequestsMutex.Unlock()
+	fake.CommitRequestsStub = nil
+	if fake.commitRequestsReturnsOnCall == nil {
+		fake.commitRequestsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.commitRequestsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommittedRequests() (atc.ResourceRequests, error) {
+	fake.committedRequestsMutex.Lock()
+	ret, specificReturn := fake.committedRequestsReturnsOnCall[len(fake.committedRequestsArgsForCall)]
+	fake.committedRequestsArgsForCall = append(fake.committedRequestsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CommittedRequests", []interface{}{})
+	fake.committedRequestsMutex.Unlock()
+	if fake.CommittedRequestsStub != nil {
+		return fake.CommittedRequestsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.committedRequestsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CommittedRequestsCallCount() int {
+	fake.commitRequestsMutex.RLock()
+	defer fake.commitRequestsMutex.RUnlock()
+	fake.committedRequestsMutex.RLock()
+	defer fake.committedRequestsMutex.RUnlock()
+	return len(fake.committedRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) CommittedRequestsCalls(stub func() (atc.ResourceRequests, error)) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = stub
+}
+
+func (fake *FakeWorker) CommittedRequestsReturns(result1 atc.ResourceRequests, result2 error) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = nil
+	fake.committedRequestsReturns = struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CommittedRequestsReturnsOnCall(i int, result1 atc.ResourceRequests, result2 error) {
+	fake.committedRequestsMutex.Lock()
+	defer fake.committedRequestsMutex.Unlock()
+	fake.CommittedRequestsStub = nil
+	if fake.committedRequestsReturnsOnCall == nil {
+		fake.committedRequestsReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceRequests
+			result2 error
+		})
+	}
+	fake.committedRequestsReturnsOnCall[i] = struct {
+		result1 atc.ResourceRequests
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -1353,6 +1593,58 @@ func (fake *FakeWorker) IsVersionCompatibleReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) LookupVolume(arg1 lager.Logger, arg2 string) (worker.Volume, bool, error) {
 	fake.lookupVolumeMutex.Lock()
 	ret, specificReturn := fake.lookupVolumeReturnsOnCall[len(fake.lookupVolumeArgsForCall)]
@@ -1472,6 +1764,66 @@ func (fake *FakeWorker) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ReleaseRequests(arg1 db.ContainerOwner) error {
+	fake.releaseRequestsMutex.Lock()
+	ret, specificReturn := fake.releaseRequestsReturnsOnCall[len(fake.releaseRequestsArgsForCall)]
+	fake.releaseRequestsArgsForCall = append(fake.releaseRequestsArgsForCall, struct {
+		arg1 db.ContainerOwner
+	}{arg1})
+	fake.recordInvocation("ReleaseRequests", []interface{}{arg1})
+	fake.releaseRequestsMutex.Unlock()
+	if fake.ReleaseRequestsStub != nil {
+		return fake.ReleaseRequestsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseRequestsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ReleaseRequestsCallCount() int {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
+	return len(fake.releaseRequestsArgsForCall)
+}
+
+func (fake *FakeWorker) ReleaseRequestsCalls(stub func(db.ContainerOwner) error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = stub
+}
+
+func (fake *FakeWorker) ReleaseRequestsArgsForCall(i int) db.ContainerOwner {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
+	argsForCall := fake.releaseRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) ReleaseRequestsReturns(result1 error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = nil
+	fake.releaseRequestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ReleaseRequestsReturnsOnCall(i int, result1 error) {
+	fake.releaseRequestsMutex.Lock()
+	defer fake.releaseRequestsMutex.Unlock()
+	fake.ReleaseRequestsStub = nil
+	if fake.releaseRequestsReturnsOnCall == nil {
+		fake.releaseRequestsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseRequestsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 	fake.resourceTypesMutex.Lock()
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
@@ -1490,6 +1842,8 @@ func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 }
 
 func (fake *FakeWorker) ResourceTypesCallCount() int {
+	fake.releaseRequestsMutex.RLock()
+	defer fake.releaseRequestsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	return len(fake.resourceTypesArgsForCall)
@@ -1689,15 +2043,71 @@ func (fake *FakeWorker) UptimeReturnsOnCall(i int, result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeWorker) VolumeStoreUsage() float64 {
+	fake.volumeStoreUsageMutex.Lock()
+	ret, specificReturn := fake.volumeStoreUsageReturnsOnCall[len(fake.volumeStoreUsageArgsForCall)]
+	fake.volumeStoreUsageArgsForCall = append(fake.volumeStoreUsageArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VolumeStoreUsage", []interface{}{})
+	fake.volumeStoreUsageMutex.Unlock()
+	if fake.VolumeStoreUsageStub != nil {
+		return fake.VolumeStoreUsageStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.volumeStoreUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) VolumeStoreUsageCallCount() int {
+	fake.volumeStoreUsageMutex.RLock()
+	defer fake.volumeStoreUsageMutex.RUnlock()
+	return len(fake.volumeStoreUsageArgsForCall)
+}
+
+func (fake *FakeWorker) VolumeStoreUsageCalls(stub func() float64) {
+	fake.volumeStoreUsageMutex.Lock()
+	defer fake.volumeStoreUsageMutex.Unlock()
+	fake.VolumeStoreUsageStub = stub
+}
+
+func (fake *FakeWorker) VolumeStoreUsageReturns(result1 float64) {
+	fake.volumeStoreUsageMutex.Lock()
+	defer fake.volumeStoreUsageMutex.Unlock()
+	fake.VolumeStoreUsageStub = nil
+	fake.volumeStoreUsageReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeWorker) VolumeStoreUsageReturnsOnCall(i int, result1 float64) {
+	fake.volumeStoreUsageMutex.Lock()
+	defer fake.volumeStoreUsageMutex.Unlock()
+	fake.VolumeStoreUsageStub = nil
+	if fake.volumeStoreUsageReturnsOnCall == nil {
+		fake.volumeStoreUsageReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.volumeStoreUsageReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.activeTasksMutex.RLock()
 	defer fake.activeTasksMutex.RUnlock()
+	fake.allocatableRequestsMutex.RLock()
+	defer fake.allocatableRequestsMutex.RUnlock()
 	fake.buildContainersMutex.RLock()
 	defer fake.buildContainersMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
 	defer fake.certsVolumeMutex.RUnlock()
+	fake.committedRequestsMutex.RLock()
+	defer fake.committedRequestsMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
@@ -1726,6 +2136,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.isOwnedByTeamMutex.RUnlock()
 	fake.isVersionCompatibleMutex.RLock()
 	defer fake.isVersionCompatibleMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -1738,6 +2150,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.tagsMutex.RUnlock()
 	fake.uptimeMutex.RLock()
 	defer fake.uptimeMutex.RUnlock()
+	fake.volumeStoreUsageMutex.RLock()
+	defer fake.volumeStoreUsageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,283 @@
+package atc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// WorkerSelector chooses workers by their labels. A worker must match every
+// required expression. Preferred expressions only guide placement: workers
+// matching more of them are chosen over workers matching fewer.
+//
+// A worker's tags are visible to selectors as labels without a value, so the
+// expression "ssd" (or "ssd exists") matches a worker tagged "ssd".
+type WorkerSelector struct {
+	Required  []LabelExpression `json:"required,omitempty"`
+	Preferred []LabelExpression `json:"preferred,omitempty"`
+}
+
+// UnmarshalJSON accepts either an object with required and preferred
+// expressions, or a list of expressions which are all required.
+func (selector *WorkerSelector) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var required []LabelExpression
+		err := json.Unmarshal(data, &required)
+		if err != nil {
+			return err
+		}
+
+		*selector = WorkerSelector{Required: required}
+
+		return nil
+	}
+
+	type target WorkerSelector
+
+	var t target
+	err := unmarshalStrict(data, &t)
+	if err != nil {
+		return err
+	}
+
+	*selector = WorkerSelector(t)
+
+	return nil
+}
+
+// IsEmpty returns true if the selector has no expressions, and so matches
+// every worker.
+func (selector *WorkerSelector) IsEmpty() bool {
+	return selector == nil || len(selector.Required) == 0 && len(selector.Preferred) == 0
+}
+
+// Matches returns true if the labels satisfy every required expression.
+func (selector *WorkerSelector) Matches(labels map[string]string) bool {
+	if selector == nil {
+		return true
+	}
+
+	for _, expr := range selector.Required {
+		if !expr.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Targets returns true if a required expression positively picks out the
+// labels, i.e. one other than != matches them. Workers without a label
+// satisfy != too, so it cannot be used to single workers out.
+func (selector *WorkerSelector) Targets(labels map[string]string) bool {
+	if selector == nil {
+		return false
+	}
+
+	for _, expr := range selector.Required {
+		if expr.Operator != LabelOperatorNotEquals && expr.Matches(labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Preference returns the number of preferred expressions which the labels
+// satisfy.
+func (selector *WorkerSelector) Preference(labels map[string]string) int {
+	if selector == nil {
+		return 0
+	}
+
+	matched := 0
+	for _, expr := range selector.Preferred {
+		if expr.Matches(labels) {
+			matched++
+		}
+	}
+
+	return matched
+}
+
+func (selector *WorkerSelector) String() string {
+	if selector.IsEmpty() {
+		return ""
+	}
+
+	var exprs []string
+	for _, expr := range selector.Required {
+		exprs = append(exprs, expr.String())
+	}
+
+	for _, expr := range selector.Preferred {
+		exprs = append(exprs, "preferred "+expr.String())
+	}
+
+	return strings.Join(exprs, ", ")
+}
+
+type LabelOperator string
+
+const (
+	LabelOperatorEquals    LabelOperator = "="
+	LabelOperatorNotEquals LabelOperator = "!="
+	LabelOperatorIn        LabelOperator = "in"
+	LabelOperatorExists    LabelOperator = "exists"
+)
+
+// LabelExpression is a condition on a single label, written as one of:
+//
+//	zone=us-east
+//	zone!=us-east
+//	disk in (ssd, nvme)
+//	gpu exists
+//	gpu
+//
+// A worker without the label satisfies != but none of the others.
+type LabelExpression struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+var (
+	labelKeyPattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+	labelValuePattern = regexp.MustCompile(`^[A-Za-z0-9._/:-]*$`)
+
+	labelInPattern     = regexp.MustCompile(`^(\S+)\s+in\s*\((.*)\)$`)
+	labelExistsPattern = regexp.MustCompile(`^(\S+)(?:\s+exists)?$`)
+)
+
+// ParseLabelExpression parses an expression in the syntax described on
+// LabelExpression.
+func ParseLabelExpression(raw string) (LabelExpression, error) {
+	expr := strings.TrimSpace(raw)
+
+	var parsed LabelExpression
+	if match := labelInPattern.FindStringSubmatch(expr); match != nil {
+		parsed = LabelExpression{Key: match[1], Operator: LabelOperatorIn}
+		for _, value := range strings.Split(match[2], ",") {
+			parsed.Values = append(parsed.Values, strings.TrimSpace(value))
+		}
+	} else if i := strings.Index(expr, "!="); i != -1 {
+		parsed = LabelExpression{
+			Key:      strings.TrimSpace(expr[:i]),
+			Operator: LabelOperatorNotEquals,
+			Values:   []string{strings.TrimSpace(expr[i+2:])},
+		}
+	} else if i := strings.Index(expr, "="); i != -1 {
+		parsed = LabelExpression{
+			Key:      strings.TrimSpace(expr[:i]),
+			Operator: LabelOperatorEquals,
+			Values:   []string{strings.TrimSpace(expr[i+1:])},
+		}
+	} else if match := labelExistsPattern.FindStringSubmatch(expr); match != nil {
+		parsed = LabelExpression{Key: match[1], Operator: LabelOperatorExists}
+	} else {
+		return LabelExpression{}, LabelExpressionError{Expression: raw, Message: "unknown operator"}
+	}
+
+	err := ValidateLabelKey(parsed.Key)
+	if err != nil {
+		return LabelExpression{}, LabelExpressionError{Expression: raw, Message: err.Error()}
+	}
+
+	for _, value := range parsed.Values {
+		err := ValidateLabelValue(value)
+		if err != nil {
+			return LabelExpression{}, LabelExpressionError{Expression: raw, Message: err.Error()}
+		}
+	}
+
+	return parsed, nil
+}
+
+func (expr LabelExpression) Matches(labels map[string]string) bool {
+	value, found := labels[expr.Key]
+
+	switch expr.Operator {
+	case LabelOperatorEquals:
+		return found && value == expr.Values[0]
+	case LabelOperatorNotEquals:
+		return !found || value != expr.Values[0]
+	case LabelOperatorIn:
+		if !found {
+			return false
+		}
+
+		for _, v := range expr.Values {
+			if v == value {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return found
+	}
+}
+
+func (expr LabelExpression) String() string {
+	switch expr.Operator {
+	case LabelOperatorEquals, LabelOperatorNotEquals:
+		return expr.Key + string(expr.Operator) + expr.Values[0]
+	case LabelOperatorIn:
+		return fmt.Sprintf("%s in (%s)", expr.Key, strings.Join(expr.Values, ", "))
+	default:
+		return expr.Key + " exists"
+	}
+}
+
+func (expr LabelExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(expr.String())
+}
+
+func (expr *LabelExpression) UnmarshalJSON(data []byte) error {
+	var raw string
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return errors.New("label expression must be a string")
+	}
+
+	parsed, err := ParseLabelExpression(raw)
+	if err != nil {
+		return err
+	}
+
+	*expr = parsed
+
+	return nil
+}
+
+type LabelExpressionError struct {
+	Expression string
+	Message    string
+}
+
+func (err LabelExpressionError) Error() string {
+	return fmt.Sprintf("invalid label expression '%s': %s", err.Expression, err.Message)
+}
+
+// ValidateLabelKey returns an error if the key is empty or contains characters
+// other than letters, digits, '.', '_', '/' and '-'.
+func ValidateLabelKey(key string) error {
+	if !labelKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid label key '%s'", key)
+	}
+
+	return nil
+}
+
+// ValidateLabelValue returns an error if the value contains characters other
+// than letters, digits, '.', '_', '/', ':' and '-'.
+func ValidateLabelValue(value string) error {
+	if !labelValuePattern.MatchString(value) {
+		return fmt.Errorf("invalid label value '%s'", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package atc_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerSelector", func() {
+	labels := map[string]string{
+		"zone": "us-east",
+		"disk": "ssd",
+		"gpu":  "",
+	}
+
+	DescribeTable("label expressions",
+		func(expression string, expected bool) {
+			parsed, err := atc.ParseLabelExpression(expression)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Matches(labels)).To(Equal(expected))
+		},
+		Entry("equals", "zone=us-east", true),
+		Entry("equals another value", "zone=us-west", false),
+		Entry("equals with spaces", "zone = us-east", true),
+		Entry("equals a missing label", "arch=arm64", false),
+		Entry("not equals", "zone!=us-west", true),
+		Entry("not equals the value", "zone!=us-east", false),
+		Entry("not equals a missing label", "arch!=arm64", true),
+		Entry("in", "disk in (ssd, nvme)", true),
+		Entry("in without the value", "disk in (hdd)", false),
+		Entry("in a missing label", "arch in (amd64)", false),
+		Entry("exists", "gpu exists", true),
+		Entry("exists for a missing label", "arch exists", false),
+		Entry("bare key", "gpu", true),
+		Entry("bare missing key", "arch", false),
+	)
+
+	DescribeTable("invalid label expressions",
+		func(expression string) {
+			_, err := atc.ParseLabelExpression(expression)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("missing key", "=us-east"),
+		Entry("invalid key", "zo ne=us-east"),
+		Entry("invalid value", "zone=us east"),
+		Entry("unknown operator", "zone like us-east"),
+	)
+
+	Describe("unmarshaling", func() {
+		It("treats a list of expressions as required", func() {
+			var selector atc.WorkerSelector
+			err := json.Unmarshal([]byte(`["zone=us-east", "gpu"]`), &selector)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector).To(Equal(atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+					{Key: "gpu", Operator: atc.LabelOperatorExists},
+				},
+			}))
+		})
+
+		It("accepts required and preferred expressions", func() {
+			var selector atc.WorkerSelector
+			err := json.Unmarshal([]byte(`{"required":["zone=us-east"],"preferred":["disk in (ssd, nvme)"]}`), &selector)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector).To(Equal(atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+				Preferred: []atc.LabelExpression{
+					{Key: "disk", Operator: atc.LabelOperatorIn, Values: []string{"ssd", "nvme"}},
+				},
+			}))
+		})
+
+		It("rejects unknown fields", func() {
+			var selector atc.WorkerSelector
+			err := json.Unmarshal([]byte(`{"requires":["zone=us-east"]}`), &selector)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects invalid expressions", func() {
+			var selector atc.WorkerSelector
+			err := json.Unmarshal([]byte(`["zone like us-east"]`), &selector)
+			Expect(err).To(MatchError("invalid label expression 'zone like us-east': unknown operator"))
+		})
+
+		It("round-trips through JSON", func() {
+			selector := atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorNotEquals, Values: []string{"us-east"}},
+				},
+				Preferred: []atc.LabelExpression{
+					{Key: "disk", Operator: atc.LabelOperatorIn, Values: []string{"ssd", "nvme"}},
+					{Key: "gpu", Operator: atc.LabelOperatorExists},
+				},
+			}
+
+			payload, err := json.Marshal(selector)
+			Expect(err).ToNot(HaveOccurred())
+
+			var unmarshaled atc.WorkerSelector
+			err = json.Unmarshal(payload, &unmarshaled)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unmarshaled).To(Equal(selector))
+		})
+	})
+
+	Describe("Matches", func() {
+		It("requires every required expression to match", func() {
+			selector := &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+					{Key: "arch", Operator: atc.LabelOperatorExists},
+				},
+			}
+
+			Expect(selector.Matches(labels)).To(BeFalse())
+			Expect(selector.Matches(map[string]string{"zone": "us-east", "arch": "amd64"})).To(BeTrue())
+		})
+
+		It("ignores preferred expressions", func() {
+			selector := &atc.WorkerSelector{
+				Preferred: []atc.LabelExpression{
+					{Key: "arch", Operator: atc.LabelOperatorExists},
+				},
+			}
+
+			Expect(selector.Matches(labels)).To(BeTrue())
+		})
+
+		It("matches everything when nil", func() {
+			var selector *atc.WorkerSelector
+			Expect(selector.Matches(labels)).To(BeTrue())
+		})
+	})
+
+	Describe("Targets", func() {
+		It("is true when a required expression other than != matches", func() {
+			selector := &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+			}
+
+			Expect(selector.Targets(labels)).To(BeTrue())
+		})
+
+		It("is false when only != expressions match", func() {
+			selector := &atc.WorkerSelector{
+				Required: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorNotEquals, Values: []string{"us-west"}},
+					{Key: "arch", Operator: atc.LabelOperatorExists},
+				},
+			}
+
+			Expect(selector.Targets(labels)).To(BeFalse())
+		})
+
+		It("ignores preferred expressions", func() {
+			selector := &atc.WorkerSelector{
+				Preferred: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+				},
+			}
+
+			Expect(selector.Targets(labels)).To(BeFalse())
+		})
+	})
+
+	Describe("Preference", func() {
+		It("counts the matching preferred expressions", func() {
+			selector := &atc.WorkerSelector{
+				Preferred: []atc.LabelExpression{
+					{Key: "zone", Operator: atc.LabelOperatorEquals, Values: []string{"us-east"}},
+					{Key: "disk", Operator: atc.LabelOperatorEquals, Values: []string{"nvme"}},
+					{Key: "gpu", Operator: atc.LabelOperatorExists},
+				},
+			}
+
+			Expect(selector.Preference(labels)).To(Equal(2))
+		})
+	})
+})
@@ -44,6 +44,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
+			atc.GetBuildStepLog,
+			atc.DownloadBuildLog,
 			atc.GetBuildPlan,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
@@ -64,6 +66,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline is public or authorized
 		case atc.GetPipeline,
+			atc.GetPipelineGraph,
+			atc.GetPipelineStats,
 			atc.GetJobBuild,
 			atc.PipelineBadge,
 			atc.JobBadge,
@@ -86,16 +90,21 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetContainer,
 			atc.HijackContainer,
 			atc.ListContainers,
+			atc.ListHijackSessions,
+			atc.GetHijackSessionRecording,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
 			atc.GetTeam,
 			atc.SetTeam,
+			atc.ApplyTeam,
 			atc.ListTeamBuilds,
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes,
+			atc.ListLockPools,
+			atc.ReleaseLockPool,
 			atc.GetUser:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
@@ -119,7 +128,16 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.GetMaintenance,
+			atc.EnableMaintenance,
+			atc.DisableMaintenance,
+			atc.ListComponents,
+			atc.PauseComponent,
+			atc.UnpauseComponent,
+			atc.RunComponent,
+			atc.ListSystemLocks,
+			atc.ReleaseSystemLock:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -149,6 +167,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SaveConfig,
 			atc.ArchivePipeline,
 			atc.ClearTaskCache,
+			atc.ClearTaskResultCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
 			atc.GetArtifact:
@@ -122,6 +122,8 @@ var _ = Describe("APIAuthWrappa", func() {
 
 				// authorized or public pipeline and public job
 				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
+				atc.GetBuildStepLog:     checksIfPrivateJob(inputHandlers[atc.GetBuildStepLog]),
+				atc.DownloadBuildLog:    checksIfPrivateJob(inputHandlers[atc.DownloadBuildLog]),
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
@@ -140,6 +142,8 @@ var _ = Describe("APIAuthWrappa", func() {
 
 				// belongs to public pipeline or authorized
 				atc.GetPipeline:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipeline]),
+				atc.GetPipelineGraph:              openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipelineGraph]),
+				atc.GetPipelineStats:              openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipelineStats]),
 				atc.GetJobBuild:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobBuild]),
 				atc.PipelineBadge:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.PipelineBadge]),
 				atc.JobBadge:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.JobBadge]),
@@ -161,7 +165,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
 				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
 				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
+
+				atc.ListHijackSessions:        authenticated(inputHandlers[atc.ListHijackSessions]),
+				atc.GetHijackSessionRecording: authenticated(inputHandlers[atc.GetHijackSessionRecording]),
+
 				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListLockPools:   authenticated(inputHandlers[atc.ListLockPools]),
+				atc.ReleaseLockPool: authenticated(inputHandlers[atc.ReleaseLockPool]),
 				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
 				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
 				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
@@ -169,6 +179,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
 				atc.GetTeam:         authenticated(inputHandlers[atc.GetTeam]),
 				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
+				atc.ApplyTeam:       authenticated(inputHandlers[atc.ApplyTeam]),
 				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
@@ -195,6 +206,18 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SetWall:              authenticatedAndAdmin(inputHandlers[atc.SetWall]),
 				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
 
+				atc.GetMaintenance:     authenticatedAndAdmin(inputHandlers[atc.GetMaintenance]),
+				atc.EnableMaintenance:  authenticatedAndAdmin(inputHandlers[atc.EnableMaintenance]),
+				atc.DisableMaintenance: authenticatedAndAdmin(inputHandlers[atc.DisableMaintenance]),
+
+				atc.ListComponents:   authenticatedAndAdmin(inputHandlers[atc.ListComponents]),
+				atc.PauseComponent:   authenticatedAndAdmin(inputHandlers[atc.PauseComponent]),
+				atc.UnpauseComponent: authenticatedAndAdmin(inputHandlers[atc.UnpauseComponent]),
+				atc.RunComponent:     authenticatedAndAdmin(inputHandlers[atc.RunComponent]),
+
+				atc.ListSystemLocks:   authenticatedAndAdmin(inputHandlers[atc.ListSystemLocks]),
+				atc.ReleaseSystemLock: authenticatedAndAdmin(inputHandlers[atc.ReleaseSystemLock]),
+
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
@@ -223,6 +246,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
 				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.ClearTaskResultCache:    authorized(inputHandlers[atc.ClearTaskResultCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
 			}
@@ -30,8 +30,11 @@ func (wrappa CompressionWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			}
 
 			wrapped[name] = gzipEnforcedHandler(handler)
-		// skip gzip as this endpoint does it already
-		case atc.DownloadCLI:
+		// skip gzip as these endpoints do it already
+		case atc.DownloadCLI, atc.DownloadBuildLog:
+			wrapped[name] = handler
+		// skip gzip as it would hold back small writes while following a step
+		case atc.GetBuildStepLog:
 			wrapped[name] = handler
 		default:
 			wrapped[name] = gziphandler.GzipHandler(handler)
@@ -45,6 +45,8 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
+			atc.GetBuildStepLog,
+			atc.DownloadBuildLog,
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
@@ -76,7 +78,11 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetContainer,
 			atc.HijackContainer,
 			atc.ListContainers,
+			atc.ListHijackSessions,
+			atc.GetHijackSessionRecording,
 			atc.ListVolumes,
+			atc.ListLockPools,
+			atc.ReleaseLockPool,
 			atc.ListTeamBuilds,
 			atc.ListWorkers,
 			atc.RegisterWorker,
@@ -84,6 +90,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.DeleteWorker,
 			atc.GetTeam,
 			atc.SetTeam,
+			atc.ApplyTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.GetUser,
@@ -105,9 +112,20 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListActiveUsersSince,
 			atc.SetWall,
 			atc.ClearWall,
+			atc.GetMaintenance,
+			atc.EnableMaintenance,
+			atc.DisableMaintenance,
+			atc.ListComponents,
+			atc.PauseComponent,
+			atc.UnpauseComponent,
+			atc.RunComponent,
+			atc.ListSystemLocks,
+			atc.ReleaseSystemLock,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.GetPipelineGraph,
+			atc.GetPipelineStats,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
@@ -119,6 +137,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.HidePipeline,
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
+			atc.ClearTaskResultCache,
 			atc.CreateArtifact,
 			atc.GetArtifact:
 
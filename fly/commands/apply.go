@@ -0,0 +1,358 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
+)
+
+// ApplyManifestFile is the manifest read when a directory is given to apply.
+const ApplyManifestFile = "manifest.yml"
+
+type ApplyCommand struct {
+	File atc.PathFlag `short:"f" long:"file" required:"true" description:"Manifest file, or directory containing a manifest.yml"`
+
+	DryRun          bool `long:"dry-run"              description:"Show the changes without applying them"`
+	Prune           bool `long:"prune"                description:"Destroy pipelines of the declared teams which are not in the manifest"`
+	SkipInteractive bool `short:"n" long:"non-interactive" description:"Apply the changes without confirmation"`
+	JSON            bool `long:"json"                 description:"Print the plans as JSON"`
+}
+
+type applyManifest struct {
+	Teams []applyTeam `json:"teams"`
+}
+
+type applyTeam struct {
+	Name      string          `json:"name"`
+	Auth      atc.TeamAuth    `json:"auth,omitempty"`
+	Pipelines []applyPipeline `json:"pipelines"`
+}
+
+type applyPipeline struct {
+	Name         string                 `json:"name"`
+	Config       string                 `json:"config"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	LoadVarsFrom []string               `json:"load_vars_from,omitempty"`
+	Paused       bool                   `json:"paused"`
+	Exposed      bool                   `json:"exposed"`
+}
+
+func (command *ApplyCommand) Execute([]string) error {
+	if command.JSON && !command.DryRun && !command.SkipInteractive {
+		return errors.New("--json requires --dry-run or --non-interactive")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teamManifests, err := command.loadManifest()
+	if err != nil {
+		return err
+	}
+
+	plans := []atc.TeamApplyPlan{}
+	for _, teamName := range sortedTeamNames(teamManifests) {
+		plan, err := target.Client().Team(teamName).Apply(teamManifests[teamName], true, command.Prune)
+		if err != nil {
+			return fmt.Errorf("failed to plan team '%s': %s", teamName, err)
+		}
+
+		plans = append(plans, plan)
+	}
+
+	hasChanges := false
+	for _, plan := range plans {
+		if plan.HasChanges() {
+			hasChanges = true
+		}
+	}
+
+	if command.DryRun || !hasChanges {
+		if command.JSON {
+			return json.NewEncoder(os.Stdout).Encode(plans)
+		}
+
+		showApplyPlans(plans)
+
+		if !hasChanges {
+			fmt.Println("no changes to apply")
+		}
+
+		return nil
+	}
+
+	if !command.JSON {
+		showApplyPlans(plans)
+
+		if !command.SkipInteractive {
+			confirm := false
+			err = interact.NewInteraction("apply changes?").Resolve(&confirm)
+			if err != nil || !confirm {
+				fmt.Println("bailing out")
+				return err
+			}
+		}
+	}
+
+	applied := []atc.TeamApplyPlan{}
+	for _, plan := range plans {
+		if !plan.HasChanges() {
+			applied = append(applied, plan)
+			continue
+		}
+
+		// only apply what was confirmed; the server refuses if any of the
+		// pipelines have been saved since the plan was made
+		manifest := teamManifests[plan.Team]
+		manifest.ConfigVersions = plan.ConfigVersions()
+
+		appliedPlan, err := target.Client().Team(plan.Team).Apply(manifest, false, command.Prune)
+		if err != nil {
+			switch e := err.(type) {
+			case concourse.ConflictError:
+				return fmt.Errorf("failed to apply team '%s': %s\nrun apply again to review the changes", plan.Team, e.Message)
+
+			case concourse.ApplyError:
+				if command.JSON {
+					jsonErr := json.NewEncoder(os.Stdout).Encode(append(applied, e.Plan))
+					if jsonErr != nil {
+						return jsonErr
+					}
+				} else {
+					showPartialApply(e.Plan)
+				}
+
+				return fmt.Errorf("team '%s' was only partially applied: %s", plan.Team, e.Plan.Error)
+			}
+
+			return fmt.Errorf("failed to apply team '%s': %s", plan.Team, err)
+		}
+
+		applied = append(applied, appliedPlan)
+
+		if !command.JSON {
+			fmt.Printf("team '%s' applied\n", plan.Team)
+		}
+	}
+
+	if command.JSON {
+		return json.NewEncoder(os.Stdout).Encode(applied)
+	}
+
+	return nil
+}
+
+func (command *ApplyCommand) loadManifest() (map[string]atc.TeamManifest, error) {
+	manifestPath := string(command.File)
+
+	info, err := os.Stat(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		manifestPath = filepath.Join(manifestPath, ApplyManifestFile)
+	}
+
+	payload, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest: %s", err)
+	}
+
+	var manifest applyManifest
+	err = yaml.UnmarshalStrict(payload, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse manifest: %s", err)
+	}
+
+	baseDir := filepath.Dir(manifestPath)
+
+	teamManifests := map[string]atc.TeamManifest{}
+	for _, team := range manifest.Teams {
+		if team.Name == "" {
+			return nil, errors.New("manifest declares a team without a name")
+		}
+
+		if _, found := teamManifests[team.Name]; found {
+			return nil, fmt.Errorf("team '%s' is declared more than once", team.Name)
+		}
+
+		teamManifest := atc.TeamManifest{
+			Auth:      team.Auth,
+			Pipelines: []atc.PipelineManifest{},
+		}
+
+		for _, pipeline := range team.Pipelines {
+			config, err := loadApplyPipelineConfig(baseDir, pipeline)
+			if err != nil {
+				return nil, fmt.Errorf("team '%s' pipeline '%s': %s", team.Name, pipeline.Name, err)
+			}
+
+			teamManifest.Pipelines = append(teamManifest.Pipelines, atc.PipelineManifest{
+				Name:    pipeline.Name,
+				Config:  config,
+				Paused:  pipeline.Paused,
+				Exposed: pipeline.Exposed,
+			})
+		}
+
+		teamManifests[team.Name] = teamManifest
+	}
+
+	return teamManifests, nil
+}
+
+func loadApplyPipelineConfig(baseDir string, pipeline applyPipeline) (atc.Config, error) {
+	if pipeline.Config == "" {
+		return atc.Config{}, errors.New("no config given")
+	}
+
+	varsFrom := []atc.PathFlag{}
+	for _, path := range pipeline.LoadVarsFrom {
+		varsFrom = append(varsFrom, atc.PathFlag(resolveManifestPath(baseDir, path)))
+	}
+
+	yamlVars := []flaghelpers.YAMLVariablePairFlag{}
+	for name, value := range pipeline.Vars {
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{Name: name, Value: value})
+	}
+
+	template := templatehelpers.NewYamlTemplateWithParams(
+		atc.PathFlag(resolveManifestPath(baseDir, pipeline.Config)),
+		varsFrom,
+		nil,
+		yamlVars,
+	)
+
+	evaluatedTemplate, err := template.Evaluate(false, false)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &config)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	return config, nil
+}
+
+func resolveManifestPath(baseDir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(baseDir, path)
+}
+
+func sortedTeamNames(teamManifests map[string]atc.TeamManifest) []string {
+	names := []string{}
+	for name := range teamManifests {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func showApplyPlans(plans []atc.TeamApplyPlan) {
+	for _, plan := range plans {
+		fmt.Printf("team %s:\n", plan.Team)
+
+		if plan.CreateTeam {
+			fmt.Println("  " + ui.SucceededColor.Sprint("create team"))
+		}
+
+		if plan.UpdateAuth {
+			fmt.Println("  update auth")
+		}
+
+		for _, pipeline := range plan.Pipelines {
+			changes := []string{}
+
+			switch pipeline.Action {
+			case atc.ApplyActionCreate:
+				changes = append(changes, ui.SucceededColor.Sprint("create"))
+			case atc.ApplyActionUpdate:
+				changes = append(changes, ui.StartedColor.Sprint("update"))
+			case atc.ApplyActionDestroy:
+				changes = append(changes, ui.ErroredColor.Sprint("destroy"))
+			}
+
+			if pipeline.Pause != nil {
+				if *pipeline.Pause {
+					changes = append(changes, "pause")
+				} else {
+					changes = append(changes, "unpause")
+				}
+			}
+
+			if pipeline.Expose != nil {
+				if *pipeline.Expose {
+					changes = append(changes, "expose")
+				} else {
+					changes = append(changes, "hide")
+				}
+			}
+
+			if len(changes) == 0 {
+				changes = append(changes, "unchanged")
+			}
+
+			fmt.Printf("  pipeline %s: %s\n", pipeline.Name, strings.Join(changes, ", "))
+
+			if pipeline.Diff != "" {
+				for _, line := range strings.Split(strings.TrimRight(pipeline.Diff, "\n"), "\n") {
+					fmt.Println("    " + line)
+				}
+			}
+		}
+
+		if plan.Reorder {
+			fmt.Println("  reorder pipelines")
+		}
+
+		fmt.Println("")
+	}
+}
+
+func showPartialApply(plan atc.TeamApplyPlan) {
+	fmt.Printf("team %s:\n", plan.Team)
+
+	for _, pipeline := range plan.Pipelines {
+		if !pipeline.HasChanges() {
+			continue
+		}
+
+		if pipeline.Applied {
+			fmt.Printf("  pipeline %s: %s\n", pipeline.Name, ui.SucceededColor.Sprint("applied"))
+		} else {
+			fmt.Printf("  pipeline %s: %s\n", pipeline.Name, ui.ErroredColor.Sprint("not applied"))
+		}
+	}
+
+	fmt.Println("")
+}
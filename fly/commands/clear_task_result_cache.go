@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type ClearTaskResultCacheCommand struct {
+	Job             flaghelpers.JobFlag `short:"j" long:"job"  required:"true"  description:"Job to clear cached results from"`
+	StepName        string              `short:"s" long:"step"  required:"true" description:"Step name to clear cached results from"`
+	SkipInteractive bool                `short:"n"  long:"non-interactive"          description:"Clear the cached results without confirmation"`
+}
+
+func (command *ClearTaskResultCacheCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("!!! this will remove the cached results for `%s/%s`, task step `%s`\n\n",
+		command.Job.PipelineName, command.Job.JobName, command.StepName)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	numRemoved, err := target.Team().ClearTaskResultCache(command.Job.PipelineName, command.Job.JobName, command.StepName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d cached results removed\n", numRemoved)
+	return nil
+}
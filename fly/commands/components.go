@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ComponentsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ComponentsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	components, err := target.Client().ListComponents()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(components)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "interval", Color: color.New(color.Bold)},
+			{Contents: "paused", Color: color.New(color.Bold)},
+			{Contents: "last ran", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "ran by", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, component := range components {
+		pausedCell := ui.TableCell{Contents: "no"}
+		if component.Paused {
+			pausedCell.Contents = "yes"
+			pausedCell.Color = ui.OnColor
+		}
+
+		lastRanCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if component.LastRan != 0 {
+			lastRanCell = ui.TableCell{Contents: time.Unix(component.LastRan, 0).Local().Format(timeDateLayout)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: component.Name},
+			{Contents: secondsToDuration(component.Interval).String()},
+			pausedCell,
+			lastRanCell,
+			componentDurationCell(component),
+			stringOrDefault(component.LastRanBy, "n/a"),
+			stringOrDefault(component.LastError),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func componentDurationCell(component atc.ComponentStatus) ui.TableCell {
+	if component.LastRanBy == "" {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: secondsToDuration(component.LastDuration).Round(time.Millisecond).String()}
+}
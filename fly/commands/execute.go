@@ -133,7 +133,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 
 	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
 
-	eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+	eventSource, err := client.FilteredBuildEvents(strconv.Itoa(build.ID), concourse.BuildEventsFilter{Progress: true})
 	if err != nil {
 		return err
 	}
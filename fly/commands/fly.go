@@ -32,22 +32,28 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	Apply ApplyCommand `command:"apply" description:"Reconcile teams and pipelines with a manifest"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
 	Watch   WatchCommand   `command:"watch"   alias:"w" description:"Stream a build's output"`
 
-	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
-	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	Containers     ContainersCommand     `command:"containers"      alias:"cs" description:"Print the active containers"`
+	Hijack         HijackCommand         `command:"hijack"          alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	HijackSessions HijackSessionsCommand `command:"hijack-sessions" alias:"hss" description:"List the team's recorded hijack sessions"`
+	ReplaySession  ReplaySessionCommand  `command:"replay-session"  alias:"rps" description:"Replay the recording of a hijack session"`
 
 	Jobs        JobsCommand        `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	JobStats    JobStatsCommand    `command:"job-stats"               description:"Show the success rate, duration and recovery time of the jobs in a pipeline"`
 
 	Pipelines        PipelinesCommand        `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
 	GetPipeline      GetPipelineCommand      `command:"get-pipeline"        alias:"gp"   description:"Get a pipeline's current configuration"`
+	PipelineGraph    PipelineGraphCommand    `command:"pipeline-graph"                   description:"Print the graph of a pipeline's jobs and resources"`
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
@@ -69,7 +75,8 @@ type FlyCommand struct {
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
-	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
+	ClearTaskCache       ClearTaskCacheCommand       `command:"clear-task-cache"        alias:"ctc"  description:"Clears cache from a task container"`
+	ClearTaskResultCache ClearTaskResultCacheCommand `command:"clear-task-result-cache" alias:"ctrc" description:"Clears the cached results of a task step"`
 
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
@@ -77,12 +84,23 @@ type FlyCommand struct {
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
+	Locks             LocksCommand             `command:"locks"               alias:"lk"  description:"List the team's lock pools and the builds holding them, or the ATCs' database locks"`
+	ReleaseLock       ReleaseLockCommand       `command:"release-lock"        alias:"rl"  description:"Release a lock held by a build"`
+	ReleaseSystemLock ReleaseSystemLockCommand `command:"release-system-lock" alias:"rsl" description:"Release a database lock held by an ATC"`
+
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
 	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
 
+	Maintenance MaintenanceCommand `command:"maintenance" alias:"mt" description:"Enable, disable or show the cluster's maintenance mode, during which no new builds are started"`
+
+	Components       ComponentsCommand       `command:"components" alias:"cps" description:"List the ATC's background components and their last run"`
+	PauseComponent   PauseComponentCommand   `command:"pause-component" alias:"pc" description:"Pause a background component"`
+	UnpauseComponent UnpauseComponentCommand `command:"unpause-component" alias:"upc" description:"Unpause a background component"`
+	RunComponent     RunComponentCommand     `command:"run-component" alias:"rnc" description:"Run a background component immediately"`
+
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
 	Completion CompletionCommand `command:"completion" description:"generate shell completion code"`
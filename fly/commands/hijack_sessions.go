@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type HijackSessionsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *HijackSessionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	sessions, err := target.Team().ListHijackSessions()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(sessions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "handle", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "command", Color: color.New(color.Bold)},
+			{Contents: "started", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "exit status", Color: color.New(color.Bold)},
+		},
+	}
+
+	now := time.Now()
+
+	for _, session := range sessions {
+		duration := roundSecondsOffDuration(session.Duration(now)).String()
+
+		exitStatusCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if session.EndTime == 0 {
+			duration += "+"
+		} else if session.ExitStatus != nil {
+			exitStatusCell = ui.TableCell{Contents: strconv.Itoa(*session.ExitStatus)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(session.ID)},
+			{Contents: session.User},
+			{Contents: session.Container.Handle},
+			hijackSessionBuildCell(session.Container),
+			stringOrDefault(session.Container.StepName),
+			{Contents: strings.Join(append([]string{session.Path}, session.Args...), " ")},
+			{Contents: time.Unix(session.StartTime, 0).Local().Format(timeDateLayout)},
+			{Contents: duration},
+			exitStatusCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func hijackSessionBuildCell(container atc.HijackSessionContainer) ui.TableCell {
+	if container.JobName != "" {
+		return ui.TableCell{Contents: fmt.Sprintf("%s/%s/%s", container.PipelineName, container.JobName, container.BuildName)}
+	}
+
+	if container.BuildID != 0 {
+		return ui.TableCell{Contents: "one-off"}
+	}
+
+	return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+}
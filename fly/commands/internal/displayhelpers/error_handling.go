@@ -37,6 +37,9 @@ func ShowWarnings(warnings []concourse.ConfigWarning) {
 
 	for _, warning := range warnings {
 		fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
+		if warning.Hint != "" {
+			fmt.Fprintf(ui.Stderr, "    hint: %s\n", warning.Hint)
+		}
 	}
 
 	fmt.Fprintln(ui.Stderr, "")
@@ -26,6 +26,10 @@ func NewYamlTemplateWithParams(filePath atc.PathFlag, templateVariablesFiles []a
 	}
 }
 
+func (yamlTemplate YamlTemplateWithParams) Path() atc.PathFlag {
+	return yamlTemplate.filePath
+}
+
 func (yamlTemplate YamlTemplateWithParams) Evaluate(
 	allowEmpty bool,
 	strict bool,
@@ -0,0 +1,173 @@
+package validatepipelinehelpers
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	// rule IDs used for validation errors and warnings, which are not lint
+	// rules of their own
+	invalidConfigRule = "invalid-config"
+	configWarningRule = "config-warning"
+)
+
+// SARIFLog is a subset of the Static Analysis Results Interchange Format,
+// understood by code scanning tools and code review bots.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name  string      `json:"name"`
+	Rules []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     SARIFMessage       `json:"shortDescription"`
+	Help                 *SARIFMessage      `json:"help,omitempty"`
+	DefaultConfiguration SARIFConfiguration `json:"defaultConfiguration"`
+}
+
+type SARIFConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SARIFLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// NewSARIFLog converts the report for the pipeline config at the path into a
+// SARIF log.
+func NewSARIFLog(path string, report Report) SARIFLog {
+	rules := []SARIFRule{
+		{
+			ID:                   invalidConfigRule,
+			ShortDescription:     SARIFMessage{Text: "pipeline config is invalid"},
+			DefaultConfiguration: SARIFConfiguration{Level: "error"},
+		},
+		{
+			ID:                   configWarningRule,
+			ShortDescription:     SARIFMessage{Text: "pipeline config uses deprecated or unused features"},
+			DefaultConfiguration: SARIFConfiguration{Level: "warning"},
+		},
+	}
+
+	for _, rule := range configvalidate.AllLintRules {
+		rules = append(rules, SARIFRule{
+			ID:                   rule.ID,
+			ShortDescription:     SARIFMessage{Text: rule.Description},
+			Help:                 &SARIFMessage{Text: rule.Hint},
+			DefaultConfiguration: SARIFConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+	}
+
+	fileLocation := SARIFPhysicalLocation{
+		ArtifactLocation: SARIFArtifactLocation{URI: path},
+	}
+
+	results := []SARIFResult{}
+
+	for _, message := range report.Errors {
+		results = append(results, SARIFResult{
+			RuleID:    invalidConfigRule,
+			Level:     "error",
+			Message:   SARIFMessage{Text: message},
+			Locations: []SARIFLocation{{PhysicalLocation: fileLocation}},
+		})
+	}
+
+	for _, warning := range report.Warnings {
+		results = append(results, SARIFResult{
+			RuleID:    configWarningRule,
+			Level:     "warning",
+			Message:   SARIFMessage{Text: warning.Message},
+			Locations: []SARIFLocation{{PhysicalLocation: fileLocation}},
+		})
+	}
+
+	for _, finding := range report.Findings {
+		message := finding.Message
+		if finding.Hint != "" {
+			message += " (hint: " + finding.Hint + ")"
+		}
+
+		results = append(results, SARIFResult{
+			RuleID:  finding.Rule,
+			Level:   sarifLevel(finding.Severity),
+			Message: SARIFMessage{Text: message},
+			Locations: []SARIFLocation{{
+				PhysicalLocation: fileLocation,
+				LogicalLocations: []SARIFLogicalLocation{{FullyQualifiedName: finding.Location}},
+			}},
+		})
+	}
+
+	return SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SARIFRun{
+			{
+				Tool: SARIFTool{
+					Driver: SARIFDriver{
+						Name:  "fly validate-pipeline",
+						Rules: rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+func sarifLevel(severity atc.LintSeverity) string {
+	switch severity {
+	case atc.LintSeverityError:
+		return "error"
+	case atc.LintSeverityWarning:
+		return "warning"
+	case atc.LintSeverityInfo:
+		return "note"
+	default:
+		return "none"
+	}
+}
@@ -1,17 +1,56 @@
 package validatepipelinehelpers
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+
 	"github.com/concourse/concourse/atc"
 
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	"sigs.k8s.io/yaml"
 )
 
-func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool) error {
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Report is the result of validating a pipeline, as printed by the json
+// format.
+type Report struct {
+	Errors   []string            `json:"errors"`
+	Warnings []atc.ConfigWarning `json:"warnings"`
+	Findings []atc.LintFinding   `json:"findings"`
+}
+
+func (report Report) Failed(strict bool) bool {
+	if len(report.Errors) > 0 || (strict && len(report.Warnings) > 0) {
+		return true
+	}
+
+	for _, finding := range report.Findings {
+		if finding.Severity == atc.LintSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate validates the pipeline, printing the result in the given format.
+// Lint rules are only run in strict mode or when a machine-readable format is
+// requested.
+func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool, lintRules atc.LintRules, format string) error {
+	if err := configvalidate.ValidateLintRules(lintRules); err != nil {
+		return err
+	}
+
 	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
 	if err != nil {
 		return err
@@ -32,22 +71,41 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 
 	warnings, errorMessages := configvalidate.Validate(unmarshalledTemplate)
 
-	if len(warnings) > 0 {
-		configWarnings := make([]concourse.ConfigWarning, len(warnings))
-		for idx, warning := range warnings {
-			configWarnings[idx] = concourse.ConfigWarning(warning)
-		}
-		displayhelpers.ShowWarnings(configWarnings)
+	report := Report{
+		Errors:   errorMessages,
+		Warnings: warnings,
+		Findings: []atc.LintFinding{},
 	}
 
-	if len(errorMessages) > 0 {
-		displayhelpers.ShowErrors("Error loading existing config", errorMessages)
+	if strict || format != FormatText {
+		report.Findings = configvalidate.Lint(unmarshalledTemplate, lintRules)
+	}
+
+	switch format {
+	case FormatJSON:
+		err = json.NewEncoder(os.Stdout).Encode(report)
+		if err != nil {
+			return err
+		}
+
+	case FormatSARIF:
+		err = json.NewEncoder(os.Stdout).Encode(NewSARIFLog(string(yamlTemplate.Path()), report))
+		if err != nil {
+			return err
+		}
+
+	default:
+		showReport(report)
 	}
 
-	if len(errorMessages) > 0 || (strict && len(warnings) > 0) {
+	if report.Failed(strict) {
 		displayhelpers.Failf("configuration invalid")
 	}
 
+	if format != FormatText {
+		return nil
+	}
+
 	if output {
 		fmt.Println(string(evaluatedTemplate))
 	} else {
@@ -56,3 +114,36 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 
 	return nil
 }
+
+func showReport(report Report) {
+	if len(report.Warnings) > 0 {
+		configWarnings := make([]concourse.ConfigWarning, len(report.Warnings))
+		for idx, warning := range report.Warnings {
+			configWarnings[idx] = concourse.ConfigWarning(warning)
+		}
+		displayhelpers.ShowWarnings(configWarnings)
+	}
+
+	if len(report.Errors) > 0 {
+		displayhelpers.ShowErrors("Error loading existing config", report.Errors)
+	}
+
+	if len(report.Findings) > 0 {
+		fmt.Fprintln(ui.Stderr, "")
+		fmt.Fprintln(ui.Stderr, "lint findings:")
+
+		for _, finding := range report.Findings {
+			severity := string(finding.Severity)
+			if finding.Severity == atc.LintSeverityError {
+				severity = ui.ErroredColor.Sprint(severity)
+			}
+
+			fmt.Fprintf(ui.Stderr, "  - [%s] %s: %s (%s)\n", severity, finding.Location, finding.Message, finding.Rule)
+			if finding.Hint != "" {
+				fmt.Fprintf(ui.Stderr, "    hint: %s\n", finding.Hint)
+			}
+		}
+
+		fmt.Fprintln(ui.Stderr, "")
+	}
+}
@@ -99,24 +99,84 @@ resource_types:
 		})
 
 		It("validates a good pipeline", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, false, false)
+			err := validatepipelinehelpers.Validate(goodPipeline, false, false, nil, validatepipelinehelpers.FormatText)
 			Expect(err).To(BeNil())
 		})
 		It("validates a good pipeline with strict", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, true, false)
+			err := validatepipelinehelpers.Validate(goodPipeline, true, false, nil, validatepipelinehelpers.FormatText)
 			Expect(err).To(BeNil())
 		})
 		It("validates a good pipeline with output", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, true, true)
+			err := validatepipelinehelpers.Validate(goodPipeline, true, true, nil, validatepipelinehelpers.FormatText)
 			Expect(err).To(BeNil())
 		})
 		It("do not fail validating a pipeline with repeated resource types (probably should but for compat doesn't)", func() {
-			err := validatepipelinehelpers.Validate(dupkeyPipeline, false, false)
+			err := validatepipelinehelpers.Validate(dupkeyPipeline, false, false, nil, validatepipelinehelpers.FormatText)
 			Expect(err).To(BeNil())
 		})
 		It("fail validating a pipeline with repeated resource types with strict", func() {
-			err := validatepipelinehelpers.Validate(dupkeyPipeline, true, false)
+			err := validatepipelinehelpers.Validate(dupkeyPipeline, true, false, nil, validatepipelinehelpers.FormatText)
 			Expect(err).ToNot(BeNil())
 		})
 	})
+
+	Describe("NewSARIFLog", func() {
+		It("converts errors, warnings and lint findings into results", func() {
+			log := validatepipelinehelpers.NewSARIFLog("pipeline.yml", validatepipelinehelpers.Report{
+				Errors:   []string{"invalid jobs"},
+				Warnings: []atc.ConfigWarning{{Type: "pipeline", Message: "deprecated"}},
+				Findings: []atc.LintFinding{{
+					Rule:     "privileged-task",
+					Severity: atc.LintSeverityError,
+					Location: "jobs.some-job.plan.task(some-task)",
+					Message:  "task 'some-task' runs privileged",
+					Hint:     "drop it",
+				}},
+			})
+
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Results).To(Equal([]validatepipelinehelpers.SARIFResult{
+				{
+					RuleID:  "invalid-config",
+					Level:   "error",
+					Message: validatepipelinehelpers.SARIFMessage{Text: "invalid jobs"},
+					Locations: []validatepipelinehelpers.SARIFLocation{{
+						PhysicalLocation: validatepipelinehelpers.SARIFPhysicalLocation{
+							ArtifactLocation: validatepipelinehelpers.SARIFArtifactLocation{URI: "pipeline.yml"},
+						},
+					}},
+				},
+				{
+					RuleID:  "config-warning",
+					Level:   "warning",
+					Message: validatepipelinehelpers.SARIFMessage{Text: "deprecated"},
+					Locations: []validatepipelinehelpers.SARIFLocation{{
+						PhysicalLocation: validatepipelinehelpers.SARIFPhysicalLocation{
+							ArtifactLocation: validatepipelinehelpers.SARIFArtifactLocation{URI: "pipeline.yml"},
+						},
+					}},
+				},
+				{
+					RuleID:  "privileged-task",
+					Level:   "error",
+					Message: validatepipelinehelpers.SARIFMessage{Text: "task 'some-task' runs privileged (hint: drop it)"},
+					Locations: []validatepipelinehelpers.SARIFLocation{{
+						PhysicalLocation: validatepipelinehelpers.SARIFPhysicalLocation{
+							ArtifactLocation: validatepipelinehelpers.SARIFArtifactLocation{URI: "pipeline.yml"},
+						},
+						LogicalLocations: []validatepipelinehelpers.SARIFLogicalLocation{{
+							FullyQualifiedName: "jobs.some-job.plan.task(some-task)",
+						}},
+					}},
+				},
+			}))
+
+			var ruleIDs []string
+			for _, rule := range log.Runs[0].Tool.Driver.Rules {
+				ruleIDs = append(ruleIDs, rule.ID)
+			}
+			Expect(ruleIDs).To(ContainElements("invalid-config", "config-warning", "privileged-task", "resource-without-icon"))
+		})
+	})
 })
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type JobStatsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get the stats of the jobs in this pipeline"`
+	Since    time.Duration            `long:"since" default:"720h" description:"Only include builds which finished within this duration"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *JobStatsCommand) Execute([]string) error {
+	err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	stats, found, err := target.Team().PipelineStats(string(command.Pipeline), time.Now().Add(-command.Since))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(stats)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := []string{"name", "builds", "success rate", "p50", "p95", "mttr", "scheduled", "manual", "rerun"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, s := range stats {
+		row := ui.TableRow{
+			{Contents: s.JobName},
+			{Contents: strconv.Itoa(s.Builds)},
+		}
+
+		if s.Builds-s.Aborted > 0 {
+			row = append(row, ui.TableCell{Contents: fmt.Sprintf("%.1f%%", s.SuccessRate*100)})
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)})
+		}
+
+		if s.Builds > 0 {
+			row = append(row,
+				ui.TableCell{Contents: formatStatsDuration(s.DurationP50)},
+				ui.TableCell{Contents: formatStatsDuration(s.DurationP95)},
+			)
+		} else {
+			row = append(row,
+				ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)},
+				ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)},
+			)
+		}
+
+		if s.Recoveries > 0 {
+			row = append(row, ui.TableCell{Contents: formatStatsDuration(s.MeanTimeToRecovery)})
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)})
+		}
+
+		row = append(row,
+			ui.TableCell{Contents: strconv.Itoa(s.Triggers.Scheduled)},
+			ui.TableCell{Contents: strconv.Itoa(s.Triggers.Manual)},
+			ui.TableCell{Contents: strconv.Itoa(s.Triggers.Rerun)},
+		)
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func formatStatsDuration(seconds float64) string {
+	return (time.Duration(seconds) * time.Second).Round(time.Second).String()
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type LocksCommand struct {
+	System bool `long:"system" description:"List the database locks held by the ATCs instead of the team's lock pools (admin only)"`
+	Json   bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *LocksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.System {
+		return command.listSystemLocks(target)
+	}
+
+	pools, err := target.Team().ListLockPools()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(pools)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "size", Color: color.New(color.Bold)},
+			{Contents: "build id", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "acquired", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, pool := range pools {
+		size := fmt.Sprintf("%d/%d", len(pool.Holders), pool.Size)
+
+		if len(pool.Holders) == 0 {
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: pool.Name},
+				{Contents: size},
+				{Contents: "n/a", Color: ui.OffColor},
+				{Contents: "n/a", Color: ui.OffColor},
+				{Contents: "n/a", Color: ui.OffColor},
+			})
+			continue
+		}
+
+		for _, holder := range pool.Holders {
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: pool.Name},
+				{Contents: size},
+				{Contents: fmt.Sprintf("%d", holder.BuildID)},
+				{Contents: lockHolderBuild(holder)},
+				{Contents: time.Unix(holder.AcquiredAt, 0).Local().Format(timeDateLayout)},
+			})
+		}
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *LocksCommand) listSystemLocks(target rc.Target) error {
+	locks, err := target.Client().ListSystemLocks()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(locks)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "type", Color: color.New(color.Bold)},
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "object", Color: color.New(color.Bold)},
+			{Contents: "atc", Color: color.New(color.Bold)},
+			{Contents: "session", Color: color.New(color.Bold)},
+			{Contents: "held", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, systemLock := range locks {
+		heldCell := ui.TableCell{Contents: "unknown", Color: ui.OffColor}
+		if held, known := systemLock.Held(); known {
+			heldCell = ui.TableCell{Contents: roundSecondsOffDuration(held).String()}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: systemLock.Type},
+			{Contents: systemLockID(systemLock.ID)},
+			stringOrDefault(systemLock.Object),
+			stringOrDefault(systemLock.ATC, "unknown"),
+			{Contents: strconv.Itoa(systemLock.SessionID)},
+			heldCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func systemLockID(id []int) string {
+	parts := make([]string, len(id))
+	for i, part := range id {
+		parts[i] = strconv.Itoa(part)
+	}
+
+	return strings.Join(parts, ":")
+}
+
+func lockHolderBuild(holder atc.LockPoolHolder) string {
+	if holder.JobName == "" {
+		return "one-off"
+	}
+
+	return fmt.Sprintf("%s/%s/%s", holder.PipelineName, holder.JobName, holder.BuildName)
+}
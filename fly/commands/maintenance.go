@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type MaintenanceCommand struct {
+	Args struct {
+		Mode string `positional-arg-name:"on|off" description:"Enable or disable maintenance mode (default: print whether it is enabled)"`
+	} `positional-args:"yes"`
+
+	Message      string        `short:"m" long:"message" description:"Message to show on the wall while in maintenance (default: a generic notice)"`
+	Wait         bool          `short:"w" long:"wait" description:"After enabling maintenance mode, wait for running builds to finish"`
+	WaitInterval time.Duration `long:"wait-interval" default:"5s" description:"How often to check for running builds while waiting"`
+	Json         bool          `long:"json" description:"Print the status as JSON"`
+}
+
+func (command *MaintenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	switch command.Args.Mode {
+	case "":
+		return command.printStatus(client)
+
+	case "on":
+		err = client.EnableMaintenance(command.Message)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("maintenance mode enabled; no new builds will be started")
+
+		if command.Wait {
+			return command.waitForRunningBuilds(client)
+		}
+
+		return nil
+
+	case "off":
+		err = client.DisableMaintenance()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("maintenance mode disabled")
+		return nil
+
+	default:
+		return errors.New("maintenance mode must be 'on' or 'off'")
+	}
+}
+
+func (command *MaintenanceCommand) printStatus(client concourse.Client) error {
+	status, err := client.Maintenance()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(status)
+	}
+
+	if !status.Enabled {
+		fmt.Println("maintenance mode: off")
+	} else {
+		fmt.Println("maintenance mode: on")
+		fmt.Printf("message: %s\n", status.Message)
+		fmt.Printf("since: %s\n", time.Unix(status.StartTime, 0).Local().Format(timeDateLayout))
+	}
+
+	fmt.Printf("running builds: %d\n", status.RunningBuilds)
+	fmt.Printf("pending builds: %d\n", status.PendingBuilds)
+
+	return nil
+}
+
+func (command *MaintenanceCommand) waitForRunningBuilds(client concourse.Client) error {
+	lastRunning := -1
+
+	for {
+		status, err := client.Maintenance()
+		if err != nil {
+			return err
+		}
+
+		if status.Drained() {
+			fmt.Println("all running builds have finished")
+			return nil
+		}
+
+		if status.RunningBuilds != lastRunning {
+			fmt.Printf("waiting for %s to finish...\n", runningBuildsCount(status.RunningBuilds))
+			lastRunning = status.RunningBuilds
+		}
+
+		time.Sleep(command.WaitInterval)
+	}
+}
+
+func runningBuildsCount(count int) string {
+	if count == 1 {
+		return "1 running build"
+	}
+
+	return fmt.Sprintf("%d running builds", count)
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PipelineGraphCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Pipeline to graph"`
+	Config   atc.PathFlag             `short:"c" long:"config"   description:"Local pipeline configuration file to graph instead of a pipeline"`
+
+	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the local configuration"`
+	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the local configuration"`
+
+	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in the local configuration from a YAML file"`
+
+	Group  string `short:"g" long:"group"  description:"Only graph the jobs and resources in this group"`
+	Format string `long:"format" default:"dot" choice:"dot" choice:"mermaid" choice:"json" description:"Output format"`
+}
+
+func (command *PipelineGraphCommand) Validate() error {
+	if (command.Pipeline == "") == (command.Config == "") {
+		return errors.New("either --pipeline or --config must be given")
+	}
+
+	if command.Pipeline != "" {
+		return command.Pipeline.Validate()
+	}
+
+	return nil
+}
+
+func (command *PipelineGraphCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	var graph atc.PipelineGraph
+	if command.Config != "" {
+		graph, err = command.localGraph()
+	} else {
+		graph, err = command.remoteGraph()
+	}
+	if err != nil {
+		return err
+	}
+
+	switch command.Format {
+	case atc.PipelineGraphFormatJSON:
+		return json.NewEncoder(os.Stdout).Encode(graph)
+	case atc.PipelineGraphFormatMermaid:
+		fmt.Print(graph.Mermaid())
+	default:
+		fmt.Print(graph.DOT())
+	}
+
+	return nil
+}
+
+func (command *PipelineGraphCommand) localGraph() (atc.PipelineGraph, error) {
+	template := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar)
+
+	evaluatedTemplate, err := template.Evaluate(true, false)
+	if err != nil {
+		return atc.PipelineGraph{}, err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &config)
+	if err != nil {
+		return atc.PipelineGraph{}, err
+	}
+
+	graph := atc.NewPipelineGraph(config)
+
+	if command.Group != "" {
+		if _, _, found := config.Groups.Lookup(command.Group); !found {
+			return atc.PipelineGraph{}, fmt.Errorf("group '%s' not found", command.Group)
+		}
+
+		graph = graph.Group(command.Group)
+	}
+
+	return graph, nil
+}
+
+func (command *PipelineGraphCommand) remoteGraph() (atc.PipelineGraph, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return atc.PipelineGraph{}, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return atc.PipelineGraph{}, err
+	}
+
+	graph, found, err := target.Team().PipelineGraph(string(command.Pipeline), command.Group)
+	if err != nil {
+		return atc.PipelineGraph{}, err
+	}
+
+	if !found {
+		if command.Group != "" {
+			return atc.PipelineGraph{}, errors.New("pipeline or group not found")
+		}
+
+		return atc.PipelineGraph{}, errors.New("pipeline not found")
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ReleaseLockCommand struct {
+	Lock  string `short:"l" long:"lock"  required:"true" description:"Name of the lock to release"`
+	Build int    `short:"b" long:"build" description:"ID of the build whose hold on the lock should be released (default: every holder)"`
+}
+
+func (command *ReleaseLockCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Team().ReleaseLockPool(command.Lock, command.Build)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("lock '%s' not found", command.Lock)
+	}
+
+	if command.Build != 0 {
+		fmt.Printf("released lock '%s' held by build %d\n", command.Lock, command.Build)
+	} else {
+		fmt.Printf("released lock '%s'\n", command.Lock)
+	}
+
+	return nil
+}
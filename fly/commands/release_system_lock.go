@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type ReleaseSystemLockCommand struct {
+	Session         int    `short:"s" long:"session" required:"true" description:"ID of the database session holding the lock, as shown by 'fly locks --system'"`
+	ID              string `short:"i" long:"id"      required:"true" description:"ID of the lock to release, e.g. '8:42', as shown by 'fly locks --system'"`
+	SkipInteractive bool   `short:"n" long:"non-interactive"         description:"Release the lock without confirmation"`
+}
+
+func (command *ReleaseSystemLockCommand) Execute([]string) error {
+	id, err := parseSystemLockID(command.ID)
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	locks, err := target.Client().ListSystemLocks()
+	if err != nil {
+		return err
+	}
+
+	var held *atc.SystemLock
+	for i, systemLock := range locks {
+		if systemLock.SessionID == command.Session && systemLockID(systemLock.ID) == systemLockID(id) {
+			held = &locks[i]
+			break
+		}
+	}
+
+	if held == nil {
+		return fmt.Errorf("session %d does not hold lock %s", command.Session, command.ID)
+	}
+
+	fmt.Printf("!!! this will release the following lock, even though it may still be in use:\n\n")
+
+	if held.Object != "" {
+		fmt.Printf("  %s %s (%s)\n", held.Type, systemLockID(held.ID), held.Object)
+	} else {
+		fmt.Printf("  %s %s\n", held.Type, systemLockID(held.ID))
+	}
+
+	fmt.Println()
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := target.Client().ReleaseSystemLock(command.Session, id)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("session %d does not hold lock %s", command.Session, command.ID)
+	}
+
+	// the ATC holding the lock releases it once it is notified
+	fmt.Printf("requested release of lock %s held by session %d\n", command.ID, command.Session)
+
+	return nil
+}
+
+// parseSystemLockID parses a lock ID in the format printed by systemLockID.
+func parseSystemLockID(raw string) ([]int, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("malformed lock ID '%s'", raw)
+	}
+
+	id := make([]int, len(parts))
+	for i, part := range parts {
+		var err error
+		id[i], err = strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("malformed lock ID '%s'", raw)
+		}
+	}
+
+	return id, nil
+}
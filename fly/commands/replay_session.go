@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ReplaySessionCommand struct {
+	Session       int           `short:"s" long:"session" required:"true" description:"ID of the hijack session to replay"`
+	Speed         float64       `long:"speed" default:"1" description:"Playback speed multiplier"`
+	IdleTimeLimit time.Duration `long:"idle-time-limit" default:"2s" description:"Maximum time to wait between output, e.g. while the user was idle"`
+	Raw           bool          `long:"raw" description:"Print the recording as an asciicast instead of replaying it, e.g. for use with asciinema"`
+}
+
+func (command *ReplaySessionCommand) Execute([]string) error {
+	if command.Speed <= 0 {
+		return errors.New("speed must be greater than zero")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	recording, found, err := target.Team().HijackSessionRecording(command.Session)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("recording of session %d not found", command.Session)
+	}
+
+	defer recording.Close()
+
+	if command.Raw {
+		_, err = io.Copy(os.Stdout, recording)
+		return err
+	}
+
+	return command.replay(recording, os.Stdout)
+}
+
+type asciicastHeader struct {
+	Version int `json:"version"`
+}
+
+func (command *ReplaySessionCommand) replay(recording io.Reader, dst io.Writer) error {
+	decoder := json.NewDecoder(bufio.NewReader(recording))
+
+	var header asciicastHeader
+	err := decoder.Decode(&header)
+	if err != nil {
+		return fmt.Errorf("malformed recording: %s", err)
+	}
+
+	if header.Version != 2 {
+		return fmt.Errorf("unsupported recording version: %d", header.Version)
+	}
+
+	var elapsed float64
+	for {
+		var event []interface{}
+		err := decoder.Decode(&event)
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("malformed recording: %s", err)
+		}
+
+		if len(event) != 3 {
+			return fmt.Errorf("malformed recording event: %v", event)
+		}
+
+		at, ok := event[0].(float64)
+		if !ok {
+			return fmt.Errorf("malformed recording event: %v", event)
+		}
+
+		code, _ := event[1].(string)
+		data, _ := event[2].(string)
+
+		// only output is replayed; input is echoed back by the terminal anyway
+		if code != "o" {
+			continue
+		}
+
+		wait := time.Duration((at - elapsed) / command.Speed * float64(time.Second))
+		if command.IdleTimeLimit > 0 && wait > command.IdleTimeLimit {
+			wait = command.IdleTimeLimit
+		}
+
+		elapsed = at
+
+		time.Sleep(wait)
+
+		_, err = io.WriteString(dst, data)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -6,16 +6,21 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type RerunBuildCommand struct {
 	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
 	Build string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
 	Watch bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
+
+	Partial  bool   `long:"partial" description:"Reuse the results of the steps which succeeded before the first failed step, if their volumes still exist"`
+	FromStep string `long:"from-step" value-name:"STEP" description:"Reuse the results of the steps before the named step, if their volumes still exist, and rerun from it"`
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
@@ -31,7 +36,12 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	var build atc.Build
+	if command.Partial || command.FromStep != "" {
+		build, err = target.Team().PartialRerunJobBuild(pipelineName, jobName, buildName, command.FromStep)
+	} else {
+		build, err = target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	}
 	if err != nil {
 		return err
 	}
@@ -51,7 +61,7 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
 
 		fmt.Println("")
-		eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
+		eventSource, err := target.Client().FilteredBuildEvents(fmt.Sprintf("%d", build.ID), concourse.BuildEventsFilter{Progress: true})
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"fmt"
 	"os"
 	"sort"
 	"strconv"
@@ -19,6 +20,10 @@ type ResourceVersionsCommand struct {
 	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	Metadata map[string]string `long:"metadata" value-name:"NAME:VALUE"  description:"Only show versions with this metadata (can be specified multiple times)"`
+	Match    map[string]string `long:"match"    value-name:"FIELD:REGEX" description:"Only show versions whose field matches a regular expression (can be specified multiple times)"`
+	Semver   string            `long:"semver"   value-name:"FIELD:RANGE" description:"Only show versions whose field is a semantic version within a range, e.g. tag:>=1.2 <2"`
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -32,11 +37,21 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
+	filter, err := command.versionFilter()
+	if err != nil {
+		return err
+	}
+
 	page := concourse.Page{Limit: command.Count}
 
 	team := target.Team()
 
-	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page, atc.Version{})
+	var versions []atc.ResourceVersion
+	if filter != nil {
+		versions, _, _, err = team.FilteredResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page, *filter)
+	} else {
+		versions, _, _, err = team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page, atc.Version{})
+	}
 	if err != nil {
 		return err
 	}
@@ -89,3 +104,30 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func (command *ResourceVersionsCommand) versionFilter() (*atc.VersionFilter, error) {
+	filter := &atc.VersionFilter{
+		Metadata: command.Metadata,
+		Version:  command.Match,
+	}
+
+	if command.Semver != "" {
+		vs := strings.SplitN(command.Semver, ":", 2)
+		if len(vs) != 2 {
+			return nil, fmt.Errorf("invalid semver filter '%s' (must be field:range)", command.Semver)
+		}
+
+		filter.Semver = &atc.SemverFilter{Field: vs[0], Range: vs[1]}
+	}
+
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	errs := filter.Validate()
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RunComponentCommand struct {
+	Component string `short:"c" long:"component" required:"true" description:"Name of a component to run immediately"`
+}
+
+func (command *RunComponentCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().RunComponent(command.Component)
+	if err == concourse.ErrComponentPaused {
+		return fmt.Errorf("component '%s' is paused; unpause it with fly unpause-component first", command.Component)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("component '%s' not found", command.Component)
+	}
+
+	fmt.Printf("triggered '%s'\n", command.Component)
+
+	return nil
+}
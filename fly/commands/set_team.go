@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -27,7 +28,14 @@ func WireTeamConnectors(command *flags.Command) {
 type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
-	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	LintRules       []string             `long:"lint-rule" value-name:"RULE=SEVERITY" description:"Override the severity of a pipeline lint rule for the team. Severity is one of error, warning, info or off. Can be specified multiple times."`
+
+	MaxConcurrentBuilds *int `long:"max-concurrent-builds" value-name:"N" description:"Maximum number of builds the team may run at once. 0 means unlimited. Setting any quota replaces all of the team's quotas."`
+	MaxContainers       *int `long:"max-containers" value-name:"N" description:"Maximum number of containers the team may have at once. 0 means unlimited."`
+	MaxVolumes          *int `long:"max-volumes" value-name:"N" description:"Maximum number of volumes the team may have at once. 0 means unlimited."`
+	MaxChecksPerMinute  *int `long:"max-checks-per-minute" value-name:"N" description:"Maximum number of resource checks the team may run per minute. 0 means unlimited."`
+
+	AuthFlags skycmd.AuthTeamFlags `group:"Authentication"`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -47,6 +55,19 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	lintRules, err := atc.ParseLintRules(command.LintRules)
+	if err != nil {
+		return err
+	}
+
+	quotas := command.quotas()
+	if quotas != nil {
+		err = quotas.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -82,6 +103,20 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if len(lintRules) > 0 {
+		fmt.Println()
+		fmt.Printf("lint rules: %s\n", lintRules)
+	}
+
+	if quotas != nil {
+		fmt.Println()
+		fmt.Println("quotas:")
+		fmt.Printf("  max concurrent builds: %s\n", quotaString(quotas.MaxConcurrentBuilds))
+		fmt.Printf("  max containers:        %s\n", quotaString(quotas.MaxContainers))
+		fmt.Printf("  max volumes:           %s\n", quotaString(quotas.MaxVolumes))
+		fmt.Printf("  max checks per minute: %s\n", quotaString(quotas.MaxChecksPerMinute))
+	}
+
 	confirm := true
 	if !command.SkipInteractive {
 		confirm = false
@@ -95,7 +130,7 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{Auth: authRoles, LintRules: lintRules, Quotas: quotas}
 
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -110,3 +145,38 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	return nil
 }
+
+// quotas returns nil unless a quota flag was given, so that the team's
+// existing quotas are left alone.
+func (command *SetTeamCommand) quotas() *atc.TeamQuotas {
+	if command.MaxConcurrentBuilds == nil &&
+		command.MaxContainers == nil &&
+		command.MaxVolumes == nil &&
+		command.MaxChecksPerMinute == nil {
+		return nil
+	}
+
+	quotas := &atc.TeamQuotas{}
+	if command.MaxConcurrentBuilds != nil {
+		quotas.MaxConcurrentBuilds = *command.MaxConcurrentBuilds
+	}
+	if command.MaxContainers != nil {
+		quotas.MaxContainers = *command.MaxContainers
+	}
+	if command.MaxVolumes != nil {
+		quotas.MaxVolumes = *command.MaxVolumes
+	}
+	if command.MaxChecksPerMinute != nil {
+		quotas.MaxChecksPerMinute = *command.MaxChecksPerMinute
+	}
+
+	return quotas
+}
+
+func quotaString(quota int) string {
+	if quota == 0 {
+		return ui.OffColor.Sprint("unlimited")
+	}
+
+	return strconv.Itoa(quota)
+}
@@ -67,7 +67,7 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
 
 		fmt.Println("")
-		eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
+		eventSource, err := target.Client().FilteredBuildEvents(fmt.Sprintf("%d", build.ID), concourse.BuildEventsFilter{Progress: true})
 		if err != nil {
 			return err
 		}
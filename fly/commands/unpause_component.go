@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type UnpauseComponentCommand struct {
+	Component string `short:"c" long:"component" required:"true" description:"Name of a component to unpause"`
+}
+
+func (command *UnpauseComponentCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().UnpauseComponent(command.Component)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("component '%s' not found", command.Component)
+	}
+
+	fmt.Printf("unpaused '%s'\n", command.Component)
+
+	return nil
+}
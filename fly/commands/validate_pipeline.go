@@ -25,9 +25,17 @@ type ValidatePipelineCommand struct {
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	LintRules []string `long:"lint-rule" value-name:"RULE=SEVERITY" description:"Override the severity of a lint rule. Severity is one of error, warning, info or off. Can be specified multiple times."`
+	Format    string   `long:"format" default:"text" choice:"text" choice:"json" choice:"sarif" description:"Format of the validation results. Lint rules are run in strict mode or with the json and sarif formats."`
 }
 
 func (command *ValidatePipelineCommand) Execute(args []string) error {
+	lintRules, err := atc.ParseLintRules(command.LintRules)
+	if err != nil {
+		return err
+	}
+
 	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar)
-	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output)
+	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output, lintRules, command.Format)
 }
@@ -1,14 +1,17 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type WatchCommand struct {
@@ -16,6 +19,8 @@ type WatchCommand struct {
 	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
 	Url       string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Tail      int                 `long:"tail"                  value-name:"N"             description:"Start from the last N events instead of the beginning of the build"`
+	Step      string              `long:"step"                  value-name:"NAME"          description:"Only show the output of the steps with the given name"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -98,7 +103,19 @@ func (command *WatchCommand) Execute(args []string) error {
 		}
 	}
 
-	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
+	filter := concourse.BuildEventsFilter{
+		Tail:     command.Tail,
+		Progress: true,
+	}
+
+	if command.Step != "" {
+		filter.Origins, err = stepOrigins(client, buildId, command.Step)
+		if err != nil {
+			return err
+		}
+	}
+
+	eventSource, err := client.FilteredBuildEvents(fmt.Sprintf("%d", buildId), filter)
 	if err != nil {
 		return err
 	}
@@ -113,3 +130,58 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// stepOrigins finds the ids of the steps with the given name in the build's
+// plan, which are the origins of their events.
+func stepOrigins(client concourse.Client, buildID int, name string) ([]string, error) {
+	plan, found, err := client.BuildPlan(buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found || plan.Plan == nil {
+		return nil, fmt.Errorf("build %d has no plan yet", buildID)
+	}
+
+	var tree interface{}
+	err = json.Unmarshal(*plan.Plan, &tree)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := findStepOrigins(tree, name)
+	if len(origins) == 0 {
+		return nil, fmt.Errorf("step '%s' not found in build %d", name, buildID)
+	}
+
+	sort.Strings(origins)
+
+	return origins, nil
+}
+
+func findStepOrigins(tree interface{}, name string) []string {
+	var origins []string
+
+	switch node := tree.(type) {
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, step := range node {
+				if config, ok := step.(map[string]interface{}); ok && config["name"] == name {
+					origins = append(origins, id)
+					break
+				}
+			}
+		}
+
+		for _, child := range node {
+			origins = append(origins, findStepOrigins(child, name)...)
+		}
+
+	case []interface{}:
+		for _, child := range node {
+			origins = append(origins, findStepOrigins(child, name)...)
+		}
+	}
+
+	return origins
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
@@ -20,6 +21,8 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 	exitStatus := 0
 
+	reportedOutliers := map[event.OriginID]bool{}
+
 	for {
 		ev, err := src.NextEvent()
 		if err != nil {
@@ -51,6 +54,58 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.TimedOut:
+			errCol := ui.ErroredColor.SprintFunc()
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "%s\n", errCol("build timed out after "+e.Timeout))
+
+		case event.WaitingForWorker:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mwaiting for a worker\x1b[0m: %s\n", e.Reason)
+
+		case event.QuotaExceeded:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mquota exceeded, waiting\x1b[0m: team is using %d of its %d %s\n", e.Usage, e.Limit, e.Quota)
+
+		case event.TaskResultCacheHit:
+			key := e.Key
+			if len(key) > 12 {
+				key = key[:12]
+			}
+
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mreusing cached result of build #%s\x1b[0m (key %s)\n", e.BuildName, key)
+
+		case event.Progress:
+			dstImpl.SetTimestamp(e.Time)
+
+			remaining := time.Duration(e.EstimatedFinish-e.Time) * time.Second
+			if remaining > 0 {
+				fmt.Fprintf(dstImpl, "\x1b[2mestimated to finish in %s\x1b[0m\n", remaining)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[2mrunning %s longer than usual\x1b[0m\n", -remaining)
+			}
+
+			for _, outlier := range e.Outliers {
+				if reportedOutliers[outlier.Origin.ID] || outlier.Usual == 0 {
+					continue
+				}
+
+				reportedOutliers[outlier.Origin.ID] = true
+
+				warnCol := ui.StartedColor.SprintFunc()
+				fmt.Fprintf(dstImpl, "%s\n", warnCol(fmt.Sprintf(
+					"%s is running %.1fx longer than usual (usually %s)",
+					strings.Replace(outlier.Step, ":", " ", 1),
+					float64(outlier.Elapsed)/float64(outlier.Usual),
+					time.Duration(outlier.Usual)*time.Second,
+				)))
+			}
+
+		case event.Skipped:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipped\x1b[0m: %s\n", e.Condition)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
@@ -2,6 +2,7 @@ package eventstream_test
 
 import (
 	"io"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -119,6 +120,110 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a TimedOut event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.TimedOut{
+				Time:    time.Now().Unix(),
+				Timeout: "1h0m0s",
+			}
+		})
+
+		It("prints the timeout in bold red", func() {
+			Expect(out.Contents()).To(ContainSubstring(ui.ErroredColor.SprintFunc()("build timed out after 1h0m0s") + "\n"))
+		})
+	})
+
+	Context("when a Progress event is received", func() {
+		BeforeEach(func() {
+			now := time.Now().Unix()
+
+			receivedEvents <- event.Progress{
+				Time:            now,
+				EstimatedFinish: now + 150,
+				Outliers: []event.ProgressOutlier{
+					{Origin: event.Origin{ID: "some-id"}, Step: "task:unit", Elapsed: 360, Usual: 120},
+				},
+			}
+
+			receivedEvents <- event.Progress{
+				Time:            now + 30,
+				EstimatedFinish: now - 60,
+				Outliers: []event.ProgressOutlier{
+					{Origin: event.Origin{ID: "some-id"}, Step: "task:unit", Elapsed: 390, Usual: 120},
+				},
+			}
+		})
+
+		It("prints the estimated time remaining", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[2mestimated to finish in 2m30s\x1b[0m\n"))
+		})
+
+		It("prints how long the build is overdue", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[2mrunning 1m30s longer than usual\x1b[0m\n"))
+		})
+
+		It("flags each outlier once", func() {
+			warning := ui.StartedColor.SprintFunc()("task unit is running 3.0x longer than usual (usually 2m0s)") + "\n"
+			Expect(strings.Count(string(out.Contents()), warning)).To(Equal(1))
+		})
+	})
+
+	Context("when a Skipped event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Skipped{
+				Time:      time.Now().Unix(),
+				Condition: `vars.branch == "main"`,
+			}
+		})
+
+		It("prints the condition which did not hold", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipped\x1b[0m: vars.branch == \"main\"\n"))
+		})
+	})
+
+	Context("when a WaitingForWorker event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForWorker{
+				Time:   time.Now().Unix(),
+				Reason: "no worker has 500m cpu free",
+			}
+		})
+
+		It("prints the reason", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mwaiting for a worker\x1b[0m: no worker has 500m cpu free\n"))
+		})
+	})
+
+	Context("when a QuotaExceeded event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.QuotaExceeded{
+				Time:  time.Now().Unix(),
+				Quota: "concurrent builds",
+				Limit: 5,
+				Usage: 5,
+			}
+		})
+
+		It("prints the exceeded quota", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mquota exceeded, waiting\x1b[0m: team is using 5 of its 5 concurrent builds\n"))
+		})
+	})
+
+	Context("when a TaskResultCacheHit event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.TaskResultCacheHit{
+				Time:      time.Now().Unix(),
+				Key:       "0123456789abcdef",
+				BuildID:   42,
+				BuildName: "7",
+			}
+		})
+
+		It("prints the source build and key", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mreusing cached result of build #7\x1b[0m (key 0123456789ab)\n"))
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
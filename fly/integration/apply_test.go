@@ -0,0 +1,325 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("apply", func() {
+		var (
+			manifestDir string
+			flyCmd      *exec.Cmd
+			args        []string
+
+			expectedManifest atc.TeamManifest
+			plan             atc.TeamApplyPlan
+		)
+
+		BeforeEach(func() {
+			var err error
+			manifestDir, err = ioutil.TempDir("", "fly-apply")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.MkdirAll(filepath.Join(manifestDir, "pipelines"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(manifestDir, "manifest.yml"), []byte(`---
+teams:
+- name: main
+  pipelines:
+  - name: some-pipeline
+    config: pipelines/some-pipeline.yml
+    vars:
+      job_name: some-job
+    exposed: true
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(manifestDir, "pipelines", "some-pipeline.yml"), []byte(`---
+jobs:
+- name: ((job_name))
+  plan:
+  - task: some-task
+    file: some/task.yml
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedManifest = atc.TeamManifest{
+				Pipelines: []atc.PipelineManifest{
+					{
+						Name: "some-pipeline",
+						Config: atc.Config{
+							Jobs: atc.JobConfigs{
+								{
+									Name: "some-job",
+									PlanSequence: []atc.Step{
+										{
+											Config: &atc.TaskStep{
+												Name:       "some-task",
+												ConfigPath: "some/task.yml",
+											},
+										},
+									},
+								},
+							},
+						},
+						Exposed: true,
+					},
+				},
+			}
+
+			exposed := true
+			plan = atc.TeamApplyPlan{
+				Team: "main",
+				Pipelines: []atc.PipelineApplyPlan{
+					{
+						Name:   "some-pipeline",
+						Action: atc.ApplyActionCreate,
+						Diff:   "job some-job has been added:\n+ name: some-job\n",
+						Expose: &exposed,
+					},
+				},
+			}
+
+			args = []string{}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(manifestDir)
+		})
+
+		JustBeforeEach(func() {
+			flyCmd = exec.Command(flyPath, append([]string{"-t", targetName, "apply", "-f", manifestDir}, args...)...)
+		})
+
+		verifyManifest := func(w http.ResponseWriter, r *http.Request) {
+			var manifest atc.TeamManifest
+			err := json.NewDecoder(r.Body).Decode(&manifest)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(manifest).To(Equal(expectedManifest))
+		}
+
+		verifyConfirmedManifest := func(w http.ResponseWriter, r *http.Request) {
+			var manifest atc.TeamManifest
+			err := json.NewDecoder(r.Body).Decode(&manifest)
+			Expect(err).NotTo(HaveOccurred())
+
+			confirmedManifest := expectedManifest
+			confirmedManifest.ConfigVersions = map[string]int{"some-pipeline": 0}
+			Expect(manifest).To(Equal(confirmedManifest))
+		}
+
+		Context("when the changes are confirmed", func() {
+			BeforeEach(func() {
+				appliedPlan := plan
+				appliedPlan.Applied = true
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true"),
+						verifyManifest,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", ""),
+						verifyConfirmedManifest,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, appliedPlan),
+					),
+				)
+			})
+
+			It("shows the plan and applies it", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`team main:`))
+				Eventually(sess).Should(gbytes.Say(`pipeline some-pipeline: .*create.*, expose`))
+				Eventually(sess).Should(gbytes.Say(`job some-job has been added:`))
+				Eventually(sess).Should(gbytes.Say(`apply changes\? \[yN\]: `))
+				fmt.Fprintf(stdin, "y\n")
+
+				Eventually(sess).Should(gbytes.Say(`team 'main' applied`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when the pipelines changed since the plan was made", func() {
+			BeforeEach(func() {
+				args = append(args, "-n")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", ""),
+						verifyConfirmedManifest,
+						ghttp.RespondWith(http.StatusConflict, "pipelines changed since the plan was made: some-pipeline\n"),
+					),
+				)
+			})
+
+			It("errors without applying", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say(`failed to apply team 'main': pipelines changed since the plan was made: some-pipeline`))
+				Expect(sess.Err).To(gbytes.Say(`run apply again to review the changes`))
+			})
+		})
+
+		Context("when the changes are only partially applied", func() {
+			BeforeEach(func() {
+				args = append(args, "-n")
+
+				plan.Pipelines = append(plan.Pipelines, atc.PipelineApplyPlan{
+					Name:   "other-pipeline",
+					Action: atc.ApplyActionDestroy,
+				})
+
+				failedPlan := plan
+				failedPlan.Pipelines = []atc.PipelineApplyPlan{plan.Pipelines[0], plan.Pipelines[1]}
+				failedPlan.Pipelines[0].Applied = true
+				failedPlan.Error = "nope"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, failedPlan),
+					),
+				)
+			})
+
+			It("shows which pipelines were applied", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Out).To(gbytes.Say(`pipeline some-pipeline: .*applied`))
+				Expect(sess.Out).To(gbytes.Say(`pipeline other-pipeline: .*not applied`))
+				Expect(sess.Err).To(gbytes.Say(`team 'main' was only partially applied: nope`))
+			})
+		})
+
+		Context("when the changes are not confirmed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+					),
+				)
+			})
+
+			It("bails out", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`apply changes\? \[yN\]: `))
+				fmt.Fprintf(stdin, "n\n")
+
+				Eventually(sess).Should(gbytes.Say(`bailing out`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when running a dry run with json output and pruning", func() {
+			BeforeEach(func() {
+				args = []string{"--dry-run", "--json", "--prune"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true&prune=true"),
+						verifyManifest,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+					),
+				)
+			})
+
+			It("prints the plans as json without applying them", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				var plans []atc.TeamApplyPlan
+				err = json.Unmarshal(sess.Out.Contents(), &plans)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plans).To(Equal([]atc.TeamApplyPlan{plan}))
+			})
+		})
+
+		Context("when the manifest is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/apply", "dry_run=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.SaveConfigResponse{
+							Errors: []string{"pipeline 'some-pipeline': something is wrong"},
+						}),
+					),
+				)
+			})
+
+			It("shows the errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`failed to plan team 'main'`))
+				Eventually(sess.Err).Should(gbytes.Say(`pipeline 'some-pipeline': something is wrong`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when --json is given without --dry-run or --non-interactive", func() {
+			BeforeEach(func() {
+				args = []string{"--json"}
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`--json requires --dry-run or --non-interactive`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("clear-task-result-cache", func() {
+		var (
+			stdin io.Writer
+			args  []string
+			sess  *gexec.Session
+		)
+
+		BeforeEach(func() {
+			args = []string{"-j", "some-pipeline/some-job", "-s", "some-step-name"}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "clear-task-result-cache"}, args...)...)
+			stdin, err = flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("warns that it's about to remove the cached results", func() {
+			Eventually(sess).Should(gbytes.Say("!!! this will remove the cached results for `some-pipeline/some-job`, task step `some-step-name`"))
+		})
+
+		It("bails out if the user says no", func() {
+			Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+			fmt.Fprintf(stdin, "n\n")
+
+			Eventually(sess).Should(gbytes.Say(`bailing out`))
+			Eventually(sess).Should(gexec.Exit(0))
+		})
+
+		Context("when the api responds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/some-step-name/result-cache"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ClearTaskResultCacheResponse{CachesRemoved: 2}),
+					),
+				)
+			})
+
+			It("succeeds if the user says yes", func() {
+				Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+				fmt.Fprintf(stdin, "y\n")
+
+				Eventually(sess).Should(gbytes.Say("2 cached results removed"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when run noninteractively", func() {
+				BeforeEach(func() {
+					args = append(args, "-n")
+				})
+
+				It("removes the cached results without confirming", func() {
+					Eventually(sess).Should(gbytes.Say("2 cached results removed"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Context("when the api returns an unexpected status code", func() {
+			BeforeEach(func() {
+				args = append(args, "-n")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/some-step-name/result-cache"),
+						ghttp.RespondWith(402, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("components", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/components"),
+					ghttp.RespondWithJSONEncoded(200, []atc.ComponentStatus{
+						{
+							Name:         "scheduler",
+							Interval:     10,
+							LastRan:      1598270400,
+							LastDuration: 1.5,
+							LastRanBy:    "atc-1",
+						},
+						{
+							Name:     "collector_builds",
+							Interval: 30,
+							Paused:   true,
+						},
+						{
+							Name:         "scanner",
+							Interval:     10,
+							LastRan:      1598270400,
+							LastDuration: 0.25,
+							LastError:    "disaster",
+							LastRanBy:    "atc-2",
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the components", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "components")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`scheduler\s+10s\s+no\s+.+\s+1.5s\s+atc-1\s+none`))
+			Expect(sess.Out).To(gbytes.Say(`collector_builds\s+30s\s+yes\s+n/a\s+n/a\s+n/a\s+none`))
+			Expect(sess.Out).To(gbytes.Say(`scanner\s+10s\s+no\s+.+\s+250ms\s+atc-2\s+disaster`))
+		})
+
+		Context("when --json is given", func() {
+			It("prints the components as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "components", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`"name": "scheduler"`))
+				Expect(sess.Out).To(gbytes.Say(`"last_error": "disaster"`))
+			})
+		})
+	})
+
+	Describe("pause-component", func() {
+		Context("when the component exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/pause"),
+						ghttp.RespondWith(200, ""),
+					),
+				)
+			})
+
+			It("pauses it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-component", "-c", "scheduler")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("paused 'scheduler'"))
+			})
+		})
+
+		Context("when the component does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/bogus/pause"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-component", "-c", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("component 'bogus' not found"))
+			})
+		})
+	})
+
+	Describe("unpause-component", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/unpause"),
+					ghttp.RespondWith(200, ""),
+				),
+			)
+		})
+
+		It("unpauses it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "unpause-component", "-c", "scheduler")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("unpaused 'scheduler'"))
+		})
+	})
+
+	Describe("run-component", func() {
+		Context("when the component is not paused", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/run"),
+						ghttp.RespondWith(200, ""),
+					),
+				)
+			})
+
+			It("triggers it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "run-component", "-c", "scheduler")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("triggered 'scheduler'"))
+			})
+		})
+
+		Context("when the component is paused", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/run"),
+						ghttp.RespondWith(409, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "run-component", "-c", "scheduler")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("component 'scheduler' is paused"))
+			})
+		})
+	})
+})
@@ -0,0 +1,198 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("hijack-sessions", func() {
+		var (
+			flyCmd    *exec.Cmd
+			startedAt time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "hijack-sessions")
+			startedAt = time.Date(2020, 8, 10, 12, 0, 0, 0, time.UTC)
+		})
+
+		Context("when sessions are returned from the API", func() {
+			BeforeEach(func() {
+				exitStatus := 130
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/hijack-sessions"),
+						ghttp.RespondWithJSONEncoded(200, []atc.HijackSession{
+							{
+								ID:       2,
+								TeamName: "main",
+								User:     "some-user",
+								Container: atc.HijackSessionContainer{
+									Handle:       "some-handle",
+									Type:         "task",
+									PipelineName: "some-pipeline",
+									JobName:      "some-job",
+									BuildID:      42,
+									BuildName:    "7",
+									StepName:     "some-task",
+								},
+								Path:       "bash",
+								Args:       []string{"-l"},
+								StartTime:  startedAt.Unix(),
+								EndTime:    startedAt.Add(90 * time.Second).Unix(),
+								ExitStatus: &exitStatus,
+							},
+							{
+								ID:       1,
+								TeamName: "main",
+								User:     "other-user",
+								Container: atc.HijackSessionContainer{
+									Handle:  "other-handle",
+									Type:    "task",
+									BuildID: 43,
+								},
+								Path:      "sh",
+								StartTime: startedAt.Unix(),
+								EndTime:   startedAt.Add(5 * time.Second).Unix(),
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the sessions", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				started := startedAt.Local().Format("2006-01-02@15:04:05-0700")
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "user", Color: color.New(color.Bold)},
+						{Contents: "handle", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "step", Color: color.New(color.Bold)},
+						{Contents: "command", Color: color.New(color.Bold)},
+						{Contents: "started", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "exit status", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "some-user"}, {Contents: "some-handle"}, {Contents: "some-pipeline/some-job/7"}, {Contents: "some-task"}, {Contents: "bash -l"}, {Contents: started}, {Contents: "1m30s"}, {Contents: "130"}},
+						{{Contents: "1"}, {Contents: "other-user"}, {Contents: "other-handle"}, {Contents: "one-off"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "sh"}, {Contents: started}, {Contents: "5s"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/hijack-sessions"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+
+	Describe("replay-session", func() {
+		recording := `{"version":2,"width":80,"height":24,"timestamp":1597060800,"command":"bash"}
+[0.1,"o","$ "]
+[0.5,"i","ls\r"]
+[0.6,"o","ls\r\n"]
+[0.7,"o","some-file\r\n"]
+`
+
+		Context("when a session is not specified", func() {
+			It("asks the user to specify a session", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "replay-session")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("s", "session") + "' was not specified"))
+			})
+		})
+
+		Context("when the recording exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/hijack-sessions/2/recording"),
+						ghttp.RespondWith(200, recording, http.Header{"Content-Type": []string{"application/x-asciicast"}}),
+					),
+				)
+			})
+
+			It("replays the session's output", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "replay-session", "-s", "2", "--speed", "10")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal("$ ls\r\nsome-file\r\n"))
+			})
+
+			Context("with --raw", func() {
+				It("prints the recording as-is", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "replay-session", "-s", "2", "--raw")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal(recording))
+				})
+			})
+		})
+
+		Context("when the recording does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/hijack-sessions/2/recording"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "replay-session", "-s", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("recording of session 2 not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,117 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("job-stats", func() {
+		var (
+			flyCmd *exec.Cmd
+			since  time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "job-stats", "-p", "some-pipeline", "--since", "24h")
+		})
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/stats"),
+						func(w http.ResponseWriter, r *http.Request) {
+							unix, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+							Expect(err).NotTo(HaveOccurred())
+							since = time.Unix(unix, 0)
+						},
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.JobStats{
+							{
+								JobName:            "unit",
+								Builds:             4,
+								Succeeded:          3,
+								Failed:             1,
+								SuccessRate:        0.75,
+								DurationP50:        62,
+								DurationP95:        90,
+								MeanTimeToRecovery: 3600,
+								Recoveries:         1,
+								Triggers:           atc.JobTriggerStats{Scheduled: 3, Rerun: 1},
+							},
+							{
+								JobName: "deploy",
+							},
+						}),
+					),
+				)
+			})
+
+			It("prints the stats of each job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(since).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "builds", Color: color.New(color.Bold)},
+						{Contents: "success rate", Color: color.New(color.Bold)},
+						{Contents: "p50", Color: color.New(color.Bold)},
+						{Contents: "p95", Color: color.New(color.Bold)},
+						{Contents: "mttr", Color: color.New(color.Bold)},
+						{Contents: "scheduled", Color: color.New(color.Bold)},
+						{Contents: "manual", Color: color.New(color.Bold)},
+						{Contents: "rerun", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "unit"}, {Contents: "4"}, {Contents: "75.0%"}, {Contents: "1m2s"}, {Contents: "1m30s"}, {Contents: "1h0m0s"}, {Contents: "3"}, {Contents: "0"}, {Contents: "1"}},
+						{{Contents: "deploy"}, {Contents: "0"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "0"}, {Contents: "0"}, {Contents: "0"}},
+					},
+				}))
+			})
+
+			It("prints the stats as json when --json is given", func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out.Contents()).To(ContainSubstring(`"success_rate": 0.75`))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/stats"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+			})
+		})
+	})
+})
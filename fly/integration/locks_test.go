@@ -0,0 +1,403 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("locks", func() {
+		var (
+			flyCmd     *exec.Cmd
+			acquiredAt time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "locks")
+			acquiredAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		})
+
+		Context("when lock pools are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/locks"),
+						ghttp.RespondWithJSONEncoded(200, []atc.LockPool{
+							{
+								Name:     "deploy",
+								TeamName: "main",
+								Size:     2,
+								Holders: []atc.LockPoolHolder{
+									{
+										BuildID:      42,
+										BuildName:    "7",
+										JobName:      "some-job",
+										PipelineName: "some-pipeline",
+										TeamName:     "main",
+										AcquiredAt:   acquiredAt.Unix(),
+									},
+									{
+										BuildID:    43,
+										BuildName:  "43",
+										TeamName:   "main",
+										AcquiredAt: acquiredAt.Unix(),
+									},
+								},
+							},
+							{
+								Name:     "staging",
+								TeamName: "main",
+								Size:     1,
+								Holders:  []atc.LockPoolHolder{},
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists each holder of each pool", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				acquired := acquiredAt.Local().Format("2006-01-02@15:04:05-0700")
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "size", Color: color.New(color.Bold)},
+						{Contents: "build id", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "acquired", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "deploy"}, {Contents: "2/2"}, {Contents: "42"}, {Contents: "some-pipeline/some-job/7"}, {Contents: acquired}},
+						{{Contents: "deploy"}, {Contents: "2/2"}, {Contents: "43"}, {Contents: "one-off"}, {Contents: acquired}},
+						{{Contents: "staging"}, {Contents: "0/1"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/locks"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+
+	Describe("locks --system", func() {
+		Context("when locks are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/locks"),
+						ghttp.RespondWithJSONEncoded(200, []atc.SystemLock{
+							{
+								Type:         "JobScheduling",
+								ID:           []int{8, 42},
+								Object:       "job some-pipeline/some-job",
+								ATC:          "some-atc",
+								SessionID:    1234,
+								HoldDuration: 90.5,
+							},
+							{
+								Type:      "ResourceScanning",
+								ID:        []int{7},
+								ATC:       "other-atc",
+								SessionID: 5678,
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the locks", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "locks", "--system")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "type", Color: color.New(color.Bold)},
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "object", Color: color.New(color.Bold)},
+						{Contents: "atc", Color: color.New(color.Bold)},
+						{Contents: "session", Color: color.New(color.Bold)},
+						{Contents: "held", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "JobScheduling"}, {Contents: "8:42"}, {Contents: "job some-pipeline/some-job"}, {Contents: "some-atc"}, {Contents: "1234"}, {Contents: "1m30s"}},
+						{{Contents: "ResourceScanning"}, {Contents: "7"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "other-atc"}, {Contents: "5678"}, {Contents: "unknown", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+
+			It("prints the locks as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "locks", "--system", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"type": "JobScheduling",
+						"id": [8, 42],
+						"object": "job some-pipeline/some-job",
+						"atc": "some-atc",
+						"session_id": 1234,
+						"hold_duration": 90.5
+					},
+					{
+						"type": "ResourceScanning",
+						"id": [7],
+						"atc": "other-atc",
+						"session_id": 5678
+					}
+				]`))
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/locks"),
+						ghttp.RespondWith(403, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "locks", "--system")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+
+	Describe("release-system-lock", func() {
+		var (
+			args  []string
+			stdin io.Writer
+			sess  *gexec.Session
+		)
+
+		BeforeEach(func() {
+			args = []string{"-t", targetName, "release-system-lock", "-s", "1234", "-i", "8:42"}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/locks"),
+					ghttp.RespondWithJSONEncoded(200, []atc.SystemLock{
+						{
+							Type:      "JobScheduling",
+							ID:        []int{8, 42},
+							Object:    "job some-pipeline/some-job",
+							SessionID: 1234,
+						},
+						{
+							Type:      "ResourceScanning",
+							ID:        []int{7},
+							SessionID: 5678,
+						},
+					}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			flyCmd := exec.Command(flyPath, args...)
+
+			var err error
+			stdin, err = flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		yes := func() {
+			Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+			fmt.Fprintf(stdin, "y\n")
+		}
+
+		no := func() {
+			Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+			fmt.Fprintf(stdin, "n\n")
+		}
+
+		It("warns about the lock being released", func() {
+			Eventually(sess).Should(gbytes.Say("!!! this will release the following lock"))
+			Eventually(sess).Should(gbytes.Say(`JobScheduling 8:42 \(job some-pipeline/some-job\)`))
+			Consistently(sess).ShouldNot(gbytes.Say("ResourceScanning"))
+		})
+
+		It("bails out if the user says no", func() {
+			no()
+			Eventually(sess).Should(gbytes.Say(`bailing out`))
+			Eventually(sess).Should(gexec.Exit(0))
+		})
+
+		Context("when the session holds the lock", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/locks/sessions/1234/release"),
+						ghttp.VerifyJSON("[8,42]"),
+						ghttp.RespondWith(202, ""),
+					),
+				)
+			})
+
+			It("requests the release of the lock if the user says yes", func() {
+				yes()
+				Eventually(sess).Should(gbytes.Say("requested release of lock 8:42 held by session 1234"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when run noninteractively", func() {
+				BeforeEach(func() {
+					args = append(args, "-n")
+				})
+
+				It("requests the release without confirming", func() {
+					Eventually(sess).Should(gbytes.Say("requested release of lock 8:42 held by session 1234"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Context("when the session no longer holds the lock", func() {
+			BeforeEach(func() {
+				args = append(args, "-n")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/locks/sessions/1234/release"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("session 1234 does not hold lock 8:42"))
+			})
+		})
+
+		Context("when the session is not listed as holding the lock", func() {
+			BeforeEach(func() {
+				args = []string{"-t", targetName, "release-system-lock", "-s", "5678", "-i", "8:42"}
+			})
+
+			It("fails without requesting the release", func() {
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("session 5678 does not hold lock 8:42"))
+				for _, request := range atcServer.ReceivedRequests() {
+					Expect(request.Method).ToNot(Equal("PUT"))
+				}
+			})
+		})
+
+		Context("when the lock ID is malformed", func() {
+			BeforeEach(func() {
+				args = []string{"-t", targetName, "release-system-lock", "-s", "1234", "-i", "nope"}
+			})
+
+			It("fails", func() {
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("malformed lock ID 'nope'"))
+			})
+		})
+	})
+
+	Describe("release-lock", func() {
+		Context("when a lock is not specified", func() {
+			It("asks the user to specify a lock", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "release-lock")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("l", "lock") + "' was not specified"))
+			})
+		})
+
+		Context("when the lock exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/locks/deploy/release", "build_id=42"),
+						ghttp.RespondWith(200, ""),
+					),
+				)
+			})
+
+			It("releases the build's hold on the lock", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "release-lock", "-l", "deploy", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("released lock 'deploy' held by build 42"))
+			})
+		})
+
+		Context("when the lock does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/locks/deploy/release"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "release-lock", "-l", "deploy")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("lock 'deploy' not found"))
+			})
+		})
+	})
+})
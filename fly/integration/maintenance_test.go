@@ -0,0 +1,175 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("maintenance", func() {
+		Context("when no mode is given", func() {
+			Context("and maintenance is enabled", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{
+								Enabled:       true,
+								Message:       "upgrading postgres",
+								StartTime:     1597665600,
+								RunningBuilds: 2,
+								PendingBuilds: 5,
+							}),
+						),
+					)
+				})
+
+				It("prints the status", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("maintenance mode: on"))
+					Expect(sess.Out).To(gbytes.Say("message: upgrading postgres"))
+					Expect(sess.Out).To(gbytes.Say("running builds: 2"))
+					Expect(sess.Out).To(gbytes.Say("pending builds: 5"))
+				})
+			})
+
+			Context("and maintenance is disabled", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{}),
+						),
+					)
+				})
+
+				It("says so", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("maintenance mode: off"))
+				})
+			})
+		})
+
+		Context("when turning maintenance on", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/maintenance"),
+						ghttp.VerifyJSONRepresenting(atc.Maintenance{Message: "upgrading postgres"}),
+						ghttp.RespondWith(200, ""),
+					),
+				)
+			})
+
+			It("enables maintenance with the message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "on", "-m", "upgrading postgres")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("maintenance mode enabled"))
+			})
+
+			Context("with --wait", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{Enabled: true, RunningBuilds: 2}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{Enabled: true, RunningBuilds: 1}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{Enabled: true, PendingBuilds: 3}),
+						),
+					)
+				})
+
+				It("waits for running builds to finish", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "on", "-m", "upgrading postgres", "--wait", "--wait-interval", "10ms")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("waiting for 2 running builds to finish"))
+					Expect(sess.Out).To(gbytes.Say("waiting for 1 running build to finish"))
+					Expect(sess.Out).To(gbytes.Say("all running builds have finished"))
+				})
+			})
+		})
+
+		Context("when turning maintenance off", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+						ghttp.RespondWith(200, ""),
+					),
+				)
+			})
+
+			It("disables maintenance", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "off")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("maintenance mode disabled"))
+			})
+		})
+
+		Context("when the mode is invalid", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "sideways")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("maintenance mode must be 'on' or 'off'"))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+						ghttp.RespondWith(403, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "off")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,197 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-graph", func() {
+		var (
+			configFile *os.File
+			flyCmd     *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			var err error
+			configFile, err = ioutil.TempFile("", "pipeline-graph")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = configFile.WriteString(`---
+groups:
+- name: some-group
+  jobs: [deploy]
+  resources: [app]
+
+resources:
+- name: repo
+  type: git
+- name: app
+  type: cf
+
+jobs:
+- name: unit
+  plan:
+  - get: repo
+    trigger: true
+- name: deploy
+  plan:
+  - get: repo
+    passed: [unit]
+  - put: app
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configFile.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(configFile.Name())
+		})
+
+		Context("when graphing a local config", func() {
+			It("prints the graph in dot", func() {
+				flyCmd = exec.Command(flyPath, "pipeline-graph", "-c", configFile.Name())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`digraph pipeline \{`))
+				Expect(sess.Out).To(gbytes.Say(`"resource:repo" -> "job:unit" \[label="get"\];`))
+				Expect(sess.Out).To(gbytes.Say(`"job:unit" -> "job:deploy" \[label="passed repo", style=dashed\];`))
+				Expect(sess.Out).To(gbytes.Say(`"job:deploy" -> "resource:app" \[label="put"\];`))
+			})
+
+			It("prints the graph as a mermaid flowchart", func() {
+				flyCmd = exec.Command(flyPath, "pipeline-graph", "-c", configFile.Name(), "--format", "mermaid")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`flowchart LR`))
+				Expect(sess.Out).To(gbytes.Say(`n2\["unit"\]`))
+				Expect(sess.Out).To(gbytes.Say(`n2 -.->\|passed repo\| n3`))
+			})
+
+			It("prints the graph of a group as json", func() {
+				flyCmd = exec.Command(flyPath, "pipeline-graph", "-c", configFile.Name(), "--format", "json", "--group", "some-group")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`{
+					"nodes": [
+						{"id": "resource:app", "type": "resource", "name": "app", "groups": ["some-group"]},
+						{"id": "job:deploy", "type": "job", "name": "deploy", "groups": ["some-group"]}
+					],
+					"edges": [
+						{"from": "job:deploy", "to": "resource:app", "type": "put"}
+					]
+				}`))
+			})
+
+			It("fails when the group does not exist", func() {
+				flyCmd = exec.Command(flyPath, "pipeline-graph", "-c", configFile.Name(), "--group", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say(`group 'bogus' not found`))
+			})
+		})
+
+		Context("when graphing a pipeline", func() {
+			var graph atc.PipelineGraph
+
+			BeforeEach(func() {
+				graph = atc.PipelineGraph{
+					Nodes: []atc.GraphNode{
+						{ID: "resource:repo", Type: atc.GraphNodeResource, Name: "repo"},
+						{ID: "job:unit", Type: atc.GraphNodeJob, Name: "unit"},
+					},
+					Edges: []atc.GraphEdge{
+						{From: "resource:repo", To: "job:unit", Type: atc.GraphEdgeGet, Trigger: true},
+					},
+				}
+			})
+
+			Context("when the pipeline exists", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, graph),
+						),
+					)
+				})
+
+				It("prints the graph in the requested format", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-graph", "-p", "some-pipeline", "--format", "json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					var printed atc.PipelineGraph
+					Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+					Expect(printed).To(Equal(graph))
+				})
+			})
+
+			Context("when the pipeline does not exist", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph"),
+							ghttp.RespondWith(http.StatusNotFound, ""),
+						),
+					)
+				})
+
+				It("errors", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-graph", "-p", "some-pipeline")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+					Expect(sess.Err).To(gbytes.Say(`pipeline not found`))
+				})
+			})
+		})
+
+		It("requires either a pipeline or a config", func() {
+			flyCmd = exec.Command(flyPath, "pipeline-graph")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say(`either --pipeline or --config must be given`))
+		})
+	})
+})
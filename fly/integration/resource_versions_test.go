@@ -89,6 +89,56 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args,
+					"--metadata", "branch:master",
+					"--match", "ref:^abc",
+					"--semver", "tag:>=1.2 <2",
+				)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", "limit=50&metadata=branch:master&version=ref:%5Eabc&semver=tag:%3E%3D1.2+%3C2"),
+						ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+							{ID: 3, Version: atc.Version{"tag": "1.3.0", "ref": "abcdef"}, Enabled: true},
+						}),
+					),
+				)
+			})
+
+			It("lists the matching resource versions", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "enabled", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "3"}, {Contents: "ref:abcdef,tag:1.3.0"}, {Contents: "yes"}},
+					},
+				}))
+			})
+		})
+
+		Context("when the semver range is invalid", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--semver", "tag:>=latest")
+			})
+
+			It("errors without contacting the api", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("invalid semver range '>=latest'"))
+			})
+		})
+
 		Context("and the api returns an internal server error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
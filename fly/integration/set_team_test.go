@@ -717,6 +717,119 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending lint rules", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--lint-rule", "privileged-task=error",
+					"--lint-rule", "resource-without-icon=off",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:brock-obama"],
+									"groups": []
+								}
+							},
+							"lint_rules": {
+								"privileged-task": "error",
+								"resource-without-icon": "off"
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the lint rules", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`lint rules: privileged-task=error, resource-without-icon=off`))
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("sending quotas", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--max-concurrent-builds", "5",
+					"--max-containers", "100",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:brock-obama"],
+									"groups": []
+								}
+							},
+							"quotas": {
+								"max_concurrent_builds": 5,
+								"max_containers": 100
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the quotas", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`quotas:`))
+				Eventually(sess).Should(gbytes.Say(`max concurrent builds: 5`))
+				Eventually(sess).Should(gbytes.Say(`max containers:        100`))
+				Eventually(sess).Should(gbytes.Say(`max volumes:           unlimited`))
+				Eventually(sess).Should(gbytes.Say(`max checks per minute: unlimited`))
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when a quota is negative", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--max-volumes=-1",
+					}
+				})
+
+				It("errors without sending the team", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`quotas must not be negative`))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
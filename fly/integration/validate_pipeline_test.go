@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"os/exec"
 
 	. "github.com/onsi/ginkgo"
@@ -116,5 +117,121 @@ var _ = Describe("Fly CLI", func() {
 			<-sess.Exited
 			Expect(sess.ExitCode()).To(Equal(0))
 		})
+
+		It("reports lint findings with strict", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--strict",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("lint findings:"))
+			Eventually(sess.Err).Should(gbytes.Say(`  - \[info\] resources.some-resource: resource 'some-resource' has no icon \(resource-without-icon\)`))
+			Eventually(sess.Err).Should(gbytes.Say("    hint: set 'icon'"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		It("returns invalid on lint findings with error severity", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--strict",
+				"--lint-rule", "resource-without-icon=error",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Err).To(gbytes.Say("configuration invalid"))
+		})
+
+		It("rejects unknown lint rules", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--lint-rule", "bogus=error",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Err).To(gbytes.Say("unknown lint rule 'bogus'"))
+		})
+
+		It("prints the results as JSON", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--format", "json",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out.Contents()).To(MatchJSON(`{
+				"errors": [],
+				"warnings": [],
+				"findings": [
+					{
+						"rule": "resource-without-icon",
+						"severity": "info",
+						"location": "resources.some-resource",
+						"message": "resource 'some-resource' has no icon",
+						"hint": "set 'icon' to the name of a Material Design icon"
+					}
+				]
+			}`))
+		})
+
+		It("prints the results as SARIF", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--format", "sarif",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var log struct {
+				Version string `json:"version"`
+				Runs    []struct {
+					Results []struct {
+						RuleID string `json:"ruleId"`
+						Level  string `json:"level"`
+					} `json:"results"`
+				} `json:"runs"`
+			}
+			err = json.Unmarshal(sess.Out.Contents(), &log)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Results).To(HaveLen(1))
+			Expect(log.Runs[0].Results[0].RuleID).To(Equal("resource-without-icon"))
+			Expect(log.Runs[0].Results[0].Level).To(Equal("note"))
+		})
 	})
 })
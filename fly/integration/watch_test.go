@@ -127,6 +127,67 @@ var _ = Describe("Watching", func() {
 		})
 	})
 
+	Context("with a step and a tail", func() {
+		var plan json.RawMessage
+
+		BeforeEach(func() {
+			plan = json.RawMessage(`{
+				"id": "1",
+				"do": [
+					{"id": "2", "get": {"name": "some-input"}},
+					{"id": "3", "task": {"name": "build"}}
+				]
+			}`)
+		})
+
+		Context("when the step is in the plan", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/plan"),
+						ghttp.RespondWithJSONEncoded(200, atc.PublicBuildPlan{
+							Schema: "exec.v2",
+							Plan:   &plan,
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/events", "origin=3&progress=true&tail=100"),
+						eventsHandler(),
+					),
+				)
+			})
+
+			It("watches only the last events of the step", func() {
+				watch("--build", "3", "--step", "build", "--tail", "100")
+			})
+		})
+
+		Context("when the step is not in the plan", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/plan"),
+						ghttp.RespondWithJSONEncoded(200, atc.PublicBuildPlan{
+							Schema: "exec.v2",
+							Plan:   &plan,
+						}),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3", "--step", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("step 'bogus' not found in build 3"))
+			})
+		})
+	})
+
 	Context("with a specific job and pipeline", func() {
 		Context("when the job has no builds", func() {
 			BeforeEach(func() {
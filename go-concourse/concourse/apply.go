@@ -0,0 +1,77 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) Apply(manifest atc.TeamManifest, dryRun bool, prune bool) (atc.TeamApplyPlan, error) {
+	params := rata.Params{"team_name": team.name}
+
+	queryParams := url.Values{}
+	if dryRun {
+		queryParams.Add(atc.ApplyDryRun, "true")
+	}
+
+	if prune {
+		queryParams.Add(atc.ApplyPrune, "true")
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(manifest)
+	if err != nil {
+		return atc.TeamApplyPlan{}, fmt.Errorf("Unable to marshal manifest: %s", err)
+	}
+
+	var plan atc.TeamApplyPlan
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.ApplyTeam,
+		Params:      params,
+		Query:       queryParams,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &plan,
+	})
+
+	if err != nil {
+		if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+			var failedPlan atc.TeamApplyPlan
+			if json.Unmarshal([]byte(unexpectedResponseError.Body), &failedPlan) == nil && failedPlan.Error != "" {
+				return failedPlan, ApplyError{Plan: failedPlan}
+			}
+
+			if unexpectedResponseError.StatusCode == http.StatusConflict {
+				return atc.TeamApplyPlan{}, ConflictError{
+					Message: strings.TrimSpace(unexpectedResponseError.Body),
+				}
+			}
+
+			if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+				var validationErr atc.SaveConfigResponse
+				err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
+				if err != nil {
+					return atc.TeamApplyPlan{}, err
+				}
+
+				return atc.TeamApplyPlan{}, InvalidConfigError{
+					Errors: validationErr.Errors,
+				}
+			}
+		}
+
+		return atc.TeamApplyPlan{}, err
+	}
+
+	return plan, nil
+}
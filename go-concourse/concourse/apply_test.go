@@ -0,0 +1,139 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Apply", func() {
+	Describe("Apply", func() {
+		var (
+			manifest     atc.TeamManifest
+			expectedPlan atc.TeamApplyPlan
+		)
+
+		BeforeEach(func() {
+			manifest = atc.TeamManifest{
+				Pipelines: []atc.PipelineManifest{
+					{
+						Name: "some-pipeline",
+						Config: atc.Config{
+							Jobs: atc.JobConfigs{{Name: "some-job"}},
+						},
+						Paused: true,
+					},
+				},
+			}
+
+			expectedPlan = atc.TeamApplyPlan{
+				Team: "some-team",
+				Pipelines: []atc.PipelineApplyPlan{
+					{Name: "some-pipeline", Action: atc.ApplyActionCreate, Diff: "some-diff"},
+				},
+			}
+		})
+
+		Context("when the manifest is applied", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/apply", "dry_run=true&prune=true"),
+						ghttp.VerifyJSONRepresenting(manifest),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPlan),
+					),
+				)
+			})
+
+			It("returns the plan", func() {
+				plan, err := team.Apply(manifest, true, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan).To(Equal(expectedPlan))
+			})
+		})
+
+		Context("when the manifest is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/apply"),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.SaveConfigResponse{
+							Errors: []string{"pipeline 'some-pipeline': bad"},
+						}),
+					),
+				)
+			})
+
+			It("returns an InvalidConfigError", func() {
+				_, err := team.Apply(manifest, false, false)
+				Expect(err).To(Equal(concourse.InvalidConfigError{
+					Errors: []string{"pipeline 'some-pipeline': bad"},
+				}))
+			})
+		})
+
+		Context("when the pipelines changed since the plan was made", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/apply"),
+						ghttp.RespondWith(http.StatusConflict, "pipelines changed since the plan was made: some-pipeline\n"),
+					),
+				)
+			})
+
+			It("returns a ConflictError", func() {
+				_, err := team.Apply(manifest, false, false)
+				Expect(err).To(Equal(concourse.ConflictError{
+					Message: "pipelines changed since the plan was made: some-pipeline",
+				}))
+			})
+		})
+
+		Context("when the manifest is partially applied", func() {
+			BeforeEach(func() {
+				expectedPlan.Pipelines[0].Applied = true
+				expectedPlan.Pipelines = append(expectedPlan.Pipelines, atc.PipelineApplyPlan{
+					Name:   "other-pipeline",
+					Action: atc.ApplyActionUpdate,
+				})
+				expectedPlan.Error = "nope"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/apply"),
+						ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, expectedPlan),
+					),
+				)
+			})
+
+			It("returns the plan and an ApplyError", func() {
+				plan, err := team.Apply(manifest, false, false)
+				Expect(err).To(Equal(concourse.ApplyError{Plan: expectedPlan}))
+				Expect(err).To(MatchError("nope"))
+				Expect(plan).To(Equal(expectedPlan))
+			})
+		})
+
+		Context("when the server errors", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/apply"),
+						ghttp.RespondWith(http.StatusInternalServerError, "boom"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.Apply(manifest, false, false)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -72,6 +73,31 @@ func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName s
 	return build, err
 }
 
+func (team *team) PartialRerunJobBuild(pipelineName string, jobName string, buildName string, fromStep string) (atc.Build, error) {
+	params := rata.Params{
+		"build_name":    buildName,
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{"partial": {"true"}}
+	if fromStep != "" {
+		query.Set("from_step", fromStep)
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
@@ -133,6 +133,34 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("PartialRerunJobBuild", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:      123,
+				Name:    "myrerunbuild",
+				Status:  "succeeded",
+				JobName: "myjob",
+				APIURL:  "api/v1/builds/123",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL, "from_step=deploy&partial=true"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("reruns the build from the given step", func() {
+			build, err := team.PartialRerunJobBuild("mypipeline", "myjob", "mybuild", "deploy")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
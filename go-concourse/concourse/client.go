@@ -17,6 +17,7 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	FilteredBuildEvents(buildID string, filter BuildEventsFilter) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
@@ -34,6 +35,15 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	Maintenance() (atc.Maintenance, error)
+	EnableMaintenance(message string) error
+	DisableMaintenance() error
+	ListComponents() ([]atc.ComponentStatus, error)
+	PauseComponent(componentName string) (bool, error)
+	UnpauseComponent(componentName string) (bool, error)
+	RunComponent(componentName string) (bool, error)
+	ListSystemLocks() ([]atc.SystemLock, error)
+	ReleaseSystemLock(sessionID int, id []int) (bool, error)
 	Check(checkID string) (atc.Check, bool, error)
 }
 
@@ -0,0 +1,381 @@
+package concourse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/tedsuo/rata"
+)
+
+//go:generate counterfeiter . ClientV2
+
+// ClientV2 is a client for the Concourse API whose methods take a context,
+// retry failed requests according to a RetryPolicy, and return
+// UnauthorizedError, ForbiddenError, NotFoundError or ConflictError for the
+// corresponding response codes instead of a found flag.
+type ClientV2 interface {
+	URL() string
+
+	GetInfo(ctx context.Context) (atc.Info, error)
+
+	Builds(ctx context.Context, page Page) ([]atc.Build, Pagination, error)
+	IterateBuilds(page Page) *BuildIterator
+	Build(ctx context.Context, buildID int) (atc.Build, error)
+	BuildPlan(ctx context.Context, buildID int) (atc.PublicBuildPlan, error)
+	BuildEvents(ctx context.Context, buildID int, filter BuildEventsFilter) (Events, error)
+	AbortBuild(ctx context.Context, buildID int) error
+
+	ListTeams(ctx context.Context) ([]atc.Team, error)
+	ListWorkers(ctx context.Context) ([]atc.Worker, error)
+	ListPipelines(ctx context.Context, teamName string) ([]atc.Pipeline, error)
+	ListJobs(ctx context.Context, teamName string, pipelineName string) ([]atc.Job, error)
+
+	JobBuilds(ctx context.Context, teamName string, pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, error)
+	IterateJobBuilds(teamName string, pipelineName string, jobName string, page Page) *BuildIterator
+	CreateJobBuild(ctx context.Context, teamName string, pipelineName string, jobName string) (atc.Build, error)
+
+	ResourceVersions(ctx context.Context, teamName string, pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, error)
+	IterateResourceVersions(teamName string, pipelineName string, resourceName string, page Page) *ResourceVersionIterator
+}
+
+type clientV2 struct {
+	url         string
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+
+	requestGenerator *rata.RequestGenerator
+}
+
+func NewClientV2(apiURL string, httpClient *http.Client, retryPolicy RetryPolicy) ClientV2 {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	apiURL = strings.TrimRight(apiURL, "/")
+
+	return &clientV2{
+		url:         apiURL,
+		httpClient:  httpClient,
+		retryPolicy: retryPolicy,
+
+		requestGenerator: rata.NewRequestGenerator(apiURL, atc.Routes),
+	}
+}
+
+type requestV2 struct {
+	name   string
+	params rata.Params
+	query  url.Values
+	header http.Header
+	body   interface{}
+}
+
+func (client *clientV2) URL() string {
+	return client.url
+}
+
+func (client *clientV2) GetInfo(ctx context.Context) (atc.Info, error) {
+	var info atc.Info
+	_, err := client.send(ctx, requestV2{name: atc.GetInfo}, &info)
+	return info, err
+}
+
+func (client *clientV2) Builds(ctx context.Context, page Page) ([]atc.Build, Pagination, error) {
+	var builds []atc.Build
+	pagination, err := client.sendPaginated(ctx, requestV2{
+		name:  atc.ListBuilds,
+		query: page.QueryParams(),
+	}, &builds)
+	return builds, pagination, err
+}
+
+func (client *clientV2) IterateBuilds(page Page) *BuildIterator {
+	return newBuildIterator(page, client.Builds)
+}
+
+func (client *clientV2) Build(ctx context.Context, buildID int) (atc.Build, error) {
+	var build atc.Build
+	_, err := client.send(ctx, requestV2{
+		name:   atc.GetBuild,
+		params: rata.Params{"build_id": strconv.Itoa(buildID)},
+	}, &build)
+	return build, err
+}
+
+func (client *clientV2) BuildPlan(ctx context.Context, buildID int) (atc.PublicBuildPlan, error) {
+	var plan atc.PublicBuildPlan
+	_, err := client.send(ctx, requestV2{
+		name:   atc.GetBuildPlan,
+		params: rata.Params{"build_id": strconv.Itoa(buildID)},
+	}, &plan)
+	return plan, err
+}
+
+func (client *clientV2) BuildEvents(ctx context.Context, buildID int, filter BuildEventsFilter) (Events, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream := &reconnectingEventStream{
+		ctx:         ctx,
+		cancel:      cancel,
+		retryPolicy: client.retryPolicy,
+		connect: func(ctx context.Context, lastEventID string) (io.ReadCloser, error) {
+			header := http.Header{}
+			if lastEventID != "" {
+				header.Set("Last-Event-ID", lastEventID)
+			}
+
+			response, err := client.do(ctx, requestV2{
+				name:   atc.BuildEvents,
+				params: rata.Params{"build_id": strconv.Itoa(buildID)},
+				query:  filter.query(),
+				header: header,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return response.Body, nil
+		},
+	}
+
+	err := stream.reconnect()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+func (client *clientV2) AbortBuild(ctx context.Context, buildID int) error {
+	_, err := client.send(ctx, requestV2{
+		name:   atc.AbortBuild,
+		params: rata.Params{"build_id": strconv.Itoa(buildID)},
+	}, nil)
+	return err
+}
+
+func (client *clientV2) ListTeams(ctx context.Context) ([]atc.Team, error) {
+	var teams []atc.Team
+	_, err := client.send(ctx, requestV2{name: atc.ListTeams}, &teams)
+	return teams, err
+}
+
+func (client *clientV2) ListWorkers(ctx context.Context) ([]atc.Worker, error) {
+	var workers []atc.Worker
+	_, err := client.send(ctx, requestV2{name: atc.ListWorkers}, &workers)
+	return workers, err
+}
+
+func (client *clientV2) ListPipelines(ctx context.Context, teamName string) ([]atc.Pipeline, error) {
+	var pipelines []atc.Pipeline
+	_, err := client.send(ctx, requestV2{
+		name:   atc.ListPipelines,
+		params: rata.Params{"team_name": teamName},
+	}, &pipelines)
+	return pipelines, err
+}
+
+func (client *clientV2) ListJobs(ctx context.Context, teamName string, pipelineName string) ([]atc.Job, error) {
+	var jobs []atc.Job
+	_, err := client.send(ctx, requestV2{
+		name: atc.ListJobs,
+		params: rata.Params{
+			"team_name":     teamName,
+			"pipeline_name": pipelineName,
+		},
+	}, &jobs)
+	return jobs, err
+}
+
+func (client *clientV2) JobBuilds(ctx context.Context, teamName string, pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, error) {
+	var builds []atc.Build
+	pagination, err := client.sendPaginated(ctx, requestV2{
+		name: atc.ListJobBuilds,
+		params: rata.Params{
+			"team_name":     teamName,
+			"pipeline_name": pipelineName,
+			"job_name":      jobName,
+		},
+		query: page.QueryParams(),
+	}, &builds)
+	return builds, pagination, err
+}
+
+func (client *clientV2) IterateJobBuilds(teamName string, pipelineName string, jobName string, page Page) *BuildIterator {
+	return newBuildIterator(page, func(ctx context.Context, page Page) ([]atc.Build, Pagination, error) {
+		return client.JobBuilds(ctx, teamName, pipelineName, jobName, page)
+	})
+}
+
+func (client *clientV2) CreateJobBuild(ctx context.Context, teamName string, pipelineName string, jobName string) (atc.Build, error) {
+	var build atc.Build
+	_, err := client.send(ctx, requestV2{
+		name: atc.CreateJobBuild,
+		params: rata.Params{
+			"team_name":     teamName,
+			"pipeline_name": pipelineName,
+			"job_name":      jobName,
+		},
+	}, &build)
+	return build, err
+}
+
+func (client *clientV2) ResourceVersions(ctx context.Context, teamName string, pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, error) {
+	var versions []atc.ResourceVersion
+	pagination, err := client.sendPaginated(ctx, requestV2{
+		name: atc.ListResourceVersions,
+		params: rata.Params{
+			"team_name":     teamName,
+			"pipeline_name": pipelineName,
+			"resource_name": resourceName,
+		},
+		query: page.QueryParams(),
+	}, &versions)
+	return versions, pagination, err
+}
+
+func (client *clientV2) IterateResourceVersions(teamName string, pipelineName string, resourceName string, page Page) *ResourceVersionIterator {
+	return newResourceVersionIterator(page, func(ctx context.Context, page Page) ([]atc.ResourceVersion, Pagination, error) {
+		return client.ResourceVersions(ctx, teamName, pipelineName, resourceName, page)
+	})
+}
+
+func (client *clientV2) sendPaginated(ctx context.Context, request requestV2, result interface{}) (Pagination, error) {
+	header, err := client.send(ctx, request, result)
+	if err != nil {
+		return Pagination{}, err
+	}
+
+	return paginationFromHeaders(header)
+}
+
+// send makes the request and decodes the JSON response into the result,
+// returning the response headers.
+func (client *clientV2) send(ctx context.Context, request requestV2, result interface{}) (http.Header, error) {
+	response, err := client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if result == nil || response.StatusCode == http.StatusNoContent {
+		return response.Header, nil
+	}
+
+	err = json.NewDecoder(response.Body).Decode(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Header, nil
+}
+
+// do makes the request, retrying it according to the retry policy, and
+// returns the response if it was successful.
+func (client *clientV2) do(ctx context.Context, request requestV2) (*http.Response, error) {
+	var body []byte
+	if request.body != nil {
+		var err error
+		body, err = json.Marshal(request.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		req, err := client.createHTTPRequest(ctx, request, body)
+		if err != nil {
+			return nil, err
+		}
+
+		retryable := isIdempotent(req.Method) && attempt < client.retryPolicy.attempts()
+
+		response, err := client.httpClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			if !retryable {
+				return nil, err
+			}
+		} else if response.StatusCode >= 500 && retryable {
+			_, _ = io.Copy(ioutil.Discard, response.Body)
+			_ = response.Body.Close()
+		} else {
+			return response, responseError(response)
+		}
+
+		err = client.retryPolicy.wait(ctx, attempt)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (client *clientV2) createHTTPRequest(ctx context.Context, request requestV2, body []byte) (*http.Request, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := client.requestGenerator.CreateRequest(request.name, request.params, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	req.URL.RawQuery = request.query.Encode()
+
+	for h, vs := range request.header {
+		for _, v := range vs {
+			req.Header.Add(h, v)
+		}
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// responseError returns the error for an unsuccessful response, closing its
+// body, or nil if the response was successful.
+func responseError(response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	defer response.Body.Close()
+
+	body, _ := ioutil.ReadAll(response.Body)
+	message := strings.TrimSpace(string(body))
+
+	switch response.StatusCode {
+	case http.StatusUnauthorized:
+		return UnauthorizedError{Message: message}
+	case http.StatusForbidden:
+		return ForbiddenError{Message: message}
+	case http.StatusNotFound:
+		return NotFoundError{Message: message}
+	case http.StatusConflict:
+		return ConflictError{Message: message}
+	default:
+		return UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       string(body),
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package concourse_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("ClientV2", func() {
+	var (
+		ctx      context.Context
+		clientV2 concourse.ClientV2
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		clientV2 = concourse.NewClientV2(atcServer.URL(), &http.Client{}, concourse.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		})
+	})
+
+	Describe("retrying", func() {
+		Context("when a GET fails with a 5xx before succeeding", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+					ghttp.RespondWith(http.StatusBadGateway, ""),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/info"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Info{Version: "1.2.3"}),
+					),
+				)
+			})
+
+			It("retries it", func() {
+				info, err := clientV2.GetInfo(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Version).To(Equal("1.2.3"))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
+		Context("when a GET keeps failing", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+					ghttp.RespondWith(http.StatusServiceUnavailable, "still down"),
+				)
+			})
+
+			It("gives up after the maximum number of attempts", func() {
+				_, err := clientV2.GetInfo(ctx)
+
+				var unexpected concourse.UnexpectedResponseError
+				Expect(errors.As(err, &unexpected)).To(BeTrue())
+				Expect(unexpected.StatusCode).To(Equal(http.StatusServiceUnavailable))
+				Expect(unexpected.Body).To(Equal("still down"))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
+		Context("when a POST fails with a 5xx", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds"),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("does not retry it", func() {
+				_, err := clientV2.CreateJobBuild(ctx, "some-team", "some-pipeline", "some-job")
+				Expect(err).To(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the context is cancelled while waiting to retry", func() {
+			BeforeEach(func() {
+				clientV2 = concourse.NewClientV2(atcServer.URL(), &http.Client{}, concourse.RetryPolicy{
+					MaxAttempts:    3,
+					InitialBackoff: time.Hour,
+				})
+
+				atcServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+				)
+			})
+
+			It("returns the context's error", func() {
+				ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+				defer cancel()
+
+				_, err := clientV2.GetInfo(ctx)
+				Expect(err).To(Equal(context.DeadlineExceeded))
+			})
+		})
+	})
+
+	Describe("errors", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.RespondWith(status, "some message"),
+			)
+		})
+
+		Context("when the response is 401", func() {
+			BeforeEach(func() {
+				status = http.StatusUnauthorized
+			})
+
+			It("returns an UnauthorizedError which is ErrUnauthorized", func() {
+				_, err := clientV2.Build(ctx, 1)
+				Expect(err).To(Equal(concourse.UnauthorizedError{Message: "some message"}))
+				Expect(errors.Is(err, concourse.ErrUnauthorized)).To(BeTrue())
+			})
+		})
+
+		Context("when the response is 403", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns a ForbiddenError which is ErrForbidden", func() {
+				_, err := clientV2.Build(ctx, 1)
+				Expect(err).To(Equal(concourse.ForbiddenError{Message: "some message"}))
+				Expect(errors.Is(err, concourse.ErrForbidden)).To(BeTrue())
+			})
+		})
+
+		Context("when the response is 404", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns a NotFoundError", func() {
+				_, err := clientV2.Build(ctx, 1)
+				Expect(err).To(Equal(concourse.NotFoundError{Message: "some message"}))
+			})
+		})
+
+		Context("when the response is 409", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns a ConflictError", func() {
+				err := clientV2.AbortBuild(ctx, 1)
+				Expect(err).To(Equal(concourse.ConflictError{Message: "some message"}))
+			})
+		})
+	})
+
+	Describe("IterateBuilds", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds", "limit=2"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Build{{ID: 4}, {ID: 3}}, http.Header{
+						"Link": []string{`<http://some-url.com/api/v1/builds?until=3&limit=2>; rel="next"`},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds", "limit=2&until=3"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Build{{ID: 2}, {ID: 1}}, http.Header{
+						"Link": []string{`<http://some-url.com/api/v1/builds?until=1&limit=2>; rel="next"`},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds", "limit=2&until=1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Build{}),
+				),
+			)
+		})
+
+		It("walks through every page", func() {
+			var ids []int
+
+			builds := clientV2.IterateBuilds(concourse.Page{Limit: 2})
+			for builds.Next(ctx) {
+				ids = append(ids, builds.Build().ID)
+			}
+
+			Expect(builds.Err()).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int{4, 3, 2, 1}))
+		})
+	})
+
+	Describe("IterateResourceVersions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/versions", "limit=1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ResourceVersion{{ID: 2}}, http.Header{
+						"Link": []string{`<http://some-url.com/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/versions?until=2&limit=1>; rel="next"`},
+					}),
+				),
+				ghttp.RespondWith(http.StatusForbidden, ""),
+			)
+		})
+
+		It("stops at the first error", func() {
+			var ids []int
+
+			versions := clientV2.IterateResourceVersions("some-team", "some-pipeline", "some-resource", concourse.Page{Limit: 1})
+			for versions.Next(ctx) {
+				ids = append(ids, versions.ResourceVersion().ID)
+			}
+
+			Expect(ids).To(Equal([]int{2}))
+			Expect(versions.Err()).To(Equal(concourse.ForbiddenError{}))
+			Expect(versions.Next(ctx)).To(BeFalse())
+		})
+	})
+
+	Describe("BuildEvents", func() {
+		writeEvents := func(w http.ResponseWriter, firstID int, events ...atc.Event) {
+			w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+
+			for i, e := range events {
+				payload, err := json.Marshal(event.Message{Event: e})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = sse.Event{
+					ID:   fmt.Sprintf("%d", firstID+i),
+					Name: "event",
+					Data: payload,
+				}.Write(w)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3/events", "tail=10"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header.Get("Last-Event-ID")).To(BeEmpty())
+
+						// the connection drops without an end event
+						writeEvents(w, 0, event.Log{Payload: "one"}, event.Log{Payload: "two"})
+					},
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3/events", "tail=10"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header.Get("Last-Event-ID")).To(Equal("1"))
+
+						writeEvents(w, 2, event.Log{Payload: "three"})
+
+						err := sse.Event{ID: "3", Name: "end"}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+					},
+				),
+			)
+		})
+
+		It("reconnects from the last event", func() {
+			events, err := clientV2.BuildEvents(ctx, 3, concourse.BuildEventsFilter{Tail: 10})
+			Expect(err).NotTo(HaveOccurred())
+
+			defer events.Close()
+
+			for _, payload := range []string{"one", "two", "three"} {
+				e, err := events.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(e).To(Equal(event.Log{Payload: payload}))
+			}
+
+			_, err = events.NextEvent()
+			Expect(err).To(Equal(io.EOF))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package concourse
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ErrComponentPaused is returned when asking a paused component to run.
+var ErrComponentPaused = errors.New("component is paused")
+
+func (client *client) ListComponents() ([]atc.ComponentStatus, error) {
+	var components []atc.ComponentStatus
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListComponents,
+	}, &internal.Response{
+		Result: &components,
+	})
+
+	return components, err
+}
+
+func (client *client) PauseComponent(componentName string) (bool, error) {
+	return client.sendComponentRequest(atc.PauseComponent, componentName)
+}
+
+func (client *client) UnpauseComponent(componentName string) (bool, error) {
+	return client.sendComponentRequest(atc.UnpauseComponent, componentName)
+}
+
+func (client *client) RunComponent(componentName string) (bool, error) {
+	return client.sendComponentRequest(atc.RunComponent, componentName)
+}
+
+func (client *client) sendComponentRequest(requestName string, componentName string) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      rata.Params{"component_name": componentName},
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return true, ErrComponentPaused
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
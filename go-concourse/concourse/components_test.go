@@ -0,0 +1,127 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Components Handler", func() {
+	Describe("ListComponents", func() {
+		expectedComponents := []atc.ComponentStatus{
+			{
+				Name:         "scheduler",
+				Interval:     10,
+				LastRan:      1598270400,
+				LastDuration: 1.5,
+				LastRanBy:    "some-atc",
+			},
+			{
+				Name:      "collector_containers",
+				Interval:  30,
+				Paused:    true,
+				LastError: "disaster",
+			},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/components"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedComponents),
+				),
+			)
+		})
+
+		It("returns the components", func() {
+			components, err := client.ListComponents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(components).To(Equal(expectedComponents))
+		})
+	})
+
+	for _, action := range []string{"pause", "unpause", "run"} {
+		action := action
+
+		Describe(action+" component", func() {
+			var status int
+
+			send := func() (bool, error) {
+				switch action {
+				case "pause":
+					return client.PauseComponent("scheduler")
+				case "unpause":
+					return client.UnpauseComponent("scheduler")
+				default:
+					return client.RunComponent("scheduler")
+				}
+			}
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/"+action),
+						ghttp.RespondWith(status, ""),
+					),
+				)
+			})
+
+			Context("when the component exists", func() {
+				BeforeEach(func() {
+					status = http.StatusOK
+				})
+
+				It("returns true", func() {
+					found, err := send()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+
+			Context("when the component does not exist", func() {
+				BeforeEach(func() {
+					status = http.StatusNotFound
+				})
+
+				It("returns false", func() {
+					found, err := send()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+
+			Context("when the api returns an error", func() {
+				BeforeEach(func() {
+					status = http.StatusInternalServerError
+				})
+
+				It("returns the error", func() {
+					_, err := send()
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	}
+
+	Describe("RunComponent", func() {
+		Context("when the component is paused", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/components/scheduler/run"),
+						ghttp.RespondWith(http.StatusConflict, ""),
+					),
+				)
+			})
+
+			It("returns ErrComponentPaused", func() {
+				_, err := client.RunComponent("scheduler")
+				Expect(err).To(Equal(concourse.ErrComponentPaused))
+			})
+		})
+	})
+})
@@ -111,6 +111,41 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	DisableMaintenanceStub        func() error
+	disableMaintenanceMutex       sync.RWMutex
+	disableMaintenanceArgsForCall []struct {
+	}
+	disableMaintenanceReturns struct {
+		result1 error
+	}
+	disableMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnableMaintenanceStub        func(string) error
+	enableMaintenanceMutex       sync.RWMutex
+	enableMaintenanceArgsForCall []struct {
+		arg1 string
+	}
+	enableMaintenanceReturns struct {
+		result1 error
+	}
+	enableMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FilteredBuildEventsStub        func(string, concourse.BuildEventsFilter) (concourse.Events, error)
+	filteredBuildEventsMutex       sync.RWMutex
+	filteredBuildEventsArgsForCall []struct {
+		arg1 string
+		arg2 concourse.BuildEventsFilter
+	}
+	filteredBuildEventsReturns struct {
+		result1 concourse.Events
+		result2 error
+	}
+	filteredBuildEventsReturnsOnCall map[int]struct {
+		result1 concourse.Events
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -211,6 +246,18 @@ type FakeClient struct {
 		result1 []atc.WorkerArtifact
 		result2 error
 	}
+	ListComponentsStub        func() ([]atc.ComponentStatus, error)
+	listComponentsMutex       sync.RWMutex
+	listComponentsArgsForCall []struct {
+	}
+	listComponentsReturns struct {
+		result1 []atc.ComponentStatus
+		result2 error
+	}
+	listComponentsReturnsOnCall map[int]struct {
+		result1 []atc.ComponentStatus
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -223,6 +270,18 @@ type FakeClient struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
+	ListSystemLocksStub        func() ([]atc.SystemLock, error)
+	listSystemLocksMutex       sync.RWMutex
+	listSystemLocksArgsForCall []struct {
+	}
+	listSystemLocksReturns struct {
+		result1 []atc.SystemLock
+		result2 error
+	}
+	listSystemLocksReturnsOnCall map[int]struct {
+		result1 []atc.SystemLock
+		result2 error
+	}
 	ListTeamsStub        func() ([]atc.Team, error)
 	listTeamsMutex       sync.RWMutex
 	listTeamsArgsForCall []struct {
@@ -247,6 +306,31 @@ type FakeClient struct {
 		result1 []atc.Worker
 		result2 error
 	}
+	MaintenanceStub        func() (atc.Maintenance, error)
+	maintenanceMutex       sync.RWMutex
+	maintenanceArgsForCall []struct {
+	}
+	maintenanceReturns struct {
+		result1 atc.Maintenance
+		result2 error
+	}
+	maintenanceReturnsOnCall map[int]struct {
+		result1 atc.Maintenance
+		result2 error
+	}
+	PauseComponentStub        func(string) (bool, error)
+	pauseComponentMutex       sync.RWMutex
+	pauseComponentArgsForCall []struct {
+		arg1 string
+	}
+	pauseComponentReturns struct {
+		result1 bool
+		result2 error
+	}
+	pauseComponentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PruneWorkerStub        func(string) error
 	pruneWorkerMutex       sync.RWMutex
 	pruneWorkerArgsForCall []struct {
@@ -258,6 +342,33 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseSystemLockStub        func(int, []int) (bool, error)
+	releaseSystemLockMutex       sync.RWMutex
+	releaseSystemLockArgsForCall []struct {
+		arg1 int
+		arg2 []int
+	}
+	releaseSystemLockReturns struct {
+		result1 bool
+		result2 error
+	}
+	releaseSystemLockReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RunComponentStub        func(string) (bool, error)
+	runComponentMutex       sync.RWMutex
+	runComponentArgsForCall []struct {
+		arg1 string
+	}
+	runComponentReturns struct {
+		result1 bool
+		result2 error
+	}
+	runComponentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -293,6 +404,19 @@ type FakeClient struct {
 	uRLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnpauseComponentStub        func(string) (bool, error)
+	unpauseComponentMutex       sync.RWMutex
+	unpauseComponentArgsForCall []struct {
+		arg1 string
+	}
+	unpauseComponentReturns struct {
+		result1 bool
+		result2 error
+	}
+	unpauseComponentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UserInfoStub        func() (atc.UserInfo, error)
 	userInfoMutex       sync.RWMutex
 	userInfoArgsForCall []struct {
@@ -762,6 +886,182 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) DisableMaintenance() error {
+	fake.disableMaintenanceMutex.Lock()
+	ret, specificReturn := fake.disableMaintenanceReturnsOnCall[len(fake.disableMaintenanceArgsForCall)]
+	fake.disableMaintenanceArgsForCall = append(fake.disableMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DisableMaintenance", []interface{}{})
+	fake.disableMaintenanceMutex.Unlock()
+	if fake.DisableMaintenanceStub != nil {
+		return fake.DisableMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.disableMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DisableMaintenanceCallCount() int {
+	fake.disableMaintenanceMutex.RLock()
+	defer fake.disableMaintenanceMutex.RUnlock()
+	return len(fake.disableMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) DisableMaintenanceCalls(stub func() error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = stub
+}
+
+func (fake *FakeClient) DisableMaintenanceReturns(result1 error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = nil
+	fake.disableMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DisableMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = nil
+	if fake.disableMaintenanceReturnsOnCall == nil {
+		fake.disableMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EnableMaintenance(arg1 string) error {
+	fake.enableMaintenanceMutex.Lock()
+	ret, specificReturn := fake.enableMaintenanceReturnsOnCall[len(fake.enableMaintenanceArgsForCall)]
+	fake.enableMaintenanceArgsForCall = append(fake.enableMaintenanceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("EnableMaintenance", []interface{}{arg1})
+	fake.enableMaintenanceMutex.Unlock()
+	if fake.EnableMaintenanceStub != nil {
+		return fake.EnableMaintenanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.enableMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) EnableMaintenanceCallCount() int {
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
+	return len(fake.enableMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) EnableMaintenanceCalls(stub func(string) error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = stub
+}
+
+func (fake *FakeClient) EnableMaintenanceArgsForCall(i int) string {
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
+	argsForCall := fake.enableMaintenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) EnableMaintenanceReturns(result1 error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = nil
+	fake.enableMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EnableMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = nil
+	if fake.enableMaintenanceReturnsOnCall == nil {
+		fake.enableMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) FilteredBuildEvents(arg1 string, arg2 concourse.BuildEventsFilter) (concourse.Events, error) {
+	fake.filteredBuildEventsMutex.Lock()
+	ret, specificReturn := fake.filteredBuildEventsReturnsOnCall[len(fake.filteredBuildEventsArgsForCall)]
+	fake.filteredBuildEventsArgsForCall = append(fake.filteredBuildEventsArgsForCall, struct {
+		arg1 string
+		arg2 concourse.BuildEventsFilter
+	}{arg1, arg2})
+	fake.recordInvocation("FilteredBuildEvents", []interface{}{arg1, arg2})
+	fake.filteredBuildEventsMutex.Unlock()
+	if fake.FilteredBuildEventsStub != nil {
+		return fake.FilteredBuildEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.filteredBuildEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) FilteredBuildEventsCallCount() int {
+	fake.filteredBuildEventsMutex.RLock()
+	defer fake.filteredBuildEventsMutex.RUnlock()
+	return len(fake.filteredBuildEventsArgsForCall)
+}
+
+func (fake *FakeClient) FilteredBuildEventsCalls(stub func(string, concourse.BuildEventsFilter) (concourse.Events, error)) {
+	fake.filteredBuildEventsMutex.Lock()
+	defer fake.filteredBuildEventsMutex.Unlock()
+	fake.FilteredBuildEventsStub = stub
+}
+
+func (fake *FakeClient) FilteredBuildEventsArgsForCall(i int) (string, concourse.BuildEventsFilter) {
+	fake.filteredBuildEventsMutex.RLock()
+	defer fake.filteredBuildEventsMutex.RUnlock()
+	argsForCall := fake.filteredBuildEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) FilteredBuildEventsReturns(result1 concourse.Events, result2 error) {
+	fake.filteredBuildEventsMutex.Lock()
+	defer fake.filteredBuildEventsMutex.Unlock()
+	fake.FilteredBuildEventsStub = nil
+	fake.filteredBuildEventsReturns = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FilteredBuildEventsReturnsOnCall(i int, result1 concourse.Events, result2 error) {
+	fake.filteredBuildEventsMutex.Lock()
+	defer fake.filteredBuildEventsMutex.Unlock()
+	fake.FilteredBuildEventsStub = nil
+	if fake.filteredBuildEventsReturnsOnCall == nil {
+		fake.filteredBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 concourse.Events
+			result2 error
+		})
+	}
+	fake.filteredBuildEventsReturnsOnCall[i] = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1240,6 +1540,61 @@ func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.Wor
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListComponents() ([]atc.ComponentStatus, error) {
+	fake.listComponentsMutex.Lock()
+	ret, specificReturn := fake.listComponentsReturnsOnCall[len(fake.listComponentsArgsForCall)]
+	fake.listComponentsArgsForCall = append(fake.listComponentsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListComponents", []interface{}{})
+	fake.listComponentsMutex.Unlock()
+	if fake.ListComponentsStub != nil {
+		return fake.ListComponentsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listComponentsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListComponentsCallCount() int {
+	fake.listComponentsMutex.RLock()
+	defer fake.listComponentsMutex.RUnlock()
+	return len(fake.listComponentsArgsForCall)
+}
+
+func (fake *FakeClient) ListComponentsCalls(stub func() ([]atc.ComponentStatus, error)) {
+	fake.listComponentsMutex.Lock()
+	defer fake.listComponentsMutex.Unlock()
+	fake.ListComponentsStub = stub
+}
+
+func (fake *FakeClient) ListComponentsReturns(result1 []atc.ComponentStatus, result2 error) {
+	fake.listComponentsMutex.Lock()
+	defer fake.listComponentsMutex.Unlock()
+	fake.ListComponentsStub = nil
+	fake.listComponentsReturns = struct {
+		result1 []atc.ComponentStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListComponentsReturnsOnCall(i int, result1 []atc.ComponentStatus, result2 error) {
+	fake.listComponentsMutex.Lock()
+	defer fake.listComponentsMutex.Unlock()
+	fake.ListComponentsStub = nil
+	if fake.listComponentsReturnsOnCall == nil {
+		fake.listComponentsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ComponentStatus
+			result2 error
+		})
+	}
+	fake.listComponentsReturnsOnCall[i] = struct {
+		result1 []atc.ComponentStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -1295,6 +1650,61 @@ func (fake *FakeClient) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListSystemLocks() ([]atc.SystemLock, error) {
+	fake.listSystemLocksMutex.Lock()
+	ret, specificReturn := fake.listSystemLocksReturnsOnCall[len(fake.listSystemLocksArgsForCall)]
+	fake.listSystemLocksArgsForCall = append(fake.listSystemLocksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListSystemLocks", []interface{}{})
+	fake.listSystemLocksMutex.Unlock()
+	if fake.ListSystemLocksStub != nil {
+		return fake.ListSystemLocksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listSystemLocksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListSystemLocksCallCount() int {
+	fake.listSystemLocksMutex.RLock()
+	defer fake.listSystemLocksMutex.RUnlock()
+	return len(fake.listSystemLocksArgsForCall)
+}
+
+func (fake *FakeClient) ListSystemLocksCalls(stub func() ([]atc.SystemLock, error)) {
+	fake.listSystemLocksMutex.Lock()
+	defer fake.listSystemLocksMutex.Unlock()
+	fake.ListSystemLocksStub = stub
+}
+
+func (fake *FakeClient) ListSystemLocksReturns(result1 []atc.SystemLock, result2 error) {
+	fake.listSystemLocksMutex.Lock()
+	defer fake.listSystemLocksMutex.Unlock()
+	fake.ListSystemLocksStub = nil
+	fake.listSystemLocksReturns = struct {
+		result1 []atc.SystemLock
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListSystemLocksReturnsOnCall(i int, result1 []atc.SystemLock, result2 error) {
+	fake.listSystemLocksMutex.Lock()
+	defer fake.listSystemLocksMutex.Unlock()
+	fake.ListSystemLocksStub = nil
+	if fake.listSystemLocksReturnsOnCall == nil {
+		fake.listSystemLocksReturnsOnCall = make(map[int]struct {
+			result1 []atc.SystemLock
+			result2 error
+		})
+	}
+	fake.listSystemLocksReturnsOnCall[i] = struct {
+		result1 []atc.SystemLock
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListTeams() ([]atc.Team, error) {
 	fake.listTeamsMutex.Lock()
 	ret, specificReturn := fake.listTeamsReturnsOnCall[len(fake.listTeamsArgsForCall)]
@@ -1405,6 +1815,124 @@ func (fake *FakeClient) ListWorkersReturnsOnCall(i int, result1 []atc.Worker, re
 	}{result1, result2}
 }
 
+func (fake *FakeClient) Maintenance() (atc.Maintenance, error) {
+	fake.maintenanceMutex.Lock()
+	ret, specificReturn := fake.maintenanceReturnsOnCall[len(fake.maintenanceArgsForCall)]
+	fake.maintenanceArgsForCall = append(fake.maintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Maintenance", []interface{}{})
+	fake.maintenanceMutex.Unlock()
+	if fake.MaintenanceStub != nil {
+		return fake.MaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.maintenanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) MaintenanceCallCount() int {
+	fake.maintenanceMutex.RLock()
+	defer fake.maintenanceMutex.RUnlock()
+	return len(fake.maintenanceArgsForCall)
+}
+
+func (fake *FakeClient) MaintenanceCalls(stub func() (atc.Maintenance, error)) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = stub
+}
+
+func (fake *FakeClient) MaintenanceReturns(result1 atc.Maintenance, result2 error) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = nil
+	fake.maintenanceReturns = struct {
+		result1 atc.Maintenance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) MaintenanceReturnsOnCall(i int, result1 atc.Maintenance, result2 error) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = nil
+	if fake.maintenanceReturnsOnCall == nil {
+		fake.maintenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.Maintenance
+			result2 error
+		})
+	}
+	fake.maintenanceReturnsOnCall[i] = struct {
+		result1 atc.Maintenance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PauseComponent(arg1 string) (bool, error) {
+	fake.pauseComponentMutex.Lock()
+	ret, specificReturn := fake.pauseComponentReturnsOnCall[len(fake.pauseComponentArgsForCall)]
+	fake.pauseComponentArgsForCall = append(fake.pauseComponentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PauseComponent", []interface{}{arg1})
+	fake.pauseComponentMutex.Unlock()
+	if fake.PauseComponentStub != nil {
+		return fake.PauseComponentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pauseComponentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PauseComponentCallCount() int {
+	fake.pauseComponentMutex.RLock()
+	defer fake.pauseComponentMutex.RUnlock()
+	return len(fake.pauseComponentArgsForCall)
+}
+
+func (fake *FakeClient) PauseComponentCalls(stub func(string) (bool, error)) {
+	fake.pauseComponentMutex.Lock()
+	defer fake.pauseComponentMutex.Unlock()
+	fake.PauseComponentStub = stub
+}
+
+func (fake *FakeClient) PauseComponentArgsForCall(i int) string {
+	fake.pauseComponentMutex.RLock()
+	defer fake.pauseComponentMutex.RUnlock()
+	argsForCall := fake.pauseComponentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) PauseComponentReturns(result1 bool, result2 error) {
+	fake.pauseComponentMutex.Lock()
+	defer fake.pauseComponentMutex.Unlock()
+	fake.PauseComponentStub = nil
+	fake.pauseComponentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PauseComponentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pauseComponentMutex.Lock()
+	defer fake.pauseComponentMutex.Unlock()
+	fake.PauseComponentStub = nil
+	if fake.pauseComponentReturnsOnCall == nil {
+		fake.pauseComponentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pauseComponentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) PruneWorker(arg1 string) error {
 	fake.pruneWorkerMutex.Lock()
 	ret, specificReturn := fake.pruneWorkerReturnsOnCall[len(fake.pruneWorkerArgsForCall)]
@@ -1465,6 +1993,138 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ReleaseSystemLock(arg1 int, arg2 []int) (bool, error) {
+	var arg2Copy []int
+	if arg2 != nil {
+		arg2Copy = make([]int, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.releaseSystemLockMutex.Lock()
+	ret, specificReturn := fake.releaseSystemLockReturnsOnCall[len(fake.releaseSystemLockArgsForCall)]
+	fake.releaseSystemLockArgsForCall = append(fake.releaseSystemLockArgsForCall, struct {
+		arg1 int
+		arg2 []int
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ReleaseSystemLock", []interface{}{arg1, arg2Copy})
+	fake.releaseSystemLockMutex.Unlock()
+	if fake.ReleaseSystemLockStub != nil {
+		return fake.ReleaseSystemLockStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.releaseSystemLockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReleaseSystemLockCallCount() int {
+	fake.releaseSystemLockMutex.RLock()
+	defer fake.releaseSystemLockMutex.RUnlock()
+	return len(fake.releaseSystemLockArgsForCall)
+}
+
+func (fake *FakeClient) ReleaseSystemLockCalls(stub func(int, []int) (bool, error)) {
+	fake.releaseSystemLockMutex.Lock()
+	defer fake.releaseSystemLockMutex.Unlock()
+	fake.ReleaseSystemLockStub = stub
+}
+
+func (fake *FakeClient) ReleaseSystemLockArgsForCall(i int) (int, []int) {
+	fake.releaseSystemLockMutex.RLock()
+	defer fake.releaseSystemLockMutex.RUnlock()
+	argsForCall := fake.releaseSystemLockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ReleaseSystemLockReturns(result1 bool, result2 error) {
+	fake.releaseSystemLockMutex.Lock()
+	defer fake.releaseSystemLockMutex.Unlock()
+	fake.ReleaseSystemLockStub = nil
+	fake.releaseSystemLockReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReleaseSystemLockReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.releaseSystemLockMutex.Lock()
+	defer fake.releaseSystemLockMutex.Unlock()
+	fake.ReleaseSystemLockStub = nil
+	if fake.releaseSystemLockReturnsOnCall == nil {
+		fake.releaseSystemLockReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.releaseSystemLockReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RunComponent(arg1 string) (bool, error) {
+	fake.runComponentMutex.Lock()
+	ret, specificReturn := fake.runComponentReturnsOnCall[len(fake.runComponentArgsForCall)]
+	fake.runComponentArgsForCall = append(fake.runComponentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RunComponent", []interface{}{arg1})
+	fake.runComponentMutex.Unlock()
+	if fake.RunComponentStub != nil {
+		return fake.RunComponentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runComponentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RunComponentCallCount() int {
+	fake.runComponentMutex.RLock()
+	defer fake.runComponentMutex.RUnlock()
+	return len(fake.runComponentArgsForCall)
+}
+
+func (fake *FakeClient) RunComponentCalls(stub func(string) (bool, error)) {
+	fake.runComponentMutex.Lock()
+	defer fake.runComponentMutex.Unlock()
+	fake.RunComponentStub = stub
+}
+
+func (fake *FakeClient) RunComponentArgsForCall(i int) string {
+	fake.runComponentMutex.RLock()
+	defer fake.runComponentMutex.RUnlock()
+	argsForCall := fake.runComponentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RunComponentReturns(result1 bool, result2 error) {
+	fake.runComponentMutex.Lock()
+	defer fake.runComponentMutex.Unlock()
+	fake.RunComponentStub = nil
+	fake.runComponentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RunComponentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.runComponentMutex.Lock()
+	defer fake.runComponentMutex.Unlock()
+	fake.RunComponentStub = nil
+	if fake.runComponentReturnsOnCall == nil {
+		fake.runComponentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.runComponentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1641,6 +2301,69 @@ func (fake *FakeClient) URLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeClient) UnpauseComponent(arg1 string) (bool, error) {
+	fake.unpauseComponentMutex.Lock()
+	ret, specificReturn := fake.unpauseComponentReturnsOnCall[len(fake.unpauseComponentArgsForCall)]
+	fake.unpauseComponentArgsForCall = append(fake.unpauseComponentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnpauseComponent", []interface{}{arg1})
+	fake.unpauseComponentMutex.Unlock()
+	if fake.UnpauseComponentStub != nil {
+		return fake.UnpauseComponentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpauseComponentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UnpauseComponentCallCount() int {
+	fake.unpauseComponentMutex.RLock()
+	defer fake.unpauseComponentMutex.RUnlock()
+	return len(fake.unpauseComponentArgsForCall)
+}
+
+func (fake *FakeClient) UnpauseComponentCalls(stub func(string) (bool, error)) {
+	fake.unpauseComponentMutex.Lock()
+	defer fake.unpauseComponentMutex.Unlock()
+	fake.UnpauseComponentStub = stub
+}
+
+func (fake *FakeClient) UnpauseComponentArgsForCall(i int) string {
+	fake.unpauseComponentMutex.RLock()
+	defer fake.unpauseComponentMutex.RUnlock()
+	argsForCall := fake.unpauseComponentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UnpauseComponentReturns(result1 bool, result2 error) {
+	fake.unpauseComponentMutex.Lock()
+	defer fake.unpauseComponentMutex.Unlock()
+	fake.UnpauseComponentStub = nil
+	fake.unpauseComponentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UnpauseComponentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.unpauseComponentMutex.Lock()
+	defer fake.unpauseComponentMutex.Unlock()
+	fake.UnpauseComponentStub = nil
+	if fake.unpauseComponentReturnsOnCall == nil {
+		fake.unpauseComponentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.unpauseComponentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) UserInfo() (atc.UserInfo, error) {
 	fake.userInfoMutex.Lock()
 	ret, specificReturn := fake.userInfoReturnsOnCall[len(fake.userInfoArgsForCall)]
@@ -1713,6 +2436,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.disableMaintenanceMutex.RLock()
+	defer fake.disableMaintenanceMutex.RUnlock()
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
+	fake.filteredBuildEventsMutex.RLock()
+	defer fake.filteredBuildEventsMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1729,20 +2458,34 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listAllJobsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
+	fake.listComponentsMutex.RLock()
+	defer fake.listComponentsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
+	fake.listSystemLocksMutex.RLock()
+	defer fake.listSystemLocksMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
+	fake.maintenanceMutex.RLock()
+	defer fake.maintenanceMutex.RUnlock()
+	fake.pauseComponentMutex.RLock()
+	defer fake.pauseComponentMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.releaseSystemLockMutex.RLock()
+	defer fake.releaseSystemLockMutex.RUnlock()
+	fake.runComponentMutex.RLock()
+	defer fake.runComponentMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
+	fake.unpauseComponentMutex.RLock()
+	defer fake.unpauseComponentMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
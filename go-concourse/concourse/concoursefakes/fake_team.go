@@ -4,12 +4,28 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type FakeTeam struct {
+	ApplyStub        func(atc.TeamManifest, bool, bool) (atc.TeamApplyPlan, error)
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		arg1 atc.TeamManifest
+		arg2 bool
+		arg3 bool
+	}
+	applyReturns struct {
+		result1 atc.TeamApplyPlan
+		result2 error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 atc.TeamApplyPlan
+		result2 error
+	}
 	ArchivePipelineStub        func(string) (bool, error)
 	archivePipelineMutex       sync.RWMutex
 	archivePipelineArgsForCall []struct {
@@ -148,6 +164,21 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	ClearTaskResultCacheStub        func(string, string, string) (int64, error)
+	clearTaskResultCacheMutex       sync.RWMutex
+	clearTaskResultCacheArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	clearTaskResultCacheReturns struct {
+		result1 int64
+		result2 error
+	}
+	clearTaskResultCacheReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -307,6 +338,26 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	FilteredResourceVersionsStub        func(string, string, concourse.Page, atc.VersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
+	filteredResourceVersionsMutex       sync.RWMutex
+	filteredResourceVersionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 concourse.Page
+		arg4 atc.VersionFilter
+	}
+	filteredResourceVersionsReturns struct {
+		result1 []atc.ResourceVersion
+		result2 concourse.Pagination
+		result3 bool
+		result4 error
+	}
+	filteredResourceVersionsReturnsOnCall map[int]struct {
+		result1 []atc.ResourceVersion
+		result2 concourse.Pagination
+		result3 bool
+		result4 error
+	}
 	GetArtifactStub        func(int) (io.ReadCloser, error)
 	getArtifactMutex       sync.RWMutex
 	getArtifactArgsForCall []struct {
@@ -346,6 +397,21 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	HijackSessionRecordingStub        func(int) (io.ReadCloser, bool, error)
+	hijackSessionRecordingMutex       sync.RWMutex
+	hijackSessionRecordingArgsForCall []struct {
+		arg1 int
+	}
+	hijackSessionRecordingReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	hijackSessionRecordingReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	JobStub        func(string, string) (atc.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -411,6 +477,18 @@ type FakeTeam struct {
 		result1 []atc.Container
 		result2 error
 	}
+	ListHijackSessionsStub        func() ([]atc.HijackSession, error)
+	listHijackSessionsMutex       sync.RWMutex
+	listHijackSessionsArgsForCall []struct {
+	}
+	listHijackSessionsReturns struct {
+		result1 []atc.HijackSession
+		result2 error
+	}
+	listHijackSessionsReturnsOnCall map[int]struct {
+		result1 []atc.HijackSession
+		result2 error
+	}
 	ListJobsStub        func(string) ([]atc.Job, error)
 	listJobsMutex       sync.RWMutex
 	listJobsArgsForCall []struct {
@@ -424,6 +502,18 @@ type FakeTeam struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListLockPoolsStub        func() ([]atc.LockPool, error)
+	listLockPoolsMutex       sync.RWMutex
+	listLockPoolsArgsForCall []struct {
+	}
+	listLockPoolsReturns struct {
+		result1 []atc.LockPool
+		result2 error
+	}
+	listLockPoolsReturnsOnCall map[int]struct {
+		result1 []atc.LockPool
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -482,6 +572,22 @@ type FakeTeam struct {
 	orderingPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PartialRerunJobBuildStub        func(string, string, string, string) (atc.Build, error)
+	partialRerunJobBuildMutex       sync.RWMutex
+	partialRerunJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	partialRerunJobBuildReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	partialRerunJobBuildReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	PauseJobStub        func(string, string) (bool, error)
 	pauseJobMutex       sync.RWMutex
 	pauseJobArgsForCall []struct {
@@ -574,6 +680,52 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineGraphStub        func(string, string) (atc.PipelineGraph, bool, error)
+	pipelineGraphMutex       sync.RWMutex
+	pipelineGraphArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	pipelineGraphReturns struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}
+	pipelineGraphReturnsOnCall map[int]struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}
+	PipelineStatsStub        func(string, time.Time) ([]atc.JobStats, bool, error)
+	pipelineStatsMutex       sync.RWMutex
+	pipelineStatsArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+	}
+	pipelineStatsReturns struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}
+	pipelineStatsReturnsOnCall map[int]struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}
+	ReleaseLockPoolStub        func(string, int) (bool, error)
+	releaseLockPoolMutex       sync.RWMutex
+	releaseLockPoolArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseLockPoolReturns struct {
+		result1 bool
+		result2 error
+	}
+	releaseLockPoolReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -742,6 +894,71 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) Apply(arg1 atc.TeamManifest, arg2 bool, arg3 bool) (atc.TeamApplyPlan, error) {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		arg1 atc.TeamManifest
+		arg2 bool
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Apply", []interface{}{arg1, arg2, arg3})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.applyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeTeam) ApplyCalls(stub func(atc.TeamManifest, bool, bool) (atc.TeamApplyPlan, error)) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = stub
+}
+
+func (fake *FakeTeam) ApplyArgsForCall(i int) (atc.TeamManifest, bool, bool) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	argsForCall := fake.applyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ApplyReturns(result1 atc.TeamApplyPlan, result2 error) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 atc.TeamApplyPlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ApplyReturnsOnCall(i int, result1 atc.TeamApplyPlan, result2 error) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamApplyPlan
+			result2 error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 atc.TeamApplyPlan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ArchivePipeline(arg1 string) (bool, error) {
 	fake.archivePipelineMutex.Lock()
 	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
@@ -1328,6 +1545,71 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ClearTaskResultCache(arg1 string, arg2 string, arg3 string) (int64, error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	ret, specificReturn := fake.clearTaskResultCacheReturnsOnCall[len(fake.clearTaskResultCacheArgsForCall)]
+	fake.clearTaskResultCacheArgsForCall = append(fake.clearTaskResultCacheArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ClearTaskResultCache", []interface{}{arg1, arg2, arg3})
+	fake.clearTaskResultCacheMutex.Unlock()
+	if fake.ClearTaskResultCacheStub != nil {
+		return fake.ClearTaskResultCacheStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearTaskResultCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ClearTaskResultCacheCallCount() int {
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
+	return len(fake.clearTaskResultCacheArgsForCall)
+}
+
+func (fake *FakeTeam) ClearTaskResultCacheCalls(stub func(string, string, string) (int64, error)) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = stub
+}
+
+func (fake *FakeTeam) ClearTaskResultCacheArgsForCall(i int) (string, string, string) {
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
+	argsForCall := fake.clearTaskResultCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ClearTaskResultCacheReturns(result1 int64, result2 error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = nil
+	fake.clearTaskResultCacheReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ClearTaskResultCacheReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.clearTaskResultCacheMutex.Lock()
+	defer fake.clearTaskResultCacheMutex.Unlock()
+	fake.ClearTaskResultCacheStub = nil
+	if fake.clearTaskResultCacheReturnsOnCall == nil {
+		fake.clearTaskResultCacheReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.clearTaskResultCacheReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string) (atc.WorkerArtifact, error) {
 	fake.createArtifactMutex.Lock()
 	ret, specificReturn := fake.createArtifactReturnsOnCall[len(fake.createArtifactArgsForCall)]
@@ -2045,6 +2327,78 @@ func (fake *FakeTeam) ExposePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) FilteredResourceVersions(arg1 string, arg2 string, arg3 concourse.Page, arg4 atc.VersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	ret, specificReturn := fake.filteredResourceVersionsReturnsOnCall[len(fake.filteredResourceVersionsArgsForCall)]
+	fake.filteredResourceVersionsArgsForCall = append(fake.filteredResourceVersionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 concourse.Page
+		arg4 atc.VersionFilter
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("FilteredResourceVersions", []interface{}{arg1, arg2, arg3, arg4})
+	fake.filteredResourceVersionsMutex.Unlock()
+	if fake.FilteredResourceVersionsStub != nil {
+		return fake.FilteredResourceVersionsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.filteredResourceVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsCallCount() int {
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
+	return len(fake.filteredResourceVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsCalls(stub func(string, string, concourse.Page, atc.VersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error)) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = stub
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsArgsForCall(i int) (string, string, concourse.Page, atc.VersionFilter) {
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
+	argsForCall := fake.filteredResourceVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsReturns(result1 []atc.ResourceVersion, result2 concourse.Pagination, result3 bool, result4 error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = nil
+	fake.filteredResourceVersionsReturns = struct {
+		result1 []atc.ResourceVersion
+		result2 concourse.Pagination
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsReturnsOnCall(i int, result1 []atc.ResourceVersion, result2 concourse.Pagination, result3 bool, result4 error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = nil
+	if fake.filteredResourceVersionsReturnsOnCall == nil {
+		fake.filteredResourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceVersion
+			result2 concourse.Pagination
+			result3 bool
+			result4 error
+		})
+	}
+	fake.filteredResourceVersionsReturnsOnCall[i] = struct {
+		result1 []atc.ResourceVersion
+		result2 concourse.Pagination
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeTeam) GetArtifact(arg1 int) (io.ReadCloser, error) {
 	fake.getArtifactMutex.Lock()
 	ret, specificReturn := fake.getArtifactReturnsOnCall[len(fake.getArtifactArgsForCall)]
@@ -2064,6 +2418,8 @@ func (fake *FakeTeam) GetArtifact(arg1 int) (io.ReadCloser, error) {
 }
 
 func (fake *FakeTeam) GetArtifactCallCount() int {
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
 	defer fake.getArtifactMutex.RUnlock()
 	return len(fake.getArtifactArgsForCall)
@@ -2234,6 +2590,72 @@ func (fake *FakeTeam) HidePipelineReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) HijackSessionRecording(arg1 int) (io.ReadCloser, bool, error) {
+	fake.hijackSessionRecordingMutex.Lock()
+	ret, specificReturn := fake.hijackSessionRecordingReturnsOnCall[len(fake.hijackSessionRecordingArgsForCall)]
+	fake.hijackSessionRecordingArgsForCall = append(fake.hijackSessionRecordingArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("HijackSessionRecording", []interface{}{arg1})
+	fake.hijackSessionRecordingMutex.Unlock()
+	if fake.HijackSessionRecordingStub != nil {
+		return fake.HijackSessionRecordingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.hijackSessionRecordingReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) HijackSessionRecordingCallCount() int {
+	fake.hijackSessionRecordingMutex.RLock()
+	defer fake.hijackSessionRecordingMutex.RUnlock()
+	return len(fake.hijackSessionRecordingArgsForCall)
+}
+
+func (fake *FakeTeam) HijackSessionRecordingCalls(stub func(int) (io.ReadCloser, bool, error)) {
+	fake.hijackSessionRecordingMutex.Lock()
+	defer fake.hijackSessionRecordingMutex.Unlock()
+	fake.HijackSessionRecordingStub = stub
+}
+
+func (fake *FakeTeam) HijackSessionRecordingArgsForCall(i int) int {
+	fake.hijackSessionRecordingMutex.RLock()
+	defer fake.hijackSessionRecordingMutex.RUnlock()
+	argsForCall := fake.hijackSessionRecordingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) HijackSessionRecordingReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.hijackSessionRecordingMutex.Lock()
+	defer fake.hijackSessionRecordingMutex.Unlock()
+	fake.HijackSessionRecordingStub = nil
+	fake.hijackSessionRecordingReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) HijackSessionRecordingReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.hijackSessionRecordingMutex.Lock()
+	defer fake.hijackSessionRecordingMutex.Unlock()
+	fake.HijackSessionRecordingStub = nil
+	if fake.hijackSessionRecordingReturnsOnCall == nil {
+		fake.hijackSessionRecordingReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.hijackSessionRecordingReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Job(arg1 string, arg2 string) (atc.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -2503,6 +2925,61 @@ func (fake *FakeTeam) ListContainersReturnsOnCall(i int, result1 []atc.Container
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListHijackSessions() ([]atc.HijackSession, error) {
+	fake.listHijackSessionsMutex.Lock()
+	ret, specificReturn := fake.listHijackSessionsReturnsOnCall[len(fake.listHijackSessionsArgsForCall)]
+	fake.listHijackSessionsArgsForCall = append(fake.listHijackSessionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListHijackSessions", []interface{}{})
+	fake.listHijackSessionsMutex.Unlock()
+	if fake.ListHijackSessionsStub != nil {
+		return fake.ListHijackSessionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listHijackSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListHijackSessionsCallCount() int {
+	fake.listHijackSessionsMutex.RLock()
+	defer fake.listHijackSessionsMutex.RUnlock()
+	return len(fake.listHijackSessionsArgsForCall)
+}
+
+func (fake *FakeTeam) ListHijackSessionsCalls(stub func() ([]atc.HijackSession, error)) {
+	fake.listHijackSessionsMutex.Lock()
+	defer fake.listHijackSessionsMutex.Unlock()
+	fake.ListHijackSessionsStub = stub
+}
+
+func (fake *FakeTeam) ListHijackSessionsReturns(result1 []atc.HijackSession, result2 error) {
+	fake.listHijackSessionsMutex.Lock()
+	defer fake.listHijackSessionsMutex.Unlock()
+	fake.ListHijackSessionsStub = nil
+	fake.listHijackSessionsReturns = struct {
+		result1 []atc.HijackSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListHijackSessionsReturnsOnCall(i int, result1 []atc.HijackSession, result2 error) {
+	fake.listHijackSessionsMutex.Lock()
+	defer fake.listHijackSessionsMutex.Unlock()
+	fake.ListHijackSessionsStub = nil
+	if fake.listHijackSessionsReturnsOnCall == nil {
+		fake.listHijackSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.HijackSession
+			result2 error
+		})
+	}
+	fake.listHijackSessionsReturnsOnCall[i] = struct {
+		result1 []atc.HijackSession
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListJobs(arg1 string) ([]atc.Job, error) {
 	fake.listJobsMutex.Lock()
 	ret, specificReturn := fake.listJobsReturnsOnCall[len(fake.listJobsArgsForCall)]
@@ -2566,6 +3043,61 @@ func (fake *FakeTeam) ListJobsReturnsOnCall(i int, result1 []atc.Job, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListLockPools() ([]atc.LockPool, error) {
+	fake.listLockPoolsMutex.Lock()
+	ret, specificReturn := fake.listLockPoolsReturnsOnCall[len(fake.listLockPoolsArgsForCall)]
+	fake.listLockPoolsArgsForCall = append(fake.listLockPoolsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListLockPools", []interface{}{})
+	fake.listLockPoolsMutex.Unlock()
+	if fake.ListLockPoolsStub != nil {
+		return fake.ListLockPoolsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listLockPoolsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListLockPoolsCallCount() int {
+	fake.listLockPoolsMutex.RLock()
+	defer fake.listLockPoolsMutex.RUnlock()
+	return len(fake.listLockPoolsArgsForCall)
+}
+
+func (fake *FakeTeam) ListLockPoolsCalls(stub func() ([]atc.LockPool, error)) {
+	fake.listLockPoolsMutex.Lock()
+	defer fake.listLockPoolsMutex.Unlock()
+	fake.ListLockPoolsStub = stub
+}
+
+func (fake *FakeTeam) ListLockPoolsReturns(result1 []atc.LockPool, result2 error) {
+	fake.listLockPoolsMutex.Lock()
+	defer fake.listLockPoolsMutex.Unlock()
+	fake.ListLockPoolsStub = nil
+	fake.listLockPoolsReturns = struct {
+		result1 []atc.LockPool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListLockPoolsReturnsOnCall(i int, result1 []atc.LockPool, result2 error) {
+	fake.listLockPoolsMutex.Lock()
+	defer fake.listLockPoolsMutex.Unlock()
+	fake.ListLockPoolsStub = nil
+	if fake.listLockPoolsReturnsOnCall == nil {
+		fake.listLockPoolsReturnsOnCall = make(map[int]struct {
+			result1 []atc.LockPool
+			result2 error
+		})
+	}
+	fake.listLockPoolsReturnsOnCall[i] = struct {
+		result1 []atc.LockPool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -2856,6 +3388,72 @@ func (fake *FakeTeam) OrderingPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) PartialRerunJobBuild(arg1 string, arg2 string, arg3 string, arg4 string) (atc.Build, error) {
+	fake.partialRerunJobBuildMutex.Lock()
+	ret, specificReturn := fake.partialRerunJobBuildReturnsOnCall[len(fake.partialRerunJobBuildArgsForCall)]
+	fake.partialRerunJobBuildArgsForCall = append(fake.partialRerunJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("PartialRerunJobBuild", []interface{}{arg1, arg2, arg3, arg4})
+	fake.partialRerunJobBuildMutex.Unlock()
+	if fake.PartialRerunJobBuildStub != nil {
+		return fake.PartialRerunJobBuildStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.partialRerunJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PartialRerunJobBuildCallCount() int {
+	fake.partialRerunJobBuildMutex.RLock()
+	defer fake.partialRerunJobBuildMutex.RUnlock()
+	return len(fake.partialRerunJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) PartialRerunJobBuildCalls(stub func(string, string, string, string) (atc.Build, error)) {
+	fake.partialRerunJobBuildMutex.Lock()
+	defer fake.partialRerunJobBuildMutex.Unlock()
+	fake.PartialRerunJobBuildStub = stub
+}
+
+func (fake *FakeTeam) PartialRerunJobBuildArgsForCall(i int) (string, string, string, string) {
+	fake.partialRerunJobBuildMutex.RLock()
+	defer fake.partialRerunJobBuildMutex.RUnlock()
+	argsForCall := fake.partialRerunJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) PartialRerunJobBuildReturns(result1 atc.Build, result2 error) {
+	fake.partialRerunJobBuildMutex.Lock()
+	defer fake.partialRerunJobBuildMutex.Unlock()
+	fake.PartialRerunJobBuildStub = nil
+	fake.partialRerunJobBuildReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PartialRerunJobBuildReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.partialRerunJobBuildMutex.Lock()
+	defer fake.partialRerunJobBuildMutex.Unlock()
+	fake.PartialRerunJobBuildStub = nil
+	if fake.partialRerunJobBuildReturnsOnCall == nil {
+		fake.partialRerunJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.partialRerunJobBuildReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) PauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.pauseJobMutex.Lock()
 	ret, specificReturn := fake.pauseJobReturnsOnCall[len(fake.pauseJobArgsForCall)]
@@ -3253,6 +3851,204 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineGraph(arg1 string, arg2 string) (atc.PipelineGraph, bool, error) {
+	fake.pipelineGraphMutex.Lock()
+	ret, specificReturn := fake.pipelineGraphReturnsOnCall[len(fake.pipelineGraphArgsForCall)]
+	fake.pipelineGraphArgsForCall = append(fake.pipelineGraphArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineGraph", []interface{}{arg1, arg2})
+	fake.pipelineGraphMutex.Unlock()
+	if fake.PipelineGraphStub != nil {
+		return fake.PipelineGraphStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineGraphReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineGraphCallCount() int {
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	return len(fake.pipelineGraphArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineGraphCalls(stub func(string, string) (atc.PipelineGraph, bool, error)) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = stub
+}
+
+func (fake *FakeTeam) PipelineGraphArgsForCall(i int) (string, string) {
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	argsForCall := fake.pipelineGraphArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineGraphReturns(result1 atc.PipelineGraph, result2 bool, result3 error) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = nil
+	fake.pipelineGraphReturns = struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineGraphReturnsOnCall(i int, result1 atc.PipelineGraph, result2 bool, result3 error) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = nil
+	if fake.pipelineGraphReturnsOnCall == nil {
+		fake.pipelineGraphReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineGraph
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineGraphReturnsOnCall[i] = struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineStats(arg1 string, arg2 time.Time) ([]atc.JobStats, bool, error) {
+	fake.pipelineStatsMutex.Lock()
+	ret, specificReturn := fake.pipelineStatsReturnsOnCall[len(fake.pipelineStatsArgsForCall)]
+	fake.pipelineStatsArgsForCall = append(fake.pipelineStatsArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineStats", []interface{}{arg1, arg2})
+	fake.pipelineStatsMutex.Unlock()
+	if fake.PipelineStatsStub != nil {
+		return fake.PipelineStatsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineStatsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineStatsCallCount() int {
+	fake.pipelineStatsMutex.RLock()
+	defer fake.pipelineStatsMutex.RUnlock()
+	return len(fake.pipelineStatsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineStatsCalls(stub func(string, time.Time) ([]atc.JobStats, bool, error)) {
+	fake.pipelineStatsMutex.Lock()
+	defer fake.pipelineStatsMutex.Unlock()
+	fake.PipelineStatsStub = stub
+}
+
+func (fake *FakeTeam) PipelineStatsArgsForCall(i int) (string, time.Time) {
+	fake.pipelineStatsMutex.RLock()
+	defer fake.pipelineStatsMutex.RUnlock()
+	argsForCall := fake.pipelineStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineStatsReturns(result1 []atc.JobStats, result2 bool, result3 error) {
+	fake.pipelineStatsMutex.Lock()
+	defer fake.pipelineStatsMutex.Unlock()
+	fake.PipelineStatsStub = nil
+	fake.pipelineStatsReturns = struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineStatsReturnsOnCall(i int, result1 []atc.JobStats, result2 bool, result3 error) {
+	fake.pipelineStatsMutex.Lock()
+	defer fake.pipelineStatsMutex.Unlock()
+	fake.PipelineStatsStub = nil
+	if fake.pipelineStatsReturnsOnCall == nil {
+		fake.pipelineStatsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobStats
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineStatsReturnsOnCall[i] = struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ReleaseLockPool(arg1 string, arg2 int) (bool, error) {
+	fake.releaseLockPoolMutex.Lock()
+	ret, specificReturn := fake.releaseLockPoolReturnsOnCall[len(fake.releaseLockPoolArgsForCall)]
+	fake.releaseLockPoolArgsForCall = append(fake.releaseLockPoolArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ReleaseLockPool", []interface{}{arg1, arg2})
+	fake.releaseLockPoolMutex.Unlock()
+	if fake.ReleaseLockPoolStub != nil {
+		return fake.ReleaseLockPoolStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.releaseLockPoolReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ReleaseLockPoolCallCount() int {
+	fake.releaseLockPoolMutex.RLock()
+	defer fake.releaseLockPoolMutex.RUnlock()
+	return len(fake.releaseLockPoolArgsForCall)
+}
+
+func (fake *FakeTeam) ReleaseLockPoolCalls(stub func(string, int) (bool, error)) {
+	fake.releaseLockPoolMutex.Lock()
+	defer fake.releaseLockPoolMutex.Unlock()
+	fake.ReleaseLockPoolStub = stub
+}
+
+func (fake *FakeTeam) ReleaseLockPoolArgsForCall(i int) (string, int) {
+	fake.releaseLockPoolMutex.RLock()
+	defer fake.releaseLockPoolMutex.RUnlock()
+	argsForCall := fake.releaseLockPoolArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ReleaseLockPoolReturns(result1 bool, result2 error) {
+	fake.releaseLockPoolMutex.Lock()
+	defer fake.releaseLockPoolMutex.Unlock()
+	fake.ReleaseLockPoolStub = nil
+	fake.releaseLockPoolReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ReleaseLockPoolReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.releaseLockPoolMutex.Lock()
+	defer fake.releaseLockPoolMutex.Unlock()
+	fake.ReleaseLockPoolStub = nil
+	if fake.releaseLockPoolReturnsOnCall == nil {
+		fake.releaseLockPoolReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.releaseLockPoolReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3974,6 +4770,8 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -3992,6 +4790,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.clearTaskResultCacheMutex.RLock()
+	defer fake.clearTaskResultCacheMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -4020,6 +4820,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.getContainerMutex.RUnlock()
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
+	fake.hijackSessionRecordingMutex.RLock()
+	defer fake.hijackSessionRecordingMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobBuildMutex.RLock()
@@ -4028,8 +4830,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildsMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
+	fake.listHijackSessionsMutex.RLock()
+	defer fake.listHijackSessionsMutex.RUnlock()
 	fake.listJobsMutex.RLock()
 	defer fake.listJobsMutex.RUnlock()
+	fake.listLockPoolsMutex.RLock()
+	defer fake.listLockPoolsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
@@ -4040,6 +4846,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.nameMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
+	fake.partialRerunJobBuildMutex.RLock()
+	defer fake.partialRerunJobBuildMutex.RUnlock()
 	fake.pauseJobMutex.RLock()
 	defer fake.pauseJobMutex.RUnlock()
 	fake.pausePipelineMutex.RLock()
@@ -4052,6 +4860,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	fake.pipelineStatsMutex.RLock()
+	defer fake.pipelineStatsMutex.RUnlock()
+	fake.releaseLockPoolMutex.RLock()
+	defer fake.releaseLockPoolMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
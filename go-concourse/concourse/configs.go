@@ -48,6 +48,8 @@ func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool,
 type ConfigWarning struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
+	Rule    string `json:"rule,omitempty"`
+	Hint    string `json:"hint,omitempty"`
 }
 
 type setConfigResponse struct {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 )
 
@@ -34,3 +35,73 @@ type InvalidConfigError struct {
 func (c InvalidConfigError) Error() string {
 	return fmt.Sprintf("invalid pipeline config:\n%s", strings.Join(c.Errors, "\n"))
 }
+
+// UnexpectedResponseError is returned for response codes without a more
+// specific error, e.g. a 500 Internal Server Error.
+type UnexpectedResponseError = internal.UnexpectedResponseError
+
+// UnauthorizedError is returned by ClientV2 for 401 response codes. It
+// matches ErrUnauthorized with errors.Is.
+type UnauthorizedError struct {
+	Message string
+}
+
+func (err UnauthorizedError) Error() string {
+	return responseErrorMessage("not authorized", err.Message)
+}
+
+func (err UnauthorizedError) Is(target error) bool {
+	return target == ErrUnauthorized
+}
+
+// ForbiddenError is returned by ClientV2 for 403 response codes. It matches
+// ErrForbidden with errors.Is.
+type ForbiddenError struct {
+	Message string
+}
+
+func (err ForbiddenError) Error() string {
+	return responseErrorMessage("forbidden", err.Message)
+}
+
+func (err ForbiddenError) Is(target error) bool {
+	return target == ErrForbidden
+}
+
+// NotFoundError is returned by ClientV2 for 404 response codes.
+type NotFoundError struct {
+	Message string
+}
+
+func (err NotFoundError) Error() string {
+	return responseErrorMessage("not found", err.Message)
+}
+
+// ConflictError is returned by ClientV2 for 409 response codes, e.g. when
+// saving a pipeline config which was changed in the meantime.
+type ConflictError struct {
+	Message string
+}
+
+func (err ConflictError) Error() string {
+	return responseErrorMessage("conflict", err.Message)
+}
+
+// ApplyError is returned when applying a team manifest fails part of the way
+// through. The plan marks the pipelines which were applied before the
+// failure.
+type ApplyError struct {
+	Plan atc.TeamApplyPlan
+}
+
+func (err ApplyError) Error() string {
+	return err.Plan.Error
+}
+
+func responseErrorMessage(status string, message string) string {
+	if message == "" {
+		return status
+	}
+
+	return status + ": " + message
+}
@@ -1,10 +1,17 @@
 package concourse
 
 import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
 )
 
 type Events interface {
@@ -12,12 +19,54 @@ type Events interface {
 	Close() error
 }
 
+type BuildEventsFilter struct {
+	Origins []string
+	Since   time.Time
+	Until   time.Time
+	Tail    int
+
+	// Progress asks for progress events estimating when the build will finish
+	// to be interleaved with the build's events.
+	Progress bool
+}
+
+func (filter BuildEventsFilter) query() url.Values {
+	query := url.Values{}
+
+	for _, origin := range filter.Origins {
+		query.Add("origin", origin)
+	}
+
+	if !filter.Since.IsZero() {
+		query.Set("since", strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+
+	if !filter.Until.IsZero() {
+		query.Set("until", strconv.FormatInt(filter.Until.Unix(), 10))
+	}
+
+	if filter.Tail > 0 {
+		query.Set("tail", strconv.Itoa(filter.Tail))
+	}
+
+	if filter.Progress {
+		query.Set("progress", "true")
+	}
+
+	return query
+}
+
 func (client *client) BuildEvents(buildID string) (Events, error) {
+	return client.FilteredBuildEvents(buildID, BuildEventsFilter{})
+}
+
+func (client *client) FilteredBuildEvents(buildID string, filter BuildEventsFilter) (Events, error) {
 	sseEvents, err := client.connection.ConnectToEventStream(internal.Request{
 		RequestName: atc.BuildEvents,
 		Params: rata.Params{
 			"build_id": buildID,
 		},
+		Query: filter.query(),
 	})
 	if err != nil {
 		return nil, err
@@ -25,3 +74,81 @@ func (client *client) BuildEvents(buildID string) (Events, error) {
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+// reconnectingEventStream reads a build's events, reconnecting from the last
+// event it received whenever the connection drops before the end of the
+// stream.
+type reconnectingEventStream struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	retryPolicy RetryPolicy
+	connect     func(ctx context.Context, lastEventID string) (io.ReadCloser, error)
+
+	reader      *sse.ReadCloser
+	lastEventID string
+}
+
+func (stream *reconnectingEventStream) NextEvent() (atc.Event, error) {
+	// failed counts the connections dropped without receiving an event
+	failed := 0
+
+	for {
+		if stream.reader == nil {
+			err := stream.reconnect()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		se, err := stream.reader.Next()
+		if err != nil {
+			_ = stream.reader.Close()
+			stream.reader = nil
+
+			if stream.ctx.Err() != nil {
+				return nil, stream.ctx.Err()
+			}
+
+			failed++
+			if failed >= stream.retryPolicy.attempts() {
+				return nil, err
+			}
+
+			err = stream.retryPolicy.wait(stream.ctx, failed)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		failed = 0
+
+		if se.ID != "" {
+			stream.lastEventID = se.ID
+		}
+
+		return eventstream.DecodeEvent(se)
+	}
+}
+
+func (stream *reconnectingEventStream) reconnect() error {
+	body, err := stream.connect(stream.ctx, stream.lastEventID)
+	if err != nil {
+		return err
+	}
+
+	stream.reader = sse.NewReadCloser(body)
+
+	return nil
+}
+
+func (stream *reconnectingEventStream) Close() error {
+	stream.cancel()
+
+	if stream.reader == nil {
+		return nil
+	}
+
+	return stream.reader.Close()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
@@ -106,6 +107,37 @@ var _ = Describe("ATC Handler Events", func() {
 			})
 		})
 
+		Context("when the events are filtered", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%s/events", buildID), "origin=some-origin&origin=other-origin&progress=true&since=100&tail=10&until=200"),
+						eventsHandler(),
+					),
+				)
+			})
+
+			It("passes the filter as query parameters", func() {
+				stream, err := client.FilteredBuildEvents(buildID, concourse.BuildEventsFilter{
+					Origins:  []string{"some-origin", "other-origin"},
+					Since:    time.Unix(100, 0),
+					Until:    time.Unix(200, 0),
+					Tail:     10,
+					Progress: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusStarted,
+				}))
+
+				err = stream.Close()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("when the server returns 401", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, ""))
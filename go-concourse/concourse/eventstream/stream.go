@@ -31,10 +31,16 @@ func (s *SSEEventStream) NextEvent() (atc.Event, error) {
 		return nil, err
 	}
 
+	return DecodeEvent(se)
+}
+
+// DecodeEvent returns the build event sent in the server-sent event, or
+// io.EOF for the event which ends the stream.
+func DecodeEvent(se sse.Event) (atc.Event, error) {
 	switch se.Name {
 	case "event":
 		var message event.Message
-		err = json.Unmarshal(se.Data, &message)
+		err := json.Unmarshal(se.Data, &message)
 		if err != nil {
 			return nil, err
 		}
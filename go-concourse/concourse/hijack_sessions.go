@@ -0,0 +1,51 @@
+package concourse
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListHijackSessions() ([]atc.HijackSession, error) {
+	var sessions []atc.HijackSession
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListHijackSessions,
+		Params:      params,
+	}, &internal.Response{
+		Result: &sessions,
+	})
+
+	return sessions, err
+}
+
+// HijackSessionRecording returns the asciicast recorded for the session, or
+// false if there is no such session or it has not ended yet.
+func (team *team) HijackSessionRecording(sessionID int) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"team_name":         team.name,
+		"hijack_session_id": strconv.Itoa(sessionID),
+	}
+
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName:        atc.GetHijackSessionRecording,
+		Params:             params,
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
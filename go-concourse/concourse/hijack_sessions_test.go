@@ -0,0 +1,103 @@
+package concourse_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Hijack Sessions", func() {
+	Describe("ListHijackSessions", func() {
+		var expectedSessions []atc.HijackSession
+
+		BeforeEach(func() {
+			exitStatus := 0
+
+			expectedSessions = []atc.HijackSession{
+				{
+					ID:       1,
+					TeamName: "some-team",
+					User:     "some-user",
+					Container: atc.HijackSessionContainer{
+						Handle:       "some-handle",
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						BuildName:    "7",
+						StepName:     "some-task",
+					},
+					Path:       "bash",
+					StartTime:  1000,
+					EndTime:    1060,
+					ExitStatus: &exitStatus,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/hijack-sessions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSessions),
+				),
+			)
+		})
+
+		It("returns the team's sessions", func() {
+			sessions, err := team.ListHijackSessions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal(expectedSessions))
+		})
+	})
+
+	Describe("HijackSessionRecording", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/hijack-sessions/42/recording"),
+					ghttp.RespondWith(status, "some-recording"),
+				),
+			)
+		})
+
+		Context("when the recording exists", func() {
+			BeforeEach(func() {
+				status = http.StatusOK
+			})
+
+			It("returns the recording", func() {
+				recording, found, err := team.HijackSessionRecording(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(ioutil.ReadAll(recording)).To(Equal([]byte("some-recording")))
+			})
+		})
+
+		Context("when the recording does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				_, found, err := team.HijackSessionRecording(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when getting the recording fails", func() {
+			BeforeEach(func() {
+				status = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				_, _, err := team.HijackSessionRecording(42)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package concourse
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildIterator walks through builds page by page, fetching the next page
+// when the current one runs out.
+//
+//	builds := client.IterateBuilds(concourse.Page{Limit: 100})
+//	for builds.Next(ctx) {
+//		build := builds.Build()
+//		...
+//	}
+//
+//	if err := builds.Err(); err != nil {
+//		...
+//	}
+type BuildIterator struct {
+	fetch func(context.Context, Page) ([]atc.Build, Pagination, error)
+
+	page    *Page
+	builds  []atc.Build
+	current atc.Build
+	err     error
+}
+
+func newBuildIterator(page Page, fetch func(context.Context, Page) ([]atc.Build, Pagination, error)) *BuildIterator {
+	return &BuildIterator{
+		fetch: fetch,
+		page:  &page,
+	}
+}
+
+// Next advances to the next build, returning false once there are no more
+// builds or fetching a page failed.
+func (iterator *BuildIterator) Next(ctx context.Context) bool {
+	for len(iterator.builds) == 0 {
+		if iterator.page == nil || iterator.err != nil {
+			return false
+		}
+
+		builds, pagination, err := iterator.fetch(ctx, *iterator.page)
+		if err != nil {
+			iterator.err = err
+			return false
+		}
+
+		iterator.builds = builds
+		iterator.page = pagination.Next
+	}
+
+	iterator.current = iterator.builds[0]
+	iterator.builds = iterator.builds[1:]
+
+	return true
+}
+
+// Build returns the build which Next advanced to.
+func (iterator *BuildIterator) Build() atc.Build {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (iterator *BuildIterator) Err() error {
+	return iterator.err
+}
+
+// ResourceVersionIterator walks through the versions of a resource page by
+// page, like BuildIterator.
+type ResourceVersionIterator struct {
+	fetch func(context.Context, Page) ([]atc.ResourceVersion, Pagination, error)
+
+	page     *Page
+	versions []atc.ResourceVersion
+	current  atc.ResourceVersion
+	err      error
+}
+
+func newResourceVersionIterator(page Page, fetch func(context.Context, Page) ([]atc.ResourceVersion, Pagination, error)) *ResourceVersionIterator {
+	return &ResourceVersionIterator{
+		fetch: fetch,
+		page:  &page,
+	}
+}
+
+// Next advances to the next version, returning false once there are no more
+// versions or fetching a page failed.
+func (iterator *ResourceVersionIterator) Next(ctx context.Context) bool {
+	for len(iterator.versions) == 0 {
+		if iterator.page == nil || iterator.err != nil {
+			return false
+		}
+
+		versions, pagination, err := iterator.fetch(ctx, *iterator.page)
+		if err != nil {
+			iterator.err = err
+			return false
+		}
+
+		iterator.versions = versions
+		iterator.page = pagination.Next
+	}
+
+	iterator.current = iterator.versions[0]
+	iterator.versions = iterator.versions[1:]
+
+	return true
+}
+
+// ResourceVersion returns the version which Next advanced to.
+func (iterator *ResourceVersionIterator) ResourceVersion() atc.ResourceVersion {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (iterator *ResourceVersionIterator) Err() error {
+	return iterator.err
+}
@@ -191,3 +191,25 @@ func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName s
 		return ctcResponse.CachesRemoved, nil
 	}
 }
+
+func (team *team) ClearTaskResultCache(pipelineName string, jobName string, stepName string) (int64, error) {
+	params := rata.Params{
+		"team_name":     team.name,
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"step_name":     stepName,
+	}
+
+	var ctrcResponse atc.ClearTaskResultCacheResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ClearTaskResultCache,
+		Params:      params,
+	}, &internal.Response{
+		Result: &ctrcResponse,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return ctrcResponse.CachesRemoved, nil
+}
@@ -624,4 +624,21 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("Clear Job Task Result Cache", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/tasks/mystep/result-cache"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ClearTaskResultCacheResponse{CachesRemoved: 3}),
+				),
+			)
+		})
+
+		It("returns the number of cached results removed", func() {
+			numDeleted, err := team.ClearTaskResultCache("mypipeline", "myjob", "mystep")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(numDeleted).To(Equal(int64(3)))
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
 })
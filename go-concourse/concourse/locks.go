@@ -0,0 +1,53 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListLockPools() ([]atc.LockPool, error) {
+	var pools []atc.LockPool
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListLockPools,
+		Params:      params,
+	}, &internal.Response{
+		Result: &pools,
+	})
+
+	return pools, err
+}
+
+func (team *team) ReleaseLockPool(lockName string, buildID int) (bool, error) {
+	params := rata.Params{
+		"team_name": team.name,
+		"lock_name": lockName,
+	}
+
+	queryParams := url.Values{}
+	if buildID != 0 {
+		queryParams.Add("build_id", strconv.Itoa(buildID))
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ReleaseLockPool,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{})
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,96 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Locks", func() {
+	Describe("ListLockPools", func() {
+		var expectedPools []atc.LockPool
+
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/some-team/locks"
+
+			expectedPools = []atc.LockPool{
+				{
+					Name:     "some-lock",
+					TeamName: "some-team",
+					Size:     1,
+					Holders: []atc.LockPoolHolder{
+						{BuildID: 42, BuildName: "7", JobName: "some-job", PipelineName: "some-pipeline", TeamName: "some-team", AcquiredAt: 1234},
+					},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPools),
+				),
+			)
+		})
+
+		It("returns all the lock pools", func() {
+			pools, err := team.ListLockPools()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pools).To(Equal(expectedPools))
+		})
+	})
+
+	Describe("ReleaseLockPool", func() {
+		var (
+			expectedQuery string
+			status        int
+		)
+
+		BeforeEach(func() {
+			expectedQuery = "build_id=42"
+			status = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/locks/some-lock/release", expectedQuery),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		It("releases the lock held by the build", func() {
+			found, err := team.ReleaseLockPool("some-lock", 42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when no build is given", func() {
+			BeforeEach(func() {
+				expectedQuery = ""
+			})
+
+			It("releases every holder", func() {
+				found, err := team.ReleaseLockPool("some-lock", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the lock does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := team.ReleaseLockPool("some-lock", 42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
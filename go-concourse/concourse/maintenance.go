@@ -0,0 +1,42 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+func (client *client) Maintenance() (atc.Maintenance, error) {
+	var maintenance atc.Maintenance
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetMaintenance,
+	}, &internal.Response{
+		Result: &maintenance,
+	})
+
+	return maintenance, err
+}
+
+func (client *client) EnableMaintenance(message string) error {
+	payload, err := json.Marshal(atc.Maintenance{Message: message})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.EnableMaintenance,
+		Body:        bytes.NewBuffer(payload),
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) DisableMaintenance() error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.DisableMaintenance,
+	}, nil)
+}
@@ -0,0 +1,89 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Maintenance Handler", func() {
+	Describe("Maintenance", func() {
+		expectedMaintenance := atc.Maintenance{
+			Enabled:       true,
+			Message:       "upgrading postgres",
+			StartTime:     1597665600,
+			RunningBuilds: 1,
+		}
+
+		Context("when the status is returned", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMaintenance),
+					),
+				)
+			})
+
+			It("returns the status", func() {
+				maintenance, err := client.Maintenance()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(maintenance).To(Equal(expectedMaintenance))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.Maintenance()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("EnableMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/maintenance"),
+					ghttp.VerifyJSONRepresenting(atc.Maintenance{Message: "upgrading postgres"}),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("enables maintenance with the message", func() {
+			err := client.EnableMaintenance("upgrading postgres")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DisableMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("disables maintenance", func() {
+			err := client.DisableMaintenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -35,6 +38,66 @@ func (team *team) Pipeline(pipelineName string) (atc.Pipeline, bool, error) {
 	}
 }
 
+func (team *team) PipelineGraph(pipelineName string, group string) (atc.PipelineGraph, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	if group != "" {
+		queryParams.Add("group", group)
+	}
+
+	var graph atc.PipelineGraph
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineGraph,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &graph,
+	})
+
+	switch err.(type) {
+	case nil:
+		return graph, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineGraph{}, false, nil
+	default:
+		return atc.PipelineGraph{}, false, err
+	}
+}
+
+func (team *team) PipelineStats(pipelineName string, since time.Time) ([]atc.JobStats, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	if !since.IsZero() {
+		queryParams.Add("since", strconv.FormatInt(since.Unix(), 10))
+	}
+
+	var stats []atc.JobStats
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineStats,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &stats,
+	})
+
+	switch err.(type) {
+	case nil:
+		return stats, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
 func (team *team) OrderingPipelines(pipelines []string) error {
 	params := rata.Params{
 		"team_name": team.name,
@@ -3,6 +3,7 @@ package concourse_test
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -304,6 +305,112 @@ var _ = Describe("ATC Handler Pipelines", func() {
 		})
 	})
 
+	Describe("PipelineGraph", func() {
+		var expectedGraph atc.PipelineGraph
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/graph"
+
+		BeforeEach(func() {
+			expectedGraph = atc.PipelineGraph{
+				Nodes: []atc.GraphNode{
+					{ID: "resource:some-resource", Type: atc.GraphNodeResource, Name: "some-resource"},
+					{ID: "job:some-job", Type: atc.GraphNodeJob, Name: "some-job", Groups: []string{"some-group"}},
+				},
+				Edges: []atc.GraphEdge{
+					{From: "resource:some-resource", To: "job:some-job", Type: atc.GraphEdgeGet, Trigger: true},
+				},
+			}
+		})
+
+		Context("when the pipeline is found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "group=some-group"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedGraph),
+					),
+				)
+			})
+
+			It("returns the graph", func() {
+				graph, found, err := team.PipelineGraph("mypipeline", "some-group")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(graph).To(Equal(expectedGraph))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineGraph("mypipeline", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineStats", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/stats"
+
+		Context("when the pipeline is found", func() {
+			var expectedStats []atc.JobStats
+
+			BeforeEach(func() {
+				expectedStats = []atc.JobStats{
+					{
+						JobName:     "some-job",
+						Builds:      2,
+						Succeeded:   1,
+						Failed:      1,
+						SuccessRate: 0.5,
+						DurationP50: 30,
+						DurationP95: 57,
+						Triggers:    atc.JobTriggerStats{Scheduled: 2},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "since=1600000000"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStats),
+					),
+				)
+			})
+
+			It("returns the stats", func() {
+				stats, found, err := team.PipelineStats("mypipeline", time.Unix(1600000000, 0))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(stats).To(Equal(expectedStats))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineStats("mypipeline", time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("team.ListPipelines", func() {
 		var expectedPipelines []atc.Pipeline
 
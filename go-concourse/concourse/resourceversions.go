@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/concourse/concourse/atc"
@@ -13,6 +14,32 @@ import (
 )
 
 func (team *team) ResourceVersions(pipelineName string, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error) {
+	queryParams := page.QueryParams()
+	for k, v := range filter {
+		queryParams.Add("filter", fmt.Sprintf("%s:%s", k, v))
+	}
+
+	return team.listResourceVersions(pipelineName, resourceName, queryParams)
+}
+
+func (team *team) FilteredResourceVersions(pipelineName string, resourceName string, page Page, filter atc.VersionFilter) ([]atc.ResourceVersion, Pagination, bool, error) {
+	queryParams := page.QueryParams()
+	for k, v := range filter.Metadata {
+		queryParams.Add("metadata", fmt.Sprintf("%s:%s", k, v))
+	}
+
+	for k, v := range filter.Version {
+		queryParams.Add("version", fmt.Sprintf("%s:%s", k, v))
+	}
+
+	if filter.Semver != nil {
+		queryParams.Add("semver", fmt.Sprintf("%s:%s", filter.Semver.Field, filter.Semver.Range))
+	}
+
+	return team.listResourceVersions(pipelineName, resourceName, queryParams)
+}
+
+func (team *team) listResourceVersions(pipelineName string, resourceName string, queryParams url.Values) ([]atc.ResourceVersion, Pagination, bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineName,
 		"resource_name": resourceName,
@@ -22,11 +49,6 @@ func (team *team) ResourceVersions(pipelineName string, resourceName string, pag
 	var resourceVersions []atc.ResourceVersion
 	headers := http.Header{}
 
-	queryParams := page.QueryParams()
-	for k, v := range filter {
-		queryParams.Add("filter", fmt.Sprintf("%s:%s", k, v))
-	}
-
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListResourceVersions,
 		Params:      params,
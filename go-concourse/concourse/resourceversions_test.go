@@ -250,6 +250,69 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		})
 	})
 
+	Describe("FilteredResourceVersions", func() {
+		expectedURL := fmt.Sprint("/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/versions")
+
+		var expectedVersions []atc.ResourceVersion
+
+		var filter atc.VersionFilter
+
+		var versions []atc.ResourceVersion
+		var found bool
+		var clientErr error
+
+		BeforeEach(func() {
+			filter = atc.VersionFilter{
+				Metadata: map[string]string{"branch": "master"},
+				Version:  map[string]string{"ref": "^abc"},
+				Semver:   &atc.SemverFilter{Field: "tag", Range: ">=1.2 <2"},
+			}
+
+			expectedVersions = []atc.ResourceVersion{
+				{
+					Version: atc.Version{"ref": "abc123", "tag": "1.2.3"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			versions, _, found, clientErr = team.FilteredResourceVersions("mypipeline", "myresource", concourse.Page{Limit: 2}, filter)
+		})
+
+		Context("when the server returns versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=2&metadata=branch:master&version=ref:%5Eabc&semver=tag:%3E%3D1.2+%3C2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedVersions),
+					),
+				)
+			})
+
+			It("sends the filter as url params", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expectedVersions))
+			})
+		})
+
+		Context("when the server returns not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("DisableResourceVersion", func() {
 		var (
 			expectedStatus    int
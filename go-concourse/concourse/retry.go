@@ -0,0 +1,84 @@
+package concourse
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how ClientV2 retries requests which fail because the
+// connection failed or the server responded with a 5xx status code.
+//
+// Only idempotent requests (GET, PUT, DELETE) are retried; anything else
+// could be applied twice.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one. Zero or one disables retrying.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. It doubles with
+	// each following retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy makes up to five attempts over roughly three seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// NoRetries makes a single attempt for each request.
+var NoRetries = RetryPolicy{
+	MaxAttempts: 1,
+}
+
+func (policy RetryPolicy) attempts() int {
+	if policy.MaxAttempts < 1 {
+		return 1
+	}
+
+	return policy.MaxAttempts
+}
+
+// backoff returns the delay before the given retry, counting from 1.
+func (policy RetryPolicy) backoff(retry int) time.Duration {
+	delay := policy.InitialBackoff
+	for i := 1; i < retry; i++ {
+		delay *= 2
+
+		if policy.MaxBackoff > 0 && delay >= policy.MaxBackoff {
+			return policy.MaxBackoff
+		}
+	}
+
+	if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+		return policy.MaxBackoff
+	}
+
+	return delay
+}
+
+// wait sleeps before the given retry, returning early with the context's
+// error if it is cancelled in the meantime.
+func (policy RetryPolicy) wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(policy.backoff(retry))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
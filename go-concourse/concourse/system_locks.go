@@ -0,0 +1,50 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListSystemLocks() ([]atc.SystemLock, error) {
+	var locks []atc.SystemLock
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListSystemLocks,
+	}, &internal.Response{
+		Result: &locks,
+	})
+
+	return locks, err
+}
+
+func (client *client) ReleaseSystemLock(sessionID int, id []int) (bool, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(id)
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal lock ID: %s", err)
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.ReleaseSystemLock,
+		Params:      rata.Params{"session_id": strconv.Itoa(sessionID)},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,93 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC System Locks Handler", func() {
+	Describe("ListSystemLocks", func() {
+		expectedLocks := []atc.SystemLock{
+			{
+				Type:         "JobScheduling",
+				ID:           []int{8, 42},
+				Object:       "job some-pipeline/some-job",
+				ATC:          "some-atc",
+				SessionID:    1234,
+				HoldDuration: 2.5,
+			},
+			{
+				Type:      "ResourceScanning",
+				ID:        []int{7},
+				ATC:       "other-atc",
+				SessionID: 5678,
+			},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/locks"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedLocks),
+				),
+			)
+		})
+
+		It("returns the locks", func() {
+			locks, err := client.ListSystemLocks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(Equal(expectedLocks))
+		})
+	})
+
+	Describe("ReleaseSystemLock", func() {
+		var status int
+
+		BeforeEach(func() {
+			status = http.StatusAccepted
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/locks/sessions/1234/release"),
+					ghttp.VerifyJSON("[8,42]"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		It("requests the release of the lock", func() {
+			found, err := client.ReleaseSystemLock(1234, []int{8, 42})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when the session does not hold the lock", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := client.ReleaseSystemLock(1234, []int{8, 42})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				status = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				_, err := client.ReleaseSystemLock(1234, []int{8, 42})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -17,6 +18,7 @@ type Team interface {
 	CreateOrUpdate(team atc.Team) (atc.Team, bool, bool, error)
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
+	Apply(manifest atc.TeamManifest, dryRun bool, prune bool) (atc.TeamApplyPlan, error)
 
 	Pipeline(name string) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
@@ -29,6 +31,8 @@ type Team interface {
 	RenamePipeline(pipelineName, name string) (bool, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineName string) (atc.Config, string, bool, error)
+	PipelineGraph(pipelineName string, group string) (atc.PipelineGraph, bool, error)
+	PipelineStats(pipelineName string, since time.Time) ([]atc.JobStats, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
 
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
@@ -40,6 +44,7 @@ type Team interface {
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
 	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error)
+	PartialRerunJobBuild(pipelineName string, jobName string, buildName string, fromStep string) (atc.Build, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 	ScheduleJob(pipelineName string, jobName string) (bool, error)
 
@@ -47,11 +52,13 @@ type Team interface {
 	UnpauseJob(pipelineName string, jobName string) (bool, error)
 
 	ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error)
+	ClearTaskResultCache(pipelineName string, jobName string, stepName string) (int64, error)
 
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	FilteredResourceVersions(pipelineName string, resourceName string, page Page, filter atc.VersionFilter) ([]atc.ResourceVersion, Pagination, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (atc.Check, bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (atc.Check, bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
@@ -67,6 +74,10 @@ type Team interface {
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	GetContainer(id string) (atc.Container, error)
 	ListVolumes() ([]atc.Volume, error)
+	ListLockPools() ([]atc.LockPool, error)
+	ReleaseLockPool(lockName string, buildID int) (bool, error)
+	ListHijackSessions() ([]atc.HijackSession, error)
+	HijackSessionRecording(sessionID int) (io.ReadCloser, bool, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
 	OrderingPipelines(pipelineNames []string) error
@@ -86,8 +86,26 @@ type RegisterOptions struct {
 	// The function must be careful not to take too long or become deadlocked, or
 	// else the SSH connection can starve.
 	HeartbeatedFunc func()
+
+	// VolumeStoreUsageFunc, if configured, is called on an interval to measure
+	// the worker's volume store. Each measurement is sent to the SSH gateway,
+	// which reports the latest one to the ATC on every heartbeat.
+	VolumeStoreUsageFunc func() (VolumeStoreUsage, error)
+
+	// The interval on which to call VolumeStoreUsageFunc. Defaults to 30
+	// seconds.
+	VolumeStoreUsageInterval time.Duration
 }
 
+// VolumeStoreUsage is the size and usage, in bytes, of the filesystem holding
+// a worker's volumes.
+type VolumeStoreUsage struct {
+	Capacity int64 `json:"capacity"`
+	Used     int64 `json:"used"`
+}
+
+const defaultVolumeStoreUsageInterval = 30 * time.Second
+
 // Register invokes the 'forward-worker' command, proxying traffic through the
 // tunnel and to the configured Garden/Baggageclaim addresses. It will also
 // continuously keep the connection alive. The SSH gateway will continuously
@@ -157,10 +175,16 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 		}
 	}()
 
-	err = client.run(
+	var streamUsage func(context.Context, io.Writer)
+	if opts.VolumeStoreUsageFunc != nil {
+		streamUsage = opts.streamVolumeStoreUsage
+	}
+
+	err = client.runStreaming(
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		streamUsage,
 		eventsW,
 	)
 	if err != nil {
@@ -176,6 +200,41 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 	return nil
 }
 
+// streamVolumeStoreUsage writes a measurement of the volume store to the
+// 'forward-worker' command's stdin on every interval until the context is
+// done.
+func (opts RegisterOptions) streamVolumeStoreUsage(ctx context.Context, stdin io.Writer) {
+	logger := lagerctx.WithSession(ctx, "stream-volume-store-usage")
+
+	interval := opts.VolumeStoreUsageInterval
+	if interval == 0 {
+		interval = defaultVolumeStoreUsageInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	encoder := json.NewEncoder(stdin)
+	for {
+		usage, err := opts.VolumeStoreUsageFunc()
+		if err != nil {
+			logger.Error("failed-to-measure-volume-store", err)
+		} else {
+			err = encoder.Encode(usage)
+			if err != nil {
+				logger.Error("failed-to-send-volume-store-usage", err)
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Land invokes the 'land-worker' command, which will initiate the landing
 // process for the worker. The worker will transition to 'landing' and finally
 // to 'landed' when it is fully drained, causing any existing registrations to
@@ -409,6 +468,14 @@ func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey s
 
 
 func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+	return client.runStreaming(ctx, sshClient, command, nil, stdout)
+}
+
+// runStreaming runs the command with the worker's JSON payload on stdin. If
+// stream is given, it is then called to keep writing to stdin, which is
+// closed once it returns. The context given to stream is done once the
+// command exits.
+func (client *Client) runStreaming(ctx context.Context, sshClient *ssh.Client, command string, stream func(context.Context, io.Writer), stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -432,7 +499,17 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	var stdin io.WriteCloser
+	if stream == nil {
+		sess.Stdin = bytes.NewBuffer(workerPayload)
+	} else {
+		stdin, err = sess.StdinPipe()
+		if err != nil {
+			logger.Error("failed-to-open-stdin", err)
+			return err
+		}
+	}
+
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -442,6 +519,23 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
+	if stream != nil {
+		streamCtx, stopStreaming := context.WithCancel(ctx)
+		defer stopStreaming()
+
+		go func() {
+			defer stdin.Close()
+
+			_, err := stdin.Write(workerPayload)
+			if err != nil {
+				logger.Error("failed-to-write-payload", err)
+				return
+			}
+
+			stream(streamCtx, stdin)
+		}()
+	}
+
 	errs := make(chan error, 1)
 	go func() {
 		errs <- sess.Wait()
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	volumeStoreUsage     *VolumeStoreUsage
+	volumeStoreUsageLock sync.Mutex
 }
 
 func NewHeartbeater(
@@ -65,6 +69,14 @@ func NewHeartbeater(
 	}
 }
 
+// SetVolumeStoreUsage records the latest measurement of the worker's volume
+// store, to be included in subsequent registrations and heartbeats.
+func (heartbeater *Heartbeater) SetVolumeStoreUsage(usage VolumeStoreUsage) {
+	heartbeater.volumeStoreUsageLock.Lock()
+	heartbeater.volumeStoreUsage = &usage
+	heartbeater.volumeStoreUsageLock.Unlock()
+}
+
 func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx)
 
@@ -282,6 +294,13 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.volumeStoreUsageLock.Lock()
+	if heartbeater.volumeStoreUsage != nil {
+		registration.VolumeStoreCapacity = heartbeater.volumeStoreUsage.Capacity
+		registration.VolumeStoreUsed = heartbeater.volumeStoreUsage.Used
+	}
+	heartbeater.volumeStoreUsageLock.Unlock()
+
 	return registration, true
 }
 
@@ -57,7 +57,8 @@ var _ = Describe("Heartbeater", func() {
 		heartbeats    <-chan registration
 		clientWriter  *gbytes.Buffer
 
-		worker atc.Worker
+		worker           atc.Worker
+		volumeStoreUsage *VolumeStoreUsage
 	)
 
 	BeforeEach(func() {
@@ -83,6 +84,7 @@ var _ = Describe("Heartbeater", func() {
 		}
 
 		expectedWorker = worker
+		volumeStoreUsage = nil
 
 		fakeATC1 = ghttp.NewServer()
 		fakeATC2 = ghttp.NewServer()
@@ -164,6 +166,10 @@ var _ = Describe("Heartbeater", func() {
 			NewEventWriter(clientWriter),
 		)
 
+		if volumeStoreUsage != nil {
+			heartbeater.SetVolumeStoreUsage(*volumeStoreUsage)
+		}
+
 		errs := make(chan error, 1)
 		heartbeatErr = errs
 		go func() {
@@ -261,6 +267,23 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
 				})
 
+				Context("when the worker has reported its volume store usage", func() {
+					BeforeEach(func() {
+						volumeStoreUsage = &VolumeStoreUsage{
+							Capacity: 1000,
+							Used:     250,
+						}
+					})
+
+					It("includes it in the registration", func() {
+						expectedWorker.ActiveContainers = 2
+						expectedWorker.ActiveVolumes = 3
+						expectedWorker.VolumeStoreCapacity = 1000
+						expectedWorker.VolumeStoreUsed = 250
+						Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+					})
+				})
+
 				It("emits events", func() {
 					Eventually(registrations).Should(Receive())
 
@@ -34,7 +34,8 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 	logger := lagerctx.FromContext(ctx)
 
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	decoder := json.NewDecoder(channel)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -94,6 +95,20 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		tsa.NewEventWriter(channel),
 	)
 
+	// the worker may follow its registration with measurements of its volume
+	// store
+	go func() {
+		for {
+			var usage tsa.VolumeStoreUsage
+			err := decoder.Decode(&usage)
+			if err != nil {
+				return
+			}
+
+			heartbeater.SetVolumeStoreUsage(usage)
+		}
+	}()
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
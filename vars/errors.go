@@ -48,3 +48,42 @@ type InvalidInterpolationError struct {
 func (err InvalidInterpolationError) Error() string {
 	return fmt.Sprintf("cannot interpolate non-primitive value (%T) from var: %s", err.Value, err.Path)
 }
+
+type MissingIndexError struct {
+	Path  string
+	Index int
+}
+
+func (err MissingIndexError) Error() string {
+	return fmt.Sprintf("missing index '%d' in var: %s", err.Index, err.Path)
+}
+
+type InvalidIndexError struct {
+	Path  string
+	Index int
+	Value interface{}
+}
+
+func (err InvalidIndexError) Error() string {
+	return fmt.Sprintf("cannot access index '%d' of non-list value ('%T') from var: %s", err.Index, err.Value, err.Path)
+}
+
+type InvalidFilterError struct {
+	Path   string
+	Filter string
+	Value  interface{}
+}
+
+func (err InvalidFilterError) Error() string {
+	return fmt.Sprintf("cannot apply filter '%s' to value ('%T') from var: %s", err.Filter, err.Value, err.Path)
+}
+
+type InterpolationSyntaxError struct {
+	Expression string
+	Position   int
+	Message    string
+}
+
+func (err InterpolationSyntaxError) Error() string {
+	return fmt.Sprintf("invalid var expression '%s' at position %d: %s", err.Expression, err.Position, err.Message)
+}
@@ -0,0 +1,400 @@
+package vars
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// varExpression is a parsed ((...)) interpolation, consisting of a var
+// reference followed by operations applied to its value from left to right.
+//
+//	((name))
+//	((source:name.field[0].other ?: "default" | upper | join ","))
+type varExpression struct {
+	// Raw is the full text of the expression, including the parentheses.
+	Raw string
+
+	// Name is the referenced var, including its source and any '.field'
+	// path.
+	Name string
+
+	Ops []varOp
+}
+
+type varOpKind int
+
+const (
+	varOpIndex varOpKind = iota
+	varOpField
+	varOpDefault
+	varOpFilter
+)
+
+type varOp struct {
+	Kind varOpKind
+
+	Index   int
+	Field   string
+	Default interface{}
+	Filter  string
+	Arg     string
+}
+
+// HasDefault returns true if the expression provides a fallback for when the
+// var is not found.
+func (expr varExpression) HasDefault() bool {
+	for _, op := range expr.Ops {
+		if op.Kind == varOpDefault {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	// varExpressionStartRegex matches the beginning of an expression. It is
+	// only used to quickly rule out text which can't be an expression; a
+	// match is only treated as one if the rest of it parses too.
+	varExpressionStartRegex = regexp.MustCompile(`\A\(\(!?((?:[-/\.\w\pL]+:)?[-/\.\w\pL]+)(?:\)\)|\[|\s*(?:\?:|\|))`)
+
+	varFieldRegex  = regexp.MustCompile(`\A\.([-\w\pL]+)`)
+	varFilterRegex = regexp.MustCompile(`\A[a-z0-9_]+`)
+	varIntRegex    = regexp.MustCompile(`\A-?[0-9]+`)
+)
+
+// findVarExpression finds the first expression in the string at or after
+// the offset, returning its position. Anything starting with '((' which does
+// not parse as a whole is not treated as an expression, so that e.g. shell
+// arithmetic like '$((i+1))' or '$((arr[i]))' is left alone.
+func findVarExpression(str string, offset int) (varExpression, int, bool) {
+	for {
+		idx := strings.Index(str[offset:], "((")
+		if idx == -1 {
+			return varExpression{}, 0, false
+		}
+
+		start := offset + idx
+
+		if varExpressionStartRegex.MatchString(str[start:]) {
+			expr, err := parseVarExpression(str[start:])
+			if err == nil {
+				return expr, start, true
+			}
+		}
+
+		offset = start + 1
+	}
+}
+
+func parseVarExpression(str string) (varExpression, error) {
+	p := &varExpressionParser{str: str}
+	return p.parse()
+}
+
+type varExpressionParser struct {
+	str string
+	pos int
+}
+
+func (p *varExpressionParser) parse() (varExpression, error) {
+	match := varExpressionStartRegex.FindStringSubmatchIndex(p.str)
+
+	expr := varExpression{
+		Name: p.str[match[2]:match[3]],
+	}
+
+	p.pos = match[3]
+
+	for {
+		p.skipSpace()
+
+		switch {
+		case p.consume("))"):
+			expr.Raw = p.str[:p.pos]
+			return expr, nil
+
+		case p.consume("["):
+			op, err := p.parseIndex()
+			if err != nil {
+				return varExpression{}, err
+			}
+
+			expr.Ops = append(expr.Ops, op)
+
+			for {
+				field := varFieldRegex.FindStringSubmatch(p.str[p.pos:])
+				if field == nil {
+					break
+				}
+
+				expr.Ops = append(expr.Ops, varOp{Kind: varOpField, Field: field[1]})
+				p.pos += len(field[0])
+			}
+
+		case p.consume("?:"):
+			p.skipSpace()
+
+			value, err := p.parseLiteral()
+			if err != nil {
+				return varExpression{}, err
+			}
+
+			expr.Ops = append(expr.Ops, varOp{Kind: varOpDefault, Default: value})
+
+		case p.consume("|"):
+			op, err := p.parseFilter()
+			if err != nil {
+				return varExpression{}, err
+			}
+
+			expr.Ops = append(expr.Ops, op)
+
+		case p.pos >= len(p.str):
+			return varExpression{}, p.errorf("missing closing '))'")
+
+		default:
+			r, _ := utf8.DecodeRuneInString(p.str[p.pos:])
+			return varExpression{}, p.errorf("unexpected '%c'", r)
+		}
+	}
+}
+
+func (p *varExpressionParser) parseIndex() (varOp, error) {
+	p.skipSpace()
+
+	digits := varIntRegex.FindString(p.str[p.pos:])
+	if digits == "" || strings.HasPrefix(digits, "-") {
+		return varOp{}, p.errorf("expected a non-negative index")
+	}
+
+	index, err := strconv.Atoi(digits)
+	if err != nil {
+		return varOp{}, p.errorf("invalid index: %s", err)
+	}
+
+	p.pos += len(digits)
+	p.skipSpace()
+
+	if !p.consume("]") {
+		return varOp{}, p.errorf("expected ']'")
+	}
+
+	return varOp{Kind: varOpIndex, Index: index}, nil
+}
+
+func (p *varExpressionParser) parseFilter() (varOp, error) {
+	p.skipSpace()
+
+	name := varFilterRegex.FindString(p.str[p.pos:])
+	if name == "" {
+		return varOp{}, p.errorf("expected a filter name")
+	}
+
+	filter, found := varFilters[name]
+	if !found {
+		return varOp{}, p.errorf("unknown filter '%s'", name)
+	}
+
+	p.pos += len(name)
+
+	op := varOp{Kind: varOpFilter, Filter: name}
+
+	if filter.takesArg {
+		p.skipSpace()
+
+		if !strings.HasPrefix(p.str[p.pos:], `"`) {
+			return varOp{}, p.errorf("filter '%s' expects a quoted string argument", name)
+		}
+
+		arg, err := p.parseString()
+		if err != nil {
+			return varOp{}, err
+		}
+
+		op.Arg = arg
+	}
+
+	return op, nil
+}
+
+// parseLiteral parses a default value: a quoted string, an integer, or a
+// boolean.
+func (p *varExpressionParser) parseLiteral() (interface{}, error) {
+	rest := p.str[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, `"`):
+		return p.parseString()
+
+	case strings.HasPrefix(rest, "true"):
+		p.pos += len("true")
+		return true, nil
+
+	case strings.HasPrefix(rest, "false"):
+		p.pos += len("false")
+		return false, nil
+	}
+
+	digits := varIntRegex.FindString(rest)
+	if digits == "" {
+		return nil, p.errorf("expected a default value (a quoted string, integer, or boolean)")
+	}
+
+	value, err := strconv.Atoi(digits)
+	if err != nil {
+		return nil, p.errorf("invalid integer: %s", err)
+	}
+
+	p.pos += len(digits)
+
+	return value, nil
+}
+
+func (p *varExpressionParser) parseString() (string, error) {
+	start := p.pos
+
+	for i := p.pos + 1; i < len(p.str); i++ {
+		switch p.str[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(p.str[start : i+1])
+			if err != nil {
+				return "", p.errorf("invalid string: %s", err)
+			}
+
+			p.pos = i + 1
+			return value, nil
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *varExpressionParser) skipSpace() {
+	for p.pos < len(p.str) && (p.str[p.pos] == ' ' || p.str[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *varExpressionParser) consume(token string) bool {
+	if strings.HasPrefix(p.str[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+
+	return false
+}
+
+func (p *varExpressionParser) errorf(format string, args ...interface{}) error {
+	// show the expression up to its closing parentheses, if there are any
+	expression := p.str
+	if end := strings.Index(p.str[p.pos:], "))"); end != -1 {
+		expression = p.str[:p.pos+end+2]
+	}
+
+	return InterpolationSyntaxError{
+		Expression: expression,
+		Position:   utf8.RuneCountInString(p.str[:p.pos]) + 1,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+type varFilter struct {
+	takesArg bool
+	apply    func(value interface{}, arg string) (interface{}, bool)
+}
+
+var varFilters = map[string]varFilter{
+	"base64": {apply: stringFilter(func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	})},
+	"json": {apply: func(value interface{}, _ string) (interface{}, bool) {
+		payload, err := json.Marshal(jsonCompatible(value))
+		if err != nil {
+			return nil, false
+		}
+
+		return string(payload), true
+	}},
+	"upper": {apply: stringFilter(strings.ToUpper)},
+	"lower": {apply: stringFilter(strings.ToLower)},
+	"trim":  {apply: stringFilter(strings.TrimSpace)},
+	"join": {takesArg: true, apply: func(value interface{}, sep string) (interface{}, bool) {
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		strs := make([]string, len(list))
+		for i, elem := range list {
+			str, ok := scalarString(elem)
+			if !ok {
+				return nil, false
+			}
+
+			strs[i] = str
+		}
+
+		return strings.Join(strs, sep), true
+	}},
+}
+
+func stringFilter(f func(string) string) func(interface{}, string) (interface{}, bool) {
+	return func(value interface{}, _ string) (interface{}, bool) {
+		str, ok := scalarString(value)
+		if !ok {
+			return nil, false
+		}
+
+		return f(str), true
+	}
+}
+
+func scalarString(value interface{}) (string, bool) {
+	switch value.(type) {
+	case string, bool, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
+
+// jsonCompatible converts maps with interface{} keys, as parsed from YAML,
+// into maps with string keys so that they can be marshaled as JSON.
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			converted[fmt.Sprintf("%v", key)] = jsonCompatible(val)
+		}
+
+		return converted
+
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			converted[key] = jsonCompatible(val)
+		}
+
+		return converted
+
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, val := range v {
+			converted[i] = jsonCompatible(val)
+		}
+
+		return converted
+
+	default:
+		return value
+	}
+}
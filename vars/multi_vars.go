@@ -35,3 +35,11 @@ func (m MultiVars) List() ([]VariableDefinition, error) {
 
 	return allDefs, nil
 }
+
+func (m MultiVars) TrackDerived(name string, value interface{}) {
+	for _, vars := range m.varss {
+		if tracker, ok := vars.(DerivedVarsTracker); ok {
+			tracker.TrackDerived(name, value)
+		}
+	}
+}
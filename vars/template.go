@@ -1,9 +1,9 @@
 package vars
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -61,11 +61,6 @@ func (t Template) interpolateRoot(obj interface{}, tracker varsTracker) (interfa
 
 type interpolator struct{}
 
-var (
-	interpolationRegex         = regexp.MustCompile(`\(\((!?([-/\.\w\pL]+\:)?[-/\.\w\pL]+)\)\)`)
-	interpolationAnchoredRegex = regexp.MustCompile("\\A" + interpolationRegex.String() + "\\z")
-)
-
 func (i interpolator) Interpolate(node interface{}, varsLookup varsLookup) (interface{}, error) {
 	switch typedNode := node.(type) {
 	case map[interface{}]interface{}:
@@ -94,43 +89,75 @@ func (i interpolator) Interpolate(node interface{}, varsLookup varsLookup) (inte
 		}
 
 	case string:
-		for _, name := range i.extractVarNames(typedNode) {
-			foundVal, found, err := varsLookup.Get(name)
+		return i.interpolateString(typedNode, varsLookup)
+	}
+
+	return node, nil
+}
+
+func (i interpolator) interpolateString(str string, varsLookup varsLookup) (interface{}, error) {
+	var result strings.Builder
+
+	offset := 0
+	for {
+		expr, start, found := findVarExpression(str, offset)
+		if !found {
+			break
+		}
+
+		result.WriteString(str[offset:start])
+		offset = start + len(expr.Raw)
+
+		foundVal, found, err := varsLookup.Evaluate(expr)
+		if err != nil {
+			return nil, fmt.Errorf("var lookup '%s': %w", expr.Name, err)
+		}
+
+		if !found {
+			result.WriteString(expr.Raw)
+			continue
+		}
+
+		// ensure that value type is preserved when replacing the entire field
+		if start == 0 && offset == len(str) {
+			return foundVal, nil
+		}
+
+		switch foundVal.(type) {
+		case string, int, int16, int32, int64, uint, uint16, uint32, uint64:
+			result.WriteString(fmt.Sprintf("%v", foundVal))
+		case []interface{}, map[interface{}]interface{}, map[string]interface{}:
+			payload, err := json.Marshal(jsonCompatible(foundVal))
 			if err != nil {
-				return nil, fmt.Errorf("var lookup '%s': %w", name, err)
+				return nil, fmt.Errorf("var lookup '%s': %w", expr.Name, err)
 			}
 
-			if found {
-				// ensure that value type is preserved when replacing the entire field
-				if interpolationAnchoredRegex.MatchString(typedNode) {
-					return foundVal, nil
-				}
-
-				switch foundVal.(type) {
-				case string, int, int16, int32, int64, uint, uint16, uint32, uint64:
-					foundValStr := fmt.Sprintf("%v", foundVal)
-					typedNode = strings.Replace(typedNode, fmt.Sprintf("((%s))", name), foundValStr, -1)
-					typedNode = strings.Replace(typedNode, fmt.Sprintf("((!%s))", name), foundValStr, -1)
-				default:
-					return nil, InvalidInterpolationError{
-						Path:  name,
-						Value: foundVal,
-					}
-				}
+			result.Write(payload)
+		default:
+			return nil, InvalidInterpolationError{
+				Path:  expr.Name,
+				Value: foundVal,
 			}
 		}
-
-		return typedNode, nil
 	}
 
-	return node, nil
+	result.WriteString(str[offset:])
+
+	return result.String(), nil
 }
 
 func (i interpolator) extractVarNames(value string) []string {
 	var names []string
 
-	for _, match := range interpolationRegex.FindAllSubmatch([]byte(value), -1) {
-		names = append(names, strings.TrimPrefix(string(match[1]), "!"))
+	offset := 0
+	for {
+		expr, start, found := findVarExpression(value, offset)
+		if !found {
+			break
+		}
+
+		names = append(names, expr.Name)
+		offset = start + len(expr.Raw)
 	}
 
 	return names
@@ -146,58 +173,160 @@ var ErrEmptyVar = errors.New("empty var")
 // is var name; 2) 'foo:bar', where foo is var source name, and bar is var name;
 // 3) '.:foo', where . means a local var, foo is var name.
 func (l varsLookup) Get(name string) (interface{}, bool, error) {
-	var splitName []string
-	if strings.Index(name, ":") > 0 {
-		parts := strings.Split(name, ":")
-		splitName = strings.Split(parts[1], ".")
-		splitName[0] = fmt.Sprintf("%s:%s", parts[0], splitName[0])
-	} else {
-		splitName = strings.Split(name, ".")
+	return l.get(name, false)
+}
+
+// Evaluate looks up the var referenced by the expression and applies the
+// expression's operations to its value. Vars which are not found are not
+// reported as missing if the expression provides a default.
+func (l varsLookup) Evaluate(expr varExpression) (interface{}, bool, error) {
+	optional := expr.HasDefault()
+
+	val, found, err := l.get(expr.Name, optional)
+	if err != nil {
+		if !optional || !errors.As(err, &MissingFieldError{}) {
+			return nil, false, err
+		}
+
+		found = false
 	}
 
-	// this should be impossible since interpolationRegex only matches non-empty
+	derived := false
+	for _, op := range expr.Ops {
+		if !found {
+			if op.Kind == varOpDefault {
+				val, found = op.Default, true
+			}
+
+			continue
+		}
+
+		switch op.Kind {
+		case varOpIndex:
+			list, ok := val.([]interface{})
+			if !ok {
+				return nil, false, InvalidIndexError{
+					Path:  expr.Name,
+					Index: op.Index,
+					Value: val,
+				}
+			}
+
+			if op.Index >= len(list) {
+				if optional {
+					val, found = nil, false
+					continue
+				}
+
+				return nil, false, MissingIndexError{
+					Path:  expr.Name,
+					Index: op.Index,
+				}
+			}
+
+			val = list[op.Index]
+
+		case varOpField:
+			val, err = lookupField(val, op.Field, expr.Name)
+			if err != nil {
+				if optional && errors.As(err, &MissingFieldError{}) {
+					val, found = nil, false
+					continue
+				}
+
+				return nil, false, err
+			}
+
+		case varOpFilter:
+			filtered, ok := varFilters[op.Filter].apply(val, op.Arg)
+			if !ok {
+				return nil, false, InvalidFilterError{
+					Path:   expr.Name,
+					Filter: op.Filter,
+					Value:  val,
+				}
+			}
+
+			val = filtered
+			derived = true
+		}
+	}
+
+	if found && derived {
+		l.varsTracker.TrackDerived(splitVarName(expr.Name)[0], val)
+	}
+
+	return val, found, nil
+}
+
+func (l varsLookup) get(name string, optional bool) (interface{}, bool, error) {
+	splitName := splitVarName(name)
+
+	// this should be impossible since var expressions only match non-empty
 	// vars, but better to error than to panic
 	if len(splitName) == 0 {
 		return nil, false, ErrEmptyVar
 	}
 
-	val, found, err := l.varsTracker.Get(splitName[0])
+	val, found, err := l.varsTracker.get(splitName[0], optional)
 	if !found || err != nil {
 		return val, found, err
 	}
 
 	for _, seg := range splitName[1:] {
-		switch v := val.(type) {
-		case map[interface{}]interface{}:
-			var found bool
-			val, found = v[seg]
-			if !found {
-				return nil, false, MissingFieldError{
-					Path:  name,
-					Field: seg,
-				}
-			}
-		case map[string]interface{}:
-			var found bool
-			val, found = v[seg]
-			if !found {
-				return nil, false, MissingFieldError{
-					Path:  name,
-					Field: seg,
-				}
-			}
-		default:
-			return nil, false, InvalidFieldError{
-				Path:  name,
-				Field: seg,
-				Value: val,
-			}
+		val, err = lookupField(val, seg, name)
+		if err != nil {
+			return nil, false, err
 		}
 	}
 
 	return val, true, err
 }
 
+func splitVarName(name string) []string {
+	var splitName []string
+	if strings.Index(name, ":") > 0 {
+		parts := strings.Split(name, ":")
+		splitName = strings.Split(parts[1], ".")
+		splitName[0] = fmt.Sprintf("%s:%s", parts[0], splitName[0])
+	} else {
+		splitName = strings.Split(name, ".")
+	}
+
+	return splitName
+}
+
+func lookupField(val interface{}, field string, path string) (interface{}, error) {
+	switch v := val.(type) {
+	case map[interface{}]interface{}:
+		fieldVal, found := v[field]
+		if !found {
+			return nil, MissingFieldError{
+				Path:  path,
+				Field: field,
+			}
+		}
+
+		return fieldVal, nil
+	case map[string]interface{}:
+		fieldVal, found := v[field]
+		if !found {
+			return nil, MissingFieldError{
+				Path:  path,
+				Field: field,
+			}
+		}
+
+		return fieldVal, nil
+	default:
+		return nil, InvalidFieldError{
+			Path:  path,
+			Field: field,
+			Value: val,
+		}
+	}
+}
+
 type varsTracker struct {
 	vars Variables
 
@@ -221,16 +350,28 @@ func newVarsTracker(vars Variables, expectAllFound, expectAllUsed bool) varsTrac
 }
 
 func (t varsTracker) Get(name string) (interface{}, bool, error) {
+	return t.get(name, false)
+}
+
+func (t varsTracker) get(name string, optional bool) (interface{}, bool, error) {
 	t.visitedAll[name] = struct{}{}
 
 	val, found, err := t.vars.Get(VariableDefinition{Name: name})
-	if !found {
+	if !found && !optional {
 		t.missing[name] = struct{}{}
 	}
 
 	return val, found, err
 }
 
+// TrackDerived lets the vars know about a value derived from one of their
+// vars, e.g. by applying a filter, if they care.
+func (t varsTracker) TrackDerived(name string, val interface{}) {
+	if tracker, ok := t.vars.(DerivedVarsTracker); ok {
+		tracker.TrackDerived(name, val)
+	}
+}
+
 func (t varsTracker) Error() error {
 	missingErr := t.MissingError()
 	extraErr := t.ExtraError()
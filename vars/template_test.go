@@ -356,4 +356,125 @@ dup-key: ((key3))
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("fake-err"))
 	})
+
+	Describe("expressions", func() {
+		It("uses the default value if the var is not found", func() {
+			template := NewTemplate([]byte(`{a: '((missing ?: "fallback"))', b: '((missing ?: 3))', c: '((missing ?: true))'}`))
+
+			result, err := template.Evaluate(StaticVariables{}, EvaluateOpts{ExpectAllKeys: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(MatchYAML("a: fallback\nb: 3\nc: true\n"))
+		})
+
+		It("does not use the default value if the var is found", func() {
+			template := NewTemplate([]byte(`key: '((key ?: "fallback"))'`))
+
+			result, err := template.Evaluate(StaticVariables{"key": "val"}, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("key: val\n")))
+		})
+
+		It("uses the default value if a field or index is missing", func() {
+			template := NewTemplate([]byte(`{a: '((key.missing ?: "x"))', b: '((list[5] ?: "z"))'}`))
+			vars := StaticVariables{
+				"key":  map[interface{}]interface{}{"field": "e"},
+				"list": []interface{}{"a"},
+			}
+
+			result, err := template.Evaluate(vars, EvaluateOpts{ExpectAllKeys: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(MatchYAML("a: x\nb: z\n"))
+		})
+
+		It("counts vars with defaults as used", func() {
+			template := NewTemplate([]byte(`key: '((key ?: "fallback"))'`))
+
+			_, err := template.Evaluate(StaticVariables{"key": "val"}, EvaluateOpts{ExpectAllVarsUsed: true})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("can access list elements by index", func() {
+			template := NewTemplate([]byte("a: ((list[1]))\nb: ((list[0].name))"))
+			vars := StaticVariables{
+				"list": []interface{}{
+					map[interface{}]interface{}{"name": "first"},
+					"second",
+				},
+			}
+
+			result, err := template.Evaluate(vars, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(MatchYAML("a: second\nb: first\n"))
+		})
+
+		It("returns an error if an index is out of range", func() {
+			template := NewTemplate([]byte("((list[1]))"))
+
+			_, err := template.Evaluate(StaticVariables{"list": []interface{}{"a"}}, EvaluateOpts{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("missing index '1' in var: list"))
+		})
+
+		It("returns an error if indexing a non-list", func() {
+			template := NewTemplate([]byte("((key[0]))"))
+
+			_, err := template.Evaluate(StaticVariables{"key": "val"}, EvaluateOpts{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot access index '0' of non-list value ('string') from var: key"))
+		})
+
+		It("applies filters from left to right", func() {
+			template := NewTemplate([]byte(`{a: '((key | trim | upper))', b: '((key | base64))', c: '((list | join ","))', d: '((map | json))', e: '((missing ?: " x " | trim))'}`))
+			vars := StaticVariables{
+				"key":  " val ",
+				"list": []interface{}{"a", 1, true},
+				"map":  map[interface{}]interface{}{"k": []interface{}{"v"}},
+			}
+
+			result, err := template.Evaluate(vars, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(MatchYAML(`{a: VAL, b: IHZhbCA=, c: "a,1,true", d: '{"k":["v"]}', e: x}`))
+		})
+
+		It("returns an error if a filter does not support the value", func() {
+			template := NewTemplate([]byte("((list | upper))"))
+
+			_, err := template.Evaluate(StaticVariables{"list": []interface{}{"a"}}, EvaluateOpts{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot apply filter 'upper' to value ('[]interface {}') from var: list"))
+		})
+
+		It("embeds lists and maps in the middle of a string as JSON", func() {
+			template := NewTemplate([]byte("args: --list=((list)) --map=((map))"))
+			vars := StaticVariables{
+				"list": []interface{}{"a", 1},
+				"map":  map[interface{}]interface{}{"k": "v"},
+			}
+
+			result, err := template.Evaluate(vars, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte(`args: --list=["a",1] --map={"k":"v"}` + "\n")))
+		})
+
+		It("leaves things that are not var expressions alone", func() {
+			template := NewTemplate([]byte(`script: echo $((i+1)) $(( i | 1 )) $((arr[i])) $((x|4)) $((x ?:))`))
+
+			result, err := template.Evaluate(StaticVariables{"i": "val", "x": "val"}, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("script: echo $((i+1)) $(( i | 1 )) $((arr[i])) $((x|4)) $((x ?:))\n")))
+		})
+
+		It("interpolates expressions following text that is not one", func() {
+			template := NewTemplate([]byte(`script: echo $((arr[i])) ((x | upper))`))
+
+			result, err := template.Evaluate(StaticVariables{"x": "val"}, EvaluateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("script: echo $((arr[i])) VAL\n")))
+		})
+
+		It("reports only var names as extra var names", func() {
+			template := NewTemplate([]byte(`{a: '((src:key.field[0] ?: "x" | upper))', b: ((other))}`))
+			Expect(template.ExtraVarNames()).To(ConsistOf("src:key.field", "other"))
+		})
+	})
 })
@@ -10,3 +10,9 @@ type VariableDefinition struct {
 	Type    string
 	Options interface{}
 }
+
+// DerivedVarsTracker is implemented by Variables which need to know about
+// values derived from their vars, e.g. so that they can be redacted too.
+type DerivedVarsTracker interface {
+	TrackDerived(name string, value interface{})
+}
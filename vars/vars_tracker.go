@@ -99,6 +99,31 @@ func (t *credVarsTracker) track(name string, val interface{}) {
 	}
 }
 
+// TrackDerived tracks a value derived from a var, e.g. its base64 encoding,
+// so that it is redacted as well. Values derived from vars which are not
+// being tracked are ignored.
+func (t *credVarsTracker) TrackDerived(name string, val interface{}) {
+	str, ok := val.(string)
+	if !t.enabled || !ok || str == "" {
+		return
+	}
+
+	parts := strings.Split(name, ":")
+	if len(parts) == 2 && parts[0] == "." {
+		name = parts[1]
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for tracked := range t.interpolatedCreds {
+		if tracked == name || strings.HasPrefix(tracked, name+".") {
+			t.interpolatedCreds[fmt.Sprintf("%s|%s", name, str)] = str
+			return
+		}
+	}
+}
+
 func (t *credVarsTracker) List() ([]VariableDefinition, error) {
 	return t.credVars.List()
 }
@@ -40,6 +40,21 @@ var _ = Describe("vars_tracker", func() {
 			})
 		})
 
+		Describe("TrackDerived", func() {
+			It("tracks values derived from tracked vars", func() {
+				tracker.Get(VariableDefinition{Name: "k1"})
+				tracker.(DerivedVarsTracker).TrackDerived("k1", "djE=")
+				tracker.(DerivedVarsTracker).TrackDerived("k2", "djI=")
+
+				mapit := NewMapCredVarsTrackerIterator()
+				tracker.IterateInterpolatedCreds(mapit)
+				Expect(mapit.Data).To(Equal(map[string]interface{}{
+					"k1":      "v1",
+					"k1|djE=": "djE=",
+				}))
+			})
+		})
+
 		Describe("List", func() {
 			It("returns list of names from multiple vars with duplicates", func() {
 				defs, err := tracker.List()
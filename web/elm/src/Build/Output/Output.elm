@@ -225,6 +225,50 @@ handleEvent event ( model, effects ) =
             in
             ( { model | steps = newSt }, effects )
 
+        Progress _ _ ->
+            ( model, effects )
+
+        Skipped origin condition time ->
+            ( updateStep origin.id (appendStepLog ("skipped: " ++ condition ++ "\n") (Just time)) model
+            , effects
+            )
+
+        TimedOut _ _ ->
+            ( model, effects )
+
+        WaitingForWorker origin reason time ->
+            ( updateStep origin.id (appendStepLog ("waiting for a worker: " ++ reason ++ "\n") (Just time)) model
+            , effects
+            )
+
+        QuotaExceeded origin quota limit usage time ->
+            let
+                message =
+                    "quota exceeded, waiting: team is using "
+                        ++ String.fromInt usage
+                        ++ " of its "
+                        ++ String.fromInt limit
+                        ++ " "
+                        ++ quota
+                        ++ "\n"
+            in
+            ( updateStep origin.id (appendStepLog message (Just time)) model
+            , effects
+            )
+
+        TaskResultCacheHit origin buildName key time ->
+            let
+                message =
+                    "reusing cached result of build #"
+                        ++ buildName
+                        ++ " (key "
+                        ++ String.left 12 key
+                        ++ ")\n"
+            in
+            ( updateStep origin.id (appendStepLog message (Just time)) model
+            , effects
+            )
+
         End ->
             ( { model | state = StepsComplete, eventStreamUrlPath = Nothing }
             , effects
@@ -136,6 +136,12 @@ type BuildEvent
     | FinishPut Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
     | Log Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
+    | Progress Time.Posix Time.Posix
+    | Skipped Origin String Time.Posix
+    | TimedOut String Time.Posix
+    | WaitingForWorker Origin String Time.Posix
+    | QuotaExceeded Origin String Int Int Time.Posix
+    | TaskResultCacheHit Origin String String Time.Posix
     | End
     | Opened
     | NetworkError
@@ -410,6 +410,10 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildStepRetry)
                 , Json.Decode.field "timeout" <|
                     lazy (\_ -> decodeBuildStepTimeout)
+                , Json.Decode.field "acquire" <|
+                    lazy (\_ -> decodeBuildStepLock)
+                , Json.Decode.field "release" <|
+                    lazy (\_ -> decodeBuildStepLock)
                 , Json.Decode.field "set_pipeline" <|
                     lazy (\_ -> decodeBuildSetPipeline)
                 , Json.Decode.field "load_var" <|
@@ -530,6 +534,12 @@ decodeBuildStepTimeout =
         |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
 
 
+decodeBuildStepLock : Json.Decode.Decoder BuildStep
+decodeBuildStepLock =
+    Json.Decode.succeed BuildStepTimeout
+        |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+
+
 decodeBuildSetPipeline : Json.Decode.Decoder BuildStep
 decodeBuildSetPipeline =
     Json.Decode.succeed BuildStepSetPipeline
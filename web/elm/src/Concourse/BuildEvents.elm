@@ -177,6 +177,62 @@ decodeBuildEvent =
                     "finish-put" ->
                         Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+                    "progress" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 Progress
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                                (Json.Decode.field "estimated_finish" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "skipped" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 Skipped
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "condition" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "timed-out" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 TimedOut
+                                (Json.Decode.field "timeout" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "waiting-for-worker" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 WaitingForWorker
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "reason" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "quota-exceeded" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map5 QuotaExceeded
+                                -- the origin is empty when the whole build is waiting
+                                (Json.Decode.field "origin" <| Json.Decode.oneOf [ decodeOrigin, Json.Decode.succeed (Origin "" "") ])
+                                (Json.Decode.field "quota" Json.Decode.string)
+                                (Json.Decode.field "limit" Json.Decode.int)
+                                (Json.Decode.field "usage" Json.Decode.int)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "task-result-cache-hit" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map4 TaskResultCacheHit
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "build_name" Json.Decode.string)
+                                (Json.Decode.field "key" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
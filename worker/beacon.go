@@ -28,6 +28,10 @@ type Beacon struct {
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
 
+	// VolumesDir, if set, is measured and reported so that the ATC can avoid
+	// the worker and evict its caches when it is running out of disk space.
+	VolumesDir string
+
 	drained int32
 }
 
@@ -182,6 +186,13 @@ func (beacon *Beacon) registerWorker(
 
 	once := &sync.Once{}
 
+	var volumeStoreUsageFunc func() (tsa.VolumeStoreUsage, error)
+	if beacon.VolumesDir != "" {
+		volumeStoreUsageFunc = func() (tsa.VolumeStoreUsage, error) {
+			return VolumeStoreUsage(beacon.VolumesDir)
+		}
+	}
+
 	registeredOrFailed := make(chan struct{})
 	go func() {
 		defer cwg.Done()
@@ -203,6 +214,8 @@ func (beacon *Beacon) registerWorker(
 			HeartbeatedFunc: func() {
 				logger.Debug("heartbeated")
 			},
+
+			VolumeStoreUsageFunc: volumeStoreUsageFunc,
 		})
 
 		once.Do(func() { close(registeredOrFailed) })
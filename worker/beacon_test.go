@@ -63,6 +63,29 @@ var _ = Describe("Beacon", func() {
 		Expect(opts.LocalBaggageclaimAddr).To(Equal(beacon.LocalBaggageclaimAddr))
 	})
 
+	It("does not report volume store usage", func() {
+		Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+		_, opts := fakeClient.RegisterArgsForCall(0)
+		Expect(opts.VolumeStoreUsageFunc).To(BeNil())
+	})
+
+	Context("when a volumes directory is configured", func() {
+		BeforeEach(func() {
+			beacon.VolumesDir = os.TempDir()
+		})
+
+		It("reports the usage of its filesystem", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.VolumeStoreUsageFunc).ToNot(BeNil())
+
+			usage, err := opts.VolumeStoreUsageFunc()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.Capacity).To(BeNumerically(">", 0))
+			Expect(usage.Used).To(BeNumerically("<=", usage.Capacity))
+		})
+	})
+
 	Context("during registration", func() {
 		BeforeEach(func() {
 			fakeClient.RegisterStub = func(ctx context.Context, opts tsa.RegisterOptions) error {
@@ -0,0 +1,26 @@
+// +build !windows
+
+package worker
+
+import (
+	"syscall"
+
+	"github.com/concourse/concourse/tsa"
+)
+
+// VolumeStoreUsage measures the filesystem holding the given volumes
+// directory.
+func VolumeStoreUsage(volumesDir string) (tsa.VolumeStoreUsage, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(volumesDir, &stat)
+	if err != nil {
+		return tsa.VolumeStoreUsage{}, err
+	}
+
+	blockSize := int64(stat.Bsize)
+
+	return tsa.VolumeStoreUsage{
+		Capacity: int64(stat.Blocks) * blockSize,
+		Used:     int64(stat.Blocks-stat.Bfree) * blockSize,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"github.com/concourse/concourse/tsa"
+	"golang.org/x/sys/windows"
+)
+
+// VolumeStoreUsage measures the filesystem holding the given volumes
+// directory.
+func VolumeStoreUsage(volumesDir string) (tsa.VolumeStoreUsage, error) {
+	dir, err := windows.UTF16PtrFromString(volumesDir)
+	if err != nil {
+		return tsa.VolumeStoreUsage{}, err
+	}
+
+	var available, total, free uint64
+	err = windows.GetDiskFreeSpaceEx(dir, &available, &total, &free)
+	if err != nil {
+		return tsa.VolumeStoreUsage{}, err
+	}
+
+	return tsa.VolumeStoreUsage{
+		Capacity: int64(total),
+		Used:     int64(total - free),
+	}, nil
+}
@@ -0,0 +1,15 @@
+package workercmd
+
+import "golang.org/x/sys/unix"
+
+// totalMemory returns the machine's physical memory in bytes, or 0 if it
+// cannot be determined.
+func totalMemory() uint64 {
+	var info unix.Sysinfo_t
+	err := unix.Sysinfo(&info)
+	if err != nil {
+		return 0
+	}
+
+	return uint64(info.Totalram) * uint64(info.Unit)
+}
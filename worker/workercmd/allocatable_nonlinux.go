@@ -0,0 +1,9 @@
+// +build !linux
+
+package workercmd
+
+// totalMemory returns 0, as the machine's physical memory is only detected on
+// Linux.
+func totalMemory() uint64 {
+	return 0
+}
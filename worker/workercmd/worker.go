@@ -1,7 +1,9 @@
 package workercmd
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -12,6 +14,7 @@ import (
 	bclient "github.com/concourse/baggageclaim/client"
 	"github.com/concourse/concourse"
 	"github.com/concourse/concourse/atc/worker/gclient"
+	"github.com/concourse/concourse/atc/worker/p2p"
 	concourseCmd "github.com/concourse/concourse/cmd"
 	"github.com/concourse/concourse/worker"
 	"github.com/concourse/flag"
@@ -40,6 +43,11 @@ type WorkerCommand struct {
 	HealthcheckBindPort uint16        `long:"healthcheck-bind-port"  default:"8888"     description:"Port on which to listen for health checking requests."`
 	HealthCheckTimeout  time.Duration `long:"healthcheck-timeout"    default:"5s"       description:"HTTP timeout for the full duration of health checking."`
 
+	P2PURL      flag.URL `long:"p2p-url"       description:"URL at which other workers and the web node can reach this worker's P2P streaming server. Advertised when registering, enabling volumes to be streamed directly to and from this worker. Requires --p2p-secret."`
+	P2PSecret   string   `long:"p2p-secret"    description:"Secret shared with the web nodes, used to verify that P2P streaming requests were issued by them."`
+	P2PBindIP   flag.IP  `long:"p2p-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for P2P volume streaming requests. Must be reachable by other workers for P2P streaming to be used."`
+	P2PBindPort uint16   `long:"p2p-bind-port" default:"7788"      description:"Port on which to listen for P2P volume streaming requests."`
+
 	ContainerNetworkPool string `long:"container-network-pool" description:"Network range to use for dynamically allocated container subnets."`
 
 	SweepInterval               time.Duration `long:"sweep-interval" default:"30s" description:"Interval on which containers and volumes will be garbage collected from the worker."`
@@ -84,6 +92,10 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 	atcWorker.Version = concourse.WorkerVersion
 
+	if cmd.p2pIsEnabled() {
+		atcWorker.P2PURL = cmd.P2PURL.String()
+	}
+
 	baggageclaimRunner, err := cmd.baggageclaimRunner(logger.Session("baggageclaim"))
 	if err != nil {
 		return nil, err
@@ -105,6 +117,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.ConnectionDrainTimeout,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
+		cmd.Baggageclaim.VolumesDir.Path(),
 	)
 
 	gardenClient := gclient.BasicGardenClientWithRequestTimeout(
@@ -206,9 +219,58 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		},
 	}...)
 
+	if cmd.p2pIsEnabled() {
+		p2pRunner, err := cmd.p2pRunner(logger.Session("p2p"))
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, grouper.Member{
+			Name:   "p2p",
+			Runner: concourseCmd.NewLoggingRunner(logger.Session("p2p-runner"), p2pRunner),
+		})
+	}
+
 	return grouper.NewParallel(os.Interrupt, members), nil
 }
 
+func (cmd *WorkerCommand) p2pIsEnabled() bool {
+	return cmd.P2PURL.URL != nil
+}
+
+func (cmd *WorkerCommand) p2pRunner(logger lager.Logger) (ifrit.Runner, error) {
+	if cmd.P2PSecret == "" {
+		return nil, errors.New("--p2p-secret must be set when --p2p-url is configured")
+	}
+
+	handler, err := p2p.NewHandler(
+		logger,
+
+		// streams can take a long time, so don't set an overall timeout
+		bclient.NewWithHTTPClient(cmd.baggageclaimURL(), &http.Client{
+			Transport: &http.Transport{},
+		}),
+
+		&http.Client{
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{
+					Timeout: 10 * time.Second,
+				}).DialContext,
+			},
+		},
+
+		[]byte(cmd.P2PSecret),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return http_server.New(
+		fmt.Sprintf("%s:%d", cmd.P2PBindIP.IP, cmd.P2PBindPort),
+		handler,
+	), nil
+}
+
 func (cmd *WorkerCommand) gardenIsExternal() bool {
 	return cmd.ExternalGardenURL.URL != nil
 }
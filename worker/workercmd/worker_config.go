@@ -1,15 +1,19 @@
 package workercmd
 
 import (
+	"fmt"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
 )
 
 type WorkerConfig struct {
-	Name     string   `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
-	Tags     []string `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
-	TeamName string   `long:"team"  description:"The name of the team that this worker will be assigned to."`
+	Name     string        `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
+	Tags     []string      `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
+	Labels   []WorkerLabel `long:"label" value-name:"KEY=VALUE" description:"A label to set during registration, for matching against worker selectors. Can be specified multiple times."`
+	TeamName string        `long:"team"  description:"The name of the team that this worker will be assigned to."`
 
 	HTTPProxy  string `long:"http-proxy"  env:"http_proxy"                  description:"HTTP proxy endpoint to use for containers."`
 	HTTPSProxy string `long:"https-proxy" env:"https_proxy"                 description:"HTTPS proxy endpoint to use for containers."`
@@ -17,12 +21,25 @@ type WorkerConfig struct {
 
 	Ephemeral bool `long:"ephemeral" description:"If set, the worker will be immediately removed upon stalling."`
 
+	AllocatableCPU    atc.CPUQuantity    `long:"allocatable-cpu"    description:"CPU available for task resource requests, as a number of cores (e.g. 3.5) or millicores (e.g. 3500m). Defaults to the number of CPUs."`
+	AllocatableMemory atc.MemoryQuantity `long:"allocatable-memory" description:"Memory available for task resource requests (e.g. 16GB). Defaults to the machine's physical memory on Linux."`
+
 	Version string `long:"version" hidden:"true" description:"Version of the worker. This is normally baked in to the binary, so this flag is hidden."`
 }
 
 func (c WorkerConfig) Worker() atc.Worker {
+	var labels map[string]string
+	if len(c.Labels) > 0 {
+		labels = map[string]string{}
+		for _, label := range c.Labels {
+			labels[label.Key] = label.Value
+		}
+	}
+
 	return atc.Worker{
 		Tags:          c.Tags,
+		Labels:        labels,
+		Allocatable:   c.allocatable(),
 		Team:          c.TeamName,
 		Name:          c.Name,
 		StartTime:     time.Now().Unix(),
@@ -33,3 +50,47 @@ func (c WorkerConfig) Worker() atc.Worker {
 		Ephemeral:     c.Ephemeral,
 	}
 }
+
+func (c WorkerConfig) allocatable() *atc.ResourceRequests {
+	allocatable := atc.ResourceRequests{
+		CPU:    c.AllocatableCPU,
+		Memory: c.AllocatableMemory,
+	}
+
+	if allocatable.CPU == 0 {
+		allocatable.CPU = atc.CPUQuantity(runtime.NumCPU() * 1000)
+	}
+
+	if allocatable.Memory == 0 {
+		allocatable.Memory = atc.MemoryQuantity(totalMemory())
+	}
+
+	return &allocatable
+}
+
+type WorkerLabel struct {
+	Key   string
+	Value string
+}
+
+func (label *WorkerLabel) UnmarshalFlag(value string) error {
+	segs := strings.SplitN(value, "=", 2)
+	if len(segs) != 2 {
+		return fmt.Errorf("invalid label '%s': must be of the form KEY=VALUE", value)
+	}
+
+	err := atc.ValidateLabelKey(segs[0])
+	if err != nil {
+		return err
+	}
+
+	err = atc.ValidateLabelValue(segs[1])
+	if err != nil {
+		return err
+	}
+
+	label.Key = segs[0]
+	label.Value = segs[1]
+
+	return nil
+}